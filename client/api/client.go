@@ -0,0 +1,361 @@
+// Package api is a typed client of the pessimism REST API described by specs/openapi.json
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// apiKeyHeader ... Header that API keys are presented in
+	apiKeyHeader = "X-API-Key"
+	// defaultTimeout ... Upper bound on a single request unless a custom HTTP client is used
+	defaultTimeout = 30 * time.Second
+	// streamBufferSize ... Number of streamed events buffered ahead of the consumer
+	streamBufferSize = 64
+)
+
+// Error ... Failed request; the message is the one returned by the API
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+// Error ...
+func (e *Error) Error() string {
+	return fmt.Sprintf("api responded %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound ... Returns true if the error is the API reporting that no such resource exists
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Option ...
+type Option = func(*Client)
+
+// WithAPIKey ... Presents the key with every request
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.key = key
+	}
+}
+
+// WithHTTPClient ... Sends requests using the client rather than one with a 30s timeout
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.http = hc
+	}
+}
+
+// Client ... Client of a single pessimism API server
+type Client struct {
+	base string
+	key  string
+	http *http.Client
+}
+
+// NewClient ... Initializer; the base URL is the scheme & address of the server (E.G, http://localhost:8080)
+func NewClient(base string, opts ...Option) *Client {
+	c := &Client{
+		base: strings.TrimSuffix(base, "/"),
+		http: &http.Client{Timeout: defaultTimeout},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// CreateSession ... Creates the invariant session & returns its ID; requires the admin role
+func (c *Client) CreateSession(ctx context.Context, req SessionRequest) (string, error) {
+	var created struct {
+		ID string `json:"id"`
+	}
+
+	if err := c.do(ctx, http.MethodPost, "/v0/invariant", req, &created); err != nil {
+		return "", err
+	}
+
+	return created.ID, nil
+}
+
+// CreateSessions ... Creates every invariant session in order, or none if any can't be created, & returns
+// their IDs in request order; requires the admin role
+func (c *Client) CreateSessions(ctx context.Context, reqs []SessionRequest) ([]string, error) {
+	var created struct {
+		IDs []string `json:"ids"`
+	}
+
+	batch := struct {
+		Sessions []SessionRequest `json:"sessions"`
+	}{Sessions: reqs}
+
+	if err := c.do(ctx, http.MethodPost, "/v0/invariants/batch", batch, &created); err != nil {
+		return nil, err
+	}
+
+	return created.IDs, nil
+}
+
+// Sessions ... Lists every running invariant session
+func (c *Client) Sessions(ctx context.Context) ([]Session, error) {
+	var sessions []Session
+	if err := c.do(ctx, http.MethodGet, "/v0/invariant", nil, &sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Session ... Inspects the invariant session; fail with a not found Error if no such session exists
+func (c *Client) Session(ctx context.Context, id string) (*Session, error) {
+	session := &Session{}
+	if err := c.do(ctx, http.MethodGet, "/v0/invariant/"+url.PathEscape(id), nil, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// UpdateSession ... Applies the update to the running session & returns the updated session; requires
+// the admin role
+func (c *Client) UpdateSession(ctx context.Context, id string, update SessionUpdate) (*Session, error) {
+	session := &Session{}
+	if err := c.do(ctx, http.MethodPatch, "/v0/invariant/"+url.PathEscape(id), update, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// EndSession ... Ends the invariant session & returns its summary; requires the admin role
+func (c *Client) EndSession(ctx context.Context, id string) (*SessionSummary, error) {
+	summary := &SessionSummary{}
+	if err := c.do(ctx, http.MethodDelete, "/v0/invariant/"+url.PathEscape(id), nil, summary); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// Config ... Returns the settings that can be changed while running
+func (c *Client) Config(ctx context.Context) (*RuntimeConfig, error) {
+	cfg := &RuntimeConfig{}
+	if err := c.do(ctx, http.MethodGet, "/v0/config", nil, cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// UpdateConfig ... Changes settings while running & returns the resulting settings; nothing changes if
+// any setting is invalid. Requires the admin role
+func (c *Client) UpdateConfig(ctx context.Context, update RuntimeConfigUpdate) (*RuntimeConfig, error) {
+	cfg := &RuntimeConfig{}
+	if err := c.do(ctx, http.MethodPatch, "/v0/config", update, cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Pipelines ... Returns the live graph of every pipeline's components & directives
+func (c *Client) Pipelines(ctx context.Context) (*Graph, error) {
+	g := &Graph{}
+	if err := c.do(ctx, http.MethodGet, "/v0/pipelines", nil, g); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Components ... Lists every running component with its state, processed height & throughput
+func (c *Client) Components(ctx context.Context) ([]Component, error) {
+	var components []Component
+	if err := c.do(ctx, http.MethodGet, "/v0/components", nil, &components); err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
+
+// Alerts ... Returns the page of dispatched alerts matching the query, most recently dispatched first;
+// following pages are requested by setting the query's cursor to the page's NextCursor
+func (c *Client) Alerts(ctx context.Context, q AlertQuery) (*AlertPage, error) {
+	values := url.Values{}
+	for name, value := range map[string]string{
+		"network": string(q.Network), "severity": string(q.Severity), "cursor": q.Cursor,
+	} {
+		if value != "" {
+			values.Set(name, value)
+		}
+	}
+
+	if !q.From.IsZero() {
+		values.Set("from", q.From.Format(time.RFC3339))
+	}
+
+	if !q.To.IsZero() {
+		values.Set("to", q.To.Format(time.RFC3339))
+	}
+
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	path := "/v0/alerts"
+	if len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+
+	page := &AlertPage{}
+	if err := c.do(ctx, http.MethodGet, path, nil, page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// Health ... Returns the health of the server & its dependencies; unhealthy servers aren't an error
+func (c *Client) Health(ctx context.Context) (*Health, error) {
+	health := &Health{}
+	if err := c.do(ctx, http.MethodGet, "/health", nil, health, http.StatusServiceUnavailable); err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
+
+// Stream ... Streams live events of the topics, or of every topic when none are given, until the context
+// is done or the server disconnects; the returned channel is closed once the stream ends
+func (c *Client) Stream(ctx context.Context, topics ...Topic) (<-chan Event, error) {
+	u, err := url.Parse(c.base + "/v0/stream")
+	if err != nil {
+		return nil, err
+	}
+
+	u.Scheme = strings.Replace(u.Scheme, "http", "ws", 1)
+	if len(topics) > 0 {
+		names := make([]string, 0, len(topics))
+		for _, topic := range topics {
+			names = append(names, string(topic))
+		}
+
+		u.RawQuery = url.Values{"topics": {strings.Join(names, ",")}}.Encode()
+	}
+
+	header := http.Header{}
+	if c.key != "" {
+		header.Set(apiKeyHeader, c.key)
+	}
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			return nil, errorOf(resp)
+		}
+
+		return nil, err
+	}
+
+	events := make(chan Event, streamBufferSize)
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	go func() {
+		defer close(events)
+		defer conn.Close()
+
+		for {
+			var event Event
+			if rErr := conn.ReadJSON(&event); rErr != nil {
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// do ... Sends the JSON encoded body & decodes the response into the output; fail on non 2xx responses
+// other than the accepted statuses
+func (c *Client) do(ctx context.Context, method, path string, body, out any, accepted ...int) error {
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.base+path, reader)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.key != "" {
+		req.Header.Set(apiKeyHeader, c.key)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	ok := resp.StatusCode >= 200 && resp.StatusCode < 300
+	for _, status := range accepted {
+		ok = ok || resp.StatusCode == status
+	}
+
+	if !ok {
+		return errorOf(resp)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// errorOf ... Returns the error described by the failed response
+func errorOf(resp *http.Response) error {
+	var body struct {
+		Error string `json:"error"`
+	}
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err := json.Unmarshal(raw, &body); err != nil || body.Error == "" {
+		body.Error = strings.TrimSpace(string(raw))
+	}
+
+	return &Error{StatusCode: resp.StatusCode, Message: body.Error}
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/api"
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminKey = "admin-0123456789"
+
+// engineMock ... Holds sessions in memory
+type engineMock struct {
+	sessions map[engine.SessionID]*engine.Session
+}
+
+func (em *engineMock) CreateSession(req engine.SessionRequest) (engine.SessionID, error) {
+	id := engine.SessionID(fmt.Sprintf("session-%d", len(em.sessions)))
+	em.sessions[id] = &engine.Session{
+		ID:        id,
+		Invariant: req.Invariant,
+		Severity:  req.Severity,
+		Network:   req.Pipeline.Network,
+	}
+	em.sessions[id].SetCooldown(time.Duration(req.Cooldown))
+
+	return id, nil
+}
+
+func (em *engineMock) CreateSessions(reqs []engine.SessionRequest) ([]engine.SessionID, error) {
+	ids := make([]engine.SessionID, 0, len(reqs))
+	for _, req := range reqs {
+		id, _ := em.CreateSession(req)
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (em *engineMock) GetSession(id engine.SessionID) (*engine.Session, error) {
+	s, found := em.sessions[id]
+	if !found {
+		return nil, fmt.Errorf("%w for id: %s", engine.ErrSessionNotFound, id)
+	}
+
+	return s, nil
+}
+
+func (em *engineMock) Sessions() []*engine.Session {
+	sessions := make([]*engine.Session, 0, len(em.sessions))
+	for _, s := range em.sessions {
+		sessions = append(sessions, s)
+	}
+
+	return sessions
+}
+
+func (em *engineMock) EndSession(id engine.SessionID) (*engine.SessionSummary, error) {
+	s, err := em.GetSession(id)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(em.sessions, id)
+	summary := s.Summary()
+	return &summary, nil
+}
+
+func (em *engineMock) SetCooldown(id engine.SessionID, d time.Duration) error {
+	s, err := em.GetSession(id)
+	if err != nil {
+		return err
+	}
+
+	s.SetCooldown(d)
+	return nil
+}
+
+// pipelinesMock ... Reports every pipeline as live
+type pipelinesMock struct{}
+
+func (pm pipelinesMock) GetState(etl.PipelineID) (models.PipelineState, error) {
+	return models.LiveState, nil
+}
+
+func (pm pipelinesMock) States() map[etl.PipelineID]models.PipelineState {
+	return nil
+}
+
+func (pm pipelinesMock) Graph() etl.Graph {
+	return etl.Graph{Pipelines: []etl.GraphPipeline{{ID: 1, State: models.LiveState}}}
+}
+
+func (pm pipelinesMock) Stats() map[models.ComponentID]pipeline.ComponentStats {
+	return nil
+}
+
+func (pm pipelinesMock) Endpoints() map[models.Network]string {
+	return map[models.Network]string{}
+}
+
+func (pm pipelinesMock) SetEndpoint(models.Network, string) error {
+	return nil
+}
+
+// alertsMock ... Queries alerts directly from a history store
+type alertsMock struct {
+	store history.Store
+}
+
+func (am alertsMock) Alerts(q history.Query) ([]history.Record, error) {
+	return am.store.Query(q)
+}
+
+func (am alertsMock) Acknowledge(id, by string) (history.Acknowledgement, error) {
+	ack := history.Acknowledgement{By: by, Acknowledged: time.Now()}
+	return ack, am.store.Acknowledge(id, ack)
+}
+
+func Test_Client(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	bus := events.NewBus()
+	store := history.NewMemoryStore(0)
+	for _, id := range []string{"first", "second"} {
+		assert.NoError(t, store.Add(history.Record{ID: id, Invalidation: engine.Invalidation{Network: models.Layer1}}))
+	}
+
+	server := httptest.NewServer(api.NewHandlers(&engineMock{sessions: make(map[engine.SessionID]*engine.Session)},
+		pipelinesMock{}, api.WithEventBus(bus), api.WithAlerts(alertsMock{store}),
+		api.WithAPIKeys([]api.APIKey{{Key: adminKey, Role: api.Admin}})))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := NewClient(server.URL+"/", WithAPIKey(adminKey))
+
+	_, err := NewClient(server.URL).Sessions(ctx)
+	assert.Error(t, err, "Ensuring requests without a key are rejected")
+
+	id, err := c.CreateSession(ctx, SessionRequest{
+		Invariant: "LARGE_TX_VALUE",
+		Severity:  High,
+		Cooldown:  Duration(time.Minute),
+		Pipeline:  PipelineRequest{Network: Layer1},
+	})
+	assert.NoError(t, err)
+
+	sessions, err := c.Sessions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	ids, err := c.CreateSessions(ctx, []SessionRequest{
+		{Invariant: "LARGE_TX_VALUE", Pipeline: PipelineRequest{Network: Layer1}},
+		{Invariant: "LARGE_TX_VALUE", Pipeline: PipelineRequest{Network: Layer2}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ids, 2)
+
+	for _, batched := range ids {
+		_, err = c.EndSession(ctx, batched)
+		assert.NoError(t, err)
+	}
+
+	session, err := c.Session(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, High, session.Severity)
+	assert.Equal(t, Duration(time.Minute), session.Cooldown)
+	assert.Equal(t, models.LiveState, models.PipelineState(session.Pipeline.State))
+
+	cooldown := Duration(time.Hour)
+	session, err = c.UpdateSession(ctx, id, SessionUpdate{Cooldown: &cooldown})
+	assert.NoError(t, err)
+	assert.Equal(t, cooldown, session.Cooldown)
+
+	summary, err := c.EndSession(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, summary.SessionID)
+
+	_, err = c.Session(ctx, id)
+	assert.True(t, IsNotFound(err), "Ensuring ended sessions are no longer found")
+
+	graph, err := c.Pipelines(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, graph.Pipelines, 1)
+
+	components, err := c.Components(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, components)
+
+	page, err := c.Alerts(ctx, AlertQuery{Network: Layer1, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, page.Alerts, 1)
+	assert.Equal(t, "second", page.Alerts[0].ID)
+
+	page, err = c.Alerts(ctx, AlertQuery{Network: Layer1, Limit: 1, Cursor: page.NextCursor})
+	assert.NoError(t, err)
+	assert.Equal(t, "first", page.Alerts[0].ID)
+	assert.Empty(t, page.NextCursor, "Ensuring the last page has no cursor")
+
+	health, err := c.Health(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, Healthy, health.Status)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.Stream(streamCtx, InvalidationTopic)
+	assert.NoError(t, err)
+
+	// Events are published until received as the server subscribes once the upgrade completes
+	go func() {
+		for streamCtx.Err() == nil {
+			bus.Publish(engine.InvalidationTopic, engine.Invalidation{SessionID: "abc"})
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	select {
+	case event := <-stream:
+		assert.Equal(t, InvalidationTopic, event.Topic)
+		assert.Contains(t, string(event.Payload), `"session_id":"abc"`)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event was streamed")
+	}
+
+	_, err = c.Stream(ctx, "unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,342 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Severity ... Severity of an invariant's invalidations
+type Severity string
+
+const (
+	Low      Severity = "low"
+	Medium   Severity = "medium"
+	High     Severity = "high"
+	Critical Severity = "critical"
+)
+
+// Network ... Network that a pipeline reads from
+type Network string
+
+const (
+	Layer1 Network = "layer1"
+	Layer2 Network = "layer2"
+)
+
+// OracleType ... How a pipeline reads its network
+type OracleType string
+
+const (
+	// Live ... Reads new blocks as they're produced
+	Live OracleType = "live"
+	// Backfill ... Reads from a start height & then continues live
+	Backfill OracleType = "backfill"
+	// Backtest ... Reads an inclusive height range & then terminates
+	Backtest OracleType = "backtest"
+)
+
+// PipelineState ... Lifecycle state of a pipeline
+type PipelineState string
+
+// Duration ... Encoded as a Go duration string (E.G, "90s")
+type Duration time.Duration
+
+// MarshalJSON ... Encodes the duration as a Go duration string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON ... Decodes a duration string or a number of seconds
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var val any
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	switch v := val.(type) {
+	case float64:
+		*d = Duration(v * float64(time.Second))
+		return nil
+
+	case string:
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+
+		*d = Duration(parsed)
+		return nil
+
+	default:
+		return fmt.Errorf("invalid duration: %s", data)
+	}
+}
+
+// PipelineRequest ... Pipeline producing the data assessed by a session
+type PipelineRequest struct {
+	Network Network `json:"network"`
+	// Defaults to the invariant's input type when empty
+	RegisterType string `json:"register_type,omitempty"`
+	// Defaults to a live oracle when empty
+	OracleType OracleType `json:"oracle_type,omitempty"`
+
+	// Inclusive height range read by the pipeline; backtests require both heights and backfills require
+	// a start height
+	StartHeight *big.Int `json:"start_height,omitempty"`
+	EndHeight   *big.Int `json:"end_height,omitempty"`
+
+	Params   map[string]any `json:"params,omitempty"`
+	Priority int            `json:"priority,omitempty"`
+}
+
+// SessionRequest ... Invariant session to create
+type SessionRequest struct {
+	Invariant string `json:"invariant"`
+	// Params passed to the invariant constructor
+	Params map[string]any `json:"params,omitempty"`
+	// Optional; overrides the invariant's default severity
+	Severity Severity `json:"severity,omitempty"`
+	// Optional; minimum period between invalidations, those raised within it are suppressed
+	Cooldown Duration `json:"cooldown,omitempty"`
+
+	Pipeline PipelineRequest `json:"pipeline"`
+	// Required by correlated invariants only; pipeline joined with the above
+	Correlated *PipelineRequest `json:"correlated_pipeline,omitempty"`
+
+	// Optional; routes the session's invalidations rather than the default alert policy
+	AlertPolicy *AlertPolicy `json:"alert_policy,omitempty"`
+}
+
+// AlertPolicy ... Routing of a session's invalidations to alert destinations
+type AlertPolicy struct {
+	// Names of the alerted destinations; every destination is alerted when empty
+	Destinations []string `json:"destinations,omitempty"`
+	// Optional; invalidations less severe than this aren't alerted
+	MinSeverity Severity `json:"min_severity,omitempty"`
+}
+
+// Pipeline ... Pipeline producing a session's data
+type Pipeline struct {
+	ID int `json:"id"`
+	// Empty if the pipeline's state couldn't be read
+	State PipelineState `json:"state,omitempty"`
+}
+
+// Session ... Running invariant session
+type Session struct {
+	ID         string         `json:"id"`
+	Invariant  string         `json:"invariant"`
+	Params     map[string]any `json:"params,omitempty"`
+	Severity   Severity       `json:"severity"`
+	Cooldown   Duration       `json:"cooldown,omitempty"`
+	Network    Network        `json:"network"`
+	Pipeline   Pipeline       `json:"pipeline"`
+	Correlated *Pipeline      `json:"correlated_pipeline,omitempty"`
+	Created    time.Time      `json:"created"`
+
+	Assessed      int64 `json:"assessed"`
+	Invalidations int64 `json:"invalidations"`
+	Suppressed    int64 `json:"suppressed"`
+}
+
+// SessionSummary ... Counts of an ended session
+type SessionSummary struct {
+	SessionID string    `json:"session_id"`
+	Invariant string    `json:"invariant"`
+	Network   Network   `json:"network"`
+	Created   time.Time `json:"created"`
+	Ended     time.Time `json:"ended"`
+
+	Assessed      int64 `json:"assessed"`
+	Invalidations int64 `json:"invalidations"`
+	Suppressed    int64 `json:"suppressed"`
+}
+
+// SessionUpdate ... Changes to a running session; omitted settings are unchanged
+type SessionUpdate struct {
+	// Applies from the session's next invalidation
+	Cooldown *Duration `json:"cooldown,omitempty"`
+}
+
+// RuntimeConfig ... Settings that can be changed while running
+type RuntimeConfig struct {
+	// Minimum level logged (E.G, debug, info, warn, error)
+	LogLevel string `json:"log_level"`
+	// Period between the polls of every oracle
+	PollInterval Duration `json:"poll_interval"`
+	// Scheme & host of the RPC endpoint of every network
+	Endpoints map[Network]string `json:"endpoints"`
+}
+
+// RuntimeConfigUpdate ... Changes to the runtime settings; omitted settings are unchanged. Endpoints only
+// apply to sessions created afterwards
+type RuntimeConfigUpdate struct {
+	LogLevel     *string            `json:"log_level,omitempty"`
+	PollInterval *Duration          `json:"poll_interval,omitempty"`
+	Endpoints    map[Network]string `json:"endpoints,omitempty"`
+}
+
+// GraphPipeline ... Pipeline summary within the component graph
+type GraphPipeline struct {
+	ID       int           `json:"id"`
+	Register string        `json:"register"`
+	State    PipelineState `json:"state"`
+}
+
+// GraphNode ... Component, or pipeline output channel, within the component graph
+type GraphNode struct {
+	ID       string  `json:"id"`
+	Type     string  `json:"type"`
+	Network  Network `json:"network,omitempty"`
+	Register string  `json:"register,omitempty"`
+	// Pipelines containing the node in ascending order
+	Pipelines []int `json:"pipelines"`
+	// Read activity of oracles; empty for every other node
+	State PipelineState `json:"state,omitempty"`
+}
+
+// GraphEdge ... Output directive from a producer component onto a consumer's input channel
+type GraphEdge struct {
+	Directive int    `json:"directive"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Pipeline  int    `json:"pipeline"`
+	// False while the owning pipeline is paused
+	Attached bool `json:"attached"`
+}
+
+// Graph ... Point in time snapshot of every pipeline's wired components
+type Graph struct {
+	Pipelines []GraphPipeline `json:"pipelines"`
+	Nodes     []GraphNode     `json:"nodes"`
+	Edges     []GraphEdge     `json:"edges"`
+}
+
+// Component ... Running component shared by the listed pipelines
+type Component struct {
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	Network   Network `json:"network"`
+	Register  string  `json:"register"`
+	Pipelines []int   `json:"pipelines"`
+	// Read activity of oracles; empty for every other component
+	State PipelineState `json:"state,omitempty"`
+	// Last height fully transited by oracles reading by height; nil until a height is processed
+	Height *big.Int `json:"height,omitempty"`
+
+	ItemsIn      uint64     `json:"items_in"`
+	ItemsOut     uint64     `json:"items_out"`
+	AvgLatency   Duration   `json:"avg_latency"`
+	MaxLatency   Duration   `json:"max_latency"`
+	LastActivity *time.Time `json:"last_activity,omitempty"`
+}
+
+// HealthStatus ... Outcome of a health check
+type HealthStatus string
+
+const (
+	Healthy   HealthStatus = "healthy"
+	Unhealthy HealthStatus = "unhealthy"
+)
+
+// CheckResult ... Outcome of a single dependency check
+type CheckResult struct {
+	Status HealthStatus `json:"status"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// Health ... Overall status & the outcome of every dependency check
+type Health struct {
+	Status HealthStatus           `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Topic ... Category of streamed events
+type Topic string
+
+const (
+	// InvalidationTopic ... Payloads are Invalidation values
+	InvalidationTopic Topic = "invariant_invalidation"
+	// SessionEndedTopic ... Payloads are SessionSummary values
+	SessionEndedTopic Topic = "invariant_session_ended"
+	// PipelineStateTopic ... Payloads are StateTransition values
+	PipelineStateTopic Topic = "pipeline_state"
+)
+
+// Event ... Streamed event; the payload is decoded per the topic
+type Event struct {
+	Topic     Topic           `json:"topic"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// Invalidation ... Payload of invalidation events
+type Invalidation struct {
+	SessionID string   `json:"session_id"`
+	Invariant string   `json:"invariant"`
+	Severity  Severity `json:"severity"`
+	Network   Network  `json:"network"`
+	// Nil when not derived from a block
+	Height     *big.Int  `json:"height,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+	Suppressed int       `json:"suppressed"`
+
+	Message     string         `json:"message"`
+	Context     map[string]any `json:"context,omitempty"`
+	Fingerprint string         `json:"fingerprint"`
+}
+
+// Delivery ... Delivery state of an alert at a single destination
+type Delivery struct {
+	Destination string `json:"destination"`
+	// One of pending, sent, failed or dropped
+	Status  string    `json:"status"`
+	Error   string    `json:"error,omitempty"`
+	Updated time.Time `json:"updated"`
+}
+
+// Acknowledgement ... Acknowledgement of an alert by an operator
+type Acknowledgement struct {
+	By           string    `json:"by"`
+	Acknowledged time.Time `json:"acknowledged"`
+	Expires      time.Time `json:"expires"`
+}
+
+// Alert ... Dispatched alert & its delivery state at every destination it was routed to
+type Alert struct {
+	ID           string       `json:"id"`
+	Invalidation Invalidation `json:"invalidation"`
+	Dispatched   time.Time    `json:"dispatched"`
+	Deliveries   []Delivery   `json:"deliveries"`
+	// Nil until the alert is acknowledged
+	Acknowledgement *Acknowledgement `json:"acknowledgement,omitempty"`
+}
+
+// AlertQuery ... Filters applied to the alert history; zero values match every alert
+type AlertQuery struct {
+	Network  Network
+	Severity Severity
+	// Alerts dispatched at or after From & before To
+	From time.Time
+	To   time.Time
+	// NextCursor of the previous page; the first page is returned when empty
+	Cursor string
+	// Maximum number of alerts returned; the server's default when zero
+	Limit int
+}
+
+// AlertPage ... Page of dispatched alerts, most recently dispatched first
+type AlertPage struct {
+	Alerts []Alert `json:"alerts"`
+	// Empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// StateTransition ... Payload of pipeline state events
+type StateTransition struct {
+	Pipeline int           `json:"pipeline"`
+	From     PipelineState `json:"from"`
+	To       PipelineState `json:"to"`
+}
@@ -2,9 +2,10 @@ package main
 
 import (
 	"context"
-	"sync"
 
 	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/checkpoint"
+	"github.com/base-org/pessimism/internal/conduit/etl"
 	"github.com/base-org/pessimism/internal/conduit/models"
 	"github.com/base-org/pessimism/internal/conduit/pipeline"
 	"github.com/base-org/pessimism/internal/conduit/registry"
@@ -14,14 +15,10 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	outChanID   = 0x420
-	interChanID = 0x42
-)
-
 func main() {
 	/*
 		This a simple experimental POC showcasing an implicit CONTRACT_CREATE_TX register pipeline
+		constructed by the ETL manager
 
 		This is done to:
 		A) Prove that the Oracle and Pipe components operate as expected and are able to channel data between each other
@@ -39,76 +36,54 @@ func main() {
 
 	logging.NoContext().Info("pessimism boot up")
 
-	l1OracleCfg := &config.OracleConfig{
-		RPCEndpoint: cfg.L1RpcEndpoint,
-		StartHeight: nil,
-		EndHeight:   nil}
-
-	// 1. Configure blackhole tx pipe component
-	createRegister, err := registry.GetRegister(registry.ContractCreateTX)
-	if err != nil {
-		logging.NoContext().Fatal("error creating register", zap.Error(err))
-	}
-
-	initPipe, success := createRegister.ComponentConstructor.(pipeline.PipeConstructorFunc)
-	if !success {
-		logging.NoContext().Fatal("could not read component constructor Pipe constructor type")
-	}
-
-	inputChan := make(chan models.TransitData)
-
-	createTxPipe, err := initPipe(appCtx, inputChan)
-	if err != nil {
-		logging.NoContext().Fatal("error during pipe initialization", zap.Error(err))
-	}
-
-	register, err := registry.GetRegister(registry.GethBlock)
-	if err != nil {
-		logging.NoContext().Fatal("error getting register", zap.String("type", string(registry.GethBlock)), zap.Error(err))
-	}
-
-	init, success := register.ComponentConstructor.(pipeline.OracleConstructor)
-	if !success {
-		logging.NoContext().Fatal("Could not read constructor value")
+	if cfg.PluginDirectory != "" {
+		if err := registry.LoadPlugins(cfg.PluginDirectory); err != nil {
+			logging.NoContext().Fatal("error loading register plugins", zap.Error(err))
+		}
 	}
 
-	go func() {
-		if routineErr := createTxPipe.EventLoop(); routineErr != nil {
-			logging.NoContext().Error("Error received from oracle event loop", zap.Error(routineErr))
+	l1 := cfg.Networks[models.Layer1]
+	l1OracleCfg := &config.OracleConfig{
+		RPCEndpoint:       l1.RPCEndpoint,
+		StartHeight:       nil,
+		EndHeight:         nil,
+		ConfirmationDepth: l1.ConfirmationDepth,
+		PollInterval:      l1.PollInterval}
+
+	managerOpts := make([]etl.ManagerOption, 0)
+	if cfg.CheckpointPath != "" {
+		store, err := checkpoint.NewFileStore(cfg.CheckpointPath)
+		if err != nil {
+			logging.NoContext().Fatal("error loading checkpoint store", zap.Error(err))
 		}
-	}()
 
-	ethClient := client.EthClient{}
-	l1Oracle, err := init(appCtx, pipeline.LiveOracle, l1OracleCfg, &ethClient)
-	if err != nil {
-		logging.NoContext().Fatal("error initializing oracle", zap.Error(err))
+		managerOpts = append(managerOpts, etl.WithCheckpointStore(store))
 	}
 
-	if err := l1Oracle.AddDirective(interChanID, inputChan); err != nil {
-		logging.NoContext().Fatal("error adding directive", zap.Int("interChanID", interChanID), zap.Error(err))
-	}
+	manager := etl.NewManager(appCtx, func() client.EthClientInterface {
+		return &client.EthClient{}
+	}, managerOpts...)
+	defer manager.Shutdown()
 
 	outputChan := make(chan models.TransitData)
 
-	if err := createTxPipe.AddDirective(outChanID, outputChan); err != nil {
-		logging.NoContext().Fatal("error adding directive", zap.Int("outChanID", outChanID), zap.Error(err))
+	// Manager constructs & wires the GETH_BLOCK oracle dependency of the CONTRACT_CREATE_TX pipe
+	if _, err := manager.CreatePipeline(&etl.PipelineConfig{
+		Network:    models.Layer1,
+		DataType:   registry.ContractCreateTX,
+		OracleType: pipeline.LiveOracle,
+		OracleCfg:  l1OracleCfg,
+	}, outputChan); err != nil {
+		logging.NoContext().Fatal("error creating pipeline", zap.Error(err))
 	}
 
-	wg := sync.WaitGroup{}
-	wg.Add(1)
-
-	go func() {
-		if routineErr := l1Oracle.EventLoop(); routineErr != nil {
-			logging.NoContext().Error("Error received from oracle event loop", zap.Error(routineErr))
-		}
-	}()
-
 	for td := range outputChan {
 		logging.NoContext().Info("Received Contract creation Transaction", zap.Any("transitData", td))
 
-		parsedTx, success := td.Value.(types.Transaction)
-		if !success {
-			logging.NoContext().Error("Could not parse transaction value")
+		parsedTx, err := models.ValueAs[*types.Transaction](td)
+		if err != nil {
+			logging.NoContext().Error("Could not parse transaction value", zap.Error(err))
+			continue
 		}
 
 		logging.NoContext().Info("As parsed transaction", zap.Any("parsedTX", parsedTx))
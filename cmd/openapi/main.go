@@ -0,0 +1,20 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/base-org/pessimism/internal/api"
+)
+
+// Writes the OpenAPI document of the REST API to stdout; used to regenerate specs/openapi.json
+func main() {
+	doc, err := api.OpenAPI()
+	if err != nil {
+		log.Fatalf("could not generate openapi document: %s", err)
+	}
+
+	if _, err = os.Stdout.Write(doc); err != nil {
+		log.Fatalf("could not write openapi document: %s", err)
+	}
+}
@@ -1,7 +1,383 @@
 package main
 
-import "log"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert"
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/api"
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/checkpoint"
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/base-org/pessimism/internal/sink"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+// serverShutdownTimeout ... Upper bound on waiting for in-flight API requests on shutdown
+const serverShutdownTimeout = 10 * time.Second
 
 func main() {
-	log.Println("I have yet to be implemented")
+	appCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	configPath := pflag.String("config", "config.env", "env or YAML (.yaml, .yml) config file")
+	flags := config.RegisterFlags(pflag.CommandLine)
+	pflag.Parse()
+
+	cfg, cErr := config.Load(*configPath, flags)
+	if cErr != nil {
+		log.Fatalf("error loading config: %s", cErr)
+	}
+
+	if vErr := cfg.Validate(); vErr != nil {
+		log.Fatalf("invalid config: %s", vErr)
+	}
+
+	logging.NewLogger(cfg.LoggerConfig, cfg.IsProduction())
+	logging.NoContext().Info("pessimism boot up", zap.String("profile", string(cfg.Environment)))
+
+	if err := registry.SetPollInterval(cfg.PollInterval); err != nil {
+		logging.NoContext().Fatal("error setting poll interval", zap.Error(err))
+	}
+
+	if cfg.PluginDirectory != "" {
+		if err := registry.LoadPlugins(cfg.PluginDirectory); err != nil {
+			logging.NoContext().Fatal("error loading register plugins", zap.Error(err))
+		}
+	}
+
+	// Register params are only checked once plugins have registered their register types
+	for rt := range cfg.RegisterParams {
+		if _, err := registry.GetRegister(rt); err != nil {
+			logging.NoContext().Fatal("error configuring register params", zap.Error(err))
+		}
+	}
+
+	bus := events.NewBus()
+	endpoints := cfg.Endpoints()
+
+	// Shared by oracles & invariants so that all requests to an endpoint are limited together
+	limiters := client.NewLimiters()
+	for _, nc := range cfg.Networks {
+		limiters.SetLimit(nc.RPCEndpoint, nc.RateLimit)
+	}
+
+	// Registered with the default registry so that RPC health can be observed per provider
+	metrics, err := client.NewMetrics(prometheus.DefaultRegisterer)
+	if err != nil {
+		logging.NoContext().Fatal("error registering RPC metrics", zap.Error(err))
+	}
+
+	managerOpts := []etl.ManagerOption{
+		etl.WithEventBus(bus),
+		etl.WithNetworks(cfg.Networks),
+		etl.WithRegisterParams(cfg.RegisterParams),
+		etl.WithBackoff(cfg.RPCBackoff),
+		etl.WithTimeouts(cfg.RPCTimeouts),
+		etl.WithLimiters(limiters),
+		etl.WithFetchBatchSize(cfg.RPCBatchSize),
+		etl.WithMetrics(metrics),
+	}
+
+	if cfg.RPCCacheSize > 0 {
+		managerOpts = append(managerOpts, etl.WithBlockCache(client.NewBlockCache(cfg.RPCCacheSize)))
+	}
+
+	if cfg.CheckpointPath != "" {
+		store, err := checkpoint.NewFileStore(cfg.CheckpointPath)
+		if err != nil {
+			logging.NoContext().Fatal("error loading checkpoint store", zap.Error(err))
+		}
+
+		managerOpts = append(managerOpts, etl.WithCheckpointStore(store))
+	}
+
+	manager := etl.NewManager(appCtx, func() client.EthClientInterface {
+		return &client.EthClient{}
+	}, managerOpts...)
+
+	clients := dialClients(appCtx, cfg.Networks, cfg.RPCBackoff, cfg.RPCTimeouts, limiters, metrics)
+
+	invalidations := make(chan engine.Invalidation)
+
+	alertHistory := history.Store(history.NewMemoryStore(history.DefaultLimit))
+	if cfg.AlertHistoryPath != "" {
+		store, err := history.NewFileStore(cfg.AlertHistoryPath, history.DefaultLimit)
+		if err != nil {
+			logging.NoContext().Fatal("error loading alert history", zap.Error(err))
+		}
+
+		alertHistory = store
+	}
+
+	alertCfg, lErr := loadAlertConfig(cfg.AlertConfigPath)
+	if lErr != nil {
+		logging.NoContext().Fatal("error loading alert config", zap.Error(lErr))
+	}
+
+	alerts, aErr := startAlerting(appCtx, alertCfg, invalidations, alertHistory)
+	if aErr != nil {
+		logging.NoContext().Fatal("error starting alerting", zap.Error(aErr))
+	}
+
+	engineOpts := []engine.Option{engine.WithEventBus(bus), engine.WithClients(clients),
+		engine.WithPolicyRouter(alerts)}
+	if cfg.EngineWorkers > 0 {
+		engineOpts = append(engineOpts, engine.WithWorkers(cfg.EngineWorkers))
+	}
+
+	riskEngine := engine.NewEngine(appCtx, manager, invalidations, engineOpts...)
+
+	reload := &reloader{path: *configPath, flags: flags, cfg: cfg, alertCfg: alertCfg, alerts: alerts}
+	go reload.run(appCtx)
+
+	sinks := make([]sink.Sink, 0)
+	if cfg.PipelineDefinitionsPath != "" {
+		defs, err := etl.LoadDefinitions(cfg.PipelineDefinitionsPath)
+		if err != nil {
+			logging.NoContext().Fatal("error loading pipeline definitions", zap.Error(err))
+		}
+
+		for _, def := range defs.Pipelines {
+			defSinks, sErr := startDefinition(appCtx, manager, def)
+			if sErr != nil {
+				logging.NoContext().Fatal("error starting pipeline definition",
+					zap.String("pipeline", def.Name), zap.Error(sErr))
+			}
+
+			sinks = append(sinks, defSinks...)
+		}
+	}
+
+	if cfg.SessionBootstrapPath != "" {
+		if err := bootstrapSessions(riskEngine, cfg.SessionBootstrapPath); err != nil {
+			logging.NoContext().Fatal("error bootstrapping invariant sessions", zap.Error(err))
+		}
+	}
+
+	authOpts, oErr := apiAuthOptions(cfg)
+	if oErr != nil {
+		logging.NoContext().Fatal("error configuring api", zap.Error(oErr))
+	}
+
+	apiOpts := append(healthChecks(endpoints, clients, alerts), api.WithEventBus(bus), api.WithAlerts(alerts))
+	apiOpts = append(apiOpts, authOpts...)
+	server := api.NewServer(appCtx, &api.Config{Host: cfg.APIHost, Port: cfg.APIPort},
+		api.NewHandlers(riskEngine, manager, apiOpts...))
+	if err := server.Start(); err != nil {
+		logging.NoContext().Fatal("error starting api server", zap.Error(err))
+	}
+
+	<-appCtx.Done()
+	logging.NoContext().Info("pessimism shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logging.NoContext().Error("error shutting down api server", zap.Error(err))
+	}
+	cancel()
+
+	riskEngine.Shutdown()
+	alerts.Shutdown()
+	if err := alertHistory.Close(); err != nil {
+		logging.NoContext().Error("error closing alert history", zap.Error(err))
+	}
+	manager.Shutdown()
+	for _, s := range sinks {
+		if err := s.Close(); err != nil {
+			logging.NoContext().Error("error closing sink", zap.Error(err))
+		}
+	}
+}
+
+// startDefinition ... Creates the definition's pipeline and writes its output to the definition's sinks
+// until the context is done
+func startDefinition(ctx context.Context, manager *etl.Manager, def etl.PipelineDefinition) ([]sink.Sink, error) {
+	sinks := make([]sink.Sink, 0, len(def.Sinks))
+	for _, sinkCfg := range def.Sinks {
+		s, err := sink.New(def.Name, sinkCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, s)
+	}
+
+	output := make(chan models.TransitData)
+	id, err := manager.SubmitPipeline(def.PipelineRequest, output)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.NoContext().Info("Started pipeline definition",
+		zap.String("pipeline", def.Name), zap.Int("id", int(id)))
+
+	go func() {
+		for {
+			select {
+			case td := <-output:
+				for _, s := range sinks {
+					if wErr := s.Write(td); wErr != nil {
+						logging.NoContext().Error("error writing to sink",
+							zap.String("pipeline", def.Name), zap.Error(wErr))
+					}
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return sinks, nil
+}
+
+// bootstrapSessions ... Creates every session of the bootstrap file, or none at all
+func bootstrapSessions(riskEngine *engine.Engine, path string) error {
+	boot, err := engine.LoadBootstrap(path)
+	if err != nil {
+		return err
+	}
+
+	ids, err := riskEngine.CreateSessions(boot.Requests())
+	if err != nil {
+		return err
+	}
+
+	for i, id := range ids {
+		logging.NoContext().Info("Started bootstrap session",
+			zap.String("session", boot.Sessions[i].Name), zap.String("session_id", string(id)))
+	}
+
+	return nil
+}
+
+// loadAlertConfig ... Reads the alert config file; invalidations are only logged when no file is configured
+func loadAlertConfig(path string) (*alert.Config, error) {
+	if path == "" {
+		return alert.DefaultConfig(), nil
+	}
+
+	return alert.LoadConfig(path)
+}
+
+// startAlerting ... Routes every invalidation to the configured destinations & records them in the history
+func startAlerting(ctx context.Context, alertCfg *alert.Config, invalidations <-chan engine.Invalidation,
+	store history.Store) (*alert.Manager, error) {
+	dests, err := alertCfg.Construct()
+	if err != nil {
+		return nil, err
+	}
+
+	return alert.NewManager(ctx, invalidations, dests,
+		alert.WithDefaultPolicy(alertCfg.DefaultPolicy), alert.WithRoutes(alertCfg.Routes),
+		alert.WithThrottle(alertCfg.Throttle), alert.WithHistory(store),
+		alert.WithAckPolicy(alertCfg.Acknowledgement), alert.WithDigest(alertCfg.Digest))
+}
+
+// apiAuthOptions ... Returns the API's authentication & rate limiting options; fail if either is invalid
+func apiAuthOptions(cfg *config.Config) ([]api.Option, error) {
+	keys, err := api.ParseAPIKeys(cfg.APIKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	keyLimit, err := api.NewRateLimit(cfg.APIKeyRateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api key rate limit: %w", err)
+	}
+
+	ipLimit, err := api.NewRateLimit(cfg.APIIPRateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api ip rate limit: %w", err)
+	}
+
+	return []api.Option{api.WithAPIKeys(keys), api.WithKeyRateLimit(keyLimit), api.WithIPRateLimit(ipLimit)}, nil
+}
+
+// healthChecks ... Returns the health endpoint checks of every configured RPC endpoint & every alert
+// destination that can be checked
+func healthChecks(endpoints map[models.Network]string, clients invariant.Clients,
+	alerts *alert.Manager) []api.Option {
+	opts := make([]api.Option, 0)
+
+	for n, endpoint := range endpoints {
+		if endpoint == "" {
+			continue
+		}
+
+		network := n
+		opts = append(opts, api.WithHealthCheck(fmt.Sprintf("%s_rpc", network), func(ctx context.Context) error {
+			ec, err := clients.Client(network)
+			if err != nil {
+				return err
+			}
+
+			_, err = ec.HeaderByNumber(ctx, nil)
+			return err
+		}))
+	}
+
+	for name, checker := range alerts.Checkers() {
+		opts = append(opts, api.WithHealthCheck("destination:"+name, checker.Check))
+	}
+
+	return opts
+}
+
+// dialClients ... Dials a client for every configured network; networks whose endpoint can't be dialed
+// are logged and omitted so that only invariants reading their state fail, while endpoints serving another
+// chain than configured are fatal. Calls are recorded by the metrics, bounded by the timeouts, rate limited
+// by the endpoint's limiter & failed calls are retried with the backoff
+func dialClients(ctx context.Context, networks map[models.Network]config.NetworkConfig, backoff client.Backoff,
+	timeouts client.Timeouts, limiters *client.Limiters, metrics *client.Metrics) invariant.Clients {
+	clients := make(invariant.Clients)
+
+	for n, nc := range networks {
+		if nc.RPCEndpoint == "" {
+			continue
+		}
+
+		ctxTimeout, ctxCancel := context.WithTimeout(ctx, time.Second*time.Duration(models.EthClientTimeout))
+		ec := client.NewRateLimitedClient(
+			client.NewTimeoutClient(client.NewMeteredClient(&client.EthClient{}, metrics), timeouts), limiters)
+		err := ec.DialContext(ctxTimeout, nc.RPCEndpoint)
+
+		rc := client.NewRetryClient(ec, backoff)
+		if err == nil && nc.ChainID != 0 {
+			err = client.VerifyChainID(ctxTimeout, rc, nc.RPCEndpoint, nc.ChainID)
+		}
+		ctxCancel()
+
+		if errors.Is(err, client.ErrChainIDMismatch) {
+			logging.NoContext().Fatal("error verifying invariant client",
+				zap.String("network", string(n)), zap.Error(err))
+		}
+
+		if err != nil {
+			logging.NoContext().Error("error dialing invariant client",
+				zap.String("network", string(n)), zap.Error(err))
+			continue
+		}
+
+		clients[n] = rc
+	}
+
+	return clients
 }
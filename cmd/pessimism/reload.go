@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+
+	"github.com/base-org/pessimism/internal/alert"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// reloader ... Re-reads the config file on SIGHUP, applies the settings that can change at runtime & logs
+// every change that requires a restart
+type reloader struct {
+	path string
+	// Keep taking precedence over the file
+	flags    *config.Flags
+	cfg      *config.Config
+	alertCfg *alert.Config
+	alerts   *alert.Manager
+}
+
+// run ... Reloads on every SIGHUP until the context is done
+func (r *reloader) run(ctx context.Context) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	defer signal.Stop(hangups)
+
+	for {
+		select {
+		case <-hangups:
+			r.reload()
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reload ... Applies the reloadable changes of the config file; invalid files change nothing
+func (r *reloader) reload() {
+	logger := logging.NoContext()
+	logger.Info("Reloading config", zap.String("path", r.path))
+
+	cfg, err := config.Reload(r.path, r.flags)
+	if err != nil {
+		logger.Error("error reloading config; keeping the running config", zap.Error(err))
+		return
+	}
+
+	if vErr := cfg.Validate(); vErr != nil {
+		logger.Error("invalid reloaded config; keeping the running config", zap.Error(vErr))
+		return
+	}
+
+	// Alert routing is read before anything is applied so that an invalid alert config changes nothing
+	alertCfg, err := loadAlertConfig(cfg.AlertConfigPath)
+	if err != nil {
+		logger.Error("error reloading alert config; keeping the running config", zap.Error(err))
+		return
+	}
+
+	if sErr := r.alerts.SetRouting(alertCfg.DefaultPolicy, alertCfg.Routes); sErr != nil {
+		logger.Error("invalid reloaded alert routing; keeping the running config", zap.Error(sErr))
+		return
+	}
+
+	if cfg.LoggerConfig.UseCustom && r.cfg.LoggerConfig.UseCustom {
+		logging.SetLevel(zapcore.Level(cfg.LoggerConfig.Level))
+	}
+
+	if pErr := registry.SetPollInterval(cfg.PollInterval); pErr != nil {
+		logger.Error("error applying poll interval", zap.Error(pErr))
+	}
+
+	for _, change := range config.Diff(r.cfg, cfg) {
+		if change.Reloadable {
+			logger.Info("Applied config change", zap.String("setting", change.Setting))
+		} else {
+			logger.Warn("Config change requires a restart", zap.String("setting", change.Setting))
+		}
+	}
+
+	for setting, changed := range map[string]bool{
+		"Destinations":    !reflect.DeepEqual(r.alertCfg.Destinations, alertCfg.Destinations),
+		"Templates":       !reflect.DeepEqual(r.alertCfg.Templates, alertCfg.Templates),
+		"Throttle":        !reflect.DeepEqual(r.alertCfg.Throttle, alertCfg.Throttle),
+		"Acknowledgement": !reflect.DeepEqual(r.alertCfg.Acknowledgement, alertCfg.Acknowledgement),
+		"Digest":          !reflect.DeepEqual(r.alertCfg.Digest, alertCfg.Digest),
+	} {
+		if changed {
+			logger.Warn("Alert config change requires a restart", zap.String("setting", setting))
+		}
+	}
+
+	// Settings requiring a restart keep their running values so that they're reported until restarted
+	r.cfg.PollInterval = cfg.PollInterval
+	r.cfg.AlertConfigPath = cfg.AlertConfigPath
+	if cfg.LoggerConfig.UseCustom && r.cfg.LoggerConfig.UseCustom {
+		r.cfg.LoggerConfig.Level = cfg.LoggerConfig.Level
+	}
+
+	r.alertCfg.DefaultPolicy, r.alertCfg.Routes = alertCfg.DefaultPolicy, alertCfg.Routes
+	logger.Info("Reloaded config", zap.String("path", r.path))
+}
@@ -0,0 +1,118 @@
+package alert
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+const (
+	// defaultAckTimeout ... Period that acknowledgements suppress re-alerts for by default
+	defaultAckTimeout = 24 * time.Hour
+	// defaultAckResolveAfter ... Period without invalidations after which a condition is resolved by default
+	defaultAckResolveAfter = time.Hour
+)
+
+// AckPolicy ... Suppression of re-alerts following the acknowledgement of an alert; re-alerts of the
+// acknowledged condition are suppressed until the condition resolves or the acknowledgement times out
+type AckPolicy struct {
+	// Optional; period that re-alerts are suppressed for, defaults to 24h
+	Timeout models.Duration `json:"timeout,omitempty"`
+	// Optional; conditions without invalidations for this period are resolved, defaults to 1h
+	ResolveAfter models.Duration `json:"resolve_after,omitempty"`
+}
+
+// validate ... Ensures neither period is negative
+func (ap AckPolicy) validate() error {
+	if ap.Timeout < 0 || ap.ResolveAfter < 0 {
+		return fmt.Errorf("acknowledgement periods must not be negative")
+	}
+
+	return nil
+}
+
+// ack ... Acknowledged condition
+type ack struct {
+	expires time.Time
+	// Time of the latest invalidation of the condition, or of the acknowledgement if none followed
+	observed time.Time
+	// Number of invalidations suppressed since the acknowledgement
+	suppressed int
+}
+
+// acknowledger ... Tracks acknowledged conditions; accessed by both the routing routine & API callers
+type acknowledger struct {
+	timeout      time.Duration
+	resolveAfter time.Duration
+
+	mu   sync.Mutex
+	acks map[conditionKey]*ack
+}
+
+// newAcknowledger ... Initializer; defaults are used for unset periods
+func newAcknowledger(ap AckPolicy) *acknowledger {
+	a := &acknowledger{
+		timeout:      time.Duration(ap.Timeout),
+		resolveAfter: time.Duration(ap.ResolveAfter),
+		acks:         make(map[conditionKey]*ack),
+	}
+
+	if a.timeout == 0 {
+		a.timeout = defaultAckTimeout
+	}
+
+	if a.resolveAfter == 0 {
+		a.resolveAfter = defaultAckResolveAfter
+	}
+
+	return a
+}
+
+// acknowledge ... Suppresses re-alerts of the condition; returns the time the acknowledgement expires at.
+// Acknowledgements that have ended are forgotten
+func (a *acknowledger) acknowledge(key conditionKey, at time.Time) time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for k, ak := range a.acks {
+		if a.ended(ak, at) {
+			delete(a.acks, k)
+		}
+	}
+
+	expires := at.Add(a.timeout)
+	a.acks[key] = &ack{expires: expires, observed: at}
+
+	return expires
+}
+
+// suppresses ... Returns true if the invalidation's condition is acknowledged; the first invalidation
+// after an acknowledgement ends includes those suppressed by it in its suppressed count
+func (a *acknowledger) suppresses(inval *engine.Invalidation) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := conditionKey{session: inval.SessionID, fingerprint: inval.Fingerprint}
+	ak, found := a.acks[key]
+	if !found {
+		return false
+	}
+
+	if a.ended(ak, inval.Timestamp) {
+		delete(a.acks, key)
+		inval.Suppressed += ak.suppressed
+		return false
+	}
+
+	ak.observed = inval.Timestamp
+	ak.suppressed += 1 + inval.Suppressed
+	return true
+}
+
+// ended ... Returns true if the acknowledgement has timed out or its condition resolved by the time
+func (a *acknowledger) ended(ak *ack, at time.Time) bool {
+	return !at.Before(ak.expires) || at.Sub(ak.observed) >= a.resolveAfter
+}
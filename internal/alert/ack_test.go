@@ -0,0 +1,132 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Acknowledger(t *testing.T) {
+	start := time.Unix(0, 0)
+	key := conditionKey{session: "session", fingerprint: "x"}
+
+	type step struct {
+		at          time.Duration
+		fingerprint string
+
+		suppressed bool
+		// Expected suppressed count of invalidations that aren't suppressed
+		count int
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		steps []step
+	}{
+		{
+			name:        "Acknowledged Condition",
+			description: "Re-alerts of the acknowledged condition should be suppressed",
+			steps: []step{
+				{at: time.Minute, fingerprint: "x", suppressed: true},
+				{at: 30 * time.Minute, fingerprint: "x", suppressed: true},
+			},
+		},
+		{
+			name:        "Other Condition",
+			description: "Other conditions of the session shouldn't be suppressed",
+			steps: []step{
+				{at: time.Minute, fingerprint: "y"},
+			},
+		},
+		{
+			name:        "Resolved Condition",
+			description: "Conditions without invalidations for the resolve period should alert again",
+			steps: []step{
+				{at: time.Minute, fingerprint: "x", suppressed: true},
+				{at: 2 * time.Hour, fingerprint: "x", count: 1},
+				{at: 2*time.Hour + time.Minute, fingerprint: "x"},
+			},
+		},
+		{
+			name:        "Timed Out",
+			description: "Conditions should alert again once the acknowledgement times out",
+			steps: []step{
+				{at: 50 * time.Minute, fingerprint: "x", suppressed: true},
+				{at: 100 * time.Minute, fingerprint: "x", suppressed: true},
+				{at: 150 * time.Minute, fingerprint: "x", count: 2},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			a := newAcknowledger(AckPolicy{Timeout: models.Duration(2 * time.Hour)})
+			assert.Equal(t, start.Add(2*time.Hour), a.acknowledge(key, start))
+
+			for j, s := range tc.steps {
+				inval := engine.Invalidation{SessionID: "session", Fingerprint: s.fingerprint,
+					Timestamp: start.Add(s.at)}
+
+				assert.Equal(t, s.suppressed, a.suppresses(&inval), "step %d", j)
+				if !s.suppressed {
+					assert.Equal(t, s.count, inval.Suppressed, "step %d", j)
+				}
+			}
+		})
+	}
+}
+
+func Test_Manager_Acknowledge(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ops := newDestinationMock("ops")
+	input := make(chan engine.Invalidation)
+
+	_, err := NewManager(context.Background(), input, []AlertDestination{ops},
+		WithAckPolicy(AckPolicy{Timeout: models.Duration(-time.Second)}))
+	assert.Error(t, err, "Ensuring negative periods are rejected")
+
+	m, err := NewManager(context.Background(), input, []AlertDestination{ops})
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	_, err = m.Acknowledge("unknown", "oncall")
+	assert.ErrorIs(t, err, history.ErrNotFound, "Ensuring unknown alerts can't be acknowledged")
+
+	inval := engine.Invalidation{SessionID: "session", Severity: invariant.High, Fingerprint: "x"}
+	inval.Timestamp = time.Now()
+	input <- inval
+	<-ops.sent
+
+	recs, err := m.Alerts(history.Query{})
+	assert.NoError(t, err)
+	assert.Len(t, recs, 1)
+
+	ack, err := m.Acknowledge(recs[0].ID, "oncall")
+	assert.NoError(t, err)
+	assert.Equal(t, "oncall", ack.By)
+	assert.Equal(t, ack.Acknowledged.Add(defaultAckTimeout), ack.Expires)
+
+	rec, _, err := m.Alert(recs[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, &ack, rec.Acknowledgement, "Ensuring the acknowledgement is recorded")
+
+	inval.Timestamp = time.Now()
+	input <- inval
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, ops.sent, "Ensuring re-alerts of the condition are suppressed")
+
+	inval.Fingerprint = "y"
+	input <- inval
+	<-ops.sent
+}
@@ -0,0 +1,115 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+// invalidLabelChars ... Characters not allowed in Alertmanager label & annotation names
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// alertmanagerAlert ... Postable alert of the Alertmanager v2 API
+type alertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    string            `json:"startsAt"`
+}
+
+// alertmanagerDestination ... Pushes every invalidation to an Alertmanager as a v2 API alert; alerts
+// aren't resolved explicitly so Alertmanager resolves them once its resolve timeout elapses
+type alertmanagerDestination struct {
+	name   string
+	url    string
+	labels map[string]string
+	client *http.Client
+
+	// Readiness endpoint of the Alertmanager
+	healthURL string
+}
+
+// newAlertmanagerDestination ... Initializer; the static labels are added to every alert
+func newAlertmanagerDestination(name, url string, labels map[string]string) *alertmanagerDestination {
+	base := strings.TrimSuffix(url, "/")
+
+	return &alertmanagerDestination{
+		name:      name,
+		url:       base + "/api/v2/alerts",
+		labels:    labels,
+		client:    newHTTPClient(),
+		healthURL: base + "/-/ready",
+	}
+}
+
+// Name ... Returns the destination name
+func (ad *alertmanagerDestination) Name() string {
+	return ad.name
+}
+
+// Send ... Posts the invalidation as a single alert
+func (ad *alertmanagerDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	return postJSON(ctx, ad.client, ad.url, []alertmanagerAlert{ad.alertOf(inval)}, nil)
+}
+
+// Check ... Fails unless the Alertmanager reports it's ready to receive alerts
+func (ad *alertmanagerDestination) Check(ctx context.Context) error {
+	return get(ctx, ad.client, ad.healthURL)
+}
+
+// Close ... No-op
+func (ad *alertmanagerDestination) Close() error {
+	return nil
+}
+
+// alertOf ... Renders the invalidation as an alert; the invariant type is used as the alert name & the
+// invalidation's context is attached as annotations. Alerts of the same condition share their labels
+// so that Alertmanager groups & deduplicates them
+func (ad *alertmanagerDestination) alertOf(inval engine.Invalidation) alertmanagerAlert {
+	labels := make(map[string]string, len(ad.labels)+5)
+	for key, val := range ad.labels {
+		labels[key] = val
+	}
+
+	labels["alertname"] = string(inval.Invariant)
+	labels["severity"] = string(inval.Severity)
+	labels["network"] = string(inval.Network)
+	labels["session_id"] = string(inval.SessionID)
+	labels["fingerprint"] = inval.Fingerprint
+
+	annotations := make(map[string]string, len(inval.Context)+3)
+	for key, val := range inval.Context {
+		annotations[labelName(key)] = fmt.Sprintf("%v", val)
+	}
+
+	annotations["summary"] = fmt.Sprintf("%s invalidated on %s", inval.Invariant, inval.Network)
+	annotations["description"] = inval.Message
+	if inval.Height != nil {
+		annotations["height"] = inval.Height.String()
+	}
+
+	startsAt := inval.Timestamp
+	if startsAt.IsZero() {
+		startsAt = time.Now()
+	}
+
+	return alertmanagerAlert{
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    startsAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// labelName ... Returns the name with characters not allowed in label names replaced by underscores
+func labelName(name string) string {
+	name = invalidLabelChars.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	return name
+}
@@ -0,0 +1,73 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AlertmanagerDestination(t *testing.T) {
+	received := make(chan []alertmanagerAlert, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/alerts", r.URL.Path)
+
+		var alerts []alertmanagerAlert
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&alerts))
+		received <- alerts
+	}))
+	defer server.Close()
+
+	dest, err := NewDestination(DestinationConfig{Name: "am", Type: AlertmanagerDestination, URL: server.URL + "/",
+		Labels: map[string]string{"team": "protocol"}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, dest.Send(context.Background(), engine.Invalidation{
+		SessionID:   "session",
+		Invariant:   "BALANCE_ENFORCEMENT",
+		Severity:    invariant.High,
+		Network:     models.Layer1,
+		Height:      big.NewInt(7),
+		Timestamp:   time.Unix(0, 0),
+		Message:     "balance is below 1 ETH",
+		Context:     map[string]any{"address": "0x420", "min-balance": 1},
+		Fingerprint: "abc",
+	}))
+
+	alerts := <-received
+	assert.Len(t, alerts, 1)
+
+	assert.Equal(t, map[string]string{
+		"alertname":   "BALANCE_ENFORCEMENT",
+		"severity":    "high",
+		"network":     "layer1",
+		"session_id":  "session",
+		"fingerprint": "abc",
+		"team":        "protocol",
+	}, alerts[0].Labels)
+
+	assert.Equal(t, map[string]string{
+		"summary":     "BALANCE_ENFORCEMENT invalidated on layer1",
+		"description": "balance is below 1 ETH",
+		"height":      "7",
+		"address":     "0x420",
+		"min_balance": "1",
+	}, alerts[0].Annotations)
+	assert.Equal(t, "1970-01-01T00:00:00Z", alerts[0].StartsAt)
+
+	for _, cfg := range []DestinationConfig{
+		{Name: "am", Type: AlertmanagerDestination},
+		{Name: "am", Type: AlertmanagerDestination, URL: server.URL, Labels: map[string]string{"team-name": "x"}},
+	} {
+		assert.Error(t, cfg.Validate())
+	}
+}
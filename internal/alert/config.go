@@ -0,0 +1,116 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/secrets"
+	"gopkg.in/yaml.v3"
+)
+
+// Config ... Contents of an alerting config file
+type Config struct {
+	Destinations []DestinationConfig `json:"destinations"`
+	// Routing of sessions without their own policy by severity, invariant type & network
+	Routes Routes `json:"routes,omitempty"`
+	// Routing of sessions without their own policy whose invalidations match no route
+	DefaultPolicy Policy `json:"default_policy"`
+	// Optional; limits repeated alerts of the same condition
+	Throttle Throttle `json:"throttle,omitempty"`
+	// Optional; suppression of re-alerts following acknowledgements
+	Acknowledgement AckPolicy `json:"acknowledgement,omitempty"`
+	// Optional; batching of less severe invalidations into periodic digests
+	Digest Digest `json:"digest,omitempty"`
+	// Optional; templates replacing the message of alerts of specific invariant types at every destination;
+	// overridden by a destination's own templates of the same type
+	Templates map[invariant.Type]string `json:"templates,omitempty"`
+}
+
+// DefaultConfig ... Logs every invalidation; used when no config file is provided
+func DefaultConfig() *Config {
+	return &Config{
+		Destinations: []DestinationConfig{{Name: "log", Type: LogDestination}},
+	}
+}
+
+// LoadConfig ... Reads & validates a YAML or JSON alerting config file; the format is inferred from
+// the file extension. YAML files use the same field names as JSON files. Destination URLs & credentials can
+// reference secrets (E.G, vault://secret/data/pessimism#opsgenie)
+func LoadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+
+	case ".yaml", ".yml":
+		var doc any
+		if yErr := yaml.Unmarshal(raw, &doc); yErr != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", path, yErr)
+		}
+
+		if raw, err = json.Marshal(doc); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", path, err)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported alerting config file extension: %s", filepath.Ext(path))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	cfg := &Config{}
+	if dErr := dec.Decode(cfg); dErr != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", path, dErr)
+	}
+
+	for i := range cfg.Destinations {
+		dest := &cfg.Destinations[i]
+		if sErr := secrets.ResolveAll(context.Background(), &dest.URL, &dest.Secret, &dest.APIKey, &dest.Token,
+			&dest.Username, &dest.Password); sErr != nil {
+			return nil, fmt.Errorf("invalid alerting config in %s: destination %s: %w", path, dest.Name, sErr)
+		}
+	}
+
+	if _, tErr := parseTemplates(cfg.Templates); tErr != nil {
+		return nil, fmt.Errorf("invalid alerting config in %s: %w", path, tErr)
+	}
+
+	for _, dest := range cfg.Destinations {
+		if vErr := dest.Validate(); vErr != nil {
+			return nil, fmt.Errorf("invalid alerting config in %s: %w", path, vErr)
+		}
+	}
+
+	return cfg, nil
+}
+
+// Construct ... Constructs every configured destination; destinations constructed before a failure
+// are closed
+func (cfg *Config) Construct() ([]AlertDestination, error) {
+	dests := make([]AlertDestination, 0, len(cfg.Destinations))
+
+	for _, dc := range cfg.Destinations {
+		dest, err := newDestination(dc, cfg.Templates)
+		if err != nil {
+			for _, d := range dests {
+				_ = d.Close()
+			}
+
+			return nil, fmt.Errorf("could not construct destination %s: %w", dc.Name, err)
+		}
+
+		dests = append(dests, dest)
+	}
+
+	return dests, nil
+}
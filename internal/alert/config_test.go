@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LoadConfig(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		file    string
+		content string
+		valid   bool
+	}{
+		{
+			name:        "YAML",
+			description: "YAML configs should be decoded using JSON field names",
+			file:        "alerts.yaml",
+			content: `
+destinations:
+  - name: ops
+    type: log
+routes:
+  - severities: [critical]
+    destinations: [ops]
+default_policy:
+  destinations: [ops]
+  min_severity: high
+`,
+			valid: true,
+		},
+		{
+			name:        "JSON",
+			description: "JSON configs should be decoded",
+			file:        "alerts.json",
+			content: `{"destinations": [{"name": "ops", "type": "log"}],
+				"default_policy": {"destinations": ["ops"], "min_severity": "high"}}`,
+			valid: true,
+		},
+		{
+			name:        "Unknown Destination Type",
+			description: "Unknown destination types should be rejected",
+			file:        "alerts.yaml",
+			content:     "destinations:\n  - name: ops\n    type: pager\n",
+		},
+		{
+			name:        "Unknown Field",
+			description: "Unknown fields should be rejected",
+			file:        "alerts.yaml",
+			content:     "destinations: []\nreceivers: []\n",
+		},
+		{
+			name:        "Unsupported Extension",
+			description: "Files other than YAML & JSON should be rejected",
+			file:        "alerts.toml",
+			content:     "",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			cfg, err := LoadConfig(path)
+			if !tc.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, []DestinationConfig{{Name: "ops", Type: LogDestination}}, cfg.Destinations)
+			assert.Equal(t, Policy{Destinations: []string{"ops"}, MinSeverity: invariant.High}, cfg.DefaultPolicy)
+			if tc.file == "alerts.yaml" {
+				assert.Equal(t, Routes{{Severities: []invariant.Severity{invariant.Critical},
+					Destinations: []string{"ops"}}}, cfg.Routes)
+			}
+
+			dests, err := cfg.Construct()
+			assert.NoError(t, err)
+			assert.Len(t, dests, 1)
+			assert.Equal(t, "ops", dests[0].Name())
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+// DestinationType ... Identifies an alert destination implementation
+type DestinationType string
+
+const (
+	// LogDestination ... Logs every alert using the application logger
+	LogDestination DestinationType = "log"
+	// DiscordDestination ... Posts every alert to a Discord channel webhook as an embed
+	DiscordDestination DestinationType = "discord"
+	// TelegramDestination ... Sends every alert to a Telegram chat chosen by severity through a bot
+	TelegramDestination DestinationType = "telegram"
+	// WebhookDestination ... POSTs every alert as signed JSON to an arbitrary URL
+	WebhookDestination DestinationType = "webhook"
+	// EmailDestination ... Emails every alert through an SMTP server using templated subjects & bodies
+	EmailDestination DestinationType = "email"
+	// OpsgenieDestination ... Creates an Opsgenie alert prioritized by severity for every alert
+	OpsgenieDestination DestinationType = "opsgenie"
+	// SNSDestination ... Publishes every alert as JSON to an AWS SNS topic; AWS credentials are resolved
+	// through the default credential chain, i.e. env vars, shared config files, IRSA web identity tokens and
+	// ECS task or EC2 instance roles
+	SNSDestination DestinationType = "sns"
+	// AlertmanagerDestination ... Pushes every alert to a Prometheus Alertmanager using its v2 API
+	AlertmanagerDestination DestinationType = "alertmanager"
+)
+
+// DestinationConfig ... Configuration used to construct an alert destination
+type DestinationConfig struct {
+	// Unique name that routing policies reference the destination by
+	Name string          `json:"name"`
+	Type DestinationType `json:"type"`
+
+	// Optional; text/template replacing the message of alerts, executed with the invalidation
+	Template string `json:"template,omitempty"`
+	// Optional; templates replacing the message of alerts of specific invariant types
+	InvariantTemplates map[invariant.Type]string `json:"invariant_templates,omitempty"`
+
+	// URL posted to by discord & webhook destinations; base URL of alertmanager destinations. Optional API
+	// base URL of opsgenie & sns destinations
+	URL string `json:"url,omitempty"`
+	// Optional; static labels added to every alert of alertmanager destinations
+	Labels map[string]string `json:"labels,omitempty"`
+	// Optional; key used by webhook destinations to sign requests, requests are unsigned when empty
+	Secret string `json:"secret,omitempty"`
+	// Number of times webhook destinations retry failed requests
+	Retries int `json:"retries,omitempty"`
+
+	// API integration key used by opsgenie destinations
+	APIKey string `json:"api_key,omitempty"`
+
+	// Topic published to by sns destinations; the region is taken from the ARN
+	TopicARN string `json:"topic_arn,omitempty"`
+
+	// Bot token used by telegram destinations
+	Token string `json:"token,omitempty"`
+	// Chat alerted by telegram destinations for severities without their own chat
+	ChatID string `json:"chat_id,omitempty"`
+	// Chats alerted by telegram destinations for specific severities
+	SeverityChatIDs map[invariant.Severity]string `json:"severity_chat_ids,omitempty"`
+
+	// SMTP server used by email destinations; the port defaults to the standard port of the TLS mode
+	Host string `json:"host,omitempty"`
+	Port int    `json:"port,omitempty"`
+	// Optional; credentials used to authenticate with the SMTP server
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// How email destinations secure SMTP connections; starttls when empty
+	TLS  SMTPTLSMode `json:"tls,omitempty"`
+	From string      `json:"from,omitempty"`
+	To   []string    `json:"to,omitempty"`
+	// Optional; text/template subject & body executed with the invalidation, defaults are used when empty
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
+}
+
+// Validate ... Ensures the config describes a constructable destination
+func (cfg DestinationConfig) Validate() error {
+	if cfg.Name == "" {
+		return fmt.Errorf("%s destination has no name", cfg.Type)
+	}
+
+	if _, err := parseTemplate(cfg.Name, cfg.Template); err != nil {
+		return fmt.Errorf("%s destination %s has an invalid template: %w", cfg.Type, cfg.Name, err)
+	}
+
+	if _, err := parseTemplates(cfg.InvariantTemplates); err != nil {
+		return fmt.Errorf("%s destination %s has an %w", cfg.Type, cfg.Name, err)
+	}
+
+	switch cfg.Type {
+	case LogDestination:
+		return nil
+
+	case DiscordDestination:
+		if cfg.URL == "" {
+			return fmt.Errorf("%s destination %s requires a url", cfg.Type, cfg.Name)
+		}
+		return nil
+
+	case TelegramDestination:
+		return cfg.validateTelegram()
+
+	case WebhookDestination:
+		if cfg.URL == "" {
+			return fmt.Errorf("%s destination %s requires a url", cfg.Type, cfg.Name)
+		}
+
+		if cfg.Retries < 0 {
+			return fmt.Errorf("%s destination %s retries must not be negative", cfg.Type, cfg.Name)
+		}
+		return nil
+
+	case EmailDestination:
+		return cfg.validateEmail()
+
+	case OpsgenieDestination:
+		if cfg.APIKey == "" {
+			return fmt.Errorf("%s destination %s requires an api_key", cfg.Type, cfg.Name)
+		}
+		return nil
+
+	case AlertmanagerDestination:
+		if cfg.URL == "" {
+			return fmt.Errorf("%s destination %s requires a url", cfg.Type, cfg.Name)
+		}
+
+		for key := range cfg.Labels {
+			if labelName(key) != key {
+				return fmt.Errorf("%s destination %s has invalid label name: %s", cfg.Type, cfg.Name, key)
+			}
+		}
+		return nil
+
+	case SNSDestination:
+		if _, err := snsRegion(cfg.TopicARN); err != nil {
+			return fmt.Errorf("%s destination %s has an %w", cfg.Type, cfg.Name, err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown destination type: %s", cfg.Type)
+	}
+}
+
+// validateTelegram ... Ensures a token & at least one chat is configured for known severities
+func (cfg DestinationConfig) validateTelegram() error {
+	if cfg.Token == "" {
+		return fmt.Errorf("%s destination %s requires a token", cfg.Type, cfg.Name)
+	}
+
+	if cfg.ChatID == "" && len(cfg.SeverityChatIDs) == 0 {
+		return fmt.Errorf("%s destination %s requires a chat_id or severity_chat_ids", cfg.Type, cfg.Name)
+	}
+
+	for severity, chatID := range cfg.SeverityChatIDs {
+		if !severity.Valid() {
+			return fmt.Errorf("%s destination %s has invalid severity: %s", cfg.Type, cfg.Name, severity)
+		}
+
+		if chatID == "" {
+			return fmt.Errorf("%s destination %s has no chat for severity %s", cfg.Type, cfg.Name, severity)
+		}
+	}
+
+	return nil
+}
+
+// validateEmail ... Ensures a server, sender & recipients are configured and the templates parse
+func (cfg DestinationConfig) validateEmail() error {
+	if cfg.Host == "" {
+		return fmt.Errorf("%s destination %s requires a host", cfg.Type, cfg.Name)
+	}
+
+	if cfg.Port < 0 || cfg.Port > 65535 {
+		return fmt.Errorf("%s destination %s has invalid port: %d", cfg.Type, cfg.Name, cfg.Port)
+	}
+
+	if _, found := defaultSMTPPorts[cfg.TLS]; cfg.TLS != "" && !found {
+		return fmt.Errorf("%s destination %s has invalid tls mode: %s", cfg.Type, cfg.Name, cfg.TLS)
+	}
+
+	if cfg.From == "" || len(cfg.To) == 0 {
+		return fmt.Errorf("%s destination %s requires a from & to address", cfg.Type, cfg.Name)
+	}
+
+	if _, _, err := parseEmailTemplates(cfg.Subject, cfg.Body); err != nil {
+		return fmt.Errorf("%s destination %s has an %w", cfg.Type, cfg.Name, err)
+	}
+
+	return nil
+}
+
+// AlertDestination ... Receiver of the invalidations routed to it; sends are retried by the destination
+// itself if at all
+type AlertDestination interface {
+	// Name ... Returns the unique name that routing policies reference the destination by
+	Name() string
+	// Send ... Delivers the invalidation; the context bounds the delivery
+	Send(ctx context.Context, inval engine.Invalidation) error
+	Close() error
+}
+
+// Checker ... Implemented by destinations that can check they're reachable without sending an alert
+type Checker interface {
+	// Check ... Returns an error if the destination can't currently be delivered to
+	Check(ctx context.Context) error
+}
+
+// checkerOf ... Returns the destination's checker; false if the destination can't be checked
+func checkerOf(dest AlertDestination) (Checker, bool) {
+	if td, ok := dest.(*templatedDestination); ok {
+		dest = td.AlertDestination
+	}
+
+	checker, ok := dest.(Checker)
+	return checker, ok
+}
+
+// NewDestination ... Constructs the destination described by the config
+func NewDestination(cfg DestinationConfig) (AlertDestination, error) {
+	return newDestination(cfg, nil)
+}
+
+// newDestination ... Constructs the destination described by the config; messages are rendered using
+// the shared templates unless overridden by the destination's templates
+func newDestination(cfg DestinationConfig, shared map[invariant.Type]string) (AlertDestination, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	dest, err := constructDestination(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return withTemplates(dest, cfg, shared)
+}
+
+// constructDestination ... Constructs the destination implementation of the config's type
+func constructDestination(cfg DestinationConfig) (AlertDestination, error) {
+	switch cfg.Type {
+	case DiscordDestination:
+		return newDiscordDestination(cfg.Name, cfg.URL), nil
+
+	case TelegramDestination:
+		return newTelegramDestination(cfg.Name, cfg.Token, cfg.ChatID, cfg.SeverityChatIDs), nil
+
+	case WebhookDestination:
+		return newWebhookDestination(cfg.Name, cfg.URL, cfg.Secret, cfg.Retries), nil
+
+	case EmailDestination:
+		return newEmailDestination(cfg)
+
+	case OpsgenieDestination:
+		return newOpsgenieDestination(cfg.Name, cfg.URL, cfg.APIKey), nil
+
+	case SNSDestination:
+		return newSNSDestination(cfg.Name, cfg.TopicARN, cfg.URL)
+
+	case AlertmanagerDestination:
+		return newAlertmanagerDestination(cfg.Name, cfg.URL, cfg.Labels), nil
+
+	case LogDestination:
+		fallthrough
+	default:
+		return &logDestination{name: cfg.Name}, nil
+	}
+}
+
+// logDestination ... Logs every invalidation using the application logger
+type logDestination struct {
+	name string
+}
+
+// Name ... Returns the destination name
+func (ld *logDestination) Name() string {
+	return ld.name
+}
+
+// Send ... Logs the invalidation
+func (ld *logDestination) Send(_ context.Context, inval engine.Invalidation) error {
+	// Logger is unset when running outside of the application
+	if log := logging.NoContext(); log != nil {
+		log.Warn("Invariant invalidated",
+			zap.String("destination", ld.name),
+			zap.String("session", string(inval.SessionID)),
+			zap.String("invariant", string(inval.Invariant)),
+			zap.String("severity", string(inval.Severity)),
+			zap.String("network", string(inval.Network)),
+			zap.String("message", inval.Message),
+			zap.Int("suppressed", inval.Suppressed),
+			zap.Any("context", inval.Context))
+	}
+
+	return nil
+}
+
+// Close ... No-op
+func (ld *logDestination) Close() error {
+	return nil
+}
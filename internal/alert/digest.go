@@ -0,0 +1,177 @@
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// DigestInvariant ... Invariant type of digest alerts; templates of this type render digest messages
+	DigestInvariant invariant.Type = "DIGEST"
+
+	// defaultDigestInterval ... Period between digests by default
+	defaultDigestInterval = time.Hour
+	// digestMaxGroups ... Number of groups listed in a digest message; remaining groups are only counted
+	digestMaxGroups = 20
+)
+
+// Digest ... Batching of less severe invalidations into a periodic summary alert per destination rather
+// than individual alerts
+type Digest struct {
+	// Invalidations at most this severe are batched; digests are disabled when empty
+	MaxSeverity invariant.Severity `json:"max_severity,omitempty"`
+	// Optional; period between digests, defaults to 1h
+	Interval models.Duration `json:"interval,omitempty"`
+}
+
+// validate ... Ensures the severity is known & the interval isn't negative
+func (d Digest) validate() error {
+	if d.MaxSeverity != "" && !d.MaxSeverity.Valid() {
+		return fmt.Errorf("invalid digest severity: %s", d.MaxSeverity)
+	}
+
+	if d.Interval < 0 {
+		return fmt.Errorf("digest interval must not be negative")
+	}
+
+	return nil
+}
+
+// interval ... Returns the period between digests
+func (d Digest) interval() time.Duration {
+	if d.Interval == 0 {
+		return defaultDigestInterval
+	}
+
+	return time.Duration(d.Interval)
+}
+
+// batches ... Returns true if the invalidation is batched into digests
+func (d Digest) batches(inval engine.Invalidation) bool {
+	return d.MaxSeverity != "" && d.MaxSeverity.AtLeast(inval.Severity)
+}
+
+// digestGroup ... Invalidations of a single session within a digest
+type digestGroup struct {
+	session   engine.SessionID
+	invariant invariant.Type
+	network   models.Network
+	count     int
+	// Message of the latest invalidation
+	message string
+}
+
+// batch ... Invalidations awaiting the next digest of a destination
+type batch struct {
+	// Groups in order of their first invalidation
+	groups []*digestGroup
+	index  map[engine.SessionID]*digestGroup
+
+	total    int
+	severity invariant.Severity
+	from     time.Time
+	to       time.Time
+}
+
+// newBatch ... Initializer
+func newBatch() *batch {
+	return &batch{index: make(map[engine.SessionID]*digestGroup)}
+}
+
+// add ... Adds the invalidation to its session's group
+func (b *batch) add(inval engine.Invalidation) {
+	g, found := b.index[inval.SessionID]
+	if !found {
+		g = &digestGroup{session: inval.SessionID, invariant: inval.Invariant, network: inval.Network}
+		b.index[inval.SessionID] = g
+		b.groups = append(b.groups, g)
+	}
+
+	count := 1 + inval.Suppressed
+	g.count += count
+	g.message = inval.Message
+
+	if b.total == 0 || inval.Timestamp.Before(b.from) {
+		b.from = inval.Timestamp
+	}
+
+	if inval.Timestamp.After(b.to) {
+		b.to = inval.Timestamp
+	}
+
+	if b.total == 0 || inval.Severity.AtLeast(b.severity) {
+		b.severity = inval.Severity
+	}
+
+	b.total += count
+}
+
+// summary ... Returns the digest alert summarizing the batch; groups are listed in order of their first
+// invalidation
+func (b *batch) summary(at time.Time) engine.Invalidation {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d invalidations across %d sessions", b.total, len(b.groups))
+
+	for i, g := range b.groups {
+		if i == digestMaxGroups {
+			fmt.Fprintf(&sb, "\n... and %d more sessions", len(b.groups)-digestMaxGroups)
+			break
+		}
+
+		fmt.Fprintf(&sb, "\n%dx %s on %s (session %s): %s", g.count, g.invariant, g.network, g.session, g.message)
+	}
+
+	return engine.Invalidation{
+		Invariant: DigestInvariant,
+		Severity:  b.severity,
+		Timestamp: at,
+		Message:   sb.String(),
+		Context: map[string]any{
+			"invalidations": b.total,
+			"sessions":      len(b.groups),
+			"from":          b.from,
+			"to":            b.to,
+		},
+		Fingerprint: string(DigestInvariant),
+	}
+}
+
+// digester ... Batches invalidations per destination; only accessed by the manager's routing routine
+type digester struct {
+	Digest
+	pending map[string]*batch
+}
+
+// newDigester ... Initializer
+func newDigester(d Digest) *digester {
+	return &digester{Digest: d, pending: make(map[string]*batch)}
+}
+
+// add ... Batches the invalidation for every destination
+func (d *digester) add(inval engine.Invalidation, targets []string) {
+	for _, name := range targets {
+		b, found := d.pending[name]
+		if !found {
+			b = newBatch()
+			d.pending[name] = b
+		}
+
+		b.add(inval)
+	}
+}
+
+// flush ... Returns the digest of every destination with batched invalidations & resets the batches
+func (d *digester) flush(at time.Time) map[string]engine.Invalidation {
+	digests := make(map[string]engine.Invalidation, len(d.pending))
+	for name, b := range d.pending {
+		digests[name] = b.summary(at)
+	}
+
+	d.pending = make(map[string]*batch)
+	return digests
+}
@@ -0,0 +1,80 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Batch_Summary(t *testing.T) {
+	start := time.Unix(0, 0)
+
+	b := newBatch()
+	b.add(engine.Invalidation{SessionID: "a", Invariant: "LARGE_TX_VALUE", Network: models.Layer1,
+		Severity: invariant.Low, Message: "first", Timestamp: start.Add(time.Minute)})
+	b.add(engine.Invalidation{SessionID: "b", Invariant: "BALANCE_ENFORCEMENT", Network: models.Layer2,
+		Severity: invariant.Medium, Message: "balance", Timestamp: start})
+	b.add(engine.Invalidation{SessionID: "a", Invariant: "LARGE_TX_VALUE", Network: models.Layer1,
+		Severity: invariant.Low, Message: "latest", Timestamp: start.Add(2 * time.Minute), Suppressed: 2})
+
+	digest := b.summary(start.Add(time.Hour))
+	assert.Equal(t, DigestInvariant, digest.Invariant)
+	assert.Equal(t, invariant.Medium, digest.Severity, "Ensuring the most severe invalidation is used")
+	assert.Equal(t, strings.Join([]string{
+		"5 invalidations across 2 sessions",
+		"4x LARGE_TX_VALUE on layer1 (session a): latest",
+		"1x BALANCE_ENFORCEMENT on layer2 (session b): balance",
+	}, "\n"), digest.Message)
+	assert.Equal(t, start, digest.Context["from"])
+	assert.Equal(t, start.Add(2*time.Minute), digest.Context["to"])
+
+	many := newBatch()
+	for i := 0; i < digestMaxGroups+3; i++ {
+		many.add(engine.Invalidation{SessionID: engine.SessionID(fmt.Sprint(i)), Severity: invariant.Low})
+	}
+	assert.True(t, strings.HasSuffix(many.summary(start).Message, "... and 3 more sessions"))
+}
+
+func Test_Manager_Digest(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ops := newDestinationMock("ops")
+	input := make(chan engine.Invalidation)
+
+	_, err := NewManager(context.Background(), input, []AlertDestination{ops},
+		WithDigest(Digest{MaxSeverity: "urgent"}))
+	assert.Error(t, err, "Ensuring unknown severities are rejected")
+
+	m, err := NewManager(context.Background(), input, []AlertDestination{ops},
+		WithDigest(Digest{MaxSeverity: invariant.Medium, Interval: models.Duration(100 * time.Millisecond)}))
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	input <- engine.Invalidation{SessionID: "a", Severity: invariant.Low, Timestamp: time.Now()}
+	input <- engine.Invalidation{SessionID: "b", Severity: invariant.Medium, Timestamp: time.Now()}
+	input <- engine.Invalidation{SessionID: "c", Severity: invariant.High, Timestamp: time.Now()}
+
+	inval := <-ops.sent
+	assert.Equal(t, engine.SessionID("c"), inval.SessionID, "Ensuring more severe invalidations aren't batched")
+
+	select {
+	case digest := <-ops.sent:
+		assert.Equal(t, DigestInvariant, digest.Invariant)
+		assert.True(t, strings.HasPrefix(digest.Message, "2 invalidations across 2 sessions"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a digest")
+	}
+
+	recs, err := m.Alerts(history.Query{Invariant: DigestInvariant})
+	assert.NoError(t, err)
+	assert.Len(t, recs, 1, "Ensuring digests are recorded")
+}
@@ -0,0 +1,137 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// Discord embed limits; longer values are rejected by the API
+	discordMaxFields      = 25
+	discordMaxFieldValue  = 1024
+	discordMaxDescription = 4096
+)
+
+// discordColors ... Embed sidebar color of each severity
+var discordColors = map[invariant.Severity]int{
+	invariant.Low:      0x3498DB,
+	invariant.Medium:   0xF1C40F,
+	invariant.High:     0xE67E22,
+	invariant.Critical: 0xE74C3C,
+}
+
+// discordField ... Single name/value pair rendered within an embed
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// discordEmbed ... Rich message attachment
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Color       int            `json:"color"`
+	Timestamp   string         `json:"timestamp"`
+	Fields      []discordField `json:"fields"`
+}
+
+// discordMessage ... Body of a webhook execution
+type discordMessage struct {
+	Username string         `json:"username"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+
+// discordDestination ... Posts every invalidation to a Discord channel webhook as an embed
+type discordDestination struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+// newDiscordDestination ... Initializer
+func newDiscordDestination(name, url string) *discordDestination {
+	return &discordDestination{name: name, url: url, client: newHTTPClient()}
+}
+
+// Name ... Returns the destination name
+func (dd *discordDestination) Name() string {
+	return dd.name
+}
+
+// Send ... Executes the webhook with the invalidation's embed
+func (dd *discordDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	return postJSON(ctx, dd.client, dd.url, discordMessage{
+		Username: "pessimism",
+		Embeds:   []discordEmbed{discordEmbedOf(inval)},
+	}, nil)
+}
+
+// Check ... Fetches the webhook; fail if it no longer exists
+func (dd *discordDestination) Check(ctx context.Context) error {
+	return get(ctx, dd.client, dd.url)
+}
+
+// Close ... No-op
+func (dd *discordDestination) Close() error {
+	return nil
+}
+
+// discordEmbedOf ... Renders the invalidation as an embed; context entries are listed as fields in
+// key order after the invalidation's metadata
+func discordEmbedOf(inval engine.Invalidation) discordEmbed {
+	fields := []discordField{
+		{Name: "Severity", Value: string(inval.Severity), Inline: true},
+		{Name: "Network", Value: string(inval.Network), Inline: true},
+		{Name: "Session", Value: string(inval.SessionID)},
+	}
+
+	if inval.Height != nil {
+		fields = append(fields, discordField{Name: "Height", Value: inval.Height.String(), Inline: true})
+	}
+
+	if inval.Suppressed > 0 {
+		fields = append(fields, discordField{Name: "Suppressed", Value: fmt.Sprint(inval.Suppressed), Inline: true})
+	}
+
+	keys := make([]string, 0, len(inval.Context))
+	for key := range inval.Context {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if len(fields) == discordMaxFields {
+			break
+		}
+
+		fields = append(fields, discordField{
+			Name:  key,
+			Value: truncate(fmt.Sprint(inval.Context[key]), discordMaxFieldValue),
+		})
+	}
+
+	return discordEmbed{
+		Title:       fmt.Sprintf("%s invalidated", inval.Invariant),
+		Description: truncate(inval.Message, discordMaxDescription),
+		Color:       discordColors[inval.Severity],
+		Timestamp:   inval.Timestamp.UTC().Format(time.RFC3339),
+		Fields:      fields,
+	}
+}
+
+// truncate ... Shortens the string to at most limit bytes, marking truncation with an ellipsis
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:limit-3], "") + "..."
+}
@@ -0,0 +1,73 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DiscordDestination(t *testing.T) {
+	received := make(chan discordMessage, 1)
+	status := http.StatusNoContent
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var msg discordMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		received <- msg
+
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	dest, err := NewDestination(DestinationConfig{Name: "ops", Type: DiscordDestination, URL: server.URL})
+	assert.NoError(t, err)
+
+	inval := engine.Invalidation{
+		SessionID: "session",
+		Invariant: "LARGE_TX_VALUE",
+		Severity:  invariant.Critical,
+		Network:   models.Layer1,
+		Height:    big.NewInt(7),
+		Timestamp: time.Unix(0, 0),
+		Message:   "transaction transferred 100 wei",
+		Context:   map[string]any{"value": "100", "tx_hash": strings.Repeat("f", 2000)},
+	}
+
+	assert.NoError(t, dest.Send(context.Background(), inval))
+
+	msg := <-received
+	assert.Len(t, msg.Embeds, 1)
+
+	embed := msg.Embeds[0]
+	assert.Equal(t, "LARGE_TX_VALUE invalidated", embed.Title)
+	assert.Equal(t, inval.Message, embed.Description)
+	assert.Equal(t, discordColors[invariant.Critical], embed.Color)
+	assert.Equal(t, "1970-01-01T00:00:00Z", embed.Timestamp)
+
+	// Metadata fields precede the context fields which are listed in key order & truncated
+	names := make([]string, 0, len(embed.Fields))
+	for _, field := range embed.Fields {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"Severity", "Network", "Session", "Height", "tx_hash", "value"}, names)
+	assert.Len(t, embed.Fields[4].Value, discordMaxFieldValue)
+
+	status = http.StatusTooManyRequests
+	assert.Error(t, dest.Send(context.Background(), inval), "Ensuring non 2xx responses fail")
+	<-received
+
+	_, err = NewDestination(DestinationConfig{Name: "ops", Type: DiscordDestination})
+	assert.Error(t, err, "Ensuring a url is required")
+}
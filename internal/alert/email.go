@@ -0,0 +1,279 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+// SMTPTLSMode ... Identifies how connections to an SMTP server are secured
+type SMTPTLSMode string
+
+const (
+	// StartTLS ... Upgrades a plaintext connection using STARTTLS; fail if the server doesn't support it
+	StartTLS SMTPTLSMode = "starttls"
+	// ImplicitTLS ... Connects using TLS from the start
+	ImplicitTLS SMTPTLSMode = "tls"
+	// NoTLS ... Connects in plaintext; only intended for relays on a trusted network
+	NoTLS SMTPTLSMode = "none"
+)
+
+// defaultSMTPPorts ... Ports connected to when none is configured
+var defaultSMTPPorts = map[SMTPTLSMode]int{
+	StartTLS:    587,
+	ImplicitTLS: 465,
+	NoTLS:       25,
+}
+
+const (
+	// defaultEmailSubject ... Subject template used when none is configured
+	defaultEmailSubject = `[{{.Severity}}] {{.Invariant}} invalidated`
+	// defaultEmailBody ... Body template used when none is configured
+	defaultEmailBody = `{{.Invariant}} invalidated
+
+{{.Message}}
+
+severity: {{.Severity}}
+network: {{.Network}}
+session: {{.SessionID}}
+{{- if .Height}}
+height: {{.Height}}
+{{- end}}
+{{- if .Suppressed}}
+suppressed: {{.Suppressed}}
+{{- end}}
+{{- range $key, $val := .Context}}
+{{$key}}: {{$val}}
+{{- end}}
+`
+)
+
+// parseEmailTemplates ... Parses the subject & body templates, falling back to the defaults when empty;
+// templates are executed with the engine.Invalidation as data & have access to the template functions
+func parseEmailTemplates(subject, body string) (*template.Template, *template.Template, error) {
+	if subject == "" {
+		subject = defaultEmailSubject
+	}
+
+	if body == "" {
+		body = defaultEmailBody
+	}
+
+	subjectTmpl, err := parseTemplate("subject", subject)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid subject template: %w", err)
+	}
+
+	bodyTmpl, err := parseTemplate("body", body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid body template: %w", err)
+	}
+
+	return subjectTmpl, bodyTmpl, nil
+}
+
+// emailDestination ... Emails every invalidation to a fixed set of recipients through an SMTP server
+type emailDestination struct {
+	name     string
+	host     string
+	addr     string
+	mode     SMTPTLSMode
+	username string
+	password string
+
+	from string
+	to   []string
+
+	subject *template.Template
+	body    *template.Template
+
+	tlsConfig *tls.Config
+}
+
+// newEmailDestination ... Initializer
+func newEmailDestination(cfg DestinationConfig) (*emailDestination, error) {
+	subject, body, err := parseEmailTemplates(cfg.Subject, cfg.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := cfg.TLS
+	if mode == "" {
+		mode = StartTLS
+	}
+
+	port := cfg.Port
+	if port == 0 {
+		port = defaultSMTPPorts[mode]
+	}
+
+	return &emailDestination{
+		name:      cfg.Name,
+		host:      cfg.Host,
+		addr:      net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		mode:      mode,
+		username:  cfg.Username,
+		password:  cfg.Password,
+		from:      cfg.From,
+		to:        cfg.To,
+		subject:   subject,
+		body:      body,
+		tlsConfig: &tls.Config{ServerName: cfg.Host, MinVersion: tls.VersionTLS12},
+	}, nil
+}
+
+// Name ... Returns the destination name
+func (ed *emailDestination) Name() string {
+	return ed.name
+}
+
+// Send ... Renders the invalidation & delivers it to every recipient in a single SMTP transaction
+func (ed *emailDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	msg, err := ed.message(inval, time.Now())
+	if err != nil {
+		return err
+	}
+
+	client, err := ed.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ed.username != "" {
+		if aErr := client.Auth(smtp.PlainAuth("", ed.username, ed.password, ed.host)); aErr != nil {
+			return fmt.Errorf("could not authenticate: %w", aErr)
+		}
+	}
+
+	if err = client.Mail(ed.from); err != nil {
+		return err
+	}
+
+	for _, to := range ed.to {
+		if rErr := client.Rcpt(to); rErr != nil {
+			return fmt.Errorf("recipient %s rejected: %w", to, rErr)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// Check ... Connects & authenticates with the SMTP server without sending a message
+func (ed *emailDestination) Check(ctx context.Context) error {
+	client, err := ed.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ed.username != "" {
+		if aErr := client.Auth(smtp.PlainAuth("", ed.username, ed.password, ed.host)); aErr != nil {
+			return fmt.Errorf("could not authenticate: %w", aErr)
+		}
+	}
+
+	return client.Quit()
+}
+
+// Close ... No-op; connections are only held for the duration of a send
+func (ed *emailDestination) Close() error {
+	return nil
+}
+
+// dial ... Connects to the SMTP server securing the connection per the TLS mode; the connection is
+// bounded by the context's deadline
+func (ed *emailDestination) dial(ctx context.Context) (*smtp.Client, error) {
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if ed.mode == ImplicitTLS {
+		dialer := &tls.Dialer{Config: ed.tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", ed.addr)
+	} else {
+		dialer := &net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", ed.addr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, ed.host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	if ed.mode != StartTLS {
+		return client, nil
+	}
+
+	if ok, _ := client.Extension("STARTTLS"); !ok {
+		_ = client.Close()
+		return nil, fmt.Errorf("%s does not support STARTTLS", ed.addr)
+	}
+
+	if tErr := client.StartTLS(ed.tlsConfig); tErr != nil {
+		_ = client.Close()
+		return nil, tErr
+	}
+
+	return client, nil
+}
+
+// message ... Renders the invalidation as a plain text RFC 5322 message
+func (ed *emailDestination) message(inval engine.Invalidation, at time.Time) ([]byte, error) {
+	var subject, body bytes.Buffer
+
+	if err := ed.subject.Execute(&subject, inval); err != nil {
+		return nil, fmt.Errorf("could not render subject: %w", err)
+	}
+
+	if err := ed.body.Execute(&body, inval); err != nil {
+		return nil, fmt.Errorf("could not render body: %w", err)
+	}
+
+	// Line breaks in the subject would be interpreted as the start of a new header
+	subj := strings.Join(strings.Fields(subject.String()), " ")
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", ed.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(ed.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subj))
+	fmt.Fprintf(&msg, "Date: %s\r\n", at.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(strings.ReplaceAll(body.String(), "\r\n", "\n"), "\n", "\r\n"))
+
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,189 @@
+package alert
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+// smtpEnvelope ... Mail received by the fake SMTP server
+type smtpEnvelope struct {
+	from string
+	to   []string
+	data string
+}
+
+// serveSMTP ... Accepts a single SMTP transaction on a local listener without advertising STARTTLS
+func serveSMTP(t *testing.T) (string, int, <-chan smtpEnvelope) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	received := make(chan smtpEnvelope, 1)
+
+	go func() {
+		conn, aErr := ln.Accept()
+		if aErr != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+
+		var env smtpEnvelope
+		reply("220 localhost ready")
+
+		for {
+			line, rErr := r.ReadString('\n')
+			if rErr != nil {
+				return
+			}
+
+			cmd := strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				env.from = strings.Trim(strings.TrimPrefix(cmd, "MAIL FROM:"), "<>")
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				env.to = append(env.to, strings.Trim(strings.TrimPrefix(cmd, "RCPT TO:"), "<>"))
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 Send data")
+
+				var data strings.Builder
+				for {
+					dl, dErr := r.ReadString('\n')
+					if dErr != nil || dl == ".\r\n" {
+						break
+					}
+					data.WriteString(dl)
+				}
+
+				env.data = data.String()
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 Bye")
+				received <- env
+				return
+			default:
+				reply("502 Unsupported")
+			}
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, received
+}
+
+func Test_EmailDestination(t *testing.T) {
+	inval := engine.Invalidation{
+		SessionID: "session",
+		Invariant: "BALANCE_ENFORCEMENT",
+		Severity:  invariant.High,
+		Network:   models.Layer1,
+		Height:    big.NewInt(7),
+		Message:   "balance is below 1 ETH",
+		Context:   map[string]any{"balance": "0.5"},
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		subject string
+		body    string
+
+		contains []string
+	}{
+		{
+			name:        "Default Templates",
+			description: "Invalidations should be rendered with the default templates when none are configured",
+			contains: []string{
+				"Subject: [high] BALANCE_ENFORCEMENT invalidated\r\n",
+				"balance is below 1 ETH\r\n",
+				"height: 7\r\n",
+				"balance: 0.5\r\n",
+			},
+		},
+		{
+			name:        "Custom Templates",
+			description: "Invalidations should be rendered with the configured templates",
+			subject:     "TICKET {{.Network}}\n{{.Invariant}}",
+			body:        "{{.Message}} ({{index .Context \"balance\"}})",
+			contains: []string{
+				"Subject: TICKET layer1 BALANCE_ENFORCEMENT\r\n",
+				"balance is below 1 ETH (0.5)",
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			host, port, received := serveSMTP(t)
+
+			dest, err := NewDestination(DestinationConfig{
+				Name:    "email",
+				Type:    EmailDestination,
+				Host:    host,
+				Port:    port,
+				TLS:     NoTLS,
+				From:    "pessimism@example.com",
+				To:      []string{"ops@example.com", "oncall@example.com"},
+				Subject: tc.subject,
+				Body:    tc.body,
+			})
+			assert.NoError(t, err)
+			assert.NoError(t, dest.Send(context.Background(), inval))
+
+			env := <-received
+			assert.Equal(t, "pessimism@example.com", env.from)
+			assert.Equal(t, []string{"ops@example.com", "oncall@example.com"}, env.to)
+			assert.Contains(t, env.data, "To: ops@example.com, oncall@example.com\r\n")
+
+			for _, s := range tc.contains {
+				assert.Contains(t, env.data, s)
+			}
+		})
+	}
+}
+
+func Test_EmailDestination_RequiresStartTLS(t *testing.T) {
+	host, port, _ := serveSMTP(t)
+
+	dest, err := NewDestination(DestinationConfig{Name: "email", Type: EmailDestination, Host: host, Port: port,
+		From: "pessimism@example.com", To: []string{"ops@example.com"}})
+	assert.NoError(t, err)
+
+	err = dest.Send(context.Background(), engine.Invalidation{Severity: invariant.Low})
+	assert.ErrorContains(t, err, "STARTTLS")
+}
+
+func Test_EmailDestination_Config(t *testing.T) {
+	valid := DestinationConfig{Name: "email", Type: EmailDestination, Host: "smtp.example.com",
+		From: "pessimism@example.com", To: []string{"ops@example.com"}}
+	assert.NoError(t, valid.Validate())
+
+	for _, mutate := range []func(*DestinationConfig){
+		func(cfg *DestinationConfig) { cfg.Host = "" },
+		func(cfg *DestinationConfig) { cfg.To = nil },
+		func(cfg *DestinationConfig) { cfg.TLS = "ssl" },
+		func(cfg *DestinationConfig) { cfg.Port = 70000 },
+		func(cfg *DestinationConfig) { cfg.Subject = "{{.Invariant" },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		assert.Error(t, cfg.Validate())
+	}
+}
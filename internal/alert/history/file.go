@@ -0,0 +1,208 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// maxLineSize ... Upper bound on the size of a single encoded entry
+const maxLineSize = 4 << 20
+
+// entry ... Single line of a history file; either a dispatched alert, a delivery update or an
+// acknowledgement
+type entry struct {
+	Record          *Record          `json:"record,omitempty"`
+	ID              string           `json:"id,omitempty"`
+	Delivery        *Delivery        `json:"delivery,omitempty"`
+	Acknowledgement *Acknowledgement `json:"acknowledgement,omitempty"`
+}
+
+// FileStore ... Store backed by an append-only JSON lines file; records are indexed in memory & the file
+// is compacted atomically once it holds twice as many entries as retained records
+type FileStore struct {
+	*MemoryStore
+
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	entries int
+}
+
+// NewFileStore ... Initializer; replays existing entries from the path if the file exists. A truncated
+// final entry, as left by a crash mid write, is discarded
+func NewFileStore(path string, limit int) (*FileStore, error) {
+	fs := &FileStore{MemoryStore: NewMemoryStore(limit), path: path}
+
+	if err := fs.replay(); err != nil {
+		return nil, err
+	}
+
+	if err := fs.compact(); err != nil {
+		return nil, err
+	}
+
+	return fs, nil
+}
+
+// replay ... Applies every entry of the file to the in memory index
+func (fs *FileStore) replay() error {
+	f, err := os.Open(fs.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var corrupt error
+	for line := 1; scanner.Scan(); line++ {
+		// Only the final entry may be corrupt
+		if corrupt != nil {
+			return corrupt
+		}
+
+		var e entry
+		if dErr := json.Unmarshal(scanner.Bytes(), &e); dErr != nil {
+			corrupt = fmt.Errorf("could not decode entry %d of history file %s: %w", line, fs.path, dErr)
+			continue
+		}
+
+		fs.apply(e)
+	}
+
+	return scanner.Err()
+}
+
+// apply ... Applies the entry to the in memory index
+func (fs *FileStore) apply(e entry) {
+	switch {
+	case e.Record != nil:
+		_ = fs.MemoryStore.Add(*e.Record)
+
+	case e.Delivery != nil:
+		_ = fs.MemoryStore.Update(e.ID, *e.Delivery)
+
+	case e.Acknowledgement != nil:
+		_ = fs.MemoryStore.Acknowledge(e.ID, *e.Acknowledgement)
+	}
+}
+
+// Add ... Records a dispatched alert & appends it to the file
+func (fs *FileStore) Add(rec Record) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	_ = fs.MemoryStore.Add(rec)
+	return fs.append(entry{Record: &rec})
+}
+
+// Update ... Replaces the delivery state of the alert & appends the update to the file
+func (fs *FileStore) Update(id string, d Delivery) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if _, found, _ := fs.MemoryStore.Get(id); !found {
+		return nil
+	}
+
+	_ = fs.MemoryStore.Update(id, d)
+	return fs.append(entry{ID: id, Delivery: &d})
+}
+
+// Acknowledge ... Records the acknowledgement of the alert & appends it to the file
+func (fs *FileStore) Acknowledge(id string, ack Acknowledgement) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if _, found, _ := fs.MemoryStore.Get(id); !found {
+		return nil
+	}
+
+	_ = fs.MemoryStore.Acknowledge(id, ack)
+	return fs.append(entry{ID: id, Acknowledgement: &ack})
+}
+
+// append ... Writes the entry as a line of the file, compacting the file once it's too large
+func (fs *FileStore) append(e entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fs.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	fs.entries++
+	if fs.entries <= 2*fs.limit {
+		return nil
+	}
+
+	return fs.compact()
+}
+
+// compact ... Rewrites the file to hold a single entry per retained record & reopens it for appending
+func (fs *FileStore) compact() error {
+	recs := fs.all()
+
+	// Write to a temporary file first so that a crash never leaves a partially written history file
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for i := range recs {
+		if eErr := enc.Encode(entry{Record: &recs[i]}); eErr != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+			return eErr
+		}
+	}
+
+	if fErr := w.Flush(); fErr != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fErr
+	}
+
+	if cErr := tmp.Close(); cErr != nil {
+		_ = os.Remove(tmp.Name())
+		return cErr
+	}
+
+	if err = os.Rename(tmp.Name(), fs.path); err != nil {
+		return err
+	}
+
+	if fs.file != nil {
+		_ = fs.file.Close()
+	}
+
+	fs.file, err = os.OpenFile(fs.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	fs.entries = len(recs)
+	return nil
+}
+
+// Close ... Closes the file
+func (fs *FileStore) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.file.Close()
+}
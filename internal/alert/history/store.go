@@ -0,0 +1,249 @@
+package history
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+// DefaultLimit ... Number of records retained by default; the oldest records are evicted first
+const DefaultLimit = 10_000
+
+// ErrNotFound ... Returned when no alert is retained for an ID
+var ErrNotFound = errors.New("no alert exists")
+
+// DeliveryStatus ... Outcome of delivering an alert to a single destination
+type DeliveryStatus string
+
+const (
+	// Pending ... Queued for delivery
+	Pending DeliveryStatus = "pending"
+	// Sent ... Accepted by the destination
+	Sent DeliveryStatus = "sent"
+	// Failed ... Rejected by the destination or not delivered within the send timeout
+	Failed DeliveryStatus = "failed"
+	// Dropped ... Discarded without delivery, E.G because the destination's queue was full
+	Dropped DeliveryStatus = "dropped"
+)
+
+// Delivery ... Delivery state of an alert at a single destination
+type Delivery struct {
+	Destination string         `json:"destination"`
+	Status      DeliveryStatus `json:"status"`
+	Error       string         `json:"error,omitempty"`
+	Updated     time.Time      `json:"updated"`
+}
+
+// Acknowledgement ... Acknowledgement of an alert by an operator
+type Acknowledgement struct {
+	By           string    `json:"by"`
+	Acknowledged time.Time `json:"acknowledged"`
+	// Time after which re-alerts of the alert's condition are no longer suppressed
+	Expires time.Time `json:"expires"`
+}
+
+// Record ... Dispatched alert & its delivery state at every destination it was routed to
+type Record struct {
+	ID           string              `json:"id"`
+	Invalidation engine.Invalidation `json:"invalidation"`
+	Dispatched   time.Time           `json:"dispatched"`
+	Deliveries   []Delivery          `json:"deliveries"`
+	// Set once the alert is acknowledged
+	Acknowledgement *Acknowledgement `json:"acknowledgement,omitempty"`
+}
+
+// copy ... Returns a copy of the record that shares no deliveries or acknowledgement with it
+func (r Record) copy() Record {
+	r.Deliveries = append([]Delivery(nil), r.Deliveries...)
+	if r.Acknowledgement != nil {
+		ack := *r.Acknowledgement
+		r.Acknowledgement = &ack
+	}
+
+	return r
+}
+
+// Query ... Filter applied to records; zero values match every record
+type Query struct {
+	SessionID engine.SessionID
+	Invariant invariant.Type
+	Network   models.Network
+	Severity  invariant.Severity
+	// Records dispatched at or after From & before To
+	From time.Time
+	To   time.Time
+	// Maximum number of records returned; unlimited when zero
+	Limit int
+	// Optional; ID of the record that the previous page of results ended with, only records added before
+	// it are returned. Nothing is returned once the record is no longer retained
+	Before string
+}
+
+// matches ... Returns true if the record satisfies every filter
+func (q Query) matches(r Record) bool {
+	inval := r.Invalidation
+
+	switch {
+	case q.SessionID != "" && q.SessionID != inval.SessionID,
+		q.Invariant != "" && q.Invariant != inval.Invariant,
+		q.Network != "" && q.Network != inval.Network,
+		q.Severity != "" && q.Severity != inval.Severity,
+		!q.From.IsZero() && r.Dispatched.Before(q.From),
+		!q.To.IsZero() && !r.Dispatched.Before(q.To):
+		return false
+
+	default:
+		return true
+	}
+}
+
+// Store ... Persists dispatched alerts & their delivery state
+type Store interface {
+	// Add ... Records a dispatched alert
+	Add(rec Record) error
+	// Update ... Replaces the delivery state of the alert at the delivery's destination; no-op for unknown
+	// alerts
+	Update(id string, d Delivery) error
+	// Acknowledge ... Records the acknowledgement of the alert; no-op for unknown alerts
+	Acknowledge(id string, ack Acknowledgement) error
+	// Get ... Returns the alert with the ID; false if no such alert is retained
+	Get(id string) (Record, bool, error)
+	// Query ... Returns the alerts matching the query, most recently dispatched first
+	Query(q Query) ([]Record, error)
+	Close() error
+}
+
+// MemoryStore ... Non-persistent store retaining a bounded number of records; records are lost on restart
+type MemoryStore struct {
+	mu    sync.RWMutex
+	limit int
+	// IDs in the order they were added
+	order   []string
+	records map[string]*Record
+}
+
+// NewMemoryStore ... Initializer; the default limit is used when the limit isn't positive
+func NewMemoryStore(limit int) *MemoryStore {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	return &MemoryStore{limit: limit, records: make(map[string]*Record)}
+}
+
+// Add ... Records a dispatched alert, evicting the oldest record once the limit is exceeded
+func (ms *MemoryStore) Add(rec Record) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	rec = rec.copy()
+	if _, found := ms.records[rec.ID]; !found {
+		ms.order = append(ms.order, rec.ID)
+	}
+	ms.records[rec.ID] = &rec
+
+	for len(ms.order) > ms.limit {
+		delete(ms.records, ms.order[0])
+		ms.order = ms.order[1:]
+	}
+
+	return nil
+}
+
+// Update ... Replaces the delivery state of the alert at the delivery's destination
+func (ms *MemoryStore) Update(id string, d Delivery) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	rec, found := ms.records[id]
+	if !found {
+		return nil
+	}
+
+	for i := range rec.Deliveries {
+		if rec.Deliveries[i].Destination == d.Destination {
+			rec.Deliveries[i] = d
+			return nil
+		}
+	}
+
+	rec.Deliveries = append(rec.Deliveries, d)
+	return nil
+}
+
+// Acknowledge ... Records the acknowledgement of the alert
+func (ms *MemoryStore) Acknowledge(id string, ack Acknowledgement) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if rec, found := ms.records[id]; found {
+		rec.Acknowledgement = &ack
+	}
+
+	return nil
+}
+
+// Get ... Returns the alert with the ID
+func (ms *MemoryStore) Get(id string) (Record, bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	rec, found := ms.records[id]
+	if !found {
+		return Record{}, false, nil
+	}
+
+	return rec.copy(), true, nil
+}
+
+// Query ... Returns the alerts matching the query, most recently dispatched first
+func (ms *MemoryStore) Query(q Query) ([]Record, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	start := len(ms.order) - 1
+	if q.Before != "" {
+		start = -1
+		for i := len(ms.order) - 1; i >= 0; i-- {
+			if ms.order[i] == q.Before {
+				start = i - 1
+				break
+			}
+		}
+	}
+
+	recs := make([]Record, 0)
+	for i := start; i >= 0; i-- {
+		if q.Limit > 0 && len(recs) == q.Limit {
+			break
+		}
+
+		if rec := ms.records[ms.order[i]]; q.matches(*rec) {
+			recs = append(recs, rec.copy())
+		}
+	}
+
+	return recs, nil
+}
+
+// all ... Returns every record in the order they were added
+func (ms *MemoryStore) all() []Record {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	recs := make([]Record, 0, len(ms.order))
+	for _, id := range ms.order {
+		recs = append(recs, ms.records[id].copy())
+	}
+
+	return recs
+}
+
+// Close ... No-op
+func (ms *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,185 @@
+package history
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+// record ... Returns a record dispatched the number of minutes after the epoch
+func record(id string, minute int, session engine.SessionID, severity invariant.Severity) Record {
+	return Record{
+		ID: id,
+		Invalidation: engine.Invalidation{
+			SessionID: session,
+			Invariant: "BALANCE_ENFORCEMENT",
+			Severity:  severity,
+			Network:   models.Layer1,
+		},
+		Dispatched: time.Unix(int64(minute*60), 0).UTC(),
+		Deliveries: []Delivery{{Destination: "ops", Status: Pending}},
+	}
+}
+
+func ids(recs []Record) []string {
+	out := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		out = append(out, rec.ID)
+	}
+
+	return out
+}
+
+func Test_MemoryStore_Query(t *testing.T) {
+	store := NewMemoryStore(0)
+	for _, rec := range []Record{
+		record("a", 0, "s1", invariant.Low),
+		record("b", 1, "s2", invariant.Critical),
+		record("c", 2, "s1", invariant.Critical),
+		record("d", 3, "s1", invariant.Low),
+	} {
+		assert.NoError(t, store.Add(rec))
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		query Query
+		ids   []string
+	}{
+		{
+			name:        "All",
+			description: "Every record should be returned most recent first",
+			ids:         []string{"d", "c", "b", "a"},
+		},
+		{
+			name:        "Session & Severity",
+			description: "Records should match every filter",
+			query:       Query{SessionID: "s1", Severity: invariant.Critical},
+			ids:         []string{"c"},
+		},
+		{
+			name:        "Time Range",
+			description: "Records dispatched from the start & before the end should be returned",
+			query:       Query{From: time.Unix(60, 0), To: time.Unix(180, 0)},
+			ids:         []string{"c", "b"},
+		},
+		{
+			name:        "Limit",
+			description: "At most the limit should be returned",
+			query:       Query{SessionID: "s1", Limit: 2},
+			ids:         []string{"d", "c"},
+		},
+		{
+			name:        "Cursor",
+			description: "Records added before the cursor record should be returned",
+			query:       Query{SessionID: "s1", Limit: 2, Before: "c"},
+			ids:         []string{"a"},
+		},
+		{
+			name:        "Unknown Cursor",
+			description: "Nothing should be returned once the cursor record is no longer retained",
+			query:       Query{Before: "z"},
+			ids:         []string{},
+		},
+		{
+			name:        "No Match",
+			description: "An empty result should be returned when nothing matches",
+			query:       Query{Network: models.Layer2},
+			ids:         []string{},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			recs, err := store.Query(tc.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ids, ids(recs))
+		})
+	}
+}
+
+func Test_MemoryStore_Update(t *testing.T) {
+	store := NewMemoryStore(2)
+	assert.NoError(t, store.Add(record("a", 0, "s", invariant.Low)))
+
+	assert.NoError(t, store.Update("a", Delivery{Destination: "ops", Status: Sent}))
+	assert.NoError(t, store.Update("a", Delivery{Destination: "oncall", Status: Failed, Error: "timeout"}))
+	assert.NoError(t, store.Update("unknown", Delivery{Destination: "ops", Status: Sent}))
+
+	rec, found, err := store.Get("a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []Delivery{{Destination: "ops", Status: Sent},
+		{Destination: "oncall", Status: Failed, Error: "timeout"}}, rec.Deliveries)
+
+	assert.NoError(t, store.Add(record("b", 1, "s", invariant.Low)))
+	assert.NoError(t, store.Add(record("c", 2, "s", invariant.Low)))
+
+	_, found, err = store.Get("a")
+	assert.NoError(t, err)
+	assert.False(t, found, "Ensuring the oldest record is evicted once the limit is exceeded")
+}
+
+func Test_FileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+
+	store, err := NewFileStore(path, 2)
+	assert.NoError(t, err, "Ensuring a missing file yields an empty store")
+
+	assert.NoError(t, store.Add(record("a", 0, "s", invariant.Low)))
+	assert.NoError(t, store.Update("a", Delivery{Destination: "ops", Status: Sent}))
+	assert.NoError(t, store.Add(record("b", 1, "s", invariant.High)))
+	assert.NoError(t, store.Acknowledge("b", Acknowledgement{By: "oncall"}))
+	assert.NoError(t, store.Close())
+
+	// Reload from disk to ensure records & deliveries survive restarts
+	reloaded, err := NewFileStore(path, 2)
+	assert.NoError(t, err)
+
+	rec, found, err := reloaded.Get("a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, Sent, rec.Deliveries[0].Status)
+
+	rec, _, err = reloaded.Get("b")
+	assert.NoError(t, err)
+	assert.Equal(t, &Acknowledgement{By: "oncall"}, rec.Acknowledgement)
+
+	// Exceed twice the limit to force a compaction that drops the evicted record
+	for i, id := range []string{"c", "d", "e"} {
+		assert.NoError(t, reloaded.Add(record(id, i+2, "s", invariant.Low)))
+	}
+	assert.NoError(t, reloaded.Close())
+
+	raw, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), `"id":"a"`, "Ensuring evicted records are compacted away")
+
+	// Simulate a crash mid write of the final entry
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	assert.NoError(t, err)
+	_, err = f.WriteString(`{"record":{"id":"f"`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	reloaded, err = NewFileStore(path, 2)
+	assert.NoError(t, err, "Ensuring a truncated final entry is discarded")
+
+	recs, err := reloaded.Query(Query{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e", "d"}, ids(recs))
+	assert.NoError(t, reloaded.Close())
+
+	assert.NoError(t, os.WriteFile(path, []byte("not json\n{}\n"), 0o600))
+	_, err = NewFileStore(path, 2)
+	assert.Error(t, err, "Ensuring corrupt history files are rejected")
+}
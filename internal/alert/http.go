@@ -0,0 +1,86 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// httpTimeout ... Upper bound on a single HTTP request made by a destination
+	httpTimeout = 10 * time.Second
+	// maxErrorBody ... Number of response body bytes included in delivery errors
+	maxErrorBody = 512
+)
+
+// newHTTPClient ... Returns the client used by HTTP based destinations
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: httpTimeout}
+}
+
+// postJSON ... POSTs the JSON encoded body to the URL; fail on non 2xx responses
+func postJSON(ctx context.Context, client *http.Client, url string, body any, headers map[string]string) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	return post(ctx, client, url, raw, "application/json", headers)
+}
+
+// post ... POSTs the raw body to the URL; fail on non 2xx responses
+func post(ctx context.Context, client *http.Client, url string, body []byte, contentType string,
+	headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	for key, val := range headers {
+		req.Header.Set(key, val)
+	}
+
+	return do(client, req)
+}
+
+// get ... GETs the URL; fail on non 2xx responses
+func get(ctx context.Context, client *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	return do(client, req)
+}
+
+// do ... Sends the request; fail on non 2xx responses
+func do(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return &statusError{code: resp.StatusCode, body: string(msg)}
+	}
+
+	return nil
+}
+
+// statusError ... Non 2xx response returned by a destination's endpoint
+type statusError struct {
+	code int
+	body string
+}
+
+// Error ...
+func (se *statusError) Error() string {
+	return fmt.Sprintf("received status %d: %s", se.code, se.body)
+}
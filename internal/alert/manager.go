@@ -0,0 +1,419 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// outboxSize ... Number of alerts buffered per destination; alerts are dropped once full
+	outboxSize = 64
+	// sendTimeout ... Upper bound on a single delivery to a destination
+	sendTimeout = 30 * time.Second
+)
+
+// dispatch ... Alert queued for delivery
+type dispatch struct {
+	// ID of the alert's history record
+	id    string
+	inval engine.Invalidation
+}
+
+// outbox ... Queue of alerts awaiting delivery to a single destination; destinations are delivered to
+// independently so that a slow destination doesn't delay the others
+type outbox struct {
+	dest  AlertDestination
+	queue chan dispatch
+}
+
+// Option ...
+type Option = func(*Manager)
+
+// WithDefaultPolicy ... Routes invalidations of sessions without a policy using the policy; every
+// destination is alerted by default
+func WithDefaultPolicy(p Policy) Option {
+	return func(m *Manager) {
+		m.defaultPolicy = p
+	}
+}
+
+// WithRoutes ... Routes invalidations of sessions without a policy by the first route they match; the
+// default policy is used for invalidations matching no route
+func WithRoutes(routes Routes) Option {
+	return func(m *Manager) {
+		m.routes = routes
+	}
+}
+
+// WithThrottle ... Limits the alerts raised for each condition of a session within a sliding window;
+// nothing is throttled by default
+func WithThrottle(th Throttle) Option {
+	return func(m *Manager) {
+		m.throttle = th
+	}
+}
+
+// WithHistory ... Records every dispatched alert & its delivery state in the store; alerts are only
+// recorded in memory by default
+func WithHistory(store history.Store) Option {
+	return func(m *Manager) {
+		m.history = store
+	}
+}
+
+// WithAckPolicy ... Suppresses re-alerts of acknowledged conditions per the policy
+func WithAckPolicy(ap AckPolicy) Option {
+	return func(m *Manager) {
+		m.ackPolicy = ap
+	}
+}
+
+// WithDigest ... Batches less severe invalidations into periodic digests per the config; digests are
+// disabled by default
+func WithDigest(d Digest) Option {
+	return func(m *Manager) {
+		m.digest = d
+	}
+}
+
+// Manager ... Alerting subsystem used to route engine invalidations to destinations
+type Manager struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	waitGroup *sync.WaitGroup
+
+	// Destination names in configuration order
+	names    []string
+	outboxes map[string]*outbox
+
+	throttle  Throttle
+	throttler *throttler
+	history   history.Store
+
+	ackPolicy    AckPolicy
+	acknowledger *acknowledger
+
+	digest   Digest
+	digester *digester
+
+	mu            sync.RWMutex
+	defaultPolicy Policy
+	routes        Routes
+	policies      map[engine.SessionID]Policy
+}
+
+// NewManager ... Initializer; alerts every invalidation read from the input until shut down.
+// Fail if destination names aren't unique or the default policy is invalid
+func NewManager(ctx context.Context, input <-chan engine.Invalidation, destinations []AlertDestination,
+	opts ...Option) (*Manager, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	m := &Manager{
+		ctx:       ctx,
+		cancel:    cancel,
+		waitGroup: &sync.WaitGroup{},
+		names:     make([]string, 0, len(destinations)),
+		outboxes:  make(map[string]*outbox, len(destinations)),
+		policies:  make(map[engine.SessionID]Policy),
+		history:   history.NewMemoryStore(history.DefaultLimit),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	for _, dest := range destinations {
+		if _, found := m.outboxes[dest.Name()]; found {
+			cancel()
+			return nil, fmt.Errorf("destination name %s is used more than once", dest.Name())
+		}
+
+		m.names = append(m.names, dest.Name())
+		m.outboxes[dest.Name()] = &outbox{dest: dest, queue: make(chan dispatch, outboxSize)}
+	}
+
+	if err := m.defaultPolicy.validate(m.outboxes); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid default policy: %w", err)
+	}
+
+	if err := m.routes.validate(m.outboxes); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if err := m.throttle.validate(); err != nil {
+		cancel()
+		return nil, err
+	}
+	m.throttler = newThrottler(m.throttle)
+
+	if err := m.ackPolicy.validate(); err != nil {
+		cancel()
+		return nil, err
+	}
+	m.acknowledger = newAcknowledger(m.ackPolicy)
+
+	if err := m.digest.validate(); err != nil {
+		cancel()
+		return nil, err
+	}
+	m.digester = newDigester(m.digest)
+
+	for _, name := range m.names {
+		m.waitGroup.Add(1)
+		go m.deliver(m.outboxes[name])
+	}
+
+	m.waitGroup.Add(1)
+	go m.route(input)
+
+	return m, nil
+}
+
+// SetPolicy ... Routes the session's invalidations using the policy rather than the default policy;
+// called by the engine when a session requesting a policy is created
+func (m *Manager) SetPolicy(id engine.SessionID, ap engine.AlertPolicy) error {
+	p := Policy(ap)
+	if err := p.validate(m.outboxes); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.policies[id] = p
+	return nil
+}
+
+// RemovePolicy ... Routes the session's invalidations using the default policy; no-op for sessions
+// without a policy. Called by the engine when a session is torn down
+func (m *Manager) RemovePolicy(id engine.SessionID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.policies, id)
+}
+
+// SetRouting ... Replaces the default policy & routes used for sessions without a policy; fail without
+// changing either if any references an unknown destination
+func (m *Manager) SetRouting(defaultPolicy Policy, routes Routes) error {
+	if err := defaultPolicy.validate(m.outboxes); err != nil {
+		return fmt.Errorf("invalid default policy: %w", err)
+	}
+
+	if err := routes.validate(m.outboxes); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.defaultPolicy, m.routes = defaultPolicy, routes
+	return nil
+}
+
+// targets ... Returns the names of the destinations alerted of the invalidation; the session's policy
+// takes precedence over the routes which take precedence over the default policy
+func (m *Manager) targets(inval engine.Invalidation) []string {
+	m.mu.RLock()
+	p, found := m.policies[inval.SessionID]
+	routes, defaultPolicy := m.routes, m.defaultPolicy
+	m.mu.RUnlock()
+
+	if !found {
+		if r, matched := routes.match(inval); matched {
+			return r.Destinations
+		}
+
+		p = defaultPolicy
+	}
+
+	if !p.routes(inval) {
+		return nil
+	}
+
+	return p.targets(m.names)
+}
+
+// route ... Queues every invalidation read from the input onto the outboxes of its destinations unless
+// its condition is acknowledged or throttled; invalidations batched into digests are queued once the
+// digest interval elapses. Batched invalidations are discarded on shutdown
+func (m *Manager) route(input <-chan engine.Invalidation) {
+	defer m.waitGroup.Done()
+
+	// Never fires when digests are disabled
+	var digests <-chan time.Time
+	if m.digest.MaxSeverity != "" {
+		ticker := time.NewTicker(m.digest.interval())
+		defer ticker.Stop()
+
+		digests = ticker.C
+	}
+
+	for {
+		select {
+		case inval := <-input:
+			if m.acknowledger.suppresses(&inval) || !m.throttler.admit(&inval) {
+				continue
+			}
+
+			if m.digest.batches(inval) {
+				m.digester.add(inval, m.targets(inval))
+				continue
+			}
+
+			m.dispatch(inval, m.targets(inval))
+
+		case at := <-digests:
+			for name, digest := range m.digester.flush(at) {
+				m.dispatch(digest, []string{name})
+			}
+
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// dispatch ... Records the alert & queues it onto the outboxes of the destinations
+func (m *Manager) dispatch(inval engine.Invalidation, targets []string) {
+	if len(targets) == 0 {
+		return
+	}
+
+	now := time.Now()
+	rec := history.Record{
+		ID:           uuid.NewString(),
+		Invalidation: inval,
+		Dispatched:   now,
+		Deliveries:   make([]history.Delivery, 0, len(targets)),
+	}
+
+	for _, name := range targets {
+		rec.Deliveries = append(rec.Deliveries,
+			history.Delivery{Destination: name, Status: history.Pending, Updated: now})
+	}
+
+	if err := m.history.Add(rec); err != nil {
+		logging.WithContext(m.ctx).Error("Could not record alert", zap.String("session", string(inval.SessionID)),
+			zap.Error(err))
+	}
+
+	for _, name := range targets {
+		select {
+		case m.outboxes[name].queue <- dispatch{id: rec.ID, inval: inval}:
+		default:
+			logging.WithContext(m.ctx).Warn("Alert dropped; destination queue is full",
+				zap.String("destination", name), zap.String("session", string(inval.SessionID)))
+
+			m.recordDelivery(rec.ID, history.Delivery{Destination: name, Status: history.Dropped,
+				Error: "destination queue is full", Updated: time.Now()})
+		}
+	}
+}
+
+// recordDelivery ... Records the delivery state of the alert at a destination
+func (m *Manager) recordDelivery(id string, d history.Delivery) {
+	if err := m.history.Update(id, d); err != nil {
+		logging.WithContext(m.ctx).Error("Could not record alert delivery", zap.String("alert", id),
+			zap.String("destination", d.Destination), zap.Error(err))
+	}
+}
+
+// deliver ... Sends every queued alert to the outbox's destination until shut down
+func (m *Manager) deliver(ob *outbox) {
+	defer m.waitGroup.Done()
+
+	for {
+		select {
+		case d := <-ob.queue:
+			ctx, cancel := context.WithTimeout(m.ctx, sendTimeout)
+			err := ob.dest.Send(ctx, d.inval)
+			cancel()
+
+			delivery := history.Delivery{Destination: ob.dest.Name(), Status: history.Sent, Updated: time.Now()}
+			if err != nil {
+				logging.WithContext(m.ctx).Error("Could not send alert",
+					zap.String("destination", ob.dest.Name()), zap.String("session", string(d.inval.SessionID)),
+					zap.Error(err))
+
+				delivery.Status, delivery.Error = history.Failed, err.Error()
+			}
+
+			m.recordDelivery(d.id, delivery)
+
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// Checkers ... Returns the checkers of every destination that can check it's reachable keyed by name
+func (m *Manager) Checkers() map[string]Checker {
+	checkers := make(map[string]Checker)
+
+	for name, ob := range m.outboxes {
+		if checker, ok := checkerOf(ob.dest); ok {
+			checkers[name] = checker
+		}
+	}
+
+	return checkers
+}
+
+// Alert ... Returns the dispatched alert with the ID & its delivery state; false if no such alert is
+// retained by the history store
+func (m *Manager) Alert(id string) (history.Record, bool, error) {
+	return m.history.Get(id)
+}
+
+// Alerts ... Returns the dispatched alerts matching the query, most recently dispatched first
+func (m *Manager) Alerts(q history.Query) ([]history.Record, error) {
+	return m.history.Query(q)
+}
+
+// Acknowledge ... Acknowledges the alert with the ID, suppressing re-alerts of its condition until the
+// condition resolves or the acknowledgement times out. Fail if no such alert is retained by the history
+func (m *Manager) Acknowledge(id, by string) (history.Acknowledgement, error) {
+	rec, found, err := m.history.Get(id)
+	if err != nil {
+		return history.Acknowledgement{}, err
+	}
+
+	if !found {
+		return history.Acknowledgement{}, fmt.Errorf("%w for id: %s", history.ErrNotFound, id)
+	}
+
+	now := time.Now()
+	key := conditionKey{session: rec.Invalidation.SessionID, fingerprint: rec.Invalidation.Fingerprint}
+
+	ack := history.Acknowledgement{By: by, Acknowledged: now, Expires: m.acknowledger.acknowledge(key, now)}
+	if err = m.history.Acknowledge(id, ack); err != nil {
+		return history.Acknowledgement{}, err
+	}
+
+	return ack, nil
+}
+
+// Shutdown ... Stops routing, waits for in-flight deliveries to finish and closes every destination;
+// queued alerts are discarded & remain pending in the history. The history store isn't closed
+func (m *Manager) Shutdown() {
+	m.cancel()
+	m.waitGroup.Wait()
+
+	for _, name := range m.names {
+		if err := m.outboxes[name].dest.Close(); err != nil {
+			logging.WithContext(m.ctx).Error("Could not close alert destination",
+				zap.String("destination", name), zap.Error(err))
+		}
+	}
+}
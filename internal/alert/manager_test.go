@@ -0,0 +1,421 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// destinationMock ... Records every sent invalidation
+type destinationMock struct {
+	name string
+	sent chan engine.Invalidation
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func newDestinationMock(name string) *destinationMock {
+	return &destinationMock{name: name, sent: make(chan engine.Invalidation, 10)}
+}
+
+func (dm *destinationMock) Name() string {
+	return dm.name
+}
+
+func (dm *destinationMock) Send(_ context.Context, inval engine.Invalidation) error {
+	dm.sent <- inval
+	return nil
+}
+
+func (dm *destinationMock) Close() error {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dm.closed = true
+	return nil
+}
+
+func Test_Manager_Routing(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		defaultPolicy Policy
+		routes        Routes
+		policy        *Policy
+		severity      invariant.Severity
+		// Names of the destinations expected to be alerted
+		alerted []string
+	}{
+		{
+			name:          "Default Policy",
+			description:   "Every destination should be alerted by default",
+			defaultPolicy: Policy{},
+			severity:      invariant.Low,
+			alerted:       []string{"ops", "oncall"},
+		},
+		{
+			name:          "Default Destinations",
+			description:   "Only the default policy's destinations should be alerted",
+			defaultPolicy: Policy{Destinations: []string{"ops"}},
+			severity:      invariant.Low,
+			alerted:       []string{"ops"},
+		},
+		{
+			name:          "Session Policy",
+			description:   "Session policies should override the default policy",
+			defaultPolicy: Policy{Destinations: []string{"ops"}},
+			policy:        &Policy{Destinations: []string{"oncall"}},
+			severity:      invariant.Low,
+			alerted:       []string{"oncall"},
+		},
+		{
+			name:          "Below Min Severity",
+			description:   "Invalidations less severe than the min severity shouldn't be alerted",
+			defaultPolicy: Policy{},
+			policy:        &Policy{MinSeverity: invariant.High},
+			severity:      invariant.Medium,
+		},
+		{
+			name:          "At Min Severity",
+			description:   "Invalidations as severe as the min severity should be alerted",
+			defaultPolicy: Policy{},
+			policy:        &Policy{Destinations: []string{"oncall"}, MinSeverity: invariant.High},
+			severity:      invariant.Critical,
+			alerted:       []string{"oncall"},
+		},
+		{
+			name:        "Severity Route",
+			description: "Invalidations should be alerted to the destinations of their severity's route",
+			routes: Routes{
+				{Severities: []invariant.Severity{invariant.Critical}, Destinations: []string{"ops", "oncall"}},
+				{Severities: []invariant.Severity{invariant.Low}, Destinations: []string{"ops"}},
+			},
+			severity: invariant.Low,
+			alerted:  []string{"ops"},
+		},
+		{
+			name:        "First Matching Route",
+			description: "Invalidations should only be alerted to the destinations of the first route they match",
+			routes: Routes{
+				{Invariants: []invariant.Type{"BALANCE_ENFORCEMENT"}, Networks: []models.Network{models.Layer1},
+					Destinations: []string{"oncall"}},
+				{Destinations: []string{"ops"}},
+			},
+			severity: invariant.Low,
+			alerted:  []string{"oncall"},
+		},
+		{
+			name:          "Unmatched Route",
+			description:   "Invalidations matching no route should be alerted using the default policy",
+			defaultPolicy: Policy{Destinations: []string{"ops"}},
+			routes:        Routes{{Networks: []models.Network{models.Layer2}, Destinations: []string{"oncall"}}},
+			severity:      invariant.Low,
+			alerted:       []string{"ops"},
+		},
+		{
+			name:        "Session Policy Over Route",
+			description: "Session policies should take precedence over routes",
+			routes:      Routes{{Destinations: []string{"oncall"}}},
+			policy:      &Policy{Destinations: []string{"ops"}},
+			severity:    invariant.Low,
+			alerted:     []string{"ops"},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			dests := map[string]*destinationMock{
+				"ops":    newDestinationMock("ops"),
+				"oncall": newDestinationMock("oncall"),
+			}
+
+			input := make(chan engine.Invalidation)
+			m, err := NewManager(context.Background(), input,
+				[]AlertDestination{dests["ops"], dests["oncall"]}, WithDefaultPolicy(tc.defaultPolicy),
+				WithRoutes(tc.routes))
+			assert.NoError(t, err)
+			defer m.Shutdown()
+
+			if tc.policy != nil {
+				assert.NoError(t, m.SetPolicy("session", engine.AlertPolicy(*tc.policy)))
+			}
+
+			input <- engine.Invalidation{SessionID: "session", Invariant: "BALANCE_ENFORCEMENT",
+				Network: models.Layer1, Severity: tc.severity}
+
+			for _, name := range tc.alerted {
+				select {
+				case inval := <-dests[name].sent:
+					assert.Equal(t, engine.SessionID("session"), inval.SessionID)
+				case <-time.After(5 * time.Second):
+					t.Fatalf("expected %s to be alerted", name)
+				}
+			}
+
+			// Allow unexpected deliveries to be observed
+			time.Sleep(20 * time.Millisecond)
+			for _, dest := range dests {
+				assert.Empty(t, dest.sent, "%s received an unexpected alert", dest.name)
+			}
+		})
+	}
+}
+
+func Test_Manager_Invalid(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ops := newDestinationMock("ops")
+
+	_, err := NewManager(context.Background(), nil, []AlertDestination{ops, newDestinationMock("ops")})
+	assert.Error(t, err, "Ensuring destination names are unique")
+
+	_, err = NewManager(context.Background(), nil, []AlertDestination{ops},
+		WithDefaultPolicy(Policy{Destinations: []string{"unknown"}}))
+	assert.Error(t, err, "Ensuring default policies only reference known destinations")
+
+	for _, route := range []Route{
+		{},
+		{Destinations: []string{"unknown"}},
+		{Severities: []invariant.Severity{"urgent"}, Destinations: []string{"ops"}},
+		{Networks: []models.Network{"layer3"}, Destinations: []string{"ops"}},
+	} {
+		_, err = NewManager(context.Background(), nil, []AlertDestination{ops}, WithRoutes(Routes{route}))
+		assert.Error(t, err, "Ensuring invalid routes are rejected")
+	}
+
+	m, err := NewManager(context.Background(), nil, []AlertDestination{ops})
+	assert.NoError(t, err)
+
+	assert.Error(t, m.SetPolicy("session", engine.AlertPolicy{MinSeverity: "urgent"}))
+	assert.Error(t, m.SetPolicy("session", engine.AlertPolicy{Destinations: []string{"unknown"}}))
+
+	m.Shutdown()
+	assert.True(t, ops.closed, "Ensuring destinations are closed on shutdown")
+}
+
+func Test_Manager_SetRouting(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ops, oncall := newDestinationMock("ops"), newDestinationMock("oncall")
+	input := make(chan engine.Invalidation)
+	m, err := NewManager(context.Background(), input, []AlertDestination{ops, oncall},
+		WithDefaultPolicy(Policy{Destinations: []string{"ops"}}))
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	assert.Error(t, m.SetRouting(Policy{Destinations: []string{"unknown"}}, nil),
+		"Ensuring default policies only reference known destinations")
+	assert.Error(t, m.SetRouting(Policy{}, Routes{{Destinations: []string{"unknown"}}}),
+		"Ensuring invalid routes are rejected")
+
+	assert.NoError(t, m.SetRouting(Policy{Destinations: []string{"ops"}},
+		Routes{{Severities: []invariant.Severity{invariant.High}, Destinations: []string{"oncall"}}}))
+
+	input <- engine.Invalidation{SessionID: "session", Network: models.Layer1, Severity: invariant.High}
+	select {
+	case <-oncall.sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the replaced routes to be used")
+	}
+
+	// Allow unexpected deliveries to be observed
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, ops.sent, "Ensuring rejected routing changes nothing")
+}
+
+// failingDestination ... Rejects every invalidation
+type failingDestination struct {
+	name string
+}
+
+func (fd *failingDestination) Name() string {
+	return fd.name
+}
+
+func (fd *failingDestination) Send(_ context.Context, _ engine.Invalidation) error {
+	return errors.New("rejected")
+}
+
+func (fd *failingDestination) Close() error {
+	return nil
+}
+
+func Test_Manager_History(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ops := newDestinationMock("ops")
+	input := make(chan engine.Invalidation)
+
+	m, err := NewManager(context.Background(), input,
+		[]AlertDestination{ops, &failingDestination{name: "oncall"}}, WithHistory(history.NewMemoryStore(0)))
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	input <- engine.Invalidation{SessionID: "session", Severity: invariant.High, Message: "invalidated"}
+	<-ops.sent
+
+	// Deliveries are recorded after sends return
+	var rec history.Record
+	assert.Eventually(t, func() bool {
+		recs, qErr := m.Alerts(history.Query{SessionID: "session"})
+		assert.NoError(t, qErr)
+		if len(recs) != 1 {
+			return false
+		}
+
+		rec = recs[0]
+		for _, d := range rec.Deliveries {
+			if d.Status == history.Pending {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "invalidated", rec.Invalidation.Message)
+	assert.Equal(t, history.Sent, rec.Deliveries[0].Status)
+	assert.Equal(t, history.Failed, rec.Deliveries[1].Status)
+	assert.Equal(t, "rejected", rec.Deliveries[1].Error)
+
+	got, found, err := m.Alert(rec.ID)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, rec.ID, got.ID)
+}
+
+func Test_Manager_Checkers(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	var ready atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/-/ready", r.URL.Path)
+
+		if !ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	dests, err := (&Config{Destinations: []DestinationConfig{
+		{Name: "log", Type: LogDestination},
+		{Name: "am", Type: AlertmanagerDestination, URL: server.URL, Template: "{{ .Message }}"},
+	}}).Construct()
+	assert.NoError(t, err)
+
+	m, err := NewManager(context.Background(), nil, dests)
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	checkers := m.Checkers()
+	assert.Len(t, checkers, 1, "Ensuring destinations that can't be checked are omitted")
+	assert.Contains(t, checkers, "am", "Ensuring templated destinations are unwrapped")
+
+	assert.Error(t, checkers["am"].Check(context.Background()))
+
+	ready.Store(true)
+	assert.NoError(t, checkers["am"].Check(context.Background()))
+}
+
+// sessionPipelines ... Hands the engine the output channel of every submitted pipeline
+type sessionPipelines struct {
+	mu      sync.Mutex
+	outputs []chan models.TransitData
+}
+
+func (sp *sessionPipelines) SubmitPipeline(_ etl.PipelineRequest,
+	output chan models.TransitData) (etl.PipelineID, error) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	sp.outputs = append(sp.outputs, output)
+	return etl.PipelineID(len(sp.outputs)), nil
+}
+
+func (sp *sessionPipelines) RemovePipeline(_ etl.PipelineID) error {
+	return nil
+}
+
+func (sp *sessionPipelines) GetState(_ etl.PipelineID) (models.PipelineState, error) {
+	return models.LiveState, nil
+}
+
+func Test_Manager_SessionPolicy(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	dests := map[string]*destinationMock{
+		"ops":    newDestinationMock("ops"),
+		"oncall": newDestinationMock("oncall"),
+	}
+
+	input := make(chan engine.Invalidation)
+	m, err := NewManager(context.Background(), input, []AlertDestination{dests["ops"], dests["oncall"]},
+		WithDefaultPolicy(Policy{Destinations: []string{"ops"}}))
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	sp := &sessionPipelines{}
+	e := engine.NewEngine(context.Background(), sp, input, engine.WithPolicyRouter(m))
+	defer e.Shutdown()
+
+	req := engine.SessionRequest{
+		Invariant: registry.LargeTxValue,
+		Params:    models.Params{registry.ThresholdParam: 100},
+		Pipeline:  etl.PipelineRequest{Network: models.Layer1},
+	}
+
+	// Policies referencing unknown destinations should reject the session before any pipeline is created
+	req.AlertPolicy = &engine.AlertPolicy{Destinations: []string{"unknown"}}
+	_, err = e.CreateSession(req)
+	assert.Error(t, err)
+	assert.Empty(t, e.Sessions())
+	assert.Empty(t, sp.outputs)
+
+	req.AlertPolicy = &engine.AlertPolicy{Destinations: []string{"oncall"}}
+	id, err := e.CreateSession(req)
+	assert.NoError(t, err)
+
+	to := common.HexToAddress("0x1")
+	tx := types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(100)})
+	sp.outputs[0] <- models.TransitData{Type: conduit.AddressWatchTX, Value: tx, Height: big.NewInt(1)}
+
+	select {
+	case inval := <-dests["oncall"].sent:
+		assert.Equal(t, id, inval.SessionID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the session policy's destination to be alerted")
+	}
+
+	// Allow unexpected deliveries to be observed
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, dests["ops"].sent, "Ensuring the default policy isn't used")
+
+	_, err = e.EndSession(id)
+	assert.NoError(t, err)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	assert.NotContains(t, m.policies, id, "Ensuring the policy is removed once the session ends")
+}
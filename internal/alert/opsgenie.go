@@ -0,0 +1,116 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// opsgenieAPI ... Base URL of the Opsgenie API for accounts hosted in the US region
+	opsgenieAPI = "https://api.opsgenie.com"
+
+	// Opsgenie alert limits; longer values are truncated by the API
+	opsgenieMaxMessage     = 130
+	opsgenieMaxDescription = 15000
+	opsgenieMaxDetail      = 8000
+)
+
+// opsgeniePriorities ... Opsgenie priority of each severity; P5 is left for informational alerts
+var opsgeniePriorities = map[invariant.Severity]string{
+	invariant.Low:      "P4",
+	invariant.Medium:   "P3",
+	invariant.High:     "P2",
+	invariant.Critical: "P1",
+}
+
+// opsgenieAlert ... Body of a create alert request
+type opsgenieAlert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description"`
+	Tags        []string          `json:"tags"`
+	Details     map[string]string `json:"details"`
+	Entity      string            `json:"entity"`
+	Source      string            `json:"source"`
+	Priority    string            `json:"priority"`
+}
+
+// opsgenieDestination ... Creates an Opsgenie alert for every invalidation; alerts are aliased by session
+// so that repeated invalidations are deduplicated by Opsgenie while the alert is open
+type opsgenieDestination struct {
+	name   string
+	api    string
+	key    string
+	client *http.Client
+}
+
+// newOpsgenieDestination ... Initializer; the default API is used when none is provided
+func newOpsgenieDestination(name, api, key string) *opsgenieDestination {
+	if api == "" {
+		api = opsgenieAPI
+	}
+
+	return &opsgenieDestination{
+		name:   name,
+		api:    strings.TrimSuffix(api, "/"),
+		key:    key,
+		client: newHTTPClient(),
+	}
+}
+
+// Name ... Returns the destination name
+func (od *opsgenieDestination) Name() string {
+	return od.name
+}
+
+// Send ... Creates an alert for the invalidation
+func (od *opsgenieDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	return postJSON(ctx, od.client, od.api+"/v2/alerts", opsgenieAlertOf(inval), map[string]string{
+		"Authorization": "GenieKey " + od.key,
+	})
+}
+
+// Close ... No-op
+func (od *opsgenieDestination) Close() error {
+	return nil
+}
+
+// opsgenieAlertOf ... Renders the invalidation as an alert tagged by network & invariant type; the
+// invalidation's context is attached as alert details
+func opsgenieAlertOf(inval engine.Invalidation) opsgenieAlert {
+	details := make(map[string]string, len(inval.Context)+3)
+	for key, val := range inval.Context {
+		details[key] = truncate(fmt.Sprintf("%v", val), opsgenieMaxDetail)
+	}
+
+	details["session"] = string(inval.SessionID)
+	details["severity"] = string(inval.Severity)
+	if inval.Height != nil {
+		details["height"] = inval.Height.String()
+	}
+
+	if inval.Suppressed > 0 {
+		details["suppressed"] = fmt.Sprintf("%d", inval.Suppressed)
+	}
+
+	priority, found := opsgeniePriorities[inval.Severity]
+	if !found {
+		priority = opsgeniePriorities[invariant.Low]
+	}
+
+	return opsgenieAlert{
+		Message:     truncate(fmt.Sprintf("%s invalidated on %s", inval.Invariant, inval.Network), opsgenieMaxMessage),
+		Alias:       string(inval.SessionID),
+		Description: truncate(inval.Message, opsgenieMaxDescription),
+		Tags:        []string{string(inval.Network), string(inval.Invariant)},
+		Details:     details,
+		Entity:      string(inval.Invariant),
+		Source:      "pessimism",
+		Priority:    priority,
+	}
+}
@@ -0,0 +1,76 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OpsgenieDestination(t *testing.T) {
+	received := make(chan opsgenieAlert, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/alerts", r.URL.Path)
+		assert.Equal(t, "GenieKey secret", r.Header.Get("Authorization"))
+
+		var alert opsgenieAlert
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		received <- alert
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	dest, err := NewDestination(DestinationConfig{Name: "opsgenie", Type: OpsgenieDestination,
+		URL: server.URL + "/", APIKey: "secret"})
+	assert.NoError(t, err)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		severity invariant.Severity
+		priority string
+	}{
+		{name: "Low", description: "Low severities should map to P4", severity: invariant.Low, priority: "P4"},
+		{name: "Medium", description: "Medium severities should map to P3", severity: invariant.Medium, priority: "P3"},
+		{name: "High", description: "High severities should map to P2", severity: invariant.High, priority: "P2"},
+		{name: "Critical", description: "Critical severities should map to P1",
+			severity: invariant.Critical, priority: "P1"},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			assert.NoError(t, dest.Send(context.Background(), engine.Invalidation{
+				SessionID: "session",
+				Invariant: "BALANCE_ENFORCEMENT",
+				Severity:  tc.severity,
+				Network:   models.Layer2,
+				Height:    big.NewInt(7),
+				Message:   "balance is below 1 ETH",
+				Context:   map[string]any{"balance": 0.5},
+			}))
+
+			alert := <-received
+			assert.Equal(t, tc.priority, alert.Priority)
+			assert.Equal(t, []string{"layer2", "BALANCE_ENFORCEMENT"}, alert.Tags)
+			assert.Equal(t, "BALANCE_ENFORCEMENT invalidated on layer2", alert.Message)
+			assert.Equal(t, "session", alert.Alias)
+			assert.Equal(t, "balance is below 1 ETH", alert.Description)
+			assert.Equal(t, "0.5", alert.Details["balance"])
+			assert.Equal(t, "7", alert.Details["height"])
+		})
+	}
+
+	_, err = NewDestination(DestinationConfig{Name: "opsgenie", Type: OpsgenieDestination})
+	assert.Error(t, err, "Ensuring an api key is required")
+}
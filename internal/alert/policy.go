@@ -0,0 +1,40 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+// Policy ... Routing of a session's invalidations to destinations; sessions request one through
+// engine.SessionRequest
+type Policy engine.AlertPolicy
+
+// validate ... Ensures the policy only references known destinations & severities
+func (p Policy) validate(known map[string]*outbox) error {
+	if p.MinSeverity != "" && !p.MinSeverity.Valid() {
+		return fmt.Errorf("invalid severity: %s", p.MinSeverity)
+	}
+
+	for _, name := range p.Destinations {
+		if _, found := known[name]; !found {
+			return fmt.Errorf("no destination exists for name: %s", name)
+		}
+	}
+
+	return nil
+}
+
+// routes ... Returns true if the invalidation is alerted
+func (p Policy) routes(inval engine.Invalidation) bool {
+	return p.MinSeverity == "" || inval.Severity.AtLeast(p.MinSeverity)
+}
+
+// targets ... Returns the names of the alerted destinations given every known destination in order
+func (p Policy) targets(all []string) []string {
+	if len(p.Destinations) == 0 {
+		return all
+	}
+
+	return p.Destinations
+}
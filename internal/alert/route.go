@@ -0,0 +1,94 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+// Route ... Maps the invalidations it matches to a set of destinations; every condition left empty
+// matches any invalidation
+type Route struct {
+	Severities []invariant.Severity `json:"severities,omitempty"`
+	Invariants []invariant.Type     `json:"invariants,omitempty"`
+	Networks   []models.Network     `json:"networks,omitempty"`
+
+	// Names of the alerted destinations
+	Destinations []string `json:"destinations"`
+}
+
+// validate ... Ensures the route only references known destinations, severities & networks
+func (r Route) validate(known map[string]*outbox) error {
+	if len(r.Destinations) == 0 {
+		return fmt.Errorf("route has no destinations")
+	}
+
+	for _, severity := range r.Severities {
+		if !severity.Valid() {
+			return fmt.Errorf("invalid severity: %s", severity)
+		}
+	}
+
+	for _, n := range r.Networks {
+		if n != models.Layer1 && n != models.Layer2 {
+			return fmt.Errorf("invalid network: %s", n)
+		}
+	}
+
+	for _, name := range r.Destinations {
+		if _, found := known[name]; !found {
+			return fmt.Errorf("no destination exists for name: %s", name)
+		}
+	}
+
+	return nil
+}
+
+// matches ... Returns true if the invalidation satisfies every condition of the route
+func (r Route) matches(inval engine.Invalidation) bool {
+	return contains(r.Severities, inval.Severity) &&
+		contains(r.Invariants, inval.Invariant) &&
+		contains(r.Networks, inval.Network)
+}
+
+// contains ... Returns true if the values are empty or include the value
+func contains[T comparable](values []T, v T) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, val := range values {
+		if val == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Routes ... Ordered routes; invalidations are routed by the first route they match
+type Routes []Route
+
+// validate ... Ensures every route is valid
+func (rs Routes) validate(known map[string]*outbox) error {
+	for i, r := range rs {
+		if err := r.validate(known); err != nil {
+			return fmt.Errorf("invalid route %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// match ... Returns the first route matching the invalidation
+func (rs Routes) match(inval engine.Invalidation) (Route, bool) {
+	for _, r := range rs {
+		if r.matches(inval) {
+			return r, true
+		}
+	}
+
+	return Route{}, false
+}
@@ -0,0 +1,134 @@
+package alert
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/sigv4"
+)
+
+const (
+	// snsVersion ... Version of the SNS query API
+	snsVersion = "2010-03-31"
+	// snsMaxSubject ... Maximum subject length accepted by SNS
+	snsMaxSubject = 100
+)
+
+// snsRegion ... Returns the region of a topic ARN; arn:<partition>:sns:<region>:<account>:<topic>
+func snsRegion(topicARN string) (string, error) {
+	parts := strings.Split(topicARN, ":")
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "sns" || parts[3] == "" {
+		return "", fmt.Errorf("invalid topic arn: %s", topicARN)
+	}
+
+	return parts[3], nil
+}
+
+// snsDestination ... Publishes every invalidation as JSON to an SNS topic; the severity, network &
+// invariant type are set as message attributes so that subscriptions can filter on them
+type snsDestination struct {
+	name     string
+	topicARN string
+	region   string
+	endpoint string
+	signer   *sigv4.Signer
+	client   *http.Client
+}
+
+// newSNSDestination ... Initializer; credentials are resolved through the default AWS credential chain & the
+// regional endpoint is used when none is provided
+func newSNSDestination(name, topicARN, endpoint string) (*snsDestination, error) {
+	region, err := snsRegion(topicARN)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := sigv4.NewSigner(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://sns.%s.amazonaws.com/", region)
+	}
+
+	return &snsDestination{
+		name:     name,
+		topicARN: topicARN,
+		region:   region,
+		endpoint: endpoint,
+		signer:   signer,
+		client:   newHTTPClient(),
+	}, nil
+}
+
+// Name ... Returns the destination name
+func (sd *snsDestination) Name() string {
+	return sd.name
+}
+
+// Send ... Publishes the invalidation to the topic
+func (sd *snsDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	form, err := sd.publishForm(inval)
+	if err != nil {
+		return err
+	}
+
+	body := []byte(form.Encode())
+	contentType := "application/x-www-form-urlencoded; charset=utf-8"
+
+	headers, err := sd.signer.Sign(ctx, sd.region, "sns", sd.endpoint, body, contentType, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return post(ctx, sd.client, sd.endpoint, body, contentType, headers)
+}
+
+// Close ... No-op
+func (sd *snsDestination) Close() error {
+	return nil
+}
+
+// publishForm ... Returns the parameters of a Publish action for the invalidation; messages published to
+// FIFO topics are grouped by session & deduplicated by content
+func (sd *snsDestination) publishForm(inval engine.Invalidation) (url.Values, error) {
+	msg, err := json.Marshal(inval)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", snsVersion)
+	form.Set("TopicArn", sd.topicARN)
+	form.Set("Message", string(msg))
+	form.Set("Subject", truncate(fmt.Sprintf("%s invalidated on %s", inval.Invariant, inval.Network), snsMaxSubject))
+
+	for i, attr := range [][2]string{
+		{"severity", string(inval.Severity)},
+		{"network", string(inval.Network)},
+		{"invariant", string(inval.Invariant)},
+	} {
+		prefix := fmt.Sprintf("MessageAttributes.entry.%d.", i+1)
+		form.Set(prefix+"Name", attr[0])
+		form.Set(prefix+"Value.DataType", "String")
+		form.Set(prefix+"Value.StringValue", attr[1])
+	}
+
+	if strings.HasSuffix(sd.topicARN, ".fifo") {
+		digest := sha256.Sum256(msg)
+		form.Set("MessageGroupId", string(inval.SessionID))
+		form.Set("MessageDeduplicationId", hex.EncodeToString(digest[:]))
+	}
+
+	return form, nil
+}
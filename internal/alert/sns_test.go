@@ -0,0 +1,168 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/sigv4"
+	"github.com/stretchr/testify/assert"
+)
+
+// isolateAWSCredentials ... Isolates the default AWS credential chain from the host's shared config &
+// instance metadata
+func isolateAWSCredentials(t *testing.T) {
+	t.Setenv("AWS_CONFIG_FILE", t.TempDir()+"/config")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", t.TempDir()+"/credentials")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+}
+
+// verifySNSSignature ... Re-signs the received request with the expected credentials & asserts that the
+// signatures match; returns the request's form
+func verifySNSSignature(t *testing.T, r *http.Request, endpoint, accessKeyID, secret, token string) url.Values {
+	body, err := io.ReadAll(r.Body)
+	assert.NoError(t, err)
+
+	at, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+	assert.NoError(t, err)
+
+	expected, err := sigv4.NewStaticSigner(accessKeyID, secret, token).Sign(context.Background(), "us-east-1",
+		"sns", endpoint, body, r.Header.Get("Content-Type"), at)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected["Authorization"], r.Header.Get("Authorization"), "Ensuring requests are signed")
+	assert.Equal(t, token, r.Header.Get("X-Amz-Security-Token"))
+
+	form, err := url.ParseQuery(string(body))
+	assert.NoError(t, err)
+	return form
+}
+
+func Test_SNSDestination(t *testing.T) {
+	isolateAWSCredentials(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "session")
+
+	received := make(chan url.Values, 1)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- verifySNSSignature(t, r, server.URL, "AKID", "secret", "session")
+	}))
+	defer server.Close()
+
+	inval := engine.Invalidation{
+		SessionID: "session",
+		Invariant: "BALANCE_ENFORCEMENT",
+		Severity:  invariant.Critical,
+		Network:   models.Layer1,
+		Message:   "balance is below 1 ETH",
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		topicARN string
+		fifo     bool
+	}{
+		{
+			name:        "Standard Topic",
+			description: "Invalidations should be published as JSON with filterable attributes",
+			topicARN:    "arn:aws:sns:us-east-1:123456789012:pessimism",
+		},
+		{
+			name:        "FIFO Topic",
+			description: "Invalidations published to FIFO topics should be grouped by session",
+			topicARN:    "arn:aws:sns:us-east-1:123456789012:pessimism.fifo",
+			fifo:        true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			dest, err := NewDestination(DestinationConfig{Name: "sns", Type: SNSDestination,
+				TopicARN: tc.topicARN, URL: server.URL})
+			assert.NoError(t, err)
+			assert.NoError(t, dest.Send(context.Background(), inval))
+
+			form := <-received
+			assert.Equal(t, "Publish", form.Get("Action"))
+			assert.Equal(t, tc.topicARN, form.Get("TopicArn"))
+			assert.Equal(t, "BALANCE_ENFORCEMENT invalidated on layer1", form.Get("Subject"))
+			assert.Equal(t, "severity", form.Get("MessageAttributes.entry.1.Name"))
+			assert.Equal(t, "critical", form.Get("MessageAttributes.entry.1.Value.StringValue"))
+
+			var msg engine.Invalidation
+			assert.NoError(t, json.Unmarshal([]byte(form.Get("Message")), &msg))
+			assert.Equal(t, inval.Message, msg.Message)
+
+			if tc.fifo {
+				assert.Equal(t, "session", form.Get("MessageGroupId"))
+				assert.NotEmpty(t, form.Get("MessageDeduplicationId"))
+			} else {
+				assert.Empty(t, form.Get("MessageGroupId"))
+			}
+		})
+	}
+}
+
+func Test_SNSDestination_RoleCredentials(t *testing.T) {
+	isolateAWSCredentials(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	received := make(chan url.Values, 1)
+
+	// Serves both the ECS task role credential endpoint & the SNS API
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/credentials" {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"AccessKeyId":     "ROLEKEY",
+				"SecretAccessKey": "role-secret",
+				"Token":           "role-token",
+				"Expiration":      time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			})
+			return
+		}
+
+		received <- verifySNSSignature(t, r, server.URL, "ROLEKEY", "role-secret", "role-token")
+	}))
+	defer server.Close()
+
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/credentials")
+
+	dest, err := NewDestination(DestinationConfig{Name: "sns", Type: SNSDestination,
+		TopicARN: "arn:aws:sns:us-east-1:123456789012:pessimism", URL: server.URL})
+	assert.NoError(t, err)
+	assert.NoError(t, dest.Send(context.Background(), engine.Invalidation{Invariant: "BALANCE_ENFORCEMENT"}))
+
+	form := <-received
+	assert.Equal(t, "Publish", form.Get("Action"))
+}
+
+func Test_SNSDestination_Config(t *testing.T) {
+	isolateAWSCredentials(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	cfg := DestinationConfig{Name: "sns", Type: SNSDestination, TopicARN: "arn:aws:sns:us-east-1:123456789012:p"}
+	assert.NoError(t, cfg.Validate())
+
+	_, err := NewDestination(cfg)
+	assert.Error(t, err, "Ensuring credentials are required")
+
+	cfg.TopicARN = "pessimism"
+	assert.Error(t, cfg.Validate(), "Ensuring topic arns are parsed")
+}
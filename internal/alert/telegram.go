@@ -0,0 +1,121 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// telegramAPI ... Base URL of the Telegram bot API
+	telegramAPI = "https://api.telegram.org"
+	// telegramMaxText ... Maximum message length accepted by the bot API
+	telegramMaxText = 4096
+)
+
+// telegramMessage ... Body of a sendMessage request
+type telegramMessage struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// telegramDestination ... Sends every invalidation to a Telegram chat through a bot; the chat is chosen
+// by the invalidation's severity
+type telegramDestination struct {
+	name   string
+	api    string
+	token  string
+	client *http.Client
+
+	// Chat alerted for severities without their own chat; invalidations are dropped when empty
+	chatID         string
+	severityChatID map[invariant.Severity]string
+}
+
+// newTelegramDestination ... Initializer
+func newTelegramDestination(name, token, chatID string,
+	severityChatID map[invariant.Severity]string) *telegramDestination {
+	return &telegramDestination{
+		name:           name,
+		api:            telegramAPI,
+		token:          token,
+		client:         newHTTPClient(),
+		chatID:         chatID,
+		severityChatID: severityChatID,
+	}
+}
+
+// Name ... Returns the destination name
+func (td *telegramDestination) Name() string {
+	return td.name
+}
+
+// Send ... Sends the invalidation to the chat of its severity; no-op when no chat is configured for it
+func (td *telegramDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	chatID, found := td.severityChatID[inval.Severity]
+	if !found {
+		chatID = td.chatID
+	}
+
+	if chatID == "" {
+		return nil
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", td.api, td.token)
+
+	err := postJSON(ctx, td.client, url, telegramMessage{ChatID: chatID, Text: telegramText(inval)}, nil)
+	if err != nil {
+		// Request errors include the URL which contains the token
+		return fmt.Errorf("%s", strings.ReplaceAll(err.Error(), td.token, "<token>"))
+	}
+
+	return nil
+}
+
+// Check ... Fetches the bot's identity; fail if the token is rejected
+func (td *telegramDestination) Check(ctx context.Context) error {
+	if err := get(ctx, td.client, fmt.Sprintf("%s/bot%s/getMe", td.api, td.token)); err != nil {
+		return fmt.Errorf("%s", strings.ReplaceAll(err.Error(), td.token, "<token>"))
+	}
+
+	return nil
+}
+
+// Close ... No-op
+func (td *telegramDestination) Close() error {
+	return nil
+}
+
+// telegramText ... Renders the invalidation as plain text; context entries are listed in key order
+func telegramText(inval engine.Invalidation) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "[%s] %s invalidated\n\n%s\n\n", strings.ToUpper(string(inval.Severity)),
+		inval.Invariant, inval.Message)
+	fmt.Fprintf(&sb, "network: %s\nsession: %s\n", inval.Network, inval.SessionID)
+
+	if inval.Height != nil {
+		fmt.Fprintf(&sb, "height: %s\n", inval.Height)
+	}
+
+	if inval.Suppressed > 0 {
+		fmt.Fprintf(&sb, "suppressed: %d\n", inval.Suppressed)
+	}
+
+	keys := make([]string, 0, len(inval.Context))
+	for key := range inval.Context {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(&sb, "%s: %v\n", key, inval.Context[key])
+	}
+
+	return truncate(strings.TrimSuffix(sb.String(), "\n"), telegramMaxText)
+}
@@ -0,0 +1,114 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TelegramDestination(t *testing.T) {
+	received := make(chan telegramMessage, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/botsecret/sendMessage", r.URL.Path)
+
+		var msg telegramMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		received <- msg
+	}))
+	defer server.Close()
+
+	var tests = []struct {
+		name        string
+		description string
+
+		cfg      DestinationConfig
+		severity invariant.Severity
+		// Expected chat; no message is expected when empty
+		chatID string
+	}{
+		{
+			name:        "Default Chat",
+			description: "Severities without their own chat should be sent to the default chat",
+			cfg: DestinationConfig{ChatID: "ops",
+				SeverityChatIDs: map[invariant.Severity]string{invariant.Critical: "oncall"}},
+			severity: invariant.Low,
+			chatID:   "ops",
+		},
+		{
+			name:        "Severity Chat",
+			description: "Severities with their own chat should be sent to it",
+			cfg: DestinationConfig{ChatID: "ops",
+				SeverityChatIDs: map[invariant.Severity]string{invariant.Critical: "oncall"}},
+			severity: invariant.Critical,
+			chatID:   "oncall",
+		},
+		{
+			name:        "No Chat",
+			description: "Severities without a chat shouldn't be sent",
+			cfg:         DestinationConfig{SeverityChatIDs: map[invariant.Severity]string{invariant.Critical: "oncall"}},
+			severity:    invariant.Low,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			tc.cfg.Name, tc.cfg.Type, tc.cfg.Token = "telegram", TelegramDestination, "secret"
+
+			dest, err := NewDestination(tc.cfg)
+			assert.NoError(t, err)
+			dest.(*telegramDestination).api = server.URL
+
+			inval := engine.Invalidation{
+				SessionID: "session",
+				Invariant: "LARGE_TX_VALUE",
+				Severity:  tc.severity,
+				Network:   models.Layer2,
+				Height:    big.NewInt(7),
+				Message:   "transaction transferred 100 wei",
+				Context:   map[string]any{"value": "100"},
+			}
+			assert.NoError(t, dest.Send(context.Background(), inval))
+
+			if tc.chatID == "" {
+				assert.Empty(t, received)
+				return
+			}
+
+			msg := <-received
+			assert.Equal(t, tc.chatID, msg.ChatID)
+			assert.Contains(t, msg.Text, "LARGE_TX_VALUE invalidated")
+			assert.Contains(t, msg.Text, "height: 7")
+			assert.Contains(t, msg.Text, "value: 100")
+		})
+	}
+}
+
+func Test_TelegramDestination_Config(t *testing.T) {
+	for _, cfg := range []DestinationConfig{
+		{Name: "telegram", Type: TelegramDestination, ChatID: "ops"},
+		{Name: "telegram", Type: TelegramDestination, Token: "secret"},
+		{Name: "telegram", Type: TelegramDestination, Token: "secret",
+			SeverityChatIDs: map[invariant.Severity]string{"urgent": "ops"}},
+	} {
+		assert.Error(t, cfg.Validate())
+	}
+}
+
+func Test_TelegramDestination_RedactsToken(t *testing.T) {
+	dest := newTelegramDestination("telegram", "secret", "ops", nil)
+	dest.api = "http://127.0.0.1:0"
+
+	err := dest.Send(context.Background(), engine.Invalidation{Severity: invariant.Low})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+}
@@ -0,0 +1,126 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+// severityEmojis ... Emoji of each severity; available to templates through the emoji function
+var severityEmojis = map[invariant.Severity]string{
+	invariant.Low:      "🔵",
+	invariant.Medium:   "🟡",
+	invariant.High:     "🟠",
+	invariant.Critical: "🔴",
+}
+
+// templateFuncs ... Functions available to message & email templates in addition to the text/template
+// builtins
+var templateFuncs = template.FuncMap{
+	"upper": func(v any) string { return strings.ToUpper(fmt.Sprint(v)) },
+	"lower": func(v any) string { return strings.ToLower(fmt.Sprint(v)) },
+	"emoji": func(s invariant.Severity) string { return severityEmojis[s] },
+	// default ... Returns the fallback when the value is missing or empty, E.G {{default "n/a" .Context.key}}
+	"default": func(fallback, v any) any {
+		if v == nil || fmt.Sprint(v) == "" {
+			return fallback
+		}
+		return v
+	},
+	"truncate": func(limit int, v any) string { return truncate(fmt.Sprint(v), limit) },
+}
+
+// parseTemplate ... Parses a template executed with an engine.Invalidation
+func parseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+}
+
+// parseTemplates ... Parses the templates of every invariant type
+func parseTemplates(texts map[invariant.Type]string) (map[invariant.Type]*template.Template, error) {
+	tmpls := make(map[invariant.Type]*template.Template, len(texts))
+
+	for it, text := range texts {
+		tmpl, err := parseTemplate(string(it), text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s template: %w", it, err)
+		}
+
+		tmpls[it] = tmpl
+	}
+
+	return tmpls, nil
+}
+
+// templatedDestination ... Replaces the message of every invalidation with a rendered template before
+// sending it to the wrapped destination; templates of the invalidation's invariant type take precedence
+// over the destination's template
+type templatedDestination struct {
+	AlertDestination
+
+	fallback  *template.Template
+	invariant map[invariant.Type]*template.Template
+}
+
+// Send ... Renders the invalidation's message & sends it; the original message is kept when rendering
+// fails so that the alert is still delivered
+func (td *templatedDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	tmpl, found := td.invariant[inval.Invariant]
+	if !found {
+		tmpl = td.fallback
+	}
+
+	if tmpl == nil {
+		return td.AlertDestination.Send(ctx, inval)
+	}
+
+	var msg bytes.Buffer
+	if err := tmpl.Execute(&msg, inval); err != nil {
+		logging.WithContext(ctx).Warn("Could not render alert message; sending the original message",
+			zap.String("destination", td.Name()), zap.String("invariant", string(inval.Invariant)), zap.Error(err))
+
+		return td.AlertDestination.Send(ctx, inval)
+	}
+
+	inval.Message = msg.String()
+	return td.AlertDestination.Send(ctx, inval)
+}
+
+// withTemplates ... Wraps the destination so that messages are rendered using the templates; the shared
+// templates apply to every destination & are overridden by the destination's own templates of the same
+// invariant type. The destination is returned as is when no template applies
+func withTemplates(dest AlertDestination, cfg DestinationConfig,
+	shared map[invariant.Type]string) (AlertDestination, error) {
+	texts := make(map[invariant.Type]string, len(shared)+len(cfg.InvariantTemplates))
+	for it, text := range shared {
+		texts[it] = text
+	}
+	for it, text := range cfg.InvariantTemplates {
+		texts[it] = text
+	}
+
+	if cfg.Template == "" && len(texts) == 0 {
+		return dest, nil
+	}
+
+	td := &templatedDestination{AlertDestination: dest}
+
+	var err error
+	if td.invariant, err = parseTemplates(texts); err != nil {
+		return nil, err
+	}
+
+	if cfg.Template != "" {
+		if td.fallback, err = parseTemplate(cfg.Name, cfg.Template); err != nil {
+			return nil, fmt.Errorf("invalid template: %w", err)
+		}
+	}
+
+	return td, nil
+}
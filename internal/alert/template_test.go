@@ -0,0 +1,100 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TemplatedDestination(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	inval := engine.Invalidation{
+		SessionID: "session",
+		Invariant: "BALANCE_ENFORCEMENT",
+		Severity:  invariant.Critical,
+		Network:   models.Layer1,
+		Message:   "balance is below 1 ETH",
+		Context:   map[string]any{"address": "0x420"},
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		cfg    DestinationConfig
+		shared map[invariant.Type]string
+		// Expected message received by the destination
+		message string
+	}{
+		{
+			name:        "No Template",
+			description: "Messages should be sent as is without templates",
+			message:     "balance is below 1 ETH",
+		},
+		{
+			name:        "Destination Template",
+			description: "Messages should be rendered using the destination's template",
+			cfg:         DestinationConfig{Template: `{{emoji .Severity}} {{upper .Severity}}: {{.Message}}`},
+			message:     "🔴 CRITICAL: balance is below 1 ETH",
+		},
+		{
+			name:        "Shared Invariant Template",
+			description: "Shared templates of the invariant type should take precedence over the destination's",
+			cfg:         DestinationConfig{Template: `{{.Message}}`},
+			shared: map[invariant.Type]string{
+				"BALANCE_ENFORCEMENT": `{{.Context.address}} is low; see https://runbooks/balance`,
+			},
+			message: "0x420 is low; see https://runbooks/balance",
+		},
+		{
+			name:        "Destination Invariant Template",
+			description: "Destination templates of the invariant type should take precedence over shared ones",
+			cfg: DestinationConfig{InvariantTemplates: map[invariant.Type]string{
+				"BALANCE_ENFORCEMENT": `{{default "unknown" .Context.owner}} owns {{.Context.address}}`,
+			}},
+			shared:  map[invariant.Type]string{"BALANCE_ENFORCEMENT": `{{.Message}}`},
+			message: "unknown owns 0x420",
+		},
+		{
+			name:        "Other Invariant Template",
+			description: "Templates of other invariant types shouldn't apply",
+			shared:      map[invariant.Type]string{"LARGE_TX_VALUE": `{{.Invariant}}`},
+			message:     "balance is below 1 ETH",
+		},
+		{
+			name:        "Render Failure",
+			description: "The original message should be sent when rendering fails",
+			cfg:         DestinationConfig{Template: `{{index .Context "address" 9}}`},
+			message:     "balance is below 1 ETH",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			tc.cfg.Name = "ops"
+
+			mock := newDestinationMock("ops")
+			dest, err := withTemplates(mock, tc.cfg, tc.shared)
+			assert.NoError(t, err)
+
+			assert.NoError(t, dest.Send(context.Background(), inval))
+			assert.Equal(t, tc.message, (<-mock.sent).Message)
+		})
+	}
+}
+
+func Test_Templates_Invalid(t *testing.T) {
+	cfg := DestinationConfig{Name: "ops", Type: LogDestination, Template: "{{.Message"}
+	assert.Error(t, cfg.Validate())
+
+	cfg = DestinationConfig{Name: "ops", Type: LogDestination,
+		InvariantTemplates: map[invariant.Type]string{"LARGE_TX_VALUE": "{{unknown}}"}}
+	assert.Error(t, cfg.Validate(), "Ensuring unknown functions are rejected")
+}
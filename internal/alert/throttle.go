@@ -0,0 +1,126 @@
+package alert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+// Throttle ... Sliding window limit on the alerts raised for a single condition of a session; conditions
+// are identified by the invalidation fingerprint
+type Throttle struct {
+	// Length of the sliding window; throttling is disabled when zero
+	Window models.Duration `json:"window"`
+	// Maximum number of alerts per condition within the window; defaults to 1
+	Limit int `json:"limit,omitempty"`
+}
+
+// validate ... Ensures the window & limit aren't negative
+func (th Throttle) validate() error {
+	if th.Window < 0 {
+		return fmt.Errorf("throttle window must not be negative")
+	}
+
+	if th.Limit < 0 {
+		return fmt.Errorf("throttle limit must not be negative")
+	}
+
+	return nil
+}
+
+// conditionKey ... Identifies a single condition of a session
+type conditionKey struct {
+	session     engine.SessionID
+	fingerprint string
+}
+
+// condition ... Alerting history of a single condition
+type condition struct {
+	// Times of the alerts raised within the window in ascending order
+	alerted []time.Time
+	// Number of invalidations throttled since the last alert
+	throttled int
+	// Time of the latest invalidation, alerted or not
+	observed time.Time
+}
+
+// throttler ... Tracks the alerts raised per condition; only accessed by the manager's routing routine
+type throttler struct {
+	window time.Duration
+	limit  int
+
+	conditions map[conditionKey]*condition
+	lastSweep  time.Time
+}
+
+// newThrottler ... Initializer
+func newThrottler(th Throttle) *throttler {
+	limit := th.Limit
+	if limit == 0 {
+		limit = 1
+	}
+
+	return &throttler{
+		window:     time.Duration(th.Window),
+		limit:      limit,
+		conditions: make(map[conditionKey]*condition),
+	}
+}
+
+// admit ... Returns true if the invalidation should be alerted; windows are measured using invalidation
+// timestamps. Admitted invalidations include those throttled before them in their suppressed count
+func (t *throttler) admit(inval *engine.Invalidation) bool {
+	if t.window == 0 {
+		return true
+	}
+
+	now := inval.Timestamp
+	t.sweep(now)
+
+	key := conditionKey{session: inval.SessionID, fingerprint: inval.Fingerprint}
+	c, found := t.conditions[key]
+	if !found {
+		c = &condition{}
+		t.conditions[key] = c
+	}
+
+	c.observed = now
+	c.alerted = expire(c.alerted, now.Add(-t.window))
+	if len(c.alerted) >= t.limit {
+		c.throttled += 1 + inval.Suppressed
+		return false
+	}
+
+	inval.Suppressed += c.throttled
+	c.throttled = 0
+	c.alerted = append(c.alerted, now)
+
+	return true
+}
+
+// sweep ... Forgets conditions that haven't been observed within the window; runs at most once per window
+func (t *throttler) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < t.window {
+		return
+	}
+
+	t.lastSweep = now
+	for key, c := range t.conditions {
+		if !c.observed.After(now.Add(-t.window)) {
+			delete(t.conditions, key)
+		}
+	}
+}
+
+// expire ... Returns the times after the cutoff; times are in ascending order
+func expire(times []time.Time, cutoff time.Time) []time.Time {
+	for i, at := range times {
+		if at.After(cutoff) {
+			return times[i:]
+		}
+	}
+
+	return times[:0]
+}
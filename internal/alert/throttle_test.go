@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Throttler(t *testing.T) {
+	start := time.Unix(0, 0)
+
+	type step struct {
+		at          time.Duration
+		session     engine.SessionID
+		fingerprint string
+
+		admitted   bool
+		suppressed int
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		throttle Throttle
+		steps    []step
+	}{
+		{
+			name:        "Disabled",
+			description: "Every invalidation should be admitted without a window",
+			throttle:    Throttle{},
+			steps: []step{
+				{at: 0, session: "a", fingerprint: "x", admitted: true},
+				{at: time.Second, session: "a", fingerprint: "x", admitted: true},
+			},
+		},
+		{
+			name:        "Repeated Condition",
+			description: "Repeats of a condition within the window should be throttled & counted once admitted",
+			throttle:    Throttle{Window: models.Duration(time.Minute)},
+			steps: []step{
+				{at: 0, session: "a", fingerprint: "x", admitted: true},
+				{at: 12 * time.Second, session: "a", fingerprint: "x"},
+				{at: 24 * time.Second, session: "a", fingerprint: "x"},
+				{at: time.Minute, session: "a", fingerprint: "x", admitted: true, suppressed: 2},
+				{at: 72 * time.Second, session: "a", fingerprint: "x"},
+			},
+		},
+		{
+			name:        "Distinct Conditions",
+			description: "Conditions should be throttled independently per session & fingerprint",
+			throttle:    Throttle{Window: models.Duration(time.Minute)},
+			steps: []step{
+				{at: 0, session: "a", fingerprint: "x", admitted: true},
+				{at: time.Second, session: "a", fingerprint: "y", admitted: true},
+				{at: 2 * time.Second, session: "b", fingerprint: "x", admitted: true},
+				{at: 3 * time.Second, session: "a", fingerprint: "x"},
+			},
+		},
+		{
+			name:        "Sliding Limit",
+			description: "Up to the limit should be admitted within any window",
+			throttle:    Throttle{Window: models.Duration(time.Minute), Limit: 2},
+			steps: []step{
+				{at: 0, session: "a", fingerprint: "x", admitted: true},
+				{at: 30 * time.Second, session: "a", fingerprint: "x", admitted: true},
+				{at: 45 * time.Second, session: "a", fingerprint: "x"},
+				{at: 61 * time.Second, session: "a", fingerprint: "x", admitted: true, suppressed: 1},
+				{at: 80 * time.Second, session: "a", fingerprint: "x"},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			th := newThrottler(tc.throttle)
+
+			for j, s := range tc.steps {
+				inval := engine.Invalidation{SessionID: s.session, Fingerprint: s.fingerprint,
+					Timestamp: start.Add(s.at)}
+
+				assert.Equal(t, s.admitted, th.admit(&inval), "step %d", j)
+				if s.admitted {
+					assert.Equal(t, s.suppressed, inval.Suppressed, "step %d", j)
+				}
+			}
+		})
+	}
+}
+
+func Test_Throttler_Sweep(t *testing.T) {
+	th := newThrottler(Throttle{Window: models.Duration(time.Minute)})
+	start := time.Unix(0, 0)
+
+	assert.True(t, th.admit(&engine.Invalidation{SessionID: "a", Timestamp: start}))
+	assert.True(t, th.admit(&engine.Invalidation{SessionID: "b", Timestamp: start.Add(2 * time.Minute)}))
+	assert.Len(t, th.conditions, 1, "Ensuring expired conditions are forgotten")
+}
+
+func Test_Manager_Throttle(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ops := newDestinationMock("ops")
+	input := make(chan engine.Invalidation)
+
+	_, err := NewManager(context.Background(), input, []AlertDestination{ops},
+		WithThrottle(Throttle{Window: models.Duration(-time.Second)}))
+	assert.Error(t, err, "Ensuring negative windows are rejected")
+
+	m, err := NewManager(context.Background(), input, []AlertDestination{ops},
+		WithThrottle(Throttle{Window: models.Duration(time.Hour)}))
+	assert.NoError(t, err)
+	defer m.Shutdown()
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		input <- engine.Invalidation{SessionID: "session", Fingerprint: "x", Timestamp: now.Add(time.Duration(i))}
+	}
+
+	<-ops.sent
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, ops.sent, "Ensuring repeats of the condition are throttled")
+}
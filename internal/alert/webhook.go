@@ -0,0 +1,116 @@
+package alert
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+const (
+	// SignatureHeader ... Header carrying the hex encoded HMAC-SHA256 of "<timestamp>.<body>", prefixed
+	// with "sha256="; receivers should recompute it with the shared secret & reject mismatches
+	SignatureHeader = "X-Pessimism-Signature"
+	// TimestampHeader ... Header carrying the unix time at which the request was signed; receivers can
+	// reject stale timestamps to prevent replays
+	TimestampHeader = "X-Pessimism-Timestamp"
+
+	// webhookBackoff ... Delay before the first retry; doubled after every attempt
+	webhookBackoff = time.Second
+)
+
+// webhookDestination ... POSTs every invalidation as JSON to a URL; requests are signed when a secret is
+// configured and retried on network errors, 429 & 5xx responses
+type webhookDestination struct {
+	name    string
+	url     string
+	secret  []byte
+	retries int
+	backoff time.Duration
+	client  *http.Client
+}
+
+// newWebhookDestination ... Initializer
+func newWebhookDestination(name, url, secret string, retries int) *webhookDestination {
+	return &webhookDestination{
+		name:    name,
+		url:     url,
+		secret:  []byte(secret),
+		retries: retries,
+		backoff: webhookBackoff,
+		client:  newHTTPClient(),
+	}
+}
+
+// Name ... Returns the destination name
+func (wd *webhookDestination) Name() string {
+	return wd.name
+}
+
+// Send ... POSTs the invalidation, retrying retryable failures with exponential backoff; every attempt
+// is signed with the time it was made
+func (wd *webhookDestination) Send(ctx context.Context, inval engine.Invalidation) error {
+	body, err := json.Marshal(inval)
+	if err != nil {
+		return err
+	}
+
+	backoff := wd.backoff
+	for attempt := 0; ; attempt++ {
+		err = post(ctx, wd.client, wd.url, body, "application/json", wd.headers(body, time.Now()))
+		if err == nil || attempt == wd.retries || !retryable(err) {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// Close ... No-op
+func (wd *webhookDestination) Close() error {
+	return nil
+}
+
+// headers ... Returns the signature headers of the body; no headers are set without a secret
+func (wd *webhookDestination) headers(body []byte, at time.Time) map[string]string {
+	if len(wd.secret) == 0 {
+		return nil
+	}
+
+	ts := strconv.FormatInt(at.Unix(), 10)
+	return map[string]string{
+		TimestampHeader: ts,
+		SignatureHeader: "sha256=" + Sign(wd.secret, ts, body),
+	}
+}
+
+// Sign ... Returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" using the secret
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryable ... Returns true for network errors & responses indicating a transient failure
+func retryable(err error) bool {
+	var se *statusError
+	if !errors.As(err, &se) {
+		return true
+	}
+
+	return se.code == http.StatusTooManyRequests || se.code >= http.StatusInternalServerError
+}
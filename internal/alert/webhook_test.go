@@ -0,0 +1,126 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WebhookDestination(t *testing.T) {
+	inval := engine.Invalidation{
+		SessionID: "session",
+		Invariant: "BALANCE_ENFORCEMENT",
+		Severity:  invariant.High,
+		Message:   "balance is below 1 ETH",
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		secret   string
+		retries  int
+		statuses []int
+
+		attempts int
+		failed   bool
+	}{
+		{
+			name:        "Signed Delivery",
+			description: "Requests should carry a verifiable signature when a secret is configured",
+			secret:      "secret",
+			statuses:    []int{http.StatusOK},
+			attempts:    1,
+		},
+		{
+			name:        "Unsigned Delivery",
+			description: "Requests should be unsigned when no secret is configured",
+			statuses:    []int{http.StatusOK},
+			attempts:    1,
+		},
+		{
+			name:        "Retried Delivery",
+			description: "Server errors & rate limits should be retried until successful",
+			secret:      "secret",
+			retries:     2,
+			statuses:    []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			attempts:    3,
+		},
+		{
+			name:        "Exhausted Retries",
+			description: "Deliveries should fail once retries are exhausted",
+			retries:     1,
+			statuses:    []int{http.StatusInternalServerError, http.StatusInternalServerError},
+			attempts:    2,
+			failed:      true,
+		},
+		{
+			name:        "Client Error",
+			description: "Client errors shouldn't be retried",
+			retries:     2,
+			statuses:    []int{http.StatusBadRequest},
+			attempts:    1,
+			failed:      true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			var attempts atomic.Int64
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+
+				var received engine.Invalidation
+				assert.NoError(t, json.Unmarshal(body, &received))
+				assert.Equal(t, inval.SessionID, received.SessionID)
+				assert.Equal(t, inval.Message, received.Message)
+
+				ts := r.Header.Get(TimestampHeader)
+				if tc.secret == "" {
+					assert.Empty(t, ts)
+					assert.Empty(t, r.Header.Get(SignatureHeader))
+				} else {
+					assert.NotEmpty(t, ts)
+					assert.Equal(t, "sha256="+Sign([]byte(tc.secret), ts, body), r.Header.Get(SignatureHeader))
+				}
+
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer server.Close()
+
+			dest, err := NewDestination(DestinationConfig{
+				Name:    "hook",
+				Type:    WebhookDestination,
+				URL:     server.URL,
+				Secret:  tc.secret,
+				Retries: tc.retries,
+			})
+			assert.NoError(t, err)
+			dest.(*webhookDestination).backoff = time.Millisecond
+
+			err = dest.Send(context.Background(), inval)
+			assert.Equal(t, tc.failed, err != nil)
+			assert.Equal(t, int64(tc.attempts), attempts.Load())
+		})
+	}
+
+	_, err := NewDestination(DestinationConfig{Name: "hook", Type: WebhookDestination})
+	assert.Error(t, err, "Ensuring a url is required")
+
+	_, err = NewDestination(DestinationConfig{Name: "hook", Type: WebhookDestination, URL: "http://x", Retries: -1})
+	assert.Error(t, err, "Ensuring retries can't be negative")
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// AlertsPath ... History of dispatched alerts, most recently dispatched first; alerts are acknowledged
+	// by appending their ID & the ack suffix
+	AlertsPath = "/v0/alerts"
+	// AckSuffix ... Suffix of the path acknowledging an alert (E.G, /v0/alerts/<id>/ack)
+	AckSuffix = "/ack"
+
+	// defaultAlertLimit ... Number of alerts returned per page when no limit is requested
+	defaultAlertLimit = 100
+	// maxAlertLimit ... Upper bound on the number of alerts returned per page
+	maxAlertLimit = 1000
+)
+
+// Alerts ... Subset of the alerting manager used to query the history of dispatched alerts & acknowledge them
+type Alerts interface {
+	Alerts(q history.Query) ([]history.Record, error)
+	Acknowledge(id, by string) (history.Acknowledgement, error)
+}
+
+// AckRequest ... Optional body of alert acknowledgements
+type AckRequest struct {
+	// Operator acknowledging the alert; the ID of the caller's API key when omitted
+	By string `json:"by,omitempty"`
+}
+
+// AlertPage ... Page of dispatched alerts, most recently dispatched first
+type AlertPage struct {
+	Alerts []history.Record `json:"alerts"`
+	// Cursor requesting the following page; omitted on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// WithAlerts ... Serves the alert history at the alerts endpoint; the endpoint isn't served without alerts
+func WithAlerts(alerts Alerts) Option {
+	return func(h *Handlers) {
+		h.alerts = alerts
+	}
+}
+
+// alertQuery ... Returns the history query described by the request's query parameters
+func alertQuery(r *http.Request) (history.Query, error) {
+	values := r.URL.Query()
+
+	q := history.Query{
+		Network:  models.Network(values.Get("network")),
+		Severity: invariant.Severity(values.Get("severity")),
+		Before:   values.Get("cursor"),
+		Limit:    defaultAlertLimit,
+	}
+
+	if q.Severity != "" && !q.Severity.Valid() {
+		return history.Query{}, fmt.Errorf("invalid severity: %s", q.Severity)
+	}
+
+	for name, t := range map[string]*time.Time{"from": &q.From, "to": &q.To} {
+		value := values.Get(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return history.Query{}, fmt.Errorf("invalid %s time, expected RFC 3339: %s", name, value)
+		}
+
+		*t = parsed
+	}
+
+	if value := values.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxAlertLimit {
+			return history.Query{}, fmt.Errorf("limit must be between 1 & %d: %s", maxAlertLimit, value)
+		}
+
+		q.Limit = limit
+	}
+
+	return q, nil
+}
+
+// alertHistory ... Handles paginated queries (GET) of the alert history
+func (h *Handlers) alertHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	q, err := alertQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// One extra record is read to determine whether a following page exists
+	limit := q.Limit
+	q.Limit++
+
+	recs, err := h.alerts.Alerts(q)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	page := AlertPage{Alerts: recs}
+	if len(recs) > limit {
+		page.Alerts = recs[:limit]
+		page.NextCursor = recs[limit-1].ID
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+// acknowledge ... Handles acknowledgements (POST) of the alert addressed by the path, suppressing re-alerts of
+// its condition until the condition resolves or the acknowledgement expires
+func (h *Handlers) acknowledge(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, AlertsPath+"/")
+	id := strings.TrimSuffix(path, AckSuffix)
+	if id == path || id == "" || strings.Contains(id, "/") {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		notAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req AckRequest
+	if err := decode(w, r, &req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if caller, found := CallerFrom(r.Context()); found && req.By == "" {
+		req.By = caller.KeyID
+	}
+
+	ack, err := h.alerts.Acknowledge(id, req.By)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, history.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+
+		writeError(w, status, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ack)
+}
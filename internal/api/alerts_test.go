@@ -0,0 +1,253 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// storeAlerts ... Alerts queried & acknowledged directly through a history store
+type storeAlerts struct {
+	store history.Store
+}
+
+func (sa storeAlerts) Alerts(q history.Query) ([]history.Record, error) {
+	return sa.store.Query(q)
+}
+
+func (sa storeAlerts) Acknowledge(id, by string) (history.Acknowledgement, error) {
+	_, found, err := sa.store.Get(id)
+	if err != nil {
+		return history.Acknowledgement{}, err
+	}
+
+	if !found {
+		return history.Acknowledgement{}, history.ErrNotFound
+	}
+
+	ack := history.Acknowledgement{By: by, Acknowledged: time.Unix(0, 0).UTC()}
+	return ack, sa.store.Acknowledge(id, ack)
+}
+
+func Test_Alerts(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	store := history.NewMemoryStore(0)
+	for i, alert := range []struct {
+		network  models.Network
+		severity invariant.Severity
+	}{
+		{models.Layer1, invariant.Low},
+		{models.Layer2, invariant.High},
+		{models.Layer1, invariant.High},
+		{models.Layer1, invariant.High},
+	} {
+		assert.NoError(t, store.Add(history.Record{
+			ID:           fmt.Sprintf("alert-%d", i),
+			Invalidation: engine.Invalidation{Network: alert.network, Severity: alert.severity},
+			Dispatched:   time.Unix(int64(i)*60, 0).UTC(),
+		}))
+	}
+
+	h := NewHandlers(newFakeEngine(), fakePipelines{}, WithAlerts(storeAlerts{store}))
+
+	var tests = []struct {
+		name        string
+		description string
+
+		query string
+
+		status int
+		ids    []string
+		cursor string
+	}{
+		{
+			name:        "All",
+			description: "Every alert should be returned most recently dispatched first",
+			status:      http.StatusOK,
+			ids:         []string{"alert-3", "alert-2", "alert-1", "alert-0"},
+		},
+		{
+			name:        "Filters",
+			description: "Alerts should match every filter",
+			query:       "?network=layer1&severity=high&from=1970-01-01T00:02:00Z",
+			status:      http.StatusOK,
+			ids:         []string{"alert-3", "alert-2"},
+		},
+		{
+			name:        "First Page",
+			description: "A cursor should be returned when more alerts follow the page",
+			query:       "?network=layer1&limit=2",
+			status:      http.StatusOK,
+			ids:         []string{"alert-3", "alert-2"},
+			cursor:      "alert-2",
+		},
+		{
+			name:        "Last Page",
+			description: "Alerts following the cursor should be returned without a cursor on the last page",
+			query:       "?network=layer1&limit=2&cursor=alert-2",
+			status:      http.StatusOK,
+			ids:         []string{"alert-0"},
+		},
+		{
+			name:        "Invalid Severity",
+			description: "Unknown severities should be rejected",
+			query:       "?severity=urgent",
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "Invalid Time",
+			description: "Times that aren't RFC 3339 should be rejected",
+			query:       "?to=yesterday",
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "Invalid Limit",
+			description: "Limits beyond the maximum should be rejected",
+			query:       "?limit=100000",
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, AlertsPath+tc.query, nil))
+
+			assert.Equal(t, tc.status, rec.Code)
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var page AlertPage
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+
+			ids := make([]string, 0, len(page.Alerts))
+			for _, alert := range page.Alerts {
+				ids = append(ids, alert.ID)
+			}
+
+			assert.Equal(t, tc.ids, ids)
+			assert.Equal(t, tc.cursor, page.NextCursor)
+		})
+	}
+}
+
+func Test_AcknowledgeAlert(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	store := history.NewMemoryStore(0)
+	assert.NoError(t, store.Add(history.Record{ID: "alert-0", Dispatched: time.Unix(0, 0).UTC()}))
+
+	adminKey, readKey := strings.Repeat("a", 16), strings.Repeat("r", 16)
+	h := NewHandlers(newFakeEngine(), fakePipelines{}, WithAlerts(storeAlerts{store}),
+		WithAPIKeys([]APIKey{{Key: adminKey, Role: Admin}, {Key: readKey, Role: ReadOnly}}))
+
+	var tests = []struct {
+		name        string
+		description string
+
+		method string
+		path   string
+		key    string
+		body   string
+
+		status int
+		by     string
+	}{
+		{
+			name:        "Acknowledged",
+			description: "Admins should be able to acknowledge alerts as the named operator",
+			method:      http.MethodPost,
+			path:        AlertsPath + "/alert-0" + AckSuffix,
+			key:         adminKey,
+			body:        `{"by": "oncall"}`,
+			status:      http.StatusOK,
+			by:          "oncall",
+		},
+		{
+			name:        "Caller Key",
+			description: "Acknowledgements without an operator should be attributed to the caller's key",
+			method:      http.MethodPost,
+			path:        AlertsPath + "/alert-0" + AckSuffix,
+			key:         adminKey,
+			status:      http.StatusOK,
+			by:          hashKey(adminKey)[:keyIDSize],
+		},
+		{
+			name:        "Read Only",
+			description: "Read only keys shouldn't be able to acknowledge alerts",
+			method:      http.MethodPost,
+			path:        AlertsPath + "/alert-0" + AckSuffix,
+			key:         readKey,
+			status:      http.StatusForbidden,
+		},
+		{
+			name:        "Unknown Alert",
+			description: "Acknowledging an alert that isn't retained should be not found",
+			method:      http.MethodPost,
+			path:        AlertsPath + "/alert-1" + AckSuffix,
+			key:         adminKey,
+			status:      http.StatusNotFound,
+		},
+		{
+			name:        "Unknown Path",
+			description: "Paths beneath an alert other than the ack path should be not found",
+			method:      http.MethodPost,
+			path:        AlertsPath + "/alert-0",
+			key:         adminKey,
+			status:      http.StatusNotFound,
+		},
+		{
+			name:        "Invalid Body",
+			description: "Bodies with unknown fields should be rejected",
+			method:      http.MethodPost,
+			path:        AlertsPath + "/alert-0" + AckSuffix,
+			key:         adminKey,
+			body:        `{"who": "oncall"}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "Method Not Allowed",
+			description: "Only POST should acknowledge alerts",
+			method:      http.MethodGet,
+			path:        AlertsPath + "/alert-0" + AckSuffix,
+			key:         adminKey,
+			status:      http.StatusMethodNotAllowed,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Authorization", "Bearer "+tc.key)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.status, rec.Code)
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var ack history.Acknowledgement
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ack))
+			assert.Equal(t, tc.by, ack.By)
+
+			stored, _, err := store.Get("alert-0")
+			assert.NoError(t, err)
+			assert.Equal(t, &ack, stored.Acknowledgement, "Ensuring the acknowledgement is recorded")
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// APIKeyHeader ... Header that API keys are read from; bearer authorization is accepted as well
+	APIKeyHeader = "X-API-Key"
+
+	// minKeyLength ... Shortest API key accepted
+	minKeyLength = 16
+	// keyIDSize ... Number of hex characters of a key's hash used to identify it in logs
+	keyIDSize = 8
+)
+
+// Role ... Set of endpoints an API key may call
+type Role string
+
+const (
+	// ReadOnly ... May inspect sessions, health & streams
+	ReadOnly Role = "read"
+	// Admin ... May additionally create & delete sessions
+	Admin Role = "admin"
+)
+
+// roleRank ... Orders roles; a role may call every endpoint of the roles ranked below it
+var roleRank = map[Role]int{
+	ReadOnly: 1,
+	Admin:    2,
+}
+
+// Valid ... Returns true if the role is known
+func (r Role) Valid() bool {
+	_, found := roleRank[r]
+	return found
+}
+
+// allows ... Returns true if the role may call endpoints requiring the other role
+func (r Role) allows(required Role) bool {
+	return roleRank[r] >= roleRank[required]
+}
+
+// APIKey ... Key granted a role
+type APIKey struct {
+	Key  string
+	Role Role
+}
+
+// ParseAPIKeys ... Parses a comma separated list of `role:key` pairs; empty when the list is empty
+func ParseAPIKeys(list string) ([]APIKey, error) {
+	keys := make([]APIKey, 0)
+
+	for _, pair := range strings.Split(list, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		role, key, found := strings.Cut(pair, ":")
+		if !found {
+			return nil, errors.New("api keys must be listed as role:key")
+		}
+
+		if !Role(role).Valid() {
+			return nil, fmt.Errorf("invalid api key role: %s", role)
+		}
+
+		if len(key) < minKeyLength {
+			return nil, fmt.Errorf("api keys must be at least %d characters", minKeyLength)
+		}
+
+		keys = append(keys, APIKey{Key: key, Role: Role(role)})
+	}
+
+	return keys, nil
+}
+
+// WithAPIKeys ... Requires every request but health checks to present one of the keys & restricts each
+// key to the endpoints of its role; every request is allowed when no keys are configured
+func WithAPIKeys(keys []APIKey) Option {
+	return func(h *Handlers) {
+		for _, k := range keys {
+			h.keys[hashKey(k.Key)] = k.Role
+		}
+	}
+}
+
+// Caller ... Identity of an authenticated request
+type Caller struct {
+	// Prefix of the key's hash; the key itself is never logged
+	KeyID string
+	Role  Role
+}
+
+type callerKey struct{}
+
+// CallerFrom ... Returns the identity of the request's caller; false if the request is unauthenticated
+func CallerFrom(ctx context.Context) (Caller, bool) {
+	c, ok := ctx.Value(callerKey{}).(Caller)
+	return c, ok
+}
+
+// hashKey ... Returns the hex encoded hash of the key; keys are only held & compared as hashes
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// requiredRole ... Returns the role required to serve the request; false if the request needn't be
+// authenticated. Requests that only read require the read-only role
+func requiredRole(r *http.Request) (Role, bool) {
+	if r.URL.Path == HealthPath {
+		return "", false
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ReadOnly, true
+
+	default:
+		return Admin, true
+	}
+}
+
+// presentedKey ... Returns the API key presented by the request; empty if none
+func presentedKey(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if found && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+
+	return ""
+}
+
+// authenticate ... Returns the request with its caller attached; responds & returns false if the request
+// presents no known key or its key's role doesn't allow the request
+func (h *Handlers) authenticate(w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
+	required, guarded := requiredRole(r)
+	if len(h.keys) == 0 || !guarded {
+		return r, true
+	}
+
+	hash := hashKey(presentedKey(r))
+
+	role, found := h.keys[hash]
+	if !found {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, errors.New("missing or unknown api key"))
+		return r, false
+	}
+
+	if !role.allows(required) {
+		writeError(w, http.StatusForbidden, fmt.Errorf("api key role %s may not %s %s", role, r.Method, r.URL.Path))
+		return r, false
+	}
+
+	caller := Caller{KeyID: hash[:keyIDSize], Role: role}
+	return r.WithContext(context.WithValue(r.Context(), callerKey{}, caller)), true
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseAPIKeys(t *testing.T) {
+	keys, err := ParseAPIKeys(" admin:0123456789abcdef, read:fedcba9876543210 ,")
+	assert.NoError(t, err)
+	assert.Equal(t, []APIKey{
+		{Key: "0123456789abcdef", Role: Admin},
+		{Key: "fedcba9876543210", Role: ReadOnly},
+	}, keys)
+
+	keys, err = ParseAPIKeys("")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	for _, list := range []string{"0123456789abcdef", "owner:0123456789abcdef", "admin:short"} {
+		_, err = ParseAPIKeys(list)
+		assert.Error(t, err, list)
+	}
+}
+
+func Test_Auth(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	const (
+		adminKey = "admin-0123456789"
+		readKey  = "read-0123456789a"
+	)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		keys    []APIKey
+		method  string
+		path    string
+		headers map[string]string
+
+		status int
+	}{
+		{
+			name:        "Auth Disabled",
+			description: "Requests should be allowed without a key when no keys are configured",
+			method:      http.MethodDelete,
+			path:        InvariantPath + "/abc",
+			status:      http.StatusOK,
+		},
+		{
+			name:        "Unauthenticated Health",
+			description: "Health checks should never require a key",
+			keys:        []APIKey{{Key: adminKey, Role: Admin}},
+			method:      http.MethodGet,
+			path:        HealthPath,
+			status:      http.StatusOK,
+		},
+		{
+			name:        "Missing Key",
+			description: "Requests without a key should be rejected as unauthorized",
+			keys:        []APIKey{{Key: adminKey, Role: Admin}},
+			method:      http.MethodGet,
+			path:        InvariantPath,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			name:        "Unknown Key",
+			description: "Requests with an unknown key should be rejected as unauthorized",
+			keys:        []APIKey{{Key: adminKey, Role: Admin}},
+			method:      http.MethodGet,
+			path:        InvariantPath,
+			headers:     map[string]string{APIKeyHeader: "unknown-0123456789"},
+			status:      http.StatusUnauthorized,
+		},
+		{
+			name:        "Read Only Read",
+			description: "Read-only keys should be allowed to inspect sessions",
+			keys:        []APIKey{{Key: readKey, Role: ReadOnly}},
+			method:      http.MethodGet,
+			path:        InvariantPath + "/abc",
+			headers:     map[string]string{APIKeyHeader: readKey},
+			status:      http.StatusOK,
+		},
+		{
+			name:        "Read Only Write",
+			description: "Read-only keys should be forbidden from deleting sessions",
+			keys:        []APIKey{{Key: readKey, Role: ReadOnly}},
+			method:      http.MethodDelete,
+			path:        InvariantPath + "/abc",
+			headers:     map[string]string{APIKeyHeader: readKey},
+			status:      http.StatusForbidden,
+		},
+		{
+			name:        "Admin Bearer Write",
+			description: "Admin keys presented as bearer tokens should be allowed to delete sessions",
+			keys:        []APIKey{{Key: readKey, Role: ReadOnly}, {Key: adminKey, Role: Admin}},
+			method:      http.MethodDelete,
+			path:        InvariantPath + "/abc",
+			headers:     map[string]string{"Authorization": "Bearer " + adminKey},
+			status:      http.StatusOK,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			h := NewHandlers(newFakeEngine(), fakePipelines{}, WithAPIKeys(tc.keys))
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(""))
+			for key, val := range tc.headers {
+				req.Header.Set(key, val)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.status, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+const (
+	// BatchPath ... Atomic creation of many invariant sessions
+	BatchPath = "/v0/invariants/batch"
+
+	// maxBatchSize ... Upper bound on the number of sessions created by a single batch
+	maxBatchSize = 256
+)
+
+// BatchRequest ... Sessions to create together; either every session is created or none are
+type BatchRequest struct {
+	Sessions []engine.SessionRequest `json:"sessions"`
+}
+
+// BatchResponse ... Returned once every session of a batch is created
+type BatchResponse struct {
+	// Session IDs in request order
+	IDs []engine.SessionID `json:"ids"`
+}
+
+// batch ... Handles atomic creations (POST) of many sessions
+func (h *Handlers) batch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		notAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req BatchRequest
+	if err := decode(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(req.Sessions) == 0 || len(req.Sessions) > maxBatchSize {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("batches must hold between 1 & %d sessions", maxBatchSize))
+		return
+	}
+
+	ids, err := h.engine.CreateSessions(req.Sessions)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, BatchResponse{IDs: ids})
+}
@@ -0,0 +1,357 @@
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// InvariantPath ... Collection of invariant sessions; sessions are addressed by appending their ID
+	InvariantPath = "/v0/invariant"
+
+	// maxBodySize ... Upper bound on the size of request bodies
+	maxBodySize = 1 << 20
+)
+
+// Engine ... Subset of the risk engine used to manage invariant sessions
+type Engine interface {
+	CreateSession(req engine.SessionRequest) (engine.SessionID, error)
+	CreateSessions(reqs []engine.SessionRequest) ([]engine.SessionID, error)
+	GetSession(id engine.SessionID) (*engine.Session, error)
+	Sessions() []*engine.Session
+	EndSession(id engine.SessionID) (*engine.SessionSummary, error)
+	SetCooldown(id engine.SessionID, d time.Duration) error
+}
+
+// Pipelines ... Subset of the ETL manager used to report the state & components of pipelines and change
+// the endpoints they read from
+type Pipelines interface {
+	GetState(id etl.PipelineID) (models.PipelineState, error)
+	States() map[etl.PipelineID]models.PipelineState
+	Graph() etl.Graph
+	Stats() map[models.ComponentID]pipeline.ComponentStats
+	Endpoints() map[models.Network]string
+	SetEndpoint(network models.Network, endpoint string) error
+}
+
+// PipelineView ... Pipeline producing a session's data
+type PipelineView struct {
+	ID etl.PipelineID `json:"id"`
+	// Empty if the pipeline's state couldn't be read
+	State models.PipelineState `json:"state,omitempty"`
+}
+
+// SessionView ... Representation of a running session
+type SessionView struct {
+	ID         engine.SessionID   `json:"id"`
+	Invariant  invariant.Type     `json:"invariant"`
+	Params     models.Params      `json:"params,omitempty"`
+	Severity   invariant.Severity `json:"severity"`
+	Cooldown   models.Duration    `json:"cooldown,omitempty"`
+	Network    models.Network     `json:"network"`
+	Pipeline   PipelineView       `json:"pipeline"`
+	Correlated *PipelineView      `json:"correlated_pipeline,omitempty"`
+	Created    time.Time          `json:"created"`
+
+	Assessed      int64 `json:"assessed"`
+	Invalidations int64 `json:"invalidations"`
+	Suppressed    int64 `json:"suppressed"`
+}
+
+// CreatedResponse ... Returned once a session is created
+type CreatedResponse struct {
+	ID engine.SessionID `json:"id"`
+}
+
+// ErrorResponse ... Returned by every failed request
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Option ...
+type Option = func(*Handlers)
+
+// WithHealthCheck ... Reports the dependency's health under the name at the health endpoint
+func WithHealthCheck(name string, check HealthCheck) Option {
+	return func(h *Handlers) {
+		h.checks[name] = check
+	}
+}
+
+// Handlers ... Routes API requests to the engine
+type Handlers struct {
+	engine    Engine
+	pipelines Pipelines
+	mux       *http.ServeMux
+
+	// Dependency checks reported by the health endpoint keyed by name
+	checks map[string]HealthCheck
+
+	// Roles of the accepted API keys keyed by their hash; requests aren't authenticated when empty
+	keys map[string]Role
+
+	// Optional; requests aren't limited when nil
+	keyLimiter *limiter
+	ipLimiter  *limiter
+
+	// Optional; the stream endpoint isn't served when nil
+	bus      *events.Bus
+	upgrader websocket.Upgrader
+
+	// Optional; the alerts endpoints aren't served when nil
+	alerts Alerts
+}
+
+// NewHandlers ... Initializer
+func NewHandlers(e Engine, pipelines Pipelines, opts ...Option) *Handlers {
+	h := &Handlers{
+		engine:    e,
+		pipelines: pipelines,
+		mux:       http.NewServeMux(),
+		checks:    make(map[string]HealthCheck),
+		keys:      make(map[string]Role),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	h.mux.HandleFunc(HealthPath, h.health)
+	h.mux.HandleFunc(OpenAPIPath, h.openAPIDoc)
+	h.mux.HandleFunc(ConfigPath, h.runtimeConfig)
+	h.mux.HandleFunc(PipelinesPath, h.pipelineGraph)
+	h.mux.HandleFunc(ComponentsPath, h.components)
+	if h.bus != nil {
+		h.mux.HandleFunc(StreamPath, h.stream)
+	}
+	if h.alerts != nil {
+		h.mux.HandleFunc(AlertsPath, h.alertHistory)
+		h.mux.HandleFunc(AlertsPath+"/", h.acknowledge)
+	}
+	h.mux.HandleFunc(InvariantPath, h.invariants)
+	h.mux.HandleFunc(InvariantPath+"/", h.invariant)
+	h.mux.HandleFunc(BatchPath, h.batch)
+
+	return h
+}
+
+// ServeHTTP ... Rate limits, authenticates & serves the request and logs its outcome
+func (h *Handlers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+	r, ok := h.admit(rec, r)
+	if ok {
+		h.mux.ServeHTTP(rec, r)
+	}
+
+	fields := []zap.Field{zap.String("ip", clientIP(r)), zap.String("method", r.Method),
+		zap.String("path", r.URL.Path), zap.Int("status", rec.status), zap.Duration("duration", time.Since(start))}
+	if caller, found := CallerFrom(r.Context()); found {
+		fields = append(fields, zap.String("key_id", caller.KeyID))
+	}
+
+	logging.WithContext(r.Context()).Debug("Served API request", fields...)
+}
+
+// admit ... Returns the request with its caller attached if it's within the rate limits of its IP & key
+// and is authorized; otherwise responds & returns false. Health checks are never limited
+func (h *Handlers) admit(w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
+	if r.URL.Path == HealthPath {
+		return r, true
+	}
+
+	if !limit(w, h.ipLimiter, clientIP(r)) {
+		return r, false
+	}
+
+	r, ok := h.authenticate(w, r)
+	if !ok {
+		return r, false
+	}
+
+	caller, found := CallerFrom(r.Context())
+	if !found {
+		return r, true
+	}
+
+	return r, limit(w, h.keyLimiter, caller.KeyID)
+}
+
+// invariants ... Creates (POST) or lists (GET) sessions
+func (h *Handlers) invariants(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		var req engine.SessionRequest
+		if err := decode(w, r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		id, err := h.engine.CreateSession(req)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, CreatedResponse{ID: id})
+
+	case http.MethodGet:
+		sessions := h.engine.Sessions()
+
+		views := make([]SessionView, 0, len(sessions))
+		for _, s := range sessions {
+			views = append(views, h.view(s))
+		}
+
+		writeJSON(w, http.StatusOK, views)
+
+	default:
+		notAllowed(w, http.MethodGet, http.MethodPost)
+	}
+}
+
+// invariant ... Inspects (GET), updates (PATCH) or ends (DELETE) the session addressed by the path
+func (h *Handlers) invariant(w http.ResponseWriter, r *http.Request) {
+	id := engine.SessionID(strings.TrimPrefix(r.URL.Path, InvariantPath+"/"))
+	if id == "" || strings.Contains(string(id), "/") {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s, err := h.engine.GetSession(id)
+		if err != nil {
+			writeError(w, statusOf(err), err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, h.view(s))
+
+	case http.MethodDelete:
+		summary, err := h.engine.EndSession(id)
+		if err != nil {
+			writeError(w, statusOf(err), err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, summary)
+
+	case http.MethodPatch:
+		h.updateSession(w, r, id)
+
+	default:
+		notAllowed(w, http.MethodGet, http.MethodPatch, http.MethodDelete)
+	}
+}
+
+// view ... Returns the representation of the session including the state of its pipelines
+func (h *Handlers) view(s *engine.Session) SessionView {
+	summary := s.Summary()
+
+	view := SessionView{
+		ID:            s.ID,
+		Invariant:     s.Invariant,
+		Params:        s.Params,
+		Severity:      s.Severity,
+		Cooldown:      models.Duration(s.Cooldown()),
+		Network:       s.Network,
+		Pipeline:      h.pipeline(s.PipelineID),
+		Created:       s.Created,
+		Assessed:      summary.Assessed,
+		Invalidations: summary.Invalidations,
+		Suppressed:    summary.Suppressed,
+	}
+
+	if s.CorrelatedPipelineID != 0 {
+		correlated := h.pipeline(s.CorrelatedPipelineID)
+		view.Correlated = &correlated
+	}
+
+	return view
+}
+
+// pipeline ... Returns the representation of the pipeline
+func (h *Handlers) pipeline(id etl.PipelineID) PipelineView {
+	state, err := h.pipelines.GetState(id)
+	if err != nil {
+		return PipelineView{ID: id}
+	}
+
+	return PipelineView{ID: id, State: state}
+}
+
+// statusOf ... Returns the status of a failed session lookup
+func statusOf(err error) int {
+	if errors.Is(err, engine.ErrSessionNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
+
+// decode ... Decodes the JSON request body into the value; unknown fields are rejected
+func decode(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(v)
+}
+
+// writeJSON ... Writes the value as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError ... Writes the error as the response body
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
+
+// notAllowed ... Rejects requests using methods the path doesn't support
+func notAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+}
+
+// statusRecorder ... Records the status written to the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader ... Records the status before writing it
+func (sr *statusRecorder) WriteHeader(status int) {
+	sr.status = status
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+// Hijack ... Hands the connection over to the caller; used to upgrade streams
+func (sr *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := sr.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not support hijacking")
+	}
+
+	sr.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
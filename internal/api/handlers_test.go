@@ -0,0 +1,314 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEngine struct {
+	sessions map[engine.SessionID]*engine.Session
+	created  []engine.SessionRequest
+}
+
+func (fe *fakeEngine) CreateSession(req engine.SessionRequest) (engine.SessionID, error) {
+	if req.Invariant == "" {
+		return "", fmt.Errorf("invariant type is required")
+	}
+
+	fe.created = append(fe.created, req)
+	return "created", nil
+}
+
+func (fe *fakeEngine) CreateSessions(reqs []engine.SessionRequest) ([]engine.SessionID, error) {
+	for i, req := range reqs {
+		if req.Invariant == "" {
+			return nil, fmt.Errorf("session %d: invariant type is required", i)
+		}
+	}
+
+	ids := make([]engine.SessionID, 0, len(reqs))
+	for _, req := range reqs {
+		id, _ := fe.CreateSession(req)
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (fe *fakeEngine) GetSession(id engine.SessionID) (*engine.Session, error) {
+	s, found := fe.sessions[id]
+	if !found {
+		return nil, fmt.Errorf("%w for id: %s", engine.ErrSessionNotFound, id)
+	}
+
+	return s, nil
+}
+
+func (fe *fakeEngine) Sessions() []*engine.Session {
+	sessions := make([]*engine.Session, 0, len(fe.sessions))
+	for _, s := range fe.sessions {
+		sessions = append(sessions, s)
+	}
+
+	return sessions
+}
+
+func (fe *fakeEngine) EndSession(id engine.SessionID) (*engine.SessionSummary, error) {
+	s, err := fe.GetSession(id)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(fe.sessions, id)
+	summary := s.Summary()
+	return &summary, nil
+}
+
+// created ... Creation time of the session held by fake engines
+var created = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newFakeEngine ... Returns an engine holding a single session with the ID "abc"
+func newFakeEngine() *fakeEngine {
+	return &fakeEngine{sessions: map[engine.SessionID]*engine.Session{
+		"abc": {
+			ID:         "abc",
+			Invariant:  invariant.Type("LARGE_TX_VALUE"),
+			Severity:   invariant.High,
+			Network:    models.Layer1,
+			PipelineID: 1,
+			Created:    created,
+		},
+	}}
+}
+
+func (fe *fakeEngine) SetCooldown(id engine.SessionID, d time.Duration) error {
+	s, err := fe.GetSession(id)
+	if err != nil {
+		return err
+	}
+
+	s.SetCooldown(d)
+	return nil
+}
+
+type fakePipelines map[etl.PipelineID]models.PipelineState
+
+func (fp fakePipelines) States() map[etl.PipelineID]models.PipelineState {
+	return fp
+}
+
+func (fp fakePipelines) Graph() etl.Graph {
+	return etl.Graph{}
+}
+
+func (fp fakePipelines) Stats() map[models.ComponentID]pipeline.ComponentStats {
+	return map[models.ComponentID]pipeline.ComponentStats{}
+}
+
+func (fp fakePipelines) Endpoints() map[models.Network]string {
+	return map[models.Network]string{}
+}
+
+func (fp fakePipelines) SetEndpoint(models.Network, string) error {
+	return nil
+}
+
+func (fp fakePipelines) GetState(id etl.PipelineID) (models.PipelineState, error) {
+	state, found := fp[id]
+	if !found {
+		return "", fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	return state, nil
+}
+
+func Test_Handlers(t *testing.T) {
+	logging.NewLogger(nil, false)
+	var tests = []struct {
+		name        string
+		description string
+
+		method string
+		path   string
+		body   string
+
+		status int
+		check  func(t *testing.T, fe *fakeEngine, body []byte)
+	}{
+		{
+			name:        "Create Session",
+			description: "Valid session requests should be created & return the session ID",
+			method:      http.MethodPost,
+			path:        InvariantPath,
+			body:        `{"invariant": "LARGE_TX_VALUE", "cooldown": "1m", "pipeline": {"network": "layer1"}}`,
+			status:      http.StatusCreated,
+			check: func(t *testing.T, fe *fakeEngine, body []byte) {
+				var resp CreatedResponse
+				assert.NoError(t, json.Unmarshal(body, &resp))
+				assert.Equal(t, engine.SessionID("created"), resp.ID)
+
+				assert.Len(t, fe.created, 1)
+				assert.Equal(t, models.Duration(time.Minute), fe.created[0].Cooldown)
+			},
+		},
+		{
+			name:        "Create Unknown Field",
+			description: "Session requests with unknown fields should be rejected before reaching the engine",
+			method:      http.MethodPost,
+			path:        InvariantPath,
+			body:        `{"invariant": "LARGE_TX_VALUE", "frequency": 1}`,
+			status:      http.StatusBadRequest,
+			check: func(t *testing.T, fe *fakeEngine, _ []byte) {
+				assert.Empty(t, fe.created)
+			},
+		},
+		{
+			name:        "Create Invalid Session",
+			description: "Session requests rejected by the engine should fail with the engine's error",
+			method:      http.MethodPost,
+			path:        InvariantPath,
+			body:        `{}`,
+			status:      http.StatusBadRequest,
+			check: func(t *testing.T, _ *fakeEngine, body []byte) {
+				var resp ErrorResponse
+				assert.NoError(t, json.Unmarshal(body, &resp))
+				assert.Contains(t, resp.Error, "invariant type is required")
+			},
+		},
+		{
+			name:        "List Sessions",
+			description: "Every running session should be listed with the state of its pipeline",
+			method:      http.MethodGet,
+			path:        InvariantPath,
+			status:      http.StatusOK,
+			check: func(t *testing.T, _ *fakeEngine, body []byte) {
+				var views []SessionView
+				assert.NoError(t, json.Unmarshal(body, &views))
+				assert.Len(t, views, 1)
+
+				assert.Equal(t, engine.SessionID("abc"), views[0].ID)
+				assert.Equal(t, models.LiveState, views[0].Pipeline.State)
+				assert.Nil(t, views[0].Correlated)
+			},
+		},
+		{
+			name:        "Inspect Session",
+			description: "Sessions should be inspectable by ID",
+			method:      http.MethodGet,
+			path:        InvariantPath + "/abc",
+			status:      http.StatusOK,
+			check: func(t *testing.T, _ *fakeEngine, body []byte) {
+				var view SessionView
+				assert.NoError(t, json.Unmarshal(body, &view))
+
+				assert.Equal(t, invariant.Type("LARGE_TX_VALUE"), view.Invariant)
+				assert.Equal(t, invariant.High, view.Severity)
+				assert.Equal(t, created, view.Created)
+			},
+		},
+		{
+			name:        "Inspect Unknown Session",
+			description: "Inspecting sessions that don't exist should fail as not found",
+			method:      http.MethodGet,
+			path:        InvariantPath + "/xyz",
+			status:      http.StatusNotFound,
+		},
+		{
+			name:        "Delete Session",
+			description: "Deleting a session should end it & return its summary",
+			method:      http.MethodDelete,
+			path:        InvariantPath + "/abc",
+			status:      http.StatusOK,
+			check: func(t *testing.T, fe *fakeEngine, body []byte) {
+				var summary engine.SessionSummary
+				assert.NoError(t, json.Unmarshal(body, &summary))
+				assert.Equal(t, engine.SessionID("abc"), summary.SessionID)
+
+				assert.Empty(t, fe.sessions)
+			},
+		},
+		{
+			name:        "Delete Unknown Session",
+			description: "Deleting sessions that don't exist should fail as not found",
+			method:      http.MethodDelete,
+			path:        InvariantPath + "/xyz",
+			status:      http.StatusNotFound,
+		},
+		{
+			name:        "Create Batch",
+			description: "Every session of a valid batch should be created & their IDs returned in order",
+			method:      http.MethodPost,
+			path:        BatchPath,
+			body:        `{"sessions": [{"invariant": "LARGE_TX_VALUE"}, {"invariant": "BALANCE_ENFORCEMENT"}]}`,
+			status:      http.StatusCreated,
+			check: func(t *testing.T, fe *fakeEngine, body []byte) {
+				var resp BatchResponse
+				assert.NoError(t, json.Unmarshal(body, &resp))
+				assert.Len(t, resp.IDs, 2)
+
+				assert.Len(t, fe.created, 2)
+				assert.Equal(t, invariant.Type("BALANCE_ENFORCEMENT"), fe.created[1].Invariant)
+			},
+		},
+		{
+			name:        "Create Invalid Batch",
+			description: "Batches holding any invalid session should create nothing",
+			method:      http.MethodPost,
+			path:        BatchPath,
+			body:        `{"sessions": [{"invariant": "LARGE_TX_VALUE"}, {}]}`,
+			status:      http.StatusBadRequest,
+			check: func(t *testing.T, fe *fakeEngine, body []byte) {
+				var resp ErrorResponse
+				assert.NoError(t, json.Unmarshal(body, &resp))
+				assert.Contains(t, resp.Error, "session 1")
+
+				assert.Empty(t, fe.created)
+			},
+		},
+		{
+			name:        "Create Empty Batch",
+			description: "Batches without sessions should be rejected",
+			method:      http.MethodPost,
+			path:        BatchPath,
+			body:        `{"sessions": []}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "Unsupported Method",
+			description: "Unsupported methods should be rejected with the allowed methods",
+			method:      http.MethodPut,
+			path:        InvariantPath + "/abc",
+			status:      http.StatusMethodNotAllowed,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			fe := newFakeEngine()
+			h := NewHandlers(fe, fakePipelines{1: models.LiveState})
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.status, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			if tc.check != nil {
+				tc.check(t, fe, rec.Body.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+const (
+	// HealthPath ... Overall health of the application & its dependencies
+	HealthPath = "/health"
+
+	// PipelinesCheck ... Name of the built-in check that every pipeline is running
+	PipelinesCheck = "pipelines"
+
+	// checkTimeout ... Upper bound on a single dependency check
+	checkTimeout = 5 * time.Second
+)
+
+// HealthStatus ... Outcome of a health check
+type HealthStatus string
+
+const (
+	// Healthy ...
+	Healthy HealthStatus = "healthy"
+	// Unhealthy ...
+	Unhealthy HealthStatus = "unhealthy"
+)
+
+// HealthCheck ... Returns an error when the dependency is unhealthy; the context bounds the check
+type HealthCheck func(ctx context.Context) error
+
+// CheckResult ... Outcome of a single dependency check
+type CheckResult struct {
+	Status HealthStatus `json:"status"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// HealthResponse ... Overall status & the outcome of every dependency check; the overall status is
+// unhealthy when any check is
+type HealthResponse struct {
+	Status HealthStatus           `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// health ... Runs every dependency check concurrently; responds with 503 when any check fails so that
+// load balancers & uptime checks can rely on the status code alone
+func (h *Handlers) health(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		notAllowed(w, http.MethodGet, http.MethodHead)
+		return
+	}
+
+	checks := make(map[string]HealthCheck, len(h.checks)+1)
+	for name, check := range h.checks {
+		checks[name] = check
+	}
+	checks[PipelinesCheck] = h.checkPipelines
+
+	resp := HealthResponse{Status: Healthy, Checks: make(map[string]CheckResult, len(checks))}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for name, check := range checks {
+		wg.Add(1)
+
+		go func(name string, check HealthCheck) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+			defer cancel()
+
+			result := CheckResult{Status: Healthy}
+			if err := check(ctx); err != nil {
+				result = CheckResult{Status: Unhealthy, Error: err.Error()}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			resp.Checks[name] = result
+			if result.Status == Unhealthy {
+				resp.Status = Unhealthy
+			}
+		}(name, check)
+	}
+
+	wg.Wait()
+
+	status := http.StatusOK
+	if resp.Status == Unhealthy {
+		status = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, status, resp)
+}
+
+// checkPipelines ... Fails if any pipeline has terminated; pipelines that are paused or still catching
+// up are considered healthy
+func (h *Handlers) checkPipelines(_ context.Context) error {
+	terminated := make([]string, 0)
+
+	for id, state := range h.pipelines.States() {
+		if state == models.TerminatedState {
+			terminated = append(terminated, fmt.Sprint(id))
+		}
+	}
+
+	if len(terminated) == 0 {
+		return nil
+	}
+
+	sort.Strings(terminated)
+	return fmt.Errorf("pipelines have terminated: %s", strings.Join(terminated, ", "))
+}
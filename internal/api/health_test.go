@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Health(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	reachable := func(context.Context) error { return nil }
+	unreachable := func(context.Context) error { return errors.New("connection refused") }
+
+	var tests = []struct {
+		name        string
+		description string
+
+		pipelines fakePipelines
+		checks    map[string]HealthCheck
+
+		status   int
+		expected HealthResponse
+	}{
+		{
+			name:        "Healthy",
+			description: "Every dependency passing its check should report healthy",
+			pipelines:   fakePipelines{1: models.LiveState, 2: models.PausedState, 3: models.BackfillingState},
+			checks:      map[string]HealthCheck{"l1_rpc": reachable},
+			status:      http.StatusOK,
+			expected: HealthResponse{Status: Healthy, Checks: map[string]CheckResult{
+				"l1_rpc":       {Status: Healthy},
+				PipelinesCheck: {Status: Healthy},
+			}},
+		},
+		{
+			name:        "Unreachable Dependency",
+			description: "A failing dependency check should report unhealthy with the failure",
+			pipelines:   fakePipelines{1: models.LiveState},
+			checks:      map[string]HealthCheck{"l1_rpc": reachable, "l2_rpc": unreachable},
+			status:      http.StatusServiceUnavailable,
+			expected: HealthResponse{Status: Unhealthy, Checks: map[string]CheckResult{
+				"l1_rpc":       {Status: Healthy},
+				"l2_rpc":       {Status: Unhealthy, Error: "connection refused"},
+				PipelinesCheck: {Status: Healthy},
+			}},
+		},
+		{
+			name:        "Terminated Pipeline",
+			description: "Terminated pipelines should report unhealthy",
+			pipelines:   fakePipelines{1: models.LiveState, 2: models.TerminatedState},
+			status:      http.StatusServiceUnavailable,
+			expected: HealthResponse{Status: Unhealthy, Checks: map[string]CheckResult{
+				PipelinesCheck: {Status: Unhealthy, Error: "pipelines have terminated: 2"},
+			}},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			opts := make([]Option, 0, len(tc.checks))
+			for name, check := range tc.checks {
+				opts = append(opts, WithHealthCheck(name, check))
+			}
+
+			h := NewHandlers(&fakeEngine{}, tc.pipelines, opts...)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HealthPath, nil))
+			assert.Equal(t, tc.status, rec.Code)
+
+			var resp HealthResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expected, resp)
+		})
+	}
+}
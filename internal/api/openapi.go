@@ -0,0 +1,371 @@
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+)
+
+const (
+	// OpenAPIPath ... OpenAPI 3 document describing the API
+	OpenAPIPath = "/v0/openapi.json"
+
+	// Version ... Version of the API described by the OpenAPI document
+	Version = "v0"
+)
+
+// parameter ... Path or query parameter of an operation
+type parameter struct {
+	name        string
+	in          string
+	description string
+}
+
+// operation ... Documented API operation; request & response bodies are described by example values of
+// their Go types
+type operation struct {
+	method  string
+	path    string
+	summary string
+	// Role required to call the operation; empty when unauthenticated
+	role       Role
+	parameters []parameter
+	request    any
+	responses  map[int]any
+}
+
+// idParam ... Path parameter of operations addressing a single session
+var idParam = parameter{name: "id", in: "path", description: "Session ID"}
+
+// operations ... Every operation served by the API; the OpenAPI document is generated from these
+var operations = []operation{
+	{
+		method: http.MethodGet, path: HealthPath, summary: "Reports the health of the application & its dependencies",
+		responses: map[int]any{http.StatusOK: HealthResponse{}, http.StatusServiceUnavailable: HealthResponse{}},
+	},
+	{
+		method: http.MethodGet, path: OpenAPIPath, summary: "Returns this document", role: ReadOnly,
+		responses: map[int]any{http.StatusOK: map[string]any{}},
+	},
+	{
+		method: http.MethodPost, path: InvariantPath, summary: "Creates an invariant session", role: Admin,
+		request: engine.SessionRequest{},
+		responses: map[int]any{
+			http.StatusCreated:    CreatedResponse{},
+			http.StatusBadRequest: ErrorResponse{},
+		},
+	},
+	{
+		method: http.MethodPost, path: BatchPath,
+		summary: "Creates every invariant session of the batch, or none if any can't be created", role: Admin,
+		request: BatchRequest{},
+		responses: map[int]any{
+			http.StatusCreated:    BatchResponse{},
+			http.StatusBadRequest: ErrorResponse{},
+		},
+	},
+	{
+		method: http.MethodGet, path: InvariantPath, summary: "Lists every running invariant session", role: ReadOnly,
+		responses: map[int]any{http.StatusOK: []SessionView{}},
+	},
+	{
+		method: http.MethodGet, path: InvariantPath + "/{id}", summary: "Inspects an invariant session",
+		role: ReadOnly, parameters: []parameter{idParam},
+		responses: map[int]any{http.StatusOK: SessionView{}, http.StatusNotFound: ErrorResponse{}},
+	},
+	{
+		method: http.MethodPatch, path: InvariantPath + "/{id}", summary: "Updates a running invariant session",
+		role: Admin, parameters: []parameter{idParam}, request: SessionUpdate{},
+		responses: map[int]any{
+			http.StatusOK:         SessionView{},
+			http.StatusBadRequest: ErrorResponse{},
+			http.StatusNotFound:   ErrorResponse{},
+		},
+	},
+	{
+		method: http.MethodDelete, path: InvariantPath + "/{id}", summary: "Ends an invariant session",
+		role: Admin, parameters: []parameter{idParam},
+		responses: map[int]any{http.StatusOK: engine.SessionSummary{}, http.StatusNotFound: ErrorResponse{}},
+	},
+	{
+		method: http.MethodGet, path: ConfigPath, summary: "Returns the settings that can be changed while running",
+		role: ReadOnly, responses: map[int]any{http.StatusOK: RuntimeConfig{}},
+	},
+	{
+		method: http.MethodPatch, path: ConfigPath, summary: "Changes settings while running", role: Admin,
+		request:   RuntimeConfigUpdate{},
+		responses: map[int]any{http.StatusOK: RuntimeConfig{}, http.StatusBadRequest: ErrorResponse{}},
+	},
+	{
+		method: http.MethodGet, path: PipelinesPath,
+		summary: "Returns the live graph of every pipeline's components & directives", role: ReadOnly,
+		responses: map[int]any{http.StatusOK: etl.Graph{}},
+	},
+	{
+		method: http.MethodGet, path: ComponentsPath,
+		summary: "Lists every running component with its state, processed height & throughput", role: ReadOnly,
+		responses: map[int]any{http.StatusOK: []ComponentView{}},
+	},
+	{
+		method: http.MethodGet, path: AlertsPath,
+		summary: "Pages through the dispatched alerts matching the filters, most recently dispatched first",
+		role:    ReadOnly,
+		parameters: []parameter{
+			{name: "network", in: "query", description: "Only alerts of the network"},
+			{name: "severity", in: "query", description: "Only alerts of the severity"},
+			{name: "from", in: "query", description: "Only alerts dispatched at or after the RFC 3339 time"},
+			{name: "to", in: "query", description: "Only alerts dispatched before the RFC 3339 time"},
+			{name: "cursor", in: "query", description: "next_cursor of the previous page; the first page when omitted"},
+			{name: "limit", in: "query", description: "Maximum number of alerts returned; 100 when omitted"},
+		},
+		responses: map[int]any{http.StatusOK: AlertPage{}, http.StatusBadRequest: ErrorResponse{}},
+	},
+	{
+		method: http.MethodPost, path: AlertsPath + "/{id}" + AckSuffix,
+		summary: "Acknowledges an alert, suppressing re-alerts of its condition until it resolves or the " +
+			"acknowledgement expires",
+		role:       Admin,
+		parameters: []parameter{{name: "id", in: "path", description: "Alert ID"}},
+		request:    AckRequest{},
+		responses: map[int]any{
+			http.StatusOK:         history.Acknowledgement{},
+			http.StatusBadRequest: ErrorResponse{},
+			http.StatusNotFound:   ErrorResponse{},
+		},
+	},
+	{
+		method: http.MethodGet, path: StreamPath,
+		summary: "Upgrades to a WebSocket streaming a StreamEvent JSON message for every live event",
+		role:    ReadOnly,
+		parameters: []parameter{{name: "topics", in: "query",
+			description: "Comma separated topics to stream; every topic is streamed when omitted"}},
+		responses: map[int]any{http.StatusSwitchingProtocols: StreamEvent{}, http.StatusBadRequest: ErrorResponse{}},
+	},
+}
+
+// openAPI ... Returns the OpenAPI 3 document describing every operation
+func openAPI() map[string]any {
+	schemas := make(map[string]any)
+	paths := make(map[string]any)
+
+	for _, op := range operations {
+		doc := map[string]any{
+			"summary":     op.summary,
+			"operationId": operationID(op),
+			"responses":   responsesOf(op, schemas),
+		}
+
+		if op.role != "" {
+			doc["security"] = []any{map[string]any{"apiKey": []any{}}, map[string]any{"bearer": []any{}}}
+			doc["description"] = "Requires the " + string(op.role) + " role when API keys are configured"
+		}
+
+		if len(op.parameters) > 0 {
+			params := make([]any, 0, len(op.parameters))
+			for _, p := range op.parameters {
+				params = append(params, map[string]any{
+					"name":        p.name,
+					"in":          p.in,
+					"description": p.description,
+					"required":    p.in == "path",
+					"schema":      map[string]any{"type": "string"},
+				})
+			}
+
+			doc["parameters"] = params
+		}
+
+		if op.request != nil {
+			doc["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(schemaOf(reflect.TypeOf(op.request), schemas)),
+			}
+		}
+
+		path, ok := paths[op.path].(map[string]any)
+		if !ok {
+			path = make(map[string]any)
+			paths[op.path] = path
+		}
+
+		path[strings.ToLower(op.method)] = doc
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Pessimism API",
+			"version": Version,
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"apiKey": map[string]any{"type": "apiKey", "in": "header", "name": APIKeyHeader},
+				"bearer": map[string]any{"type": "http", "scheme": "bearer"},
+			},
+		},
+	}
+}
+
+// OpenAPI ... Returns the indented JSON encoding of the OpenAPI document
+func OpenAPI() ([]byte, error) {
+	doc, err := json.MarshalIndent(openAPI(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(doc, '\n'), nil
+}
+
+// operationID ... Returns a unique identifier of the operation derived from its method & path
+func operationID(op operation) string {
+	id := strings.ToLower(op.method)
+	for _, segment := range strings.Split(op.path, "/") {
+		segment = strings.Trim(segment, "{}")
+		if segment == "" || segment == Version {
+			continue
+		}
+
+		id += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+
+	return strings.ReplaceAll(id, ".", "")
+}
+
+// responsesOf ... Returns the responses of the operation including those common to every authenticated
+// operation
+func responsesOf(op operation, schemas map[string]any) map[string]any {
+	responses := make(map[string]any, len(op.responses)+3)
+
+	for status, body := range op.responses {
+		responses[strconv.Itoa(status)] = map[string]any{
+			"description": http.StatusText(status),
+			"content":     jsonContent(schemaOf(reflect.TypeOf(body), schemas)),
+		}
+	}
+
+	if op.role == "" {
+		return responses
+	}
+
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests} {
+		responses[strconv.Itoa(status)] = map[string]any{
+			"description": http.StatusText(status),
+			"content":     jsonContent(schemaOf(reflect.TypeOf(ErrorResponse{}), schemas)),
+		}
+	}
+
+	return responses
+}
+
+// jsonContent ... Returns the JSON media type of the schema
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	bigIntType   = reflect.TypeOf(big.Int{})
+	durationType = reflect.TypeOf(models.Duration(0))
+)
+
+// schemaOf ... Returns the JSON schema of values of the type as they're encoded by encoding/json; struct
+// schemas are added to the components & referenced by name
+func schemaOf(t reflect.Type, schemas map[string]any) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case bigIntType:
+		return map[string]any{"type": "integer"}
+	case durationType:
+		return map[string]any{"type": "string", "description": "Go duration (E.G, 90s) or number of seconds"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaOf(t.Elem(), schemas)}
+	case reflect.Struct:
+		return structSchema(t, schemas)
+	default:
+		// Interfaces accept any value
+		return map[string]any{}
+	}
+}
+
+// structSchema ... Adds the schema of the struct's exported JSON fields to the components & returns a
+// reference to it
+func structSchema(t reflect.Type, schemas map[string]any) map[string]any {
+	ref := map[string]any{"$ref": "#/components/schemas/" + t.Name()}
+	if _, found := schemas[t.Name()]; found {
+		return ref
+	}
+
+	properties := make(map[string]any)
+	required := make([]any, 0)
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	// Registered before the fields so that recursive types terminate
+	schemas[t.Name()] = schema
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = schemaOf(field.Type, schemas)
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return ref
+}
+
+// openAPIDoc ... Serves the OpenAPI document
+func (h *Handlers) openAPIDoc(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, openAPI())
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/alert/history"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OpenAPI(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	doc, err := OpenAPI()
+	assert.NoError(t, err)
+
+	published, err := os.ReadFile("../../specs/openapi.json")
+	assert.NoError(t, err)
+	assert.Equal(t, string(published), string(doc),
+		"specs/openapi.json is stale; regenerate it using `make gen-openapi`")
+
+	// Alert operations address the alert retained under the placeholder ID
+	store := history.NewMemoryStore(0)
+	assert.NoError(t, store.Add(history.Record{ID: "abc"}))
+
+	h := NewHandlers(newFakeEngine(), fakePipelines{}, WithEventBus(events.NewBus()),
+		WithAlerts(storeAlerts{store}))
+
+	// Every documented operation is served
+	for i, op := range operations {
+		t.Run(fmt.Sprintf("%d-%s", i, operationID(op)), func(t *testing.T) {
+			path := strings.ReplaceAll(op.path, "{id}", "abc")
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(op.method, path, strings.NewReader("{}")))
+
+			assert.NotEqual(t, http.StatusNotFound, rec.Code)
+			assert.NotEqual(t, http.StatusMethodNotAllowed, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+)
+
+const (
+	// PipelinesPath ... Live component graph of every pipeline
+	PipelinesPath = "/v0/pipelines"
+	// ComponentsPath ... State & throughput of every running component
+	ComponentsPath = "/v0/components"
+)
+
+// ComponentView ... Representation of a running component shared by the listed pipelines
+type ComponentView struct {
+	ID        string              `json:"id"`
+	Type      string              `json:"type"`
+	Network   models.Network      `json:"network"`
+	Register  models.RegisterType `json:"register"`
+	Pipelines []etl.PipelineID    `json:"pipelines"`
+	// Read activity of oracles; empty for every other component
+	State models.PipelineState `json:"state,omitempty"`
+	// Last height fully transited by oracles reading by height; omitted until a height is processed
+	Height *big.Int `json:"height,omitempty"`
+
+	// Number of unbatched items read & successfully transited
+	ItemsIn  uint64 `json:"items_in"`
+	ItemsOut uint64 `json:"items_out"`
+	// Time spent processing inputs; zero for oracles
+	AvgLatency models.Duration `json:"avg_latency"`
+	MaxLatency models.Duration `json:"max_latency"`
+	// Omitted if the component has never been active
+	LastActivity *time.Time `json:"last_activity,omitempty"`
+}
+
+// pipelineGraph ... Handles reads (GET) of the component graph
+func (h *Handlers) pipelineGraph(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.pipelines.Graph())
+}
+
+// components ... Handles listings (GET) of every running component in pipeline creation & dependency order
+func (h *Handlers) components(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	stats := make(map[string]pipeline.ComponentStats)
+	for cid, cs := range h.pipelines.Stats() {
+		stats[cid.String()] = cs
+	}
+
+	views := make([]ComponentView, 0)
+	for _, node := range h.pipelines.Graph().Nodes {
+		if node.Type == etl.OutputNodeType {
+			continue
+		}
+
+		cs := stats[node.ID]
+		view := ComponentView{
+			ID:         node.ID,
+			Type:       node.Type,
+			Network:    node.Network,
+			Register:   node.Register,
+			Pipelines:  node.Pipelines,
+			State:      node.State,
+			Height:     cs.Height,
+			ItemsIn:    cs.ItemsIn,
+			ItemsOut:   cs.ItemsOut,
+			AvgLatency: models.Duration(cs.AvgLatency),
+			MaxLatency: models.Duration(cs.MaxLatency),
+		}
+
+		if !cs.LastActivity.IsZero() {
+			view.LastActivity = &cs.LastActivity
+		}
+
+		views = append(views, view)
+	}
+
+	writeJSON(w, http.StatusOK, views)
+}
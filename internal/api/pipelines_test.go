@@ -0,0 +1,117 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// graphPipelines ... Pipelines running a single oracle
+type graphPipelines struct {
+	fakePipelines
+	oracle models.ComponentID
+	stats  pipeline.ComponentStats
+}
+
+func (gp graphPipelines) Graph() etl.Graph {
+	return etl.Graph{
+		Pipelines: []etl.GraphPipeline{{ID: 1, Register: registry.GethBlock, State: models.LiveState}},
+		Nodes: []etl.GraphNode{
+			{ID: gp.oracle.String(), Type: models.Oracle.String(), Network: models.Layer1,
+				Register: registry.GethBlock, Pipelines: []etl.PipelineID{1}, State: models.LiveState},
+			{ID: "output:1", Type: etl.OutputNodeType, Pipelines: []etl.PipelineID{1}},
+		},
+		Edges: []etl.GraphEdge{{Directive: 1, From: gp.oracle.String(), To: "output:1", Pipeline: 1, Attached: true}},
+	}
+}
+
+func (gp graphPipelines) Stats() map[models.ComponentID]pipeline.ComponentStats {
+	return map[models.ComponentID]pipeline.ComponentStats{gp.oracle: gp.stats}
+}
+
+func Test_Pipelines(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	oracle := models.NewComponentID(models.Layer1, models.Live, registry.GethBlock)
+	h := NewHandlers(newFakeEngine(), graphPipelines{
+		fakePipelines: fakePipelines{1: models.LiveState},
+		oracle:        oracle,
+		stats: pipeline.ComponentStats{ComponentID: oracle, Type: models.Oracle, ItemsOut: 10,
+			LastActivity: time.Now(), Height: big.NewInt(100)},
+	})
+
+	var tests = []struct {
+		name        string
+		description string
+
+		method string
+		path   string
+
+		status int
+		check  func(t *testing.T, body []byte)
+	}{
+		{
+			name:        "Pipeline Graph",
+			description: "The graph of every pipeline's components should be returned",
+			method:      http.MethodGet,
+			path:        PipelinesPath,
+			status:      http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var g etl.Graph
+				assert.NoError(t, json.Unmarshal(body, &g))
+
+				assert.Len(t, g.Pipelines, 1)
+				assert.Len(t, g.Nodes, 2)
+				assert.Len(t, g.Edges, 1)
+			},
+		},
+		{
+			name:        "Components",
+			description: "Components should be returned with their statistics & pipeline outputs omitted",
+			method:      http.MethodGet,
+			path:        ComponentsPath,
+			status:      http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var views []ComponentView
+				assert.NoError(t, json.Unmarshal(body, &views))
+
+				assert.Len(t, views, 1)
+				assert.Equal(t, oracle.String(), views[0].ID)
+				assert.Equal(t, models.LiveState, views[0].State)
+				assert.Equal(t, uint64(10), views[0].ItemsOut)
+				assert.Equal(t, big.NewInt(100), views[0].Height)
+				assert.NotNil(t, views[0].LastActivity)
+			},
+		},
+		{
+			name:        "Unsupported Method",
+			description: "Components can only be read",
+			method:      http.MethodDelete,
+			path:        ComponentsPath,
+			status:      http.StatusMethodNotAllowed,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+
+			assert.Equal(t, tc.status, rec.Code)
+			if tc.check != nil {
+				tc.check(t, rec.Body.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// sweepInterval ... Period between removals of idle rate limit buckets
+const sweepInterval = time.Minute
+
+// RateLimit ... Token bucket limit on the requests of a single client; requests are refilled evenly over
+// the minute & up to a minute's worth of requests can be made at once
+type RateLimit struct {
+	// Requests allowed per minute; unlimited when zero
+	PerMinute int
+}
+
+// validate ... Ensures the limit isn't negative
+func (rl RateLimit) validate() error {
+	if rl.PerMinute < 0 {
+		return errors.New("rate limit must not be negative")
+	}
+
+	return nil
+}
+
+// WithKeyRateLimit ... Limits the requests of every API key
+func WithKeyRateLimit(rl RateLimit) Option {
+	return func(h *Handlers) {
+		h.keyLimiter = newLimiter(rl)
+	}
+}
+
+// WithIPRateLimit ... Limits the requests of every client IP; applied ahead of authentication so that
+// clients guessing keys are limited too
+func WithIPRateLimit(rl RateLimit) Option {
+	return func(h *Handlers) {
+		h.ipLimiter = newLimiter(rl)
+	}
+}
+
+// NewRateLimit ... Returns the limit allowing the number of requests per minute; fail if negative
+func NewRateLimit(perMinute int) (RateLimit, error) {
+	rl := RateLimit{PerMinute: perMinute}
+	if err := rl.validate(); err != nil {
+		return RateLimit{}, err
+	}
+
+	return rl, nil
+}
+
+// bucket ... Requests available to a single client
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// limiter ... Token buckets of every client of a kind; uses its own lock as requests are served concurrently
+type limiter struct {
+	// Tokens refilled per second & bucket capacity
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// newLimiter ... Initializer; nil when the limit is unlimited
+func newLimiter(rl RateLimit) *limiter {
+	if rl.PerMinute <= 0 {
+		return nil
+	}
+
+	return &limiter{
+		rate:    float64(rl.PerMinute) / time.Minute.Seconds(),
+		burst:   float64(rl.PerMinute),
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow ... Takes a token from the client's bucket; returns false & the wait until a token is available
+// when the bucket is empty. Nil limiters allow every request
+func (l *limiter) allow(client string, now time.Time) (bool, time.Duration) {
+	if l == nil {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	b, found := l.buckets[client]
+	if !found {
+		b = &bucket{tokens: l.burst, updated: now}
+		l.buckets[client] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.updated).Seconds()*l.rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// sweep ... Removes buckets that have refilled completely as they're equivalent to new buckets; lock
+// must be held
+func (l *limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// clientIP ... Returns the IP the request was received from; forwarding headers are ignored as they
+// can be set by clients
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// limit ... Responds & returns false if the client has exceeded its limit
+func limit(w http.ResponseWriter, l *limiter, client string) bool {
+	allowed, wait := l.allow(client, time.Now())
+	if allowed {
+		return true
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	writeError(w, http.StatusTooManyRequests, fmt.Errorf("rate limit exceeded; retry in %s", wait.Round(time.Second)))
+	return false
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Limiter(t *testing.T) {
+	l := newLimiter(RateLimit{PerMinute: 2})
+	start := time.Unix(0, 0)
+
+	// Buckets start full & are refilled evenly over the minute
+	for i := 0; i < 2; i++ {
+		allowed, _ := l.allow("client", start)
+		assert.True(t, allowed)
+	}
+
+	allowed, wait := l.allow("client", start)
+	assert.False(t, allowed)
+	assert.Equal(t, 30*time.Second, wait)
+
+	allowed, _ = l.allow("other", start)
+	assert.True(t, allowed, "Ensuring clients are limited independently")
+
+	allowed, _ = l.allow("client", start.Add(30*time.Second))
+	assert.True(t, allowed)
+
+	// Refilled buckets are swept
+	l.allow("client", start.Add(time.Hour))
+	assert.Len(t, l.buckets, 1)
+
+	allowed, _ = newLimiter(RateLimit{}).allow("client", start)
+	assert.True(t, allowed, "Ensuring unlimited limiters allow every request")
+
+	_, err := NewRateLimit(-1)
+	assert.Error(t, err)
+}
+
+func Test_RateLimit(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	const (
+		key   = "read-0123456789a"
+		other = "read-0123456789b"
+	)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		opts     []Option
+		requests []*http.Request
+		statuses []int
+	}{
+		{
+			name:        "IP Limit",
+			description: "Requests of an IP beyond its limit should be rejected",
+			opts:        []Option{WithIPRateLimit(RateLimit{PerMinute: 1})},
+			requests: []*http.Request{
+				httptest.NewRequest(http.MethodGet, InvariantPath, nil),
+				httptest.NewRequest(http.MethodGet, InvariantPath, nil),
+				httptest.NewRequest(http.MethodGet, HealthPath, nil),
+			},
+			statuses: []int{http.StatusOK, http.StatusTooManyRequests, http.StatusOK},
+		},
+		{
+			name:        "Key Limit",
+			description: "Requests of a key beyond its limit should be rejected while other keys are unaffected",
+			opts: []Option{
+				WithKeyRateLimit(RateLimit{PerMinute: 1}),
+				WithAPIKeys([]APIKey{{Key: key, Role: ReadOnly}, {Key: other, Role: ReadOnly}}),
+			},
+			requests: []*http.Request{
+				withKey(httptest.NewRequest(http.MethodGet, InvariantPath, nil), key),
+				withKey(httptest.NewRequest(http.MethodGet, InvariantPath, nil), key),
+				withKey(httptest.NewRequest(http.MethodGet, InvariantPath, nil), other),
+			},
+			statuses: []int{http.StatusOK, http.StatusTooManyRequests, http.StatusOK},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			h := NewHandlers(newFakeEngine(), fakePipelines{}, tc.opts...)
+
+			for j, req := range tc.requests {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+
+				assert.Equal(t, tc.statuses[j], rec.Code)
+				if rec.Code == http.StatusTooManyRequests {
+					assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+				}
+			}
+		})
+	}
+}
+
+// withKey ... Presents the API key with the request
+func withKey(r *http.Request, key string) *http.Request {
+	r.Header.Set(APIKeyHeader, key)
+	return r
+}
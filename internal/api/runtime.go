@@ -0,0 +1,163 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap/zapcore"
+)
+
+// ConfigPath ... Settings that can be changed while running
+const ConfigPath = "/v0/config"
+
+// RuntimeConfig ... Settings that can be changed while running
+type RuntimeConfig struct {
+	// Minimum level logged (E.G, debug, info, warn, error)
+	LogLevel string `json:"log_level"`
+	// Period between the polls of every oracle
+	PollInterval models.Duration `json:"poll_interval"`
+	// Scheme & host of the RPC endpoint of every network; paths, queries & credentials are redacted as they
+	// commonly hold provider keys
+	Endpoints map[models.Network]string `json:"endpoints"`
+}
+
+// RuntimeConfigUpdate ... Changes to the runtime settings; omitted settings are unchanged. Endpoints only
+// apply to sessions created afterwards so that running pipelines aren't interrupted
+type RuntimeConfigUpdate struct {
+	LogLevel     *string                   `json:"log_level,omitempty"`
+	PollInterval *models.Duration          `json:"poll_interval,omitempty"`
+	Endpoints    map[models.Network]string `json:"endpoints,omitempty"`
+}
+
+// SessionUpdate ... Changes to a running session; omitted settings are unchanged
+type SessionUpdate struct {
+	// Applies from the session's next invalidation
+	Cooldown *models.Duration `json:"cooldown,omitempty"`
+}
+
+// runtimeConfig ... Handles reads (GET) & updates (PATCH) of the runtime settings
+func (h *Handlers) runtimeConfig(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, h.currentConfig())
+
+	case http.MethodPatch:
+		var update RuntimeConfigUpdate
+		if err := decode(w, r, &update); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := h.applyConfig(update); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, h.currentConfig())
+
+	default:
+		notAllowed(w, http.MethodGet, http.MethodPatch)
+	}
+}
+
+// currentConfig ... Returns the current runtime settings
+func (h *Handlers) currentConfig() RuntimeConfig {
+	endpoints := h.pipelines.Endpoints()
+	for network, endpoint := range endpoints {
+		endpoints[network] = redactEndpoint(endpoint)
+	}
+
+	return RuntimeConfig{
+		LogLevel:     logging.Level().String(),
+		PollInterval: models.Duration(registry.PollInterval()),
+		Endpoints:    endpoints,
+	}
+}
+
+// applyConfig ... Validates every change before applying any so that invalid updates change nothing
+func (h *Handlers) applyConfig(update RuntimeConfigUpdate) error {
+	var level zapcore.Level
+	if update.LogLevel != nil {
+		parsed, err := zapcore.ParseLevel(*update.LogLevel)
+		if err != nil {
+			return err
+		}
+
+		level = parsed
+	}
+
+	if update.PollInterval != nil && *update.PollInterval <= 0 {
+		return fmt.Errorf("poll interval must be positive; got %s", time.Duration(*update.PollInterval))
+	}
+
+	for network, endpoint := range update.Endpoints {
+		if err := config.ValidateEndpoint(endpoint); err != nil {
+			return fmt.Errorf("invalid %s endpoint: %w", network, err)
+		}
+	}
+
+	if update.LogLevel != nil {
+		logging.SetLevel(level)
+	}
+
+	if update.PollInterval != nil {
+		if err := registry.SetPollInterval(time.Duration(*update.PollInterval)); err != nil {
+			return err
+		}
+	}
+
+	for network, endpoint := range update.Endpoints {
+		if err := h.pipelines.SetEndpoint(network, endpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateSession ... Applies the update to the running session
+func (h *Handlers) updateSession(w http.ResponseWriter, r *http.Request, id engine.SessionID) {
+	var update SessionUpdate
+	if err := decode(w, r, &update); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if update.Cooldown != nil && *update.Cooldown < 0 {
+		writeError(w, http.StatusBadRequest, errors.New("cooldown must not be negative"))
+		return
+	}
+
+	s, err := h.engine.GetSession(id)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+
+	if update.Cooldown != nil {
+		if cErr := h.engine.SetCooldown(s.ID, time.Duration(*update.Cooldown)); cErr != nil {
+			writeError(w, statusOf(cErr), cErr)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, h.view(s))
+}
+
+// redactEndpoint ... Returns the scheme & host of the endpoint
+func redactEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return "<redacted>"
+	}
+
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+}
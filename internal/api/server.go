@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// readTimeout ... Upper bound on reading a request including its body
+	readTimeout = 10 * time.Second
+	// writeTimeout ... Upper bound on writing a response
+	writeTimeout = 30 * time.Second
+	// idleTimeout ... Upper bound on keeping an idle connection open
+	idleTimeout = 2 * time.Minute
+)
+
+// Config ... Address the API server listens on
+type Config struct {
+	Host string
+	Port int
+}
+
+// Server ... HTTP server exposing the API
+type Server struct {
+	ctx      context.Context
+	srv      *http.Server
+	listener net.Listener
+}
+
+// NewServer ... Initializer; the server doesn't listen until started
+func NewServer(ctx context.Context, cfg *Config, handler http.Handler) *Server {
+	return &Server{
+		ctx: ctx,
+		srv: &http.Server{
+			Addr:              net.JoinHostPort(cfg.Host, fmt.Sprint(cfg.Port)),
+			Handler:           handler,
+			ReadHeaderTimeout: readTimeout,
+			ReadTimeout:       readTimeout,
+			WriteTimeout:      writeTimeout,
+			IdleTimeout:       idleTimeout,
+			BaseContext:       func(net.Listener) context.Context { return ctx },
+		},
+	}
+}
+
+// Start ... Listens on the configured address & serves requests in the background; fail if the address
+// can't be listened on
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", s.srv.Addr, err)
+	}
+	s.listener = listener
+
+	logging.WithContext(s.ctx).Info("API server listening", zap.String("address", listener.Addr().String()))
+
+	go func() {
+		if sErr := s.srv.Serve(listener); sErr != nil && !errors.Is(sErr, http.ErrServerClosed) {
+			logging.WithContext(s.ctx).Error("API server failed", zap.Error(sErr))
+		}
+	}()
+
+	return nil
+}
+
+// Addr ... Returns the address the server listens on; nil until started
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Addr()
+}
+
+// Shutdown ... Stops accepting connections & waits for in-flight requests until the context is done
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
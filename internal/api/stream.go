@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// StreamPath ... WebSocket stream of live events
+	StreamPath = "/v0/stream"
+
+	// streamBufferSize ... Number of events buffered per stream; events are dropped for slow clients
+	// once full
+	streamBufferSize = 256
+	// streamWriteTimeout ... Upper bound on writing a single message to a stream
+	streamWriteTimeout = 10 * time.Second
+	// streamPingInterval ... Period between pings used to detect dead clients
+	streamPingInterval = 30 * time.Second
+	// streamPongTimeout ... Upper bound on waiting for a client's pong; must exceed the ping interval
+	streamPongTimeout = 2 * streamPingInterval
+)
+
+// StreamTopics ... Topics that can be streamed; every topic is streamed unless the `topics` query
+// parameter lists a subset
+var StreamTopics = []events.Topic{engine.InvalidationTopic, engine.SessionEndedTopic, etl.StateTopic}
+
+// StreamEvent ... Message written to streams for every event
+type StreamEvent struct {
+	Topic     events.Topic `json:"topic"`
+	Timestamp time.Time    `json:"timestamp"`
+	Payload   any          `json:"payload"`
+}
+
+// WithEventBus ... Streams the bus's events at the stream endpoint; the endpoint isn't served without a bus
+func WithEventBus(bus *events.Bus) Option {
+	return func(h *Handlers) {
+		h.bus = bus
+	}
+}
+
+// streamTopics ... Returns the topics listed in the comma separated query value; every streamable topic
+// when empty. Fail if any topic can't be streamed
+func streamTopics(query string) (map[events.Topic]struct{}, error) {
+	topics := make(map[events.Topic]struct{}, len(StreamTopics))
+
+	if query == "" {
+		for _, topic := range StreamTopics {
+			topics[topic] = struct{}{}
+		}
+
+		return topics, nil
+	}
+
+	for _, name := range strings.Split(query, ",") {
+		topic := events.Topic(strings.TrimSpace(name))
+
+		streamable := false
+		for _, st := range StreamTopics {
+			streamable = streamable || st == topic
+		}
+
+		if !streamable {
+			return nil, fmt.Errorf("topic cannot be streamed: %s", topic)
+		}
+
+		topics[topic] = struct{}{}
+	}
+
+	return topics, nil
+}
+
+// stream ... Upgrades the request to a WebSocket & writes every event of the requested topics as JSON
+// until either side disconnects. Messages sent by clients are discarded
+func (h *Handlers) stream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		notAllowed(w, http.MethodGet)
+		return
+	}
+
+	topics, err := streamTopics(r.URL.Query().Get("topics"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Cross-origin browser connections are rejected by the upgrader
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrader has already responded
+		return
+	}
+	defer conn.Close()
+
+	sub := h.bus.Subscribe(streamBufferSize)
+	defer h.bus.Unsubscribe(sub.ID)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	go readStream(conn, cancel)
+
+	ping := time.NewTicker(streamPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+
+			if _, found := topics[event.Topic]; !found {
+				continue
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			if wErr := conn.WriteJSON(StreamEvent(event)); wErr != nil {
+				logging.WithContext(ctx).Debug("Closing event stream", zap.Error(wErr))
+				return
+			}
+
+		case <-ping.C:
+			deadline := time.Now().Add(streamWriteTimeout)
+			if pErr := conn.WriteControl(websocket.PingMessage, nil, deadline); pErr != nil {
+				return
+			}
+
+		case <-ctx.Done():
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(streamWriteTimeout))
+			return
+		}
+	}
+}
+
+// readStream ... Reads the connection until it fails so that pongs & close frames are processed; the
+// stream is cancelled once the client disconnects or stops answering pings
+func readStream(conn *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+
+	_ = conn.SetReadDeadline(time.Now().Add(streamPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(streamPongTimeout))
+	})
+
+	for {
+		if _, _, err := conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
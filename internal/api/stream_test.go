@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stream(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	bus := events.NewBus()
+	server := httptest.NewServer(NewHandlers(&fakeEngine{}, fakePipelines{}, WithEventBus(bus)))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + StreamPath
+
+	_, resp, err := websocket.DefaultDialer.Dial(url+"?topics=unknown", nil)
+	assert.Error(t, err, "Ensuring unknown topics are rejected")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?topics="+string(engine.InvalidationTopic), nil)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	// Events are published until received as the stream subscribes once the upgrade completes
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				bus.Publish(etl.StateTopic, etl.StateTransition{Pipeline: 1, To: models.LiveState})
+				bus.Publish(engine.InvalidationTopic, engine.Invalidation{SessionID: "abc", Message: "invalidated"})
+
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var event struct {
+		Topic   events.Topic        `json:"topic"`
+		Payload engine.Invalidation `json:"payload"`
+	}
+	assert.NoError(t, conn.ReadJSON(&event))
+
+	assert.Equal(t, engine.InvalidationTopic, event.Topic, "Ensuring unrequested topics aren't streamed")
+	assert.Equal(t, engine.SessionID("abc"), event.Payload.SessionID)
+	assert.Equal(t, "invalidated", event.Payload.Message)
+}
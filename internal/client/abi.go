@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Minimal ABIs of the contracts read by invariants; only the getters in use are declared
+const (
+	erc20ABIJSON = `[
+		{"type":"function","name":"balanceOf","stateMutability":"view",
+			"inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"totalSupply","stateMutability":"view",
+			"inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+	]`
+
+	l2OutputOracleABIJSON = `[
+		{"type":"function","name":"latestOutputIndex","stateMutability":"view",
+			"inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"latestBlockNumber","stateMutability":"view",
+			"inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"nextBlockNumber","stateMutability":"view",
+			"inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"getL2OutputIndexAfter","stateMutability":"view",
+			"inputs":[{"name":"_l2BlockNumber","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"getL2Output","stateMutability":"view",
+			"inputs":[{"name":"_l2OutputIndex","type":"uint256"}],
+			"outputs":[{"name":"","type":"tuple","components":[
+				{"name":"outputRoot","type":"bytes32"},
+				{"name":"timestamp","type":"uint128"},
+				{"name":"l2BlockNumber","type":"uint128"}]}]}
+	]`
+
+	messagePasserABIJSON = `[
+		{"type":"function","name":"sentMessages","stateMutability":"view",
+			"inputs":[{"name":"","type":"bytes32"}],"outputs":[{"name":"","type":"bool"}]}
+	]`
+)
+
+var (
+	// ERC20ABI ... balanceOf & totalSupply getters of ERC20 tokens
+	ERC20ABI = mustParseABI(erc20ABIJSON)
+	// L2OutputOracleABI ... Output getters of the L2OutputOracle
+	L2OutputOracleABI = mustParseABI(l2OutputOracleABIJSON)
+	// MessagePasserABI ... sentMessages getter of the L2ToL1MessagePasser
+	MessagePasserABI = mustParseABI(messagePasserABIJSON)
+)
+
+// mustParseABI ... Parses an ABI declared in this package; panics on malformed declarations
+func mustParseABI(raw string) *abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		panic(err)
+	}
+
+	return &parsed
+}
+
+// Contract ... Reads a contract with a known ABI through eth_call
+type Contract struct {
+	client  EthClientInterface
+	address common.Address
+	abi     *abi.ABI
+}
+
+// NewContract ... Initializer
+func NewContract(client EthClientInterface, address common.Address, contractABI *abi.ABI) *Contract {
+	return &Contract{client: client, address: address, abi: contractABI}
+}
+
+// Address ... Returns the address of the contract
+func (c *Contract) Address() common.Address {
+	return c.address
+}
+
+// Call ... Calls the method with the arguments at the height & returns its unpacked outputs; nil heights read
+// the latest block
+func (c *Contract) Call(ctx context.Context, blockNumber *big.Int, method string, args ...any) ([]any, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack %s call: %w", method, err)
+	}
+
+	res, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &c.address, Data: data}, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accounts without code return no data rather than failing the call
+	if len(res) == 0 {
+		return nil, fmt.Errorf("empty %s response from %s; is it a contract?", method, c.address.Hex())
+	}
+
+	out, err := c.abi.Unpack(method, res)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack %s response from %s: %w", method, c.address.Hex(), err)
+	}
+
+	return out, nil
+}
+
+// callValue ... Calls a method returning a single value of the type; tuples are converted field by field
+func callValue[T any](ctx context.Context, c *Contract, blockNumber *big.Int, method string,
+	args ...any) (T, error) {
+	var zero T
+	out, err := c.Call(ctx, blockNumber, method, args...)
+	if err != nil {
+		return zero, err
+	}
+
+	if len(out) != 1 {
+		return zero, fmt.Errorf("expected a single %s output; got %d", method, len(out))
+	}
+
+	return *abi.ConvertType(out[0], new(T)).(*T), nil
+}
+
+// ERC20 ... ERC20 token getters
+type ERC20 struct {
+	*Contract
+}
+
+// NewERC20 ... Initializer
+func NewERC20(client EthClientInterface, address common.Address) *ERC20 {
+	return &ERC20{NewContract(client, address, ERC20ABI)}
+}
+
+// BalanceOf ... Returns the token balance of the account at the height
+func (e *ERC20) BalanceOf(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return callValue[*big.Int](ctx, e.Contract, blockNumber, "balanceOf", account)
+}
+
+// TotalSupply ... Returns the token supply at the height
+func (e *ERC20) TotalSupply(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return callValue[*big.Int](ctx, e.Contract, blockNumber, "totalSupply")
+}
+
+// OutputProposal ... Output root proposed to the L2OutputOracle
+type OutputProposal struct {
+	OutputRoot    common.Hash
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+// L2OutputOracle ... L2OutputOracle output getters
+type L2OutputOracle struct {
+	*Contract
+}
+
+// NewL2OutputOracle ... Initializer
+func NewL2OutputOracle(client EthClientInterface, address common.Address) *L2OutputOracle {
+	return &L2OutputOracle{NewContract(client, address, L2OutputOracleABI)}
+}
+
+// LatestOutputIndex ... Returns the index of the latest proposed output; fails until the first proposal
+func (o *L2OutputOracle) LatestOutputIndex(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return callValue[*big.Int](ctx, o.Contract, blockNumber, "latestOutputIndex")
+}
+
+// LatestBlockNumber ... Returns the L2 block number of the latest proposed output
+func (o *L2OutputOracle) LatestBlockNumber(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return callValue[*big.Int](ctx, o.Contract, blockNumber, "latestBlockNumber")
+}
+
+// NextBlockNumber ... Returns the L2 block number the next output must be proposed for
+func (o *L2OutputOracle) NextBlockNumber(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return callValue[*big.Int](ctx, o.Contract, blockNumber, "nextBlockNumber")
+}
+
+// L2OutputIndexAfter ... Returns the index of the first output proposed for or after the L2 block
+func (o *L2OutputOracle) L2OutputIndexAfter(ctx context.Context, l2BlockNumber,
+	blockNumber *big.Int) (*big.Int, error) {
+	return callValue[*big.Int](ctx, o.Contract, blockNumber, "getL2OutputIndexAfter", l2BlockNumber)
+}
+
+// L2Output ... Returns the output proposed at the index
+func (o *L2OutputOracle) L2Output(ctx context.Context, index, blockNumber *big.Int) (*OutputProposal, error) {
+	output, err := callValue[OutputProposal](ctx, o.Contract, blockNumber, "getL2Output", index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// MessagePasser ... L2ToL1MessagePasser getters
+type MessagePasser struct {
+	*Contract
+}
+
+// NewMessagePasser ... Initializer
+func NewMessagePasser(client EthClientInterface, address common.Address) *MessagePasser {
+	return &MessagePasser{NewContract(client, address, MessagePasserABI)}
+}
+
+// SentMessages ... Returns true if the withdrawal hash was sent as of the height
+func (mp *MessagePasser) SentMessages(ctx context.Context, withdrawalHash common.Hash,
+	blockNumber *big.Int) (bool, error) {
+	return callValue[bool](ctx, mp.Contract, blockNumber, "sentMessages", withdrawalHash)
+}
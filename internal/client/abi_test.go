@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// callClient ... Answers every call with the response while recording the last call
+type callClient struct {
+	EthClientInterface
+	response []byte
+	msg      ethereum.CallMsg
+	height   *big.Int
+}
+
+func (cc *callClient) CallContract(_ context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	cc.msg, cc.height = msg, blockNumber
+	return cc.response, nil
+}
+
+func Test_Contract(t *testing.T) {
+	address := common.HexToAddress("0x420")
+	account := common.HexToAddress("0x69")
+	height := big.NewInt(10)
+	word := func(v int64) []byte {
+		return common.BigToHash(big.NewInt(v)).Bytes()
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		contractABI *abi.ABI
+		response    []byte
+		call        func(cc *callClient) (any, error)
+		method      string
+		expected    any
+	}{
+		{
+			name:        "ERC20 Balance",
+			description: "balanceOf should pack the account & decode the balance",
+			contractABI: ERC20ABI,
+			response:    word(500),
+			call: func(cc *callClient) (any, error) {
+				return NewERC20(cc, address).BalanceOf(context.Background(), account, height)
+			},
+			method:   "balanceOf",
+			expected: big.NewInt(500),
+		},
+		{
+			name:        "ERC20 Supply",
+			description: "totalSupply should decode the supply",
+			contractABI: ERC20ABI,
+			response:    word(1000),
+			call: func(cc *callClient) (any, error) {
+				return NewERC20(cc, address).TotalSupply(context.Background(), height)
+			},
+			method:   "totalSupply",
+			expected: big.NewInt(1000),
+		},
+		{
+			name:        "L2 Output",
+			description: "getL2Output should decode the output proposal tuple",
+			contractABI: L2OutputOracleABI,
+			response:    append(append(common.HexToHash("0xabc").Bytes(), word(1700000000)...), word(1800)...),
+			call: func(cc *callClient) (any, error) {
+				return NewL2OutputOracle(cc, address).L2Output(context.Background(), big.NewInt(3), height)
+			},
+			method: "getL2Output",
+			expected: &OutputProposal{
+				OutputRoot:    common.HexToHash("0xabc"),
+				Timestamp:     big.NewInt(1700000000),
+				L2BlockNumber: big.NewInt(1800),
+			},
+		},
+		{
+			name:        "Sent Message",
+			description: "sentMessages should decode the boolean",
+			contractABI: MessagePasserABI,
+			response:    word(1),
+			call: func(cc *callClient) (any, error) {
+				return NewMessagePasser(cc, address).SentMessages(context.Background(), common.HexToHash("0x1"), height)
+			},
+			method:   "sentMessages",
+			expected: true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			cc := &callClient{response: tc.response}
+
+			actual, err := tc.call(cc)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+
+			assert.Equal(t, address, *cc.msg.To)
+			assert.Equal(t, height, cc.height)
+			assert.Equal(t, tc.contractABI.Methods[tc.method].ID, cc.msg.Data[:4], "Ensuring the method is called")
+		})
+	}
+}
+
+func Test_Contract_Errors(t *testing.T) {
+	cc := &callClient{}
+	token := NewERC20(cc, common.HexToAddress("0x420"))
+
+	_, err := token.TotalSupply(context.Background(), nil)
+	assert.ErrorContains(t, err, "is it a contract", "Ensuring accounts without code are reported")
+
+	cc.response = []byte{0x1}
+	_, err = token.TotalSupply(context.Background(), nil)
+	assert.ErrorContains(t, err, "could not unpack", "Ensuring malformed responses are reported")
+
+	_, err = token.Call(context.Background(), nil, "transfer")
+	assert.ErrorContains(t, err, "could not pack", "Ensuring undeclared methods are rejected")
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Backoff ... Exponential backoff with jitter between the attempts of a failed call; the zero value
+// makes a single attempt
+type Backoff struct {
+	// Retries after the first attempt; retries are unlimited when negative
+	Retries int
+	// Delay before the first retry; doubled after every retry
+	Base time.Duration
+	// Upper bound on the delay between attempts; delays aren't bounded when zero
+	Cap time.Duration
+	// Fraction of every delay that's randomized so that clients don't retry in lockstep; 0 through 1
+	Jitter float64
+}
+
+// DefaultBackoff ... Backoff used unless configured otherwise; matches config.env.template
+func DefaultBackoff() Backoff {
+	return Backoff{Retries: 3, Base: 100 * time.Millisecond, Cap: 5 * time.Second, Jitter: 0.5}
+}
+
+// Validate ... Ensures the delays aren't negative & the jitter is a fraction
+func (b Backoff) Validate() error {
+	if b.Base < 0 || b.Cap < 0 {
+		return fmt.Errorf("delays must not be negative; got base %s & cap %s", b.Base, b.Cap)
+	}
+
+	if b.Cap > 0 && b.Cap < b.Base {
+		return fmt.Errorf("cap %s must not be less than base %s", b.Cap, b.Base)
+	}
+
+	if b.Jitter < 0 || b.Jitter > 1 {
+		return fmt.Errorf("jitter must be between 0 & 1; got %v", b.Jitter)
+	}
+
+	return nil
+}
+
+// Delay ... Returns the delay before the retry following the attempt; attempts start at zero
+func (b Backoff) Delay(attempt int) time.Duration {
+	d := b.Base
+	for i := 0; i < attempt && d > 0; i++ {
+		if b.Cap > 0 && d >= b.Cap {
+			break
+		}
+
+		// Doubling past the largest duration would overflow
+		if d > math.MaxInt64/2 {
+			break
+		}
+
+		d *= 2
+	}
+
+	if b.Cap > 0 && d > b.Cap {
+		d = b.Cap
+	}
+
+	if b.Jitter > 0 && d > 0 {
+		d -= time.Duration(rand.Float64() * b.Jitter * float64(d)) //nolint:gosec // jitter isn't security sensitive
+	}
+
+	return d
+}
+
+// retryable ... Returns false for errors that retrying can't resolve
+func retryable(err error) bool {
+	return !errors.Is(err, ethereum.NotFound) && !errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, rpc.ErrNotificationsUnsupported)
+}
+
+// Retry ... Calls the function until it succeeds, the retries are exhausted or the context is done; fail
+// with the last error. Missing data isn't retried
+func Retry(ctx context.Context, b Backoff, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || !retryable(err) || (b.Retries >= 0 && attempt >= b.Retries) {
+			return err
+		}
+
+		timer := time.NewTimer(b.Delay(attempt))
+		select {
+		case <-timer.C:
+
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+// RetryValue ... Retry for functions returning a value
+func RetryValue[T any](ctx context.Context, b Backoff, fn func() (T, error)) (T, error) {
+	var val T
+	err := Retry(ctx, b, func() error {
+		var fErr error
+		val, fErr = fn()
+		return fErr
+	})
+
+	return val, err
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Backoff_Delay(t *testing.T) {
+	b := Backoff{Base: 100 * time.Millisecond, Cap: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, b.Delay(0))
+	assert.Equal(t, 400*time.Millisecond, b.Delay(2), "Ensuring delays double after every retry")
+	assert.Equal(t, time.Second, b.Delay(10), "Ensuring delays are capped")
+	assert.Equal(t, time.Second, b.Delay(1000), "Ensuring large attempts don't overflow")
+
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := b.Delay(1)
+		assert.True(t, d > 100*time.Millisecond && d <= 200*time.Millisecond,
+			"Ensuring jitter only randomizes the jitter fraction of the delay; got %s", d)
+	}
+}
+
+func Test_Backoff_Validate(t *testing.T) {
+	assert.NoError(t, DefaultBackoff().Validate())
+	assert.NoError(t, Backoff{}.Validate())
+	assert.Error(t, Backoff{Base: time.Second, Cap: time.Millisecond}.Validate())
+	assert.Error(t, Backoff{Base: -time.Second}.Validate())
+	assert.Error(t, Backoff{Jitter: 1.5}.Validate())
+}
+
+func Test_Retry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	var tests = []struct {
+		name        string
+		description string
+
+		backoff  Backoff
+		errs     []error
+		calls    int
+		expected error
+	}{
+		{
+			name:        "Single Attempt",
+			description: "The zero backoff shouldn't retry",
+			errs:        []error{errFlaky, nil},
+			calls:       1,
+			expected:    errFlaky,
+		},
+		{
+			name:        "Recovered",
+			description: "Calls should be retried until they succeed",
+			backoff:     Backoff{Retries: 3, Base: time.Millisecond},
+			errs:        []error{errFlaky, errFlaky, nil},
+			calls:       3,
+		},
+		{
+			name:        "Exhausted",
+			description: "The last error should be returned once the retries are exhausted",
+			backoff:     Backoff{Retries: 2, Base: time.Millisecond},
+			errs:        []error{errFlaky, errFlaky, errFlaky, nil},
+			calls:       3,
+			expected:    errFlaky,
+		},
+		{
+			name:        "Unlimited",
+			description: "Negative retries should retry until the call succeeds",
+			backoff:     Backoff{Retries: -1, Base: time.Millisecond},
+			errs:        []error{errFlaky, errFlaky, errFlaky, errFlaky, nil},
+			calls:       5,
+		},
+		{
+			name:        "Not Found",
+			description: "Missing data shouldn't be retried",
+			backoff:     Backoff{Retries: 3, Base: time.Millisecond},
+			errs:        []error{ethereum.NotFound, nil},
+			calls:       1,
+			expected:    ethereum.NotFound,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			calls := 0
+			err := Retry(context.Background(), tc.backoff, func() error {
+				calls++
+				return tc.errs[calls-1]
+			})
+
+			assert.Equal(t, tc.expected, err)
+			assert.Equal(t, tc.calls, calls)
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := Retry(ctx, Backoff{Retries: -1, Base: time.Hour}, func() error {
+		calls++
+		return errFlaky
+	})
+	assert.Equal(t, errFlaky, err, "Ensuring retries stop once the context is done")
+	assert.Equal(t, 1, calls)
+}
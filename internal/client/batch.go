@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// batchBlock ... Body fields of an eth_getBlockByNumber response
+type batchBlock struct {
+	Hash         common.Hash          `json:"hash"`
+	Transactions []*types.Transaction `json:"transactions"`
+	UncleHashes  []common.Hash        `json:"uncles"`
+	Withdrawals  []*types.Withdrawal  `json:"withdrawals,omitempty"`
+}
+
+// batchCall ... Sends every element in a single round trip; fail with the first failed element
+func (ec *EthClient) batchCall(ctx context.Context, elems []rpc.BatchElem) error {
+	if len(elems) == 0 {
+		return nil
+	}
+
+	if err := ec.rpcClient.BatchCallContext(ctx, elems); err != nil {
+		return err
+	}
+
+	for _, elem := range elems {
+		if elem.Error != nil {
+			return fmt.Errorf("%s %v failed: %w", elem.Method, elem.Args, elem.Error)
+		}
+	}
+
+	return nil
+}
+
+// BlocksByNumber ... Returns the full blocks at the heights in order using batched requests; fail with
+// ethereum.NotFound if any block doesn't exist
+func (ec *EthClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	raws := make([]json.RawMessage, len(numbers))
+	elems := make([]rpc.BatchElem, len(numbers))
+	for i, number := range numbers {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []any{hexutil.EncodeBig(number), true},
+			Result: &raws[i],
+		}
+	}
+
+	if err := ec.batchCall(ctx, elems); err != nil {
+		return nil, err
+	}
+
+	heads := make([]*types.Header, len(raws))
+	bodies := make([]batchBlock, len(raws))
+	uncleElems := make([]rpc.BatchElem, 0)
+	uncles := make([][]*types.Header, len(raws))
+
+	for i, raw := range raws {
+		// Missing blocks are returned as JSON null
+		if err := json.Unmarshal(raw, &heads[i]); err != nil || heads[i] == nil {
+			if err == nil {
+				err = ethereum.NotFound
+			}
+
+			return nil, fmt.Errorf("block %s: %w", numbers[i], err)
+		}
+
+		if err := json.Unmarshal(raw, &bodies[i]); err != nil {
+			return nil, fmt.Errorf("block %s: %w", numbers[i], err)
+		}
+
+		// Uncles aren't included in block responses
+		uncles[i] = make([]*types.Header, len(bodies[i].UncleHashes))
+		for j := range bodies[i].UncleHashes {
+			uncleElems = append(uncleElems, rpc.BatchElem{
+				Method: "eth_getUncleByBlockHashAndIndex",
+				Args:   []any{bodies[i].Hash, hexutil.EncodeUint64(uint64(j))},
+				Result: &uncles[i][j],
+			})
+		}
+	}
+
+	if err := ec.batchCall(ctx, uncleElems); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*types.Block, len(raws))
+	for i, head := range heads {
+		blocks[i] = types.NewBlockWithHeader(head).WithBody(bodies[i].Transactions, uncles[i]).
+			WithWithdrawals(bodies[i].Withdrawals)
+	}
+
+	return blocks, nil
+}
+
+// TransactionReceipts ... Returns the receipts of the transactions in order using batched requests; fail
+// with ethereum.NotFound if any receipt doesn't exist
+func (ec *EthClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(txHashes))
+	elems := make([]rpc.BatchElem, len(txHashes))
+	for i, hash := range txHashes {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []any{hash},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := ec.batchCall(ctx, elems); err != nil {
+		return nil, err
+	}
+
+	for i, receipt := range receipts {
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt %s: %w", txHashes[i], ethereum.NotFound)
+		}
+	}
+
+	return receipts, nil
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type rpcMessage struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// newBatchServer ... Serves batched requests for blocks up to the latest height & receipts of known hashes;
+// every received batch size is recorded
+func newBatchServer(t *testing.T, latest uint64, receipts map[common.Hash]*types.Receipt,
+	batches *[]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msgs []rpcMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msgs))
+		*batches = append(*batches, len(msgs))
+
+		responses := make([]map[string]any, len(msgs))
+		for i, msg := range msgs {
+			var result any
+
+			switch msg.Method {
+			case "eth_getBlockByNumber":
+				var number hexutil.Uint64
+				assert.NoError(t, json.Unmarshal(msg.Params[0], &number))
+
+				if uint64(number) <= latest {
+					header := &types.Header{Number: new(big.Int).SetUint64(uint64(number)), Difficulty: common.Big0}
+
+					fields := make(map[string]any)
+					raw, err := json.Marshal(header)
+					assert.NoError(t, err)
+					assert.NoError(t, json.Unmarshal(raw, &fields))
+
+					fields["transactions"] = []any{}
+					fields["uncles"] = []any{}
+					result = fields
+				}
+
+			case "eth_getTransactionReceipt":
+				var hash common.Hash
+				assert.NoError(t, json.Unmarshal(msg.Params[0], &hash))
+				if receipt, found := receipts[hash]; found {
+					result = receipt
+				}
+			}
+
+			responses[i] = map[string]any{"jsonrpc": "2.0", "id": msg.ID, "result": result}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(responses))
+	}))
+}
+
+func Test_BlocksByNumber(t *testing.T) {
+	batches := make([]int, 0)
+	server := newBatchServer(t, 10, nil, &batches)
+	defer server.Close()
+
+	ec := &EthClient{}
+	assert.NoError(t, ec.DialContext(context.Background(), server.URL))
+
+	blocks, err := ec.BlocksByNumber(context.Background(), []*big.Int{big.NewInt(4), big.NewInt(5), big.NewInt(6)})
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 3)
+
+	for i, block := range blocks {
+		assert.Equal(t, uint64(4+i), block.NumberU64(), "Ensuring blocks are returned in order")
+	}
+	assert.Equal(t, []int{3}, batches, "Ensuring every block is fetched in a single round trip")
+
+	_, err = ec.BlocksByNumber(context.Background(), []*big.Int{big.NewInt(10), big.NewInt(11)})
+	assert.ErrorIs(t, err, ethereum.NotFound, "Ensuring missing blocks fail the batch")
+}
+
+func Test_TransactionReceipts(t *testing.T) {
+	known := common.HexToHash("0x1")
+	receipts := map[common.Hash]*types.Receipt{
+		known: {Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}, TxHash: known},
+	}
+
+	batches := make([]int, 0)
+	server := newBatchServer(t, 0, receipts, &batches)
+	defer server.Close()
+
+	ec := &EthClient{}
+	assert.NoError(t, ec.DialContext(context.Background(), server.URL))
+
+	fetched, err := ec.TransactionReceipts(context.Background(), []common.Hash{known, known})
+	assert.NoError(t, err)
+	assert.Len(t, fetched, 2)
+	assert.Equal(t, known, fetched[1].TxHash)
+	assert.Equal(t, []int{2}, batches, "Ensuring every receipt is fetched in a single round trip")
+
+	_, err = ec.TransactionReceipts(context.Background(), []common.Hash{known, common.HexToHash("0x2")})
+	assert.ErrorIs(t, err, ethereum.NotFound, "Ensuring missing receipts fail the batch")
+}
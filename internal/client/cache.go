@@ -0,0 +1,329 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+// lru ... Fixed size cache evicting the least recently used entry once full; safe for concurrent use
+type lru[K comparable, V any] struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[K]*list.Element
+}
+
+// lruEntry ... Key & value held by an element of the recency list
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// newLRU ... Initializer; sizes below one are raised to one
+func newLRU[K comparable, V any](size int) *lru[K, V] {
+	if size < 1 {
+		size = 1
+	}
+
+	return &lru[K, V]{size: size, order: list.New(), entries: make(map[K]*list.Element, size)}
+}
+
+// get ... Returns the value of the key & marks it as the most recently used
+func (c *lru[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, found := c.entries[key]
+	if !found {
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry[K, V]).value, true
+}
+
+// add ... Sets the value of the key, evicting the least recently used entry when full
+func (c *lru[K, V]) add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.entries[key]; found {
+		elem.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+// remove ... Deletes the key's entry, if any
+func (c *lru[K, V]) remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.entries[key]; found {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// numberKey ... Height of a block read from an endpoint; heights are only unique per chain
+type numberKey struct {
+	endpoint string
+	number   uint64
+}
+
+// blockRef ... Hash of the block cached at a height along with its parent's hash
+type blockRef struct {
+	hash   common.Hash
+	parent common.Hash
+}
+
+// BlockCache ... LRU cache of the headers & blocks read from every RPC endpoint; shared by every client so
+// that pipelines reading the same network only fetch each block once. Blocks are stored by hash & indexed by
+// number; whenever a block is cached, the cached blocks at the adjacent heights that don't link to it are
+// dropped from the index so that blocks reorged out are refetched once the canonical chain is read
+type BlockCache struct {
+	// Serializes the linking of adjacent heights
+	mu      sync.Mutex
+	hashes  *lru[numberKey, blockRef]
+	headers *lru[common.Hash, *types.Header]
+	blocks  *lru[common.Hash, *types.Block]
+}
+
+// NewBlockCache ... Initializer; holds up to size headers & size blocks
+func NewBlockCache(size int) *BlockCache {
+	return &BlockCache{
+		// Every cached header or block is indexed by its number
+		hashes:  newLRU[numberKey, blockRef](2 * size),
+		headers: newLRU[common.Hash, *types.Header](size),
+		blocks:  newLRU[common.Hash, *types.Block](size),
+	}
+}
+
+// hash ... Returns the hash of the block cached at the endpoint's height
+func (bc *BlockCache) hash(endpoint string, number *big.Int) (common.Hash, bool) {
+	if !number.IsUint64() {
+		return common.Hash{}, false
+	}
+
+	ref, found := bc.hashes.get(numberKey{endpoint: endpoint, number: number.Uint64()})
+	return ref.hash, found
+}
+
+// Header ... Returns a copy of the header cached at the endpoint's height, if any
+func (bc *BlockCache) Header(endpoint string, number *big.Int) (*types.Header, bool) {
+	hash, found := bc.hash(endpoint, number)
+	if !found {
+		return nil, false
+	}
+
+	if header, ok := bc.headers.get(hash); ok {
+		return types.CopyHeader(header), true
+	}
+
+	if block, ok := bc.blocks.get(hash); ok {
+		return block.Header(), true
+	}
+
+	return nil, false
+}
+
+// Block ... Returns the block cached at the endpoint's height, if any
+func (bc *BlockCache) Block(endpoint string, number *big.Int) (*types.Block, bool) {
+	hash, found := bc.hash(endpoint, number)
+	if !found {
+		return nil, false
+	}
+
+	return bc.blocks.get(hash)
+}
+
+// AddHeader ... Caches a copy of the header read from the endpoint
+func (bc *BlockCache) AddHeader(endpoint string, header *types.Header) {
+	hash := header.Hash()
+	bc.headers.add(hash, types.CopyHeader(header))
+	bc.index(endpoint, header.Number.Uint64(), blockRef{hash: hash, parent: header.ParentHash})
+}
+
+// AddBlock ... Caches the block read from the endpoint
+func (bc *BlockCache) AddBlock(endpoint string, block *types.Block) {
+	hash := block.Hash()
+	bc.blocks.add(hash, block)
+	bc.index(endpoint, block.NumberU64(), blockRef{hash: hash, parent: block.ParentHash()})
+}
+
+// index ... Indexes the block at the endpoint's height, dropping the cached parent & child if they were
+// reorged out by it
+func (bc *BlockCache) index(endpoint string, number uint64, ref blockRef) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	if number > 0 {
+		parentKey := numberKey{endpoint: endpoint, number: number - 1}
+		if parent, found := bc.hashes.get(parentKey); found && parent.hash != ref.parent {
+			bc.hashes.remove(parentKey)
+		}
+	}
+
+	childKey := numberKey{endpoint: endpoint, number: number + 1}
+	if child, found := bc.hashes.get(childKey); found && child.parent != ref.hash {
+		bc.hashes.remove(childKey)
+	}
+
+	bc.hashes.add(numberKey{endpoint: endpoint, number: number}, ref)
+}
+
+// CachedClient ... EthClientInterface serving headers & blocks at explicit heights from a shared cache; the
+// latest header & block are always fetched but still cached so that reorgs at the tip are detected
+type CachedClient struct {
+	client EthClientInterface
+	cache  *BlockCache
+	// Set once dialed
+	endpoint string
+}
+
+// NewCachedClient ... Initializer
+func NewCachedClient(client EthClientInterface, cache *BlockCache) *CachedClient {
+	return &CachedClient{client: client, cache: cache}
+}
+
+func (cc *CachedClient) DialContext(ctx context.Context, rawURL string) error {
+	cc.endpoint = rawURL
+	return cc.client.DialContext(ctx, rawURL)
+}
+
+func (cc *CachedClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return cc.client.ChainID(ctx)
+}
+
+func (cc *CachedClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number != nil {
+		if header, found := cc.cache.Header(cc.endpoint, number); found {
+			return header, nil
+		}
+	}
+
+	header, err := cc.client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.cache.AddHeader(cc.endpoint, header)
+	return header, nil
+}
+
+func (cc *CachedClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if number != nil {
+		if block, found := cc.cache.Block(cc.endpoint, number); found {
+			return block, nil
+		}
+	}
+
+	block, err := cc.client.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.cache.AddBlock(cc.endpoint, block)
+	return block, nil
+}
+
+// BlocksByNumber ... Only fetches the blocks missing from the cache
+func (cc *CachedClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	blocks := make([]*types.Block, len(numbers))
+	missing := make([]int, 0, len(numbers))
+
+	for i, number := range numbers {
+		block, found := cc.cache.Block(cc.endpoint, number)
+		if !found {
+			missing = append(missing, i)
+			continue
+		}
+
+		blocks[i] = block
+	}
+
+	if len(missing) == 0 {
+		return blocks, nil
+	}
+
+	missingNumbers := make([]*big.Int, len(missing))
+	for i, idx := range missing {
+		missingNumbers[i] = numbers[idx]
+	}
+
+	fetched, err := cc.client.BlocksByNumber(ctx, missingNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, idx := range missing {
+		cc.cache.AddBlock(cc.endpoint, fetched[i])
+		blocks[idx] = fetched[i]
+	}
+
+	return blocks, nil
+}
+
+func (cc *CachedClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return cc.client.TransactionReceipt(ctx, txHash)
+}
+
+func (cc *CachedClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, error) {
+	return cc.client.TransactionReceipts(ctx, txHashes)
+}
+
+func (cc *CachedClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	return cc.client.BlockReceipts(ctx, number)
+}
+
+func (cc *CachedClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return cc.client.FilterLogs(ctx, query)
+}
+
+func (cc *CachedClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return cc.client.CallContract(ctx, msg, blockNumber)
+}
+
+func (cc *CachedClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return cc.client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (cc *CachedClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return cc.client.CodeAt(ctx, account, blockNumber)
+}
+
+func (cc *CachedClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return cc.client.SubscribeNewHead(ctx, ch)
+}
+
+func (cc *CachedClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return cc.client.GetProof(ctx, account, keys, blockNumber)
+}
+
+func (cc *CachedClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (json.RawMessage, error) {
+	return cc.client.TraceTransaction(ctx, txHash, opts...)
+}
+
+func (cc *CachedClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) ([]TxTraceResult, error) {
+	return cc.client.TraceBlockByNumber(ctx, number, opts...)
+}
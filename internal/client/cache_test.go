@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingClient ... Serves empty blocks & headers at every height while counting the fetched heights
+type countingClient struct {
+	EthClientInterface
+	fetched int
+}
+
+func (cc *countingClient) DialContext(_ context.Context, _ string) error {
+	return nil
+}
+
+func (cc *countingClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	cc.fetched++
+	if number == nil {
+		number = big.NewInt(100)
+	}
+
+	return &types.Header{Number: new(big.Int).Set(number)}, nil
+}
+
+func (cc *countingClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	header, err := cc.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewBlockWithHeader(header), nil
+}
+
+func (cc *countingClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	blocks := make([]*types.Block, len(numbers))
+	for i, number := range numbers {
+		blocks[i], _ = cc.BlockByNumber(ctx, number)
+	}
+
+	return blocks, nil
+}
+
+func Test_LRU(t *testing.T) {
+	c := newLRU[int, string](2)
+	c.add(1, "one")
+	c.add(2, "two")
+
+	_, found := c.get(1)
+	assert.True(t, found)
+
+	c.add(3, "three")
+	_, found = c.get(2)
+	assert.False(t, found, "Ensuring the least recently used entry is evicted")
+
+	value, found := c.get(1)
+	assert.True(t, found, "Ensuring recently read entries aren't evicted")
+	assert.Equal(t, "one", value)
+}
+
+func Test_CachedClient(t *testing.T) {
+	ctx := context.Background()
+	cache := NewBlockCache(8)
+
+	inner := &countingClient{}
+	cc := NewCachedClient(inner, cache)
+	assert.NoError(t, cc.DialContext(ctx, "http://l1"))
+
+	block, err := cc.BlockByNumber(ctx, big.NewInt(5))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), block.NumberU64())
+
+	shared := NewCachedClient(&countingClient{}, cache)
+	assert.NoError(t, shared.DialContext(ctx, "http://l1"))
+
+	_, err = shared.BlockByNumber(ctx, big.NewInt(5))
+	assert.NoError(t, err)
+	header, err := shared.HeaderByNumber(ctx, big.NewInt(5))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), header.Number.Uint64())
+	assert.Equal(t, 0, shared.client.(*countingClient).fetched,
+		"Ensuring clients sharing the cache don't refetch blocks or their headers")
+
+	other := NewCachedClient(&countingClient{}, cache)
+	assert.NoError(t, other.DialContext(ctx, "http://l2"))
+	_, err = other.BlockByNumber(ctx, big.NewInt(5))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, other.client.(*countingClient).fetched, "Ensuring heights of other endpoints aren't shared")
+
+	blocks, err := cc.BlocksByNumber(ctx, []*big.Int{big.NewInt(4), big.NewInt(5), big.NewInt(6)})
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 3)
+	assert.Equal(t, uint64(6), blocks[2].NumberU64())
+	assert.Equal(t, 3, inner.fetched, "Ensuring only blocks missing from the cache are fetched in batches")
+
+	_, err = cc.HeaderByNumber(ctx, nil)
+	assert.NoError(t, err)
+	_, err = cc.HeaderByNumber(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, inner.fetched, "Ensuring the latest header is always fetched")
+}
+
+// forkingClient ... Serves the headers of a chain that can be reorged; the last header is the latest
+type forkingClient struct {
+	EthClientInterface
+	headers []*types.Header
+	forks   byte
+	fetched int
+}
+
+// reorg ... Replaces every header from the height onwards with the headers of a new fork
+func (fc *forkingClient) reorg(from, to uint64) {
+	fc.forks++
+	fc.headers = fc.headers[:from]
+	for n := from; n <= to; n++ {
+		fc.append()
+	}
+}
+
+func (fc *forkingClient) append() {
+	header := &types.Header{Number: big.NewInt(int64(len(fc.headers))), Extra: []byte{fc.forks}}
+	if len(fc.headers) > 0 {
+		header.ParentHash = fc.headers[len(fc.headers)-1].Hash()
+	}
+
+	fc.headers = append(fc.headers, header)
+}
+
+func (fc *forkingClient) DialContext(_ context.Context, _ string) error {
+	return nil
+}
+
+func (fc *forkingClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	fc.fetched++
+	if number == nil {
+		return fc.headers[len(fc.headers)-1], nil
+	}
+
+	return fc.headers[number.Uint64()], nil
+}
+
+func (fc *forkingClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	header, err := fc.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewBlockWithHeader(header), nil
+}
+
+func Test_CachedClient_Reorg(t *testing.T) {
+	ctx := context.Background()
+
+	inner := &forkingClient{}
+	inner.reorg(0, 10)
+
+	cache := NewBlockCache(8)
+	cc := NewCachedClient(inner, cache)
+	assert.NoError(t, cc.DialContext(ctx, "http://l1"))
+
+	for _, n := range []int64{9, 10} {
+		_, err := cc.BlockByNumber(ctx, big.NewInt(n))
+		assert.NoError(t, err)
+	}
+
+	inner.reorg(10, 11)
+
+	stale, err := cc.BlockByNumber(ctx, big.NewInt(10))
+	assert.NoError(t, err)
+	assert.NotEqual(t, inner.headers[10].Hash(), stale.Hash(), "Ensuring unobserved reorgs are still served")
+
+	_, err = cc.HeaderByNumber(ctx, nil)
+	assert.NoError(t, err)
+
+	block, err := cc.BlockByNumber(ctx, big.NewInt(10))
+	assert.NoError(t, err)
+	assert.Equal(t, inner.headers[10].Hash(), block.Hash(),
+		"Ensuring blocks the latest header doesn't link to are refetched")
+
+	fetched := inner.fetched
+	_, err = cc.BlockByNumber(ctx, big.NewInt(9))
+	assert.NoError(t, err)
+	assert.Equal(t, fetched, inner.fetched, "Ensuring blocks the fork links to are still served")
+
+	_, err = cc.BlockByNumber(ctx, big.NewInt(11))
+	assert.NoError(t, err)
+
+	inner.reorg(10, 11)
+	cache.AddHeader("http://l1", inner.headers[10])
+
+	_, found := cache.Block("http://l1", big.NewInt(11))
+	assert.False(t, found, "Ensuring cached children not linking to a new parent are dropped")
+
+	block, err = cc.BlockByNumber(ctx, big.NewInt(11))
+	assert.NoError(t, err)
+	assert.Equal(t, inner.headers[11].Hash(), block.Hash())
+}
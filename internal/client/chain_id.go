@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrChainIDMismatch ... Returned when a dialed endpoint serves a chain other than the expected one
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// VerifyChainID ... Fails with ErrChainIDMismatch unless the client's endpoint serves the expected chain; only
+// the host of the endpoint is reported since provider URLs often hold API keys
+func VerifyChainID(ctx context.Context, c EthClientInterface, rawURL string, expected uint64) error {
+	actual, err := c.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("could not read chain ID of %s: %w", endpointLabel(rawURL), err)
+	}
+
+	if !actual.IsUint64() || actual.Uint64() != expected {
+		return fmt.Errorf("%w: %s serves chain %s; expected chain %d",
+			ErrChainIDMismatch, endpointLabel(rawURL), actual, expected)
+	}
+
+	return nil
+}
+
+// ChainVerifiedClient ... EthClientInterface failing dials to endpoints that don't serve the expected chain so
+// that the wrong chain is never monitored; every other call is sent to the wrapped client as is
+type ChainVerifiedClient struct {
+	EthClientInterface
+	chainID uint64
+}
+
+// NewChainVerifiedClient ... Initializer; wrap the retrying client so that chain ID reads are retried while
+// mismatches aren't
+func NewChainVerifiedClient(client EthClientInterface, chainID uint64) *ChainVerifiedClient {
+	return &ChainVerifiedClient{EthClientInterface: client, chainID: chainID}
+}
+
+// DialContext ... Dials the endpoint & verifies its chain ID
+func (cc *ChainVerifiedClient) DialContext(ctx context.Context, rawURL string) error {
+	if err := cc.EthClientInterface.DialContext(ctx, rawURL); err != nil {
+		return err
+	}
+
+	return VerifyChainID(ctx, cc.EthClientInterface, rawURL, cc.chainID)
+}
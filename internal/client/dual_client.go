@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+// DualClient ... EthClientInterface sending queries over an HTTP connection & subscriptions over a WebSocket
+// connection; subscriptions fail while the WebSocket endpoint can't be dialed so that subscribers can fall
+// back to polling
+type DualClient struct {
+	client EthClientInterface
+
+	mu       sync.Mutex
+	wsClient EthClientInterface
+	wsURL    string
+	wsDialed bool
+}
+
+// NewDualClient ... Initializer; the WebSocket client is dialed to the WebSocket URL on first subscription
+func NewDualClient(client, wsClient EthClientInterface, wsURL string) *DualClient {
+	return &DualClient{client: client, wsClient: wsClient, wsURL: wsURL}
+}
+
+// DialContext ... Dials the HTTP endpoint; the WebSocket endpoint is dialed once subscribed to so that its
+// outages don't fail queries
+func (dc *DualClient) DialContext(ctx context.Context, rawURL string) error {
+	return dc.client.DialContext(ctx, rawURL)
+}
+
+// dialWS ... Dials the WebSocket endpoint unless already dialed; dialed clients reconnect on their own once
+// the connection drops
+func (dc *DualClient) dialWS(ctx context.Context) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	if dc.wsDialed {
+		return nil
+	}
+
+	if err := dc.wsClient.DialContext(ctx, dc.wsURL); err != nil {
+		return err
+	}
+
+	dc.wsDialed = true
+	return nil
+}
+
+// SubscribeNewHead ... Subscribes to chain head updates over the WebSocket connection
+func (dc *DualClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if err := dc.dialWS(ctx); err != nil {
+		return nil, err
+	}
+
+	return dc.wsClient.SubscribeNewHead(ctx, ch)
+}
+
+func (dc *DualClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return dc.client.ChainID(ctx)
+}
+
+func (dc *DualClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return dc.client.HeaderByNumber(ctx, number)
+}
+
+func (dc *DualClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return dc.client.BlockByNumber(ctx, number)
+}
+
+func (dc *DualClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return dc.client.TransactionReceipt(ctx, txHash)
+}
+
+func (dc *DualClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	return dc.client.BlocksByNumber(ctx, numbers)
+}
+
+func (dc *DualClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, error) {
+	return dc.client.TransactionReceipts(ctx, txHashes)
+}
+
+func (dc *DualClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	return dc.client.BlockReceipts(ctx, number)
+}
+
+func (dc *DualClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return dc.client.FilterLogs(ctx, query)
+}
+
+func (dc *DualClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return dc.client.CallContract(ctx, msg, blockNumber)
+}
+
+func (dc *DualClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return dc.client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (dc *DualClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return dc.client.CodeAt(ctx, account, blockNumber)
+}
+
+func (dc *DualClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return dc.client.GetProof(ctx, account, keys, blockNumber)
+}
+
+func (dc *DualClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (json.RawMessage, error) {
+	return dc.client.TraceTransaction(ctx, txHash, opts...)
+}
+
+func (dc *DualClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) ([]TxTraceResult, error) {
+	return dc.client.TraceBlockByNumber(ctx, number, opts...)
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// wsClient ... Subscribable client failing to dial until reachable
+type wsClient struct {
+	countingClient
+	reachable bool
+	dials     int
+}
+
+func (wc *wsClient) DialContext(_ context.Context, _ string) error {
+	wc.dials++
+	if !wc.reachable {
+		return errors.New("connection refused")
+	}
+
+	return nil
+}
+
+func (wc *wsClient) SubscribeNewHead(_ context.Context, _ chan<- *types.Header) (ethereum.Subscription, error) {
+	return nil, nil
+}
+
+func Test_DualClient(t *testing.T) {
+	ctx := context.Background()
+
+	query := &countingClient{}
+	ws := &wsClient{}
+	dc := NewDualClient(query, ws, "ws://l1:8546")
+
+	assert.NoError(t, dc.DialContext(ctx, "http://l1:8545"), "Ensuring WS outages don't fail dialing")
+	assert.Equal(t, 0, ws.dials)
+
+	_, err := dc.HeaderByNumber(ctx, big.NewInt(1))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, query.fetched, "Ensuring queries are sent over HTTP")
+
+	_, err = dc.SubscribeNewHead(ctx, make(chan *types.Header))
+	assert.Error(t, err, "Ensuring subscriptions fail while the WS endpoint is unreachable")
+
+	ws.reachable = true
+	_, err = dc.SubscribeNewHead(ctx, make(chan *types.Header))
+	assert.NoError(t, err, "Ensuring subscriptions recover once the WS endpoint is reachable")
+
+	_, err = dc.SubscribeNewHead(ctx, make(chan *types.Header))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ws.dials, "Ensuring dialed WS clients aren't redialed")
+}
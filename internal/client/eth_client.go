@@ -2,35 +2,86 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
+	"strings"
+	"sync/atomic"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
-// TODO (#20) : Introduce optional Retry-able EthClient
-// TODO (#20) : Introduce optional Retry-able EthClient
+// EthClient ... EthClientInterface backed by a dialed RPC endpoint; see RetryClient for retries
 type EthClient struct {
-	client *ethclient.Client
+	rpcClient  *rpc.Client
+	client     *ethclient.Client
+	gethClient *gethclient.Client
+
+	// Set once the node rejects eth_getBlockReceipts
+	noBlockReceipts atomic.Bool
 }
 
 type EthClientInterface interface {
 	DialContext(ctx context.Context, rawURL string) error
+	ChainID(ctx context.Context) (*big.Int, error)
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	// Batched; a single round trip regardless of the number of blocks or receipts
+	BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error)
+	TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, error)
+	BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	GetProof(ctx context.Context, account common.Address, keys []string,
+		blockNumber *big.Int) (*gethclient.AccountResult, error)
+	// Requires the debug namespace; results are encoded by the configured tracer
+	TraceTransaction(ctx context.Context, txHash common.Hash, opts ...TraceOption) (json.RawMessage, error)
+	TraceBlockByNumber(ctx context.Context, number *big.Int, opts ...TraceOption) ([]TxTraceResult, error)
+}
+
+// IPCScheme ... Scheme of IPC endpoints (E.G, ipc:///var/run/geth.ipc); used by co-located nodes
+const IPCScheme = "ipc://"
+
+// IsIPC ... Returns true if the endpoint is an IPC socket
+func IsIPC(rawURL string) bool {
+	return strings.HasPrefix(rawURL, IPCScheme)
+}
+
+// dialRPC ... Dials the endpoint; IPC endpoints are dialed through their socket path since geth only dials
+// bare paths over IPC
+func dialRPC(ctx context.Context, rawURL string) (*rpc.Client, error) {
+	if IsIPC(rawURL) {
+		return rpc.DialIPC(ctx, strings.TrimPrefix(rawURL, IPCScheme))
+	}
+
+	return rpc.DialContext(ctx, rawURL)
 }
 
 func (ec *EthClient) DialContext(ctx context.Context, rawURL string) error {
-	client, err := ethclient.DialContext(ctx, rawURL)
+	rpcClient, err := dialRPC(ctx, rawURL)
 
 	if err != nil {
 		return err
 	}
 
-	ec.client = client
+	ec.rpcClient = rpcClient
+	ec.client = ethclient.NewClient(rpcClient)
+	ec.gethClient = gethclient.New(rpcClient)
 	return nil
 }
 
+func (ec *EthClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return ec.client.ChainID(ctx)
+}
+
 func (ec *EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
 	return ec.client.HeaderByNumber(ctx, number)
 }
@@ -38,3 +89,33 @@ func (ec *EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*type
 func (ec *EthClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
 	return ec.client.BlockByNumber(ctx, number)
 }
+
+func (ec *EthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return ec.client.TransactionReceipt(ctx, txHash)
+}
+
+func (ec *EthClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return ec.client.FilterLogs(ctx, query)
+}
+
+func (ec *EthClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return ec.client.CallContract(ctx, msg, blockNumber)
+}
+
+func (ec *EthClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return ec.client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (ec *EthClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return ec.client.CodeAt(ctx, account, blockNumber)
+}
+
+// SubscribeNewHead ... Subscribes to chain head updates; requires a WebSocket or IPC endpoint
+func (ec *EthClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return ec.client.SubscribeNewHead(ctx, ch)
+}
+
+func (ec *EthClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return ec.gethClient.GetProof(ctx, account, keys, blockNumber)
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// chainService ... eth namespace serving a fixed chain ID
+type chainService struct{}
+
+func (chainService) ChainId() *hexutil.Big { //nolint:revive // matches the eth_chainId method name
+	return (*hexutil.Big)(big.NewInt(10))
+}
+
+func Test_EthClient_IPC(t *testing.T) {
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("eth", chainService{}))
+	defer server.Stop()
+
+	path := filepath.Join(t.TempDir(), "geth.ipc")
+	listener, err := net.Listen("unix", path)
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		_ = server.ServeListener(listener)
+	}()
+
+	assert.True(t, IsIPC(IPCScheme+path))
+	assert.False(t, IsIPC("http://localhost:8545"))
+
+	ec := &EthClient{}
+	assert.NoError(t, ec.DialContext(context.Background(), IPCScheme+path), "Ensuring IPC URLs are dialed")
+
+	chainID, err := ec.ChainID(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(10), chainID)
+
+	assert.Equal(t, IPCScheme+path, endpointLabel(IPCScheme+path), "Ensuring IPC endpoints are labelled by path")
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "pessimism"
+	metricsSubsystem = "rpc"
+
+	// Call statuses; missing data is distinguished from failures as it's expected at the chain tip
+	statusSuccess  = "success"
+	statusNotFound = "not_found"
+	statusError    = "error"
+)
+
+// Metrics ... Counters & latency histograms of the RPC calls sent to every endpoint, labelled by method,
+// endpoint & status
+type Metrics struct {
+	calls   *prometheus.CounterVec
+	latency *prometheus.HistogramVec
+}
+
+// NewMetrics ... Initializer; registers the metrics with the registerer
+func NewMetrics(reg prometheus.Registerer) (*Metrics, error) {
+	labels := []string{"method", "endpoint", "status"}
+
+	m := &Metrics{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "calls_total",
+			Help:      "Number of RPC calls sent",
+		}, labels),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "call_duration_seconds",
+			Help:      "Latency of RPC calls",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 12),
+		}, labels),
+	}
+
+	for _, c := range []prometheus.Collector{m.calls, m.latency} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// record ... Records the outcome & latency of a call started at the time; nil metrics record nothing
+func (m *Metrics) record(method, endpoint string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+
+	status := statusSuccess
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		status = statusNotFound
+
+	case err != nil:
+		status = statusError
+	}
+
+	m.calls.WithLabelValues(method, endpoint, status).Inc()
+	m.latency.WithLabelValues(method, endpoint, status).Observe(time.Since(start).Seconds())
+}
+
+// endpointLabel ... Returns the host of the endpoint; paths & credentials of provider URLs often hold API keys
+// so they're never used as labels. IPC endpoints are labelled by their socket path
+func endpointLabel(rawURL string) string {
+	if IsIPC(rawURL) {
+		return rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+
+	return u.Host
+}
+
+// MeteredClient ... EthClientInterface recording the outcome & latency of every call to its endpoint
+type MeteredClient struct {
+	client  EthClientInterface
+	metrics *Metrics
+	// Set once dialed
+	endpoint string
+}
+
+// NewMeteredClient ... Initializer
+func NewMeteredClient(client EthClientInterface, metrics *Metrics) *MeteredClient {
+	return &MeteredClient{client: client, metrics: metrics, endpoint: "unknown"}
+}
+
+// observe ... Records the call once it returns; deferred with a pointer to the call's named error
+func (mc *MeteredClient) observe(method string, start time.Time, err *error) {
+	mc.metrics.record(method, mc.endpoint, start, *err)
+}
+
+func (mc *MeteredClient) DialContext(ctx context.Context, rawURL string) (err error) {
+	mc.endpoint = endpointLabel(rawURL)
+	defer mc.observe("DialContext", time.Now(), &err)
+	return mc.client.DialContext(ctx, rawURL)
+}
+
+func (mc *MeteredClient) ChainID(ctx context.Context) (_ *big.Int, err error) {
+	defer mc.observe("ChainID", time.Now(), &err)
+	return mc.client.ChainID(ctx)
+}
+
+func (mc *MeteredClient) HeaderByNumber(ctx context.Context, number *big.Int) (_ *types.Header, err error) {
+	defer mc.observe("HeaderByNumber", time.Now(), &err)
+	return mc.client.HeaderByNumber(ctx, number)
+}
+
+func (mc *MeteredClient) BlockByNumber(ctx context.Context, number *big.Int) (_ *types.Block, err error) {
+	defer mc.observe("BlockByNumber", time.Now(), &err)
+	return mc.client.BlockByNumber(ctx, number)
+}
+
+func (mc *MeteredClient) TransactionReceipt(ctx context.Context,
+	txHash common.Hash) (_ *types.Receipt, err error) {
+	defer mc.observe("TransactionReceipt", time.Now(), &err)
+	return mc.client.TransactionReceipt(ctx, txHash)
+}
+
+func (mc *MeteredClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) (_ []*types.Block, err error) {
+	defer mc.observe("BlocksByNumber", time.Now(), &err)
+	return mc.client.BlocksByNumber(ctx, numbers)
+}
+
+func (mc *MeteredClient) TransactionReceipts(ctx context.Context,
+	txHashes []common.Hash) (_ []*types.Receipt, err error) {
+	defer mc.observe("TransactionReceipts", time.Now(), &err)
+	return mc.client.TransactionReceipts(ctx, txHashes)
+}
+
+func (mc *MeteredClient) BlockReceipts(ctx context.Context, number *big.Int) (_ []*types.Receipt, err error) {
+	defer mc.observe("BlockReceipts", time.Now(), &err)
+	return mc.client.BlockReceipts(ctx, number)
+}
+
+func (mc *MeteredClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (_ []types.Log, err error) {
+	defer mc.observe("FilterLogs", time.Now(), &err)
+	return mc.client.FilterLogs(ctx, query)
+}
+
+func (mc *MeteredClient) CallContract(ctx context.Context, msg ethereum.CallMsg,
+	blockNumber *big.Int) (_ []byte, err error) {
+	defer mc.observe("CallContract", time.Now(), &err)
+	return mc.client.CallContract(ctx, msg, blockNumber)
+}
+
+func (mc *MeteredClient) BalanceAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) (_ *big.Int, err error) {
+	defer mc.observe("BalanceAt", time.Now(), &err)
+	return mc.client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (mc *MeteredClient) CodeAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) (_ []byte, err error) {
+	defer mc.observe("CodeAt", time.Now(), &err)
+	return mc.client.CodeAt(ctx, account, blockNumber)
+}
+
+func (mc *MeteredClient) SubscribeNewHead(ctx context.Context,
+	ch chan<- *types.Header) (_ ethereum.Subscription, err error) {
+	defer mc.observe("SubscribeNewHead", time.Now(), &err)
+	return mc.client.SubscribeNewHead(ctx, ch)
+}
+
+func (mc *MeteredClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (_ *gethclient.AccountResult, err error) {
+	defer mc.observe("GetProof", time.Now(), &err)
+	return mc.client.GetProof(ctx, account, keys, blockNumber)
+}
+
+func (mc *MeteredClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (_ json.RawMessage, err error) {
+	defer mc.observe("TraceTransaction", time.Now(), &err)
+	return mc.client.TraceTransaction(ctx, txHash, opts...)
+}
+
+func (mc *MeteredClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) (_ []TxTraceResult, err error) {
+	defer mc.observe("TraceBlockByNumber", time.Now(), &err)
+	return mc.client.TraceBlockByNumber(ctx, number, opts...)
+}
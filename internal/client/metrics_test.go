@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MeteredClient(t *testing.T) {
+	ctx := context.Background()
+
+	reg := prometheus.NewRegistry()
+	metrics, err := NewMetrics(reg)
+	assert.NoError(t, err)
+
+	mc := NewMeteredClient(&countingClient{}, metrics)
+	assert.NoError(t, mc.DialContext(ctx, "https://eth.provider.io/v2/secret-key"))
+
+	_, err = mc.HeaderByNumber(ctx, big.NewInt(1))
+	assert.NoError(t, err)
+	_, err = mc.HeaderByNumber(ctx, nil)
+	assert.NoError(t, err)
+
+	calls := metrics.calls.WithLabelValues("HeaderByNumber", "eth.provider.io", statusSuccess)
+	assert.Equal(t, 2.0, testutil.ToFloat64(calls), "Ensuring calls are labelled by the endpoint's host only")
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.latency),
+		"Ensuring latencies are observed per method; DialContext & HeaderByNumber")
+
+	var unset *Metrics
+	unmetered := NewMeteredClient(&countingClient{}, unset)
+	_, err = unmetered.HeaderByNumber(ctx, big.NewInt(1))
+	assert.NoError(t, err, "Ensuring nil metrics record nothing")
+
+	_, err = NewMetrics(reg)
+	assert.Error(t, err, "Ensuring metrics can't be registered twice")
+}
@@ -0,0 +1,153 @@
+// Package mocks ... Testify mocks of client interfaces shared across package tests
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+// Fails compilation when the mock drifts from the interface it stands in for
+var _ client.EthClientInterface = (*EthClient)(nil)
+
+// EthClient ... Mocked EthClientInterface
+type EthClient struct {
+	mock.Mock
+}
+
+func (ec *EthClient) DialContext(ctx context.Context, rawURL string) error {
+	args := ec.Called(ctx, rawURL)
+	return args.Error(0)
+}
+
+func (ec *EthClient) ChainID(ctx context.Context) (*big.Int, error) {
+	args := ec.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*big.Int), args.Error(1)
+}
+
+func (ec *EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	args := ec.Called(ctx, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.Header), args.Error(1)
+}
+
+func (ec *EthClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	args := ec.Called(ctx, number)
+	return args.Get(0).(*types.Block), args.Error(1)
+}
+
+func (ec *EthClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	args := ec.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]types.Log), args.Error(1)
+}
+
+func (ec *EthClient) CallContract(ctx context.Context, msg ethereum.CallMsg,
+	blockNumber *big.Int) ([]byte, error) {
+	args := ec.Called(ctx, msg, blockNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (ec *EthClient) BalanceAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) (*big.Int, error) {
+	args := ec.Called(ctx, account, blockNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*big.Int), args.Error(1)
+}
+
+func (ec *EthClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	args := ec.Called(ctx, account, keys, blockNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gethclient.AccountResult), args.Error(1)
+}
+
+func (ec *EthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	args := ec.Called(ctx, txHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.Receipt), args.Error(1)
+}
+
+func (ec *EthClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	args := ec.Called(ctx, numbers)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.Block), args.Error(1)
+}
+
+func (ec *EthClient) TransactionReceipts(ctx context.Context,
+	txHashes []common.Hash) ([]*types.Receipt, error) {
+	args := ec.Called(ctx, txHashes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.Receipt), args.Error(1)
+}
+
+func (ec *EthClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...client.TraceOption) (json.RawMessage, error) {
+	args := ec.Called(ctx, txHash, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(json.RawMessage), args.Error(1)
+}
+
+func (ec *EthClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...client.TraceOption) ([]client.TxTraceResult, error) {
+	args := ec.Called(ctx, number, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]client.TxTraceResult), args.Error(1)
+}
+
+func (ec *EthClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	args := ec.Called(ctx, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*types.Receipt), args.Error(1)
+}
+
+func (ec *EthClient) CodeAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) ([]byte, error) {
+	args := ec.Called(ctx, account, blockNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (ec *EthClient) SubscribeNewHead(ctx context.Context,
+	ch chan<- *types.Header) (ethereum.Subscription, error) {
+	args := ec.Called(ctx, ch)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(ethereum.Subscription), args.Error(1)
+}
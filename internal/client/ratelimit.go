@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+// RateLimit ... Token bucket limit on the requests sent to a single RPC endpoint; the zero value is unlimited
+type RateLimit struct {
+	// Requests refilled per second; unlimited when zero
+	PerSecond float64
+	// Requests that can be sent at once; one when zero
+	Burst int
+}
+
+// Validate ... Ensures neither the rate nor the burst is negative
+func (rl RateLimit) Validate() error {
+	if rl.PerSecond < 0 || rl.Burst < 0 {
+		return fmt.Errorf("rate & burst must not be negative; got %v/s & %d", rl.PerSecond, rl.Burst)
+	}
+
+	return nil
+}
+
+// bucket ... Requests available to send to a single endpoint; tokens go negative while requests wait for
+// their reserved token
+type bucket struct {
+	limit   RateLimit
+	tokens  float64
+	updated time.Time
+}
+
+// burst ... Returns the capacity of the bucket
+func (b *bucket) burst() float64 {
+	return math.Max(1, float64(b.limit.Burst))
+}
+
+// reserve ... Takes n tokens & returns the wait until they're available
+func (b *bucket) reserve(now time.Time, n int) time.Duration {
+	b.tokens = math.Min(b.burst(), b.tokens+now.Sub(b.updated).Seconds()*b.limit.PerSecond)
+	b.updated = now
+	b.tokens -= float64(n)
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.limit.PerSecond * float64(time.Second))
+}
+
+// Limiters ... Rate limiters of every RPC endpoint; shared by every client of an endpoint so that their
+// requests are limited together
+type Limiters struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewLimiters ... Initializer; endpoints are unlimited until their limit is set
+func NewLimiters() *Limiters {
+	return &Limiters{buckets: make(map[string]*bucket)}
+}
+
+// SetLimit ... Sets the rate limit of the endpoint; unlimited limits remove the endpoint's limiter
+func (l *Limiters) SetLimit(endpoint string, rl RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if rl.PerSecond <= 0 {
+		delete(l.buckets, endpoint)
+		return
+	}
+
+	b := &bucket{limit: rl, updated: time.Now()}
+	b.tokens = b.burst()
+	l.buckets[endpoint] = b
+}
+
+// Wait ... Blocks until a request can be sent to the endpoint; fail if the context is done first. Nil
+// limiters allow every request
+func (l *Limiters) Wait(ctx context.Context, endpoint string) error {
+	return l.WaitN(ctx, endpoint, 1)
+}
+
+// WaitN ... Blocks until n requests can be sent to the endpoint (E.G, the calls of a batch); fail if the
+// context is done first. Nil limiters allow every request
+func (l *Limiters) WaitN(ctx context.Context, endpoint string, n int) error {
+	if l == nil || n < 1 {
+		return nil
+	}
+
+	l.mu.Lock()
+	b, found := l.buckets[endpoint]
+	if !found {
+		l.mu.Unlock()
+		return nil
+	}
+
+	wait := b.reserve(time.Now(), n)
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		// The reserved token is returned as the request is never sent
+		l.mu.Lock()
+		b.tokens += float64(n)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// RateLimitedClient ... EthClientInterface waiting for the rate limiter of its endpoint before every call
+type RateLimitedClient struct {
+	client   EthClientInterface
+	limiters *Limiters
+	// Set once dialed
+	endpoint string
+}
+
+// NewRateLimitedClient ... Initializer
+func NewRateLimitedClient(client EthClientInterface, limiters *Limiters) *RateLimitedClient {
+	return &RateLimitedClient{client: client, limiters: limiters}
+}
+
+// DialContext ... Dials the endpoint; dialing isn't limited as it doesn't send a request for HTTP endpoints
+func (rc *RateLimitedClient) DialContext(ctx context.Context, rawURL string) error {
+	rc.endpoint = rawURL
+	return rc.client.DialContext(ctx, rawURL)
+}
+
+func (rc *RateLimitedClient) ChainID(ctx context.Context) (*big.Int, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.ChainID(ctx)
+}
+
+func (rc *RateLimitedClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.HeaderByNumber(ctx, number)
+}
+
+func (rc *RateLimitedClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.BlockByNumber(ctx, number)
+}
+
+func (rc *RateLimitedClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.TransactionReceipt(ctx, txHash)
+}
+
+// BlocksByNumber ... Counts every block of the batch as a request
+func (rc *RateLimitedClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	if err := rc.limiters.WaitN(ctx, rc.endpoint, len(numbers)); err != nil {
+		return nil, err
+	}
+
+	return rc.client.BlocksByNumber(ctx, numbers)
+}
+
+// TransactionReceipts ... Counts every receipt of the batch as a request
+func (rc *RateLimitedClient) TransactionReceipts(ctx context.Context,
+	txHashes []common.Hash) ([]*types.Receipt, error) {
+	if err := rc.limiters.WaitN(ctx, rc.endpoint, len(txHashes)); err != nil {
+		return nil, err
+	}
+
+	return rc.client.TransactionReceipts(ctx, txHashes)
+}
+
+// BlockReceipts ... Counted as a single request even if the receipts are fetched individually
+func (rc *RateLimitedClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.BlockReceipts(ctx, number)
+}
+
+func (rc *RateLimitedClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.FilterLogs(ctx, query)
+}
+
+func (rc *RateLimitedClient) CallContract(ctx context.Context, msg ethereum.CallMsg,
+	blockNumber *big.Int) ([]byte, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.CallContract(ctx, msg, blockNumber)
+}
+
+func (rc *RateLimitedClient) BalanceAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) (*big.Int, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (rc *RateLimitedClient) CodeAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) ([]byte, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.CodeAt(ctx, account, blockNumber)
+}
+
+func (rc *RateLimitedClient) SubscribeNewHead(ctx context.Context,
+	ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.SubscribeNewHead(ctx, ch)
+}
+
+func (rc *RateLimitedClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.GetProof(ctx, account, keys, blockNumber)
+}
+
+func (rc *RateLimitedClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (json.RawMessage, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.TraceTransaction(ctx, txHash, opts...)
+}
+
+func (rc *RateLimitedClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) ([]TxTraceResult, error) {
+	if err := rc.limiters.Wait(ctx, rc.endpoint); err != nil {
+		return nil, err
+	}
+
+	return rc.client.TraceBlockByNumber(ctx, number, opts...)
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Limiters(t *testing.T) {
+	ctx := context.Background()
+	limiters := NewLimiters()
+	limiters.SetLimit("http://limited", RateLimit{PerSecond: 20, Burst: 2})
+
+	start := time.Now()
+	assert.NoError(t, limiters.Wait(ctx, "http://limited"))
+	assert.NoError(t, limiters.Wait(ctx, "http://limited"))
+	assert.Less(t, time.Since(start), 40*time.Millisecond, "Ensuring the burst is sent at once")
+
+	assert.NoError(t, limiters.Wait(ctx, "http://limited"))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond, "Ensuring requests past the burst wait")
+
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, limiters.Wait(ctx, "http://unlimited"))
+	}
+	assert.Less(t, time.Since(start), 40*time.Millisecond, "Ensuring endpoints without a limit aren't limited")
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, limiters.Wait(cancelled, "http://limited"), context.Canceled,
+		"Ensuring waits stop once the context is done")
+
+	limiters.SetLimit("http://limited", RateLimit{})
+	assert.NoError(t, limiters.Wait(cancelled, "http://limited"), "Ensuring limits can be removed")
+
+	var unset *Limiters
+	assert.NoError(t, unset.Wait(ctx, "http://limited"), "Ensuring nil limiters allow every request")
+}
+
+func Test_RateLimit_Validate(t *testing.T) {
+	assert.NoError(t, RateLimit{}.Validate())
+	assert.NoError(t, RateLimit{PerSecond: 0.5, Burst: 10}.Validate())
+	assert.Error(t, RateLimit{PerSecond: -1}.Validate())
+	assert.Error(t, RateLimit{Burst: -1}.Validate())
+}
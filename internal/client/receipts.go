@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// methodNotFoundCode ... JSON-RPC error code of methods the node doesn't support
+	methodNotFoundCode = -32601
+
+	// receiptConcurrency ... Upper bound on the receipts fetched at once when eth_getBlockReceipts isn't supported
+	receiptConcurrency = 8
+)
+
+// blockNumberArg ... Returns the RPC argument of the height; nil heights are the latest block
+func blockNumberArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+
+	return hexutil.EncodeBig(number)
+}
+
+// methodNotFound ... Returns true if the error is the node rejecting an unsupported method
+func methodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundCode
+}
+
+// BlockReceipts ... Returns the receipts of every transaction in the block in order; uses eth_getBlockReceipts
+// unless the node doesn't support it, in which case every receipt is fetched with eth_getTransactionReceipt.
+// Fail with ethereum.NotFound if the block doesn't exist
+func (ec *EthClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	if !ec.noBlockReceipts.Load() {
+		var receipts []*types.Receipt
+		err := ec.rpcClient.CallContext(ctx, &receipts, "eth_getBlockReceipts", blockNumberArg(number))
+
+		switch {
+		case err == nil && receipts == nil:
+			return nil, ethereum.NotFound
+
+		case err == nil:
+			return receipts, nil
+
+		case !methodNotFound(err):
+			return nil, err
+		}
+
+		// Unsupported methods stay unsupported, so the fallback is used from now on
+		ec.noBlockReceipts.Store(true)
+	}
+
+	return ec.fetchBlockReceipts(ctx, number)
+}
+
+// fetchBlockReceipts ... Fetches the receipt of every transaction in the block in parallel
+func (ec *EthClient) fetchBlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	var block *struct {
+		Transactions []common.Hash `json:"transactions"`
+	}
+
+	// Only transaction hashes are returned when full transactions aren't requested
+	if err := ec.rpcClient.CallContext(ctx, &block, "eth_getBlockByNumber", blockNumberArg(number),
+		false); err != nil {
+		return nil, err
+	}
+
+	if block == nil {
+		return nil, ethereum.NotFound
+	}
+
+	receipts := make([]*types.Receipt, len(block.Transactions))
+	errs := make([]error, len(block.Transactions))
+
+	sem := make(chan struct{}, receiptConcurrency)
+	wg := &sync.WaitGroup{}
+
+	for i, hash := range block.Transactions {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, hash common.Hash) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			receipts[i], errs[i] = ec.client.TransactionReceipt(ctx, hash)
+		}(i, hash)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("receipt %s: %w", block.Transactions[i], err)
+		}
+	}
+
+	return receipts, nil
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newReceiptServer ... Serves a single block of transactions at height one; eth_getBlockReceipts is only
+// served when supported. Every received method is counted
+func newReceiptServer(t *testing.T, supported bool, txHashes []common.Hash, calls map[string]int) *httptest.Server {
+	receipts := make([]*types.Receipt, len(txHashes))
+	for i, hash := range txHashes {
+		receipts[i] = &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}, TxHash: hash}
+	}
+
+	mu := &sync.Mutex{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg rpcMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+
+		mu.Lock()
+		calls[msg.Method]++
+		mu.Unlock()
+
+		var arg string
+		assert.NoError(t, json.Unmarshal(msg.Params[0], &arg))
+
+		response := map[string]any{"jsonrpc": "2.0", "id": msg.ID, "result": nil}
+		switch {
+		case msg.Method == "eth_getBlockReceipts" && !supported:
+			delete(response, "result")
+			response["error"] = map[string]any{"code": methodNotFoundCode, "message": "method not found"}
+
+		case msg.Method == "eth_getBlockReceipts" && arg == "0x1":
+			response["result"] = receipts
+
+		case msg.Method == "eth_getBlockByNumber" && arg == "0x1":
+			response["result"] = map[string]any{"transactions": txHashes}
+
+		case msg.Method == "eth_getTransactionReceipt":
+			for _, receipt := range receipts {
+				if receipt.TxHash == common.HexToHash(arg) {
+					response["result"] = receipt
+				}
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+}
+
+func Test_BlockReceipts(t *testing.T) {
+	txHashes := make([]common.Hash, 20)
+	for i := range txHashes {
+		txHashes[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		supported bool
+		calls     map[string]int
+	}{
+		{
+			name:        "Supported",
+			description: "Receipts should be fetched with a single eth_getBlockReceipts call",
+			supported:   true,
+			calls:       map[string]int{"eth_getBlockReceipts": 2},
+		},
+		{
+			name:        "Unsupported",
+			description: "Receipts should be fetched individually once the node rejects eth_getBlockReceipts",
+			supported:   false,
+			calls: map[string]int{
+				"eth_getBlockReceipts":      1,
+				"eth_getBlockByNumber":      2,
+				"eth_getTransactionReceipt": len(txHashes),
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			calls := make(map[string]int)
+			server := newReceiptServer(t, tc.supported, txHashes, calls)
+			defer server.Close()
+
+			ec := &EthClient{}
+			assert.NoError(t, ec.DialContext(context.Background(), server.URL))
+
+			receipts, err := ec.BlockReceipts(context.Background(), big.NewInt(1))
+			assert.NoError(t, err)
+			assert.Len(t, receipts, len(txHashes))
+			for j, receipt := range receipts {
+				assert.Equal(t, txHashes[j], receipt.TxHash, "Ensuring receipts are returned in order")
+			}
+
+			_, err = ec.BlockReceipts(context.Background(), big.NewInt(2))
+			assert.ErrorIs(t, err, ethereum.NotFound, "Ensuring missing blocks aren't found")
+
+			assert.Equal(t, tc.calls, calls)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+// RetryClient ... EthClientInterface retrying every failed call of the wrapped client with backoff
+type RetryClient struct {
+	client  EthClientInterface
+	backoff Backoff
+}
+
+// NewRetryClient ... Initializer
+func NewRetryClient(client EthClientInterface, backoff Backoff) *RetryClient {
+	return &RetryClient{client: client, backoff: backoff}
+}
+
+func (rc *RetryClient) DialContext(ctx context.Context, rawURL string) error {
+	return Retry(ctx, rc.backoff, func() error {
+		return rc.client.DialContext(ctx, rawURL)
+	})
+}
+
+func (rc *RetryClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return RetryValue(ctx, rc.backoff, func() (*big.Int, error) {
+		return rc.client.ChainID(ctx)
+	})
+}
+
+func (rc *RetryClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return RetryValue(ctx, rc.backoff, func() (*types.Header, error) {
+		return rc.client.HeaderByNumber(ctx, number)
+	})
+}
+
+func (rc *RetryClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return RetryValue(ctx, rc.backoff, func() (*types.Block, error) {
+		return rc.client.BlockByNumber(ctx, number)
+	})
+}
+
+func (rc *RetryClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return RetryValue(ctx, rc.backoff, func() (*types.Receipt, error) {
+		return rc.client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (rc *RetryClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]*types.Block, error) {
+		return rc.client.BlocksByNumber(ctx, numbers)
+	})
+}
+
+func (rc *RetryClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]*types.Receipt, error) {
+		return rc.client.TransactionReceipts(ctx, txHashes)
+	})
+}
+
+func (rc *RetryClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]*types.Receipt, error) {
+		return rc.client.BlockReceipts(ctx, number)
+	})
+}
+
+func (rc *RetryClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]types.Log, error) {
+		return rc.client.FilterLogs(ctx, query)
+	})
+}
+
+func (rc *RetryClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]byte, error) {
+		return rc.client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+func (rc *RetryClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return RetryValue(ctx, rc.backoff, func() (*big.Int, error) {
+		return rc.client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (rc *RetryClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]byte, error) {
+		return rc.client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+func (rc *RetryClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return RetryValue(ctx, rc.backoff, func() (ethereum.Subscription, error) {
+		return rc.client.SubscribeNewHead(ctx, ch)
+	})
+}
+
+func (rc *RetryClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return RetryValue(ctx, rc.backoff, func() (*gethclient.AccountResult, error) {
+		return rc.client.GetProof(ctx, account, keys, blockNumber)
+	})
+}
+
+func (rc *RetryClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (json.RawMessage, error) {
+	return RetryValue(ctx, rc.backoff, func() (json.RawMessage, error) {
+		return rc.client.TraceTransaction(ctx, txHash, opts...)
+	})
+}
+
+func (rc *RetryClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) ([]TxTraceResult, error) {
+	return RetryValue(ctx, rc.backoff, func() ([]TxTraceResult, error) {
+		return rc.client.TraceBlockByNumber(ctx, number, opts...)
+	})
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// BlockID ... Hash & number identifying a block
+type BlockID struct {
+	Hash   common.Hash `json:"hash"`
+	Number uint64      `json:"number"`
+}
+
+// BlockRef ... Block reference reported by an op-node
+type BlockRef struct {
+	Hash       common.Hash `json:"hash"`
+	Number     uint64      `json:"number"`
+	ParentHash common.Hash `json:"parentHash"`
+	Timestamp  uint64      `json:"timestamp"`
+}
+
+// L2BlockRef ... L2 block reference reported by an op-node, including the L1 block it was derived from
+type L2BlockRef struct {
+	BlockRef
+	L1Origin       BlockID `json:"l1origin"`
+	SequenceNumber uint64  `json:"sequenceNumber"`
+}
+
+// SyncStatus ... Subset of an op-node's sync status; L2 heads progress from unsafe, to safe,
+// to finalized as their batches are posted & finalized on L1
+type SyncStatus struct {
+	CurrentL1   BlockRef `json:"current_l1"`
+	HeadL1      BlockRef `json:"head_l1"`
+	UnsafeL2    BlockRef `json:"unsafe_l2"`
+	SafeL2      BlockRef `json:"safe_l2"`
+	FinalizedL2 BlockRef `json:"finalized_l2"`
+}
+
+// OutputResponse ... Output root of an L2 block & the roots it commits to; output roots are what proposers
+// post to the L2OutputOracle
+type OutputResponse struct {
+	Version               common.Hash `json:"version"`
+	OutputRoot            common.Hash `json:"outputRoot"`
+	BlockRef              L2BlockRef  `json:"blockRef"`
+	WithdrawalStorageRoot common.Hash `json:"withdrawalStorageRoot"`
+	StateRoot             common.Hash `json:"stateRoot"`
+	// Sync status of the op-node when the output was computed
+	Status *SyncStatus `json:"syncStatus"`
+}
+
+// RollupGenesis ... Genesis blocks & time of a rollup
+type RollupGenesis struct {
+	L1     BlockID `json:"l1"`
+	L2     BlockID `json:"l2"`
+	L2Time uint64  `json:"l2_time"`
+}
+
+// RollupConfig ... Subset of the rollup config of an op-node's chain
+type RollupConfig struct {
+	Genesis RollupGenesis `json:"genesis"`
+	// Seconds between L2 blocks
+	BlockTime uint64 `json:"block_time"`
+	// Seconds that the sequencer can get ahead of its L1 origin
+	MaxSequencerDrift uint64 `json:"max_sequencer_drift"`
+	// L1 blocks within which batches must be posted
+	SeqWindowSize  uint64 `json:"seq_window_size"`
+	ChannelTimeout uint64 `json:"channel_timeout"`
+
+	L1ChainID *big.Int `json:"l1_chain_id"`
+	L2ChainID *big.Int `json:"l2_chain_id"`
+
+	BatchInboxAddress      common.Address `json:"batch_inbox_address"`
+	DepositContractAddress common.Address `json:"deposit_contract_address"`
+	L1SystemConfigAddress  common.Address `json:"l1_system_config_address"`
+}
+
+// RollupClient ... RollupClientInterface backed by a dialed op-node RPC endpoint
+type RollupClient struct {
+	client *rpc.Client
+}
+
+// RollupClientInterface ... Typed client of an op-node's optimism_ RPC namespace
+type RollupClientInterface interface {
+	DialContext(ctx context.Context, rawURL string) error
+	SyncStatus(ctx context.Context) (*SyncStatus, error)
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*OutputResponse, error)
+	RollupConfig(ctx context.Context) (*RollupConfig, error)
+}
+
+func (rc *RollupClient) DialContext(ctx context.Context, rawURL string) error {
+	client, err := dialRPC(ctx, rawURL)
+
+	if err != nil {
+		return err
+	}
+
+	rc.client = client
+	return nil
+}
+
+func (rc *RollupClient) SyncStatus(ctx context.Context) (*SyncStatus, error) {
+	var status SyncStatus
+	if err := rc.client.CallContext(ctx, &status, "optimism_syncStatus"); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// OutputAtBlock ... Returns the output root of the L2 block; fail if the op-node hasn't derived the block
+func (rc *RollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*OutputResponse, error) {
+	var output OutputResponse
+	if err := rc.client.CallContext(ctx, &output, "optimism_outputAtBlock", hexutil.Uint64(blockNum)); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func (rc *RollupClient) RollupConfig(ctx context.Context) (*RollupConfig, error) {
+	var cfg RollupConfig
+	if err := rc.client.CallContext(ctx, &cfg, "optimism_rollupConfig"); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
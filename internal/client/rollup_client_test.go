@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// rollupResults ... Canned op-node responses of every optimism_ method
+var rollupResults = map[string]string{
+	"optimism_syncStatus": `{"unsafe_l2": {"number": 12}, "safe_l2": {"number": 10}}`,
+	"optimism_outputAtBlock": `{
+		"version": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"outputRoot": "0x00000000000000000000000000000000000000000000000000000000000000aa",
+		"blockRef": {"number": 7, "l1origin": {"number": 3}, "sequenceNumber": 1},
+		"syncStatus": {"finalized_l2": {"number": 5}}
+	}`,
+	"optimism_rollupConfig": `{
+		"genesis": {"l1": {"number": 100}, "l2": {"number": 0}, "l2_time": 1686068903},
+		"block_time": 2,
+		"l1_chain_id": 1,
+		"l2_chain_id": 8453,
+		"batch_inbox_address": "0xff00000000000000000000000000000000008453"
+	}`,
+}
+
+func Test_RollupClient(t *testing.T) {
+	params := make(chan json.RawMessage, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+
+		if msg.Method == "optimism_outputAtBlock" {
+			params <- msg.Params
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"jsonrpc": "2.0", "id": ` + string(msg.ID) + `, "result": ` +
+			rollupResults[msg.Method] + `}`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	rc := &RollupClient{}
+	assert.NoError(t, rc.DialContext(ctx, server.URL))
+
+	status, err := rc.SyncStatus(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), status.UnsafeL2.Number)
+	assert.Equal(t, uint64(10), status.SafeL2.Number)
+
+	output, err := rc.OutputAtBlock(ctx, 7)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["0x7"]`, string(<-params), "Ensuring block numbers are sent as hex quantities")
+	assert.Equal(t, common.HexToHash("0xaa"), output.OutputRoot)
+	assert.Equal(t, uint64(7), output.BlockRef.Number)
+	assert.Equal(t, uint64(3), output.BlockRef.L1Origin.Number)
+	assert.Equal(t, uint64(5), output.Status.FinalizedL2.Number)
+
+	cfg, err := rc.RollupConfig(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), cfg.BlockTime)
+	assert.Equal(t, uint64(100), cfg.Genesis.L1.Number)
+	assert.Equal(t, big.NewInt(8453), cfg.L2ChainID)
+	assert.Equal(t, common.HexToAddress("0xff00000000000000000000000000000000008453"), cfg.BatchInboxAddress)
+}
@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+// ErrCallTimeout ... Returned when a call exceeds its timeout while the caller's context is still live; unlike
+// context.DeadlineExceeded it's retried
+var ErrCallTimeout = errors.New("RPC call timed out")
+
+// Timeouts ... Upper bounds on the duration of every call by kind; zero values don't bound the call
+type Timeouts struct {
+	// Header reads & subscriptions
+	Header time.Duration
+	// Block, receipt & log reads
+	Block time.Duration
+	// State reads (E.G, eth_call, balances & proofs) & chain ID reads
+	Call time.Duration
+	// debug_trace* calls
+	Trace time.Duration
+}
+
+// DefaultTimeouts ... Timeouts used unless configured otherwise; matches config.env.template
+func DefaultTimeouts() Timeouts {
+	return Timeouts{Header: 5 * time.Second, Block: 10 * time.Second, Call: 10 * time.Second, Trace: 30 * time.Second}
+}
+
+// Validate ... Ensures no timeout is negative
+func (t Timeouts) Validate() error {
+	// Listed in order so that the same problem is reported first on every run
+	for _, timeout := range []struct {
+		kind string
+		d    time.Duration
+	}{
+		{"header", t.Header}, {"block", t.Block}, {"call", t.Call}, {"trace", t.Trace},
+	} {
+		if timeout.d < 0 {
+			return fmt.Errorf("%s timeout must not be negative; got %s", timeout.kind, timeout.d)
+		}
+	}
+
+	return nil
+}
+
+// withTimeout ... Calls the function with a context bounded by the timeout; calls that time out before the
+// caller's context is done fail with ErrCallTimeout
+func withTimeout[T any](ctx context.Context, timeout time.Duration, method string,
+	fn func(ctx context.Context) (T, error)) (T, error) {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	v, err := fn(ctxTimeout)
+	if err != nil && ctx.Err() == nil && errors.Is(ctxTimeout.Err(), context.DeadlineExceeded) {
+		return v, fmt.Errorf("%w: %s exceeded %s", ErrCallTimeout, method, timeout)
+	}
+
+	return v, err
+}
+
+// TimeoutClient ... EthClientInterface bounding every call of the wrapped client by the timeout of its kind so
+// that hung calls can't stall their caller; see RetryClient for retrying timed out calls
+type TimeoutClient struct {
+	client   EthClientInterface
+	timeouts Timeouts
+}
+
+// NewTimeoutClient ... Initializer
+func NewTimeoutClient(client EthClientInterface, timeouts Timeouts) *TimeoutClient {
+	return &TimeoutClient{client: client, timeouts: timeouts}
+}
+
+// DialContext ... Not bounded; dials are bounded by their callers
+func (tc *TimeoutClient) DialContext(ctx context.Context, rawURL string) error {
+	return tc.client.DialContext(ctx, rawURL)
+}
+
+func (tc *TimeoutClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return withTimeout(ctx, tc.timeouts.Call, "ChainID", func(ctx context.Context) (*big.Int, error) {
+		return tc.client.ChainID(ctx)
+	})
+}
+
+func (tc *TimeoutClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return withTimeout(ctx, tc.timeouts.Header, "HeaderByNumber", func(ctx context.Context) (*types.Header, error) {
+		return tc.client.HeaderByNumber(ctx, number)
+	})
+}
+
+func (tc *TimeoutClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return withTimeout(ctx, tc.timeouts.Block, "BlockByNumber", func(ctx context.Context) (*types.Block, error) {
+		return tc.client.BlockByNumber(ctx, number)
+	})
+}
+
+func (tc *TimeoutClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return withTimeout(ctx, tc.timeouts.Block, "TransactionReceipt",
+		func(ctx context.Context) (*types.Receipt, error) {
+			return tc.client.TransactionReceipt(ctx, txHash)
+		})
+}
+
+func (tc *TimeoutClient) BlocksByNumber(ctx context.Context, numbers []*big.Int) ([]*types.Block, error) {
+	return withTimeout(ctx, tc.timeouts.Block, "BlocksByNumber", func(ctx context.Context) ([]*types.Block, error) {
+		return tc.client.BlocksByNumber(ctx, numbers)
+	})
+}
+
+func (tc *TimeoutClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, error) {
+	return withTimeout(ctx, tc.timeouts.Block, "TransactionReceipts",
+		func(ctx context.Context) ([]*types.Receipt, error) {
+			return tc.client.TransactionReceipts(ctx, txHashes)
+		})
+}
+
+// BlockReceipts ... Bounded once even if the receipts are fetched individually
+func (tc *TimeoutClient) BlockReceipts(ctx context.Context, number *big.Int) ([]*types.Receipt, error) {
+	return withTimeout(ctx, tc.timeouts.Block, "BlockReceipts", func(ctx context.Context) ([]*types.Receipt, error) {
+		return tc.client.BlockReceipts(ctx, number)
+	})
+}
+
+func (tc *TimeoutClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return withTimeout(ctx, tc.timeouts.Block, "FilterLogs", func(ctx context.Context) ([]types.Log, error) {
+		return tc.client.FilterLogs(ctx, query)
+	})
+}
+
+func (tc *TimeoutClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return withTimeout(ctx, tc.timeouts.Call, "CallContract", func(ctx context.Context) ([]byte, error) {
+		return tc.client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+func (tc *TimeoutClient) BalanceAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) (*big.Int, error) {
+	return withTimeout(ctx, tc.timeouts.Call, "BalanceAt", func(ctx context.Context) (*big.Int, error) {
+		return tc.client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (tc *TimeoutClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return withTimeout(ctx, tc.timeouts.Call, "CodeAt", func(ctx context.Context) ([]byte, error) {
+		return tc.client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+// SubscribeNewHead ... Only the subscription request is bounded; the subscription outlives it
+func (tc *TimeoutClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return withTimeout(ctx, tc.timeouts.Header, "SubscribeNewHead",
+		func(ctx context.Context) (ethereum.Subscription, error) {
+			return tc.client.SubscribeNewHead(ctx, ch)
+		})
+}
+
+func (tc *TimeoutClient) GetProof(ctx context.Context, account common.Address, keys []string,
+	blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return withTimeout(ctx, tc.timeouts.Call, "GetProof", func(ctx context.Context) (*gethclient.AccountResult, error) {
+		return tc.client.GetProof(ctx, account, keys, blockNumber)
+	})
+}
+
+func (tc *TimeoutClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (json.RawMessage, error) {
+	return withTimeout(ctx, tc.timeouts.Trace, "TraceTransaction", func(ctx context.Context) (json.RawMessage, error) {
+		return tc.client.TraceTransaction(ctx, txHash, opts...)
+	})
+}
+
+func (tc *TimeoutClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) ([]TxTraceResult, error) {
+	return withTimeout(ctx, tc.timeouts.Trace, "TraceBlockByNumber",
+		func(ctx context.Context) ([]TxTraceResult, error) {
+			return tc.client.TraceBlockByNumber(ctx, number, opts...)
+		})
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// hungClient ... Responds to header reads after the delay unless their context is done first
+type hungClient struct {
+	EthClientInterface
+	delay time.Duration
+	calls int
+}
+
+func (hc *hungClient) HeaderByNumber(ctx context.Context, _ *big.Int) (*types.Header, error) {
+	hc.calls++
+	select {
+	case <-time.After(hc.delay):
+		return &types.Header{}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func Test_TimeoutClient(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		delay    time.Duration
+		timeouts Timeouts
+		// Caller's deadline; unbounded when zero
+		deadline time.Duration
+		expected error
+	}{
+		{
+			name:        "Within Timeout",
+			description: "Calls completing within their timeout should succeed",
+			delay:       time.Millisecond,
+			timeouts:    Timeouts{Header: time.Second},
+		},
+		{
+			name:        "Timed Out",
+			description: "Hung calls should fail with ErrCallTimeout once their timeout passes",
+			delay:       time.Hour,
+			timeouts:    Timeouts{Header: 10 * time.Millisecond},
+			expected:    ErrCallTimeout,
+		},
+		{
+			name:        "Other Kind",
+			description: "Calls should only be bounded by the timeout of their kind",
+			delay:       10 * time.Millisecond,
+			timeouts:    Timeouts{Block: time.Nanosecond, Call: time.Nanosecond, Trace: time.Nanosecond},
+		},
+		{
+			name:        "Caller Deadline",
+			description: "Calls outliving their caller's deadline should fail with the caller's error",
+			delay:       time.Hour,
+			timeouts:    Timeouts{Header: time.Hour},
+			deadline:    10 * time.Millisecond,
+			expected:    context.DeadlineExceeded,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ctx := context.Background()
+			if tc.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.deadline)
+				defer cancel()
+			}
+
+			_, err := NewTimeoutClient(&hungClient{delay: tc.delay}, tc.timeouts).HeaderByNumber(ctx, nil)
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func Test_TimeoutClient_Retry(t *testing.T) {
+	hc := &hungClient{delay: time.Hour}
+	rc := NewRetryClient(NewTimeoutClient(hc, Timeouts{Header: time.Millisecond}), Backoff{Retries: 2})
+
+	_, err := rc.HeaderByNumber(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrCallTimeout)
+	assert.Equal(t, 3, hc.calls, "Ensuring timed out calls are retried")
+}
+
+func Test_Timeouts_Validate(t *testing.T) {
+	assert.NoError(t, DefaultTimeouts().Validate())
+	assert.NoError(t, Timeouts{}.Validate())
+	assert.Error(t, Timeouts{Trace: -time.Second}.Validate())
+}
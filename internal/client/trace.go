@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CallTracer ... Native tracer returning the call frames of a transaction (see CallFrame)
+const CallTracer = "callTracer"
+
+// TraceConfig ... Options of debug_trace* calls; the struct logger is used when no tracer is set
+type TraceConfig struct {
+	Tracer       string          `json:"tracer,omitempty"`
+	TracerConfig json.RawMessage `json:"tracerConfig,omitempty"`
+	// Go duration string (E.G, 5s) bounding the trace on the node
+	Timeout string `json:"timeout,omitempty"`
+
+	// Struct logger options
+	EnableMemory     bool `json:"enableMemory,omitempty"`
+	DisableStack     bool `json:"disableStack,omitempty"`
+	DisableStorage   bool `json:"disableStorage,omitempty"`
+	EnableReturnData bool `json:"enableReturnData,omitempty"`
+}
+
+// TraceOption ...
+type TraceOption = func(*TraceConfig)
+
+// WithTracer ... Traces using the named tracer (E.G, CallTracer) & its JSON encoded config; nil configs use the
+// tracer's defaults
+func WithTracer(tracer string, config json.RawMessage) TraceOption {
+	return func(tc *TraceConfig) {
+		tc.Tracer = tracer
+		tc.TracerConfig = config
+	}
+}
+
+// WithTraceTimeout ... Bounds the time the node spends tracing
+func WithTraceTimeout(timeout time.Duration) TraceOption {
+	return func(tc *TraceConfig) {
+		tc.Timeout = timeout.String()
+	}
+}
+
+// WithStructLogger ... Sets the options of the default struct logger
+func WithStructLogger(enableMemory, disableStack, disableStorage, enableReturnData bool) TraceOption {
+	return func(tc *TraceConfig) {
+		tc.EnableMemory = enableMemory
+		tc.DisableStack = disableStack
+		tc.DisableStorage = disableStorage
+		tc.EnableReturnData = enableReturnData
+	}
+}
+
+// newTraceConfig ... Applies the options in order
+func newTraceConfig(opts []TraceOption) *TraceConfig {
+	tc := &TraceConfig{}
+	for _, opt := range opts {
+		opt(tc)
+	}
+
+	return tc
+}
+
+// TxTraceResult ... Trace of a single transaction within a traced block; the result is encoded by the tracer
+type TxTraceResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// CallFrame ... Call traced by CallTracer; nested calls are traced as sub frames
+type CallFrame struct {
+	// Opcode of the call (E.G, CALL, CREATE2 or SELFDESTRUCT)
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// Walk ... Calls the function with the frame & then every sub frame depth first
+func (cf *CallFrame) Walk(fn func(frame *CallFrame)) {
+	fn(cf)
+	for i := range cf.Calls {
+		cf.Calls[i].Walk(fn)
+	}
+}
+
+// IsSelfDestruct ... Returns true if the frame destroys its caller
+func (cf *CallFrame) IsSelfDestruct() bool {
+	return strings.EqualFold(cf.Type, "SELFDESTRUCT")
+}
+
+// TraceTransaction ... Returns the trace of the transaction encoded by the configured tracer
+func (ec *EthClient) TraceTransaction(ctx context.Context, txHash common.Hash,
+	opts ...TraceOption) (json.RawMessage, error) {
+	var result json.RawMessage
+	err := ec.rpcClient.CallContext(ctx, &result, "debug_traceTransaction", txHash, newTraceConfig(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// TraceBlockByNumber ... Returns the trace of every transaction in the block in order; nil heights trace the
+// latest block
+func (ec *EthClient) TraceBlockByNumber(ctx context.Context, number *big.Int,
+	opts ...TraceOption) ([]TxTraceResult, error) {
+	var results []TxTraceResult
+	err := ec.rpcClient.CallContext(ctx, &results, "debug_traceBlockByNumber", blockNumberArg(number),
+		newTraceConfig(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTraceServer ... Serves the call frame for every traced transaction & records the trace config of the
+// last call
+func newTraceServer(t *testing.T, frame *CallFrame, txHashes []common.Hash, config *TraceConfig) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg rpcMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		assert.NoError(t, json.Unmarshal(msg.Params[1], config))
+
+		response := map[string]any{"jsonrpc": "2.0", "id": msg.ID}
+		switch msg.Method {
+		case "debug_traceTransaction":
+			response["result"] = frame
+
+		case "debug_traceBlockByNumber":
+			results := make([]map[string]any, len(txHashes))
+			for i, hash := range txHashes {
+				results[i] = map[string]any{"txHash": hash, "result": frame}
+			}
+			response["result"] = results
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+}
+
+func Test_Trace(t *testing.T) {
+	to := common.HexToAddress("0x420")
+	frame := &CallFrame{
+		Type:  "CALL",
+		From:  common.HexToAddress("0x69"),
+		To:    &to,
+		Input: hexutil.Bytes{0xde, 0xad},
+		Calls: []CallFrame{
+			{Type: "STATICCALL", From: to, Input: hexutil.Bytes{}},
+			{Type: "SELFDESTRUCT", From: to, To: &common.Address{}, Input: hexutil.Bytes{}},
+		},
+	}
+	txHashes := []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		opts   []TraceOption
+		config TraceConfig
+	}{
+		{
+			name:        "Default",
+			description: "No options should trace with the node's default struct logger",
+			config:      TraceConfig{},
+		},
+		{
+			name:        "Struct logger",
+			description: "Struct logger options should be sent as is",
+			opts:        []TraceOption{WithStructLogger(true, true, false, true)},
+			config:      TraceConfig{EnableMemory: true, DisableStack: true, EnableReturnData: true},
+		},
+		{
+			name:        "Call tracer",
+			description: "Tracer name, tracer config & timeout should be sent",
+			opts: []TraceOption{
+				WithTracer(CallTracer, json.RawMessage(`{"onlyTopCall":false}`)),
+				WithTraceTimeout(5 * time.Second),
+			},
+			config: TraceConfig{
+				Tracer:       CallTracer,
+				TracerConfig: json.RawMessage(`{"onlyTopCall":false}`),
+				Timeout:      "5s",
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			config := &TraceConfig{}
+			server := newTraceServer(t, frame, txHashes, config)
+			defer server.Close()
+
+			ec := &EthClient{}
+			assert.NoError(t, ec.DialContext(context.Background(), server.URL))
+
+			raw, err := ec.TraceTransaction(context.Background(), txHashes[0], tc.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.config, *config)
+
+			var actual CallFrame
+			assert.NoError(t, json.Unmarshal(raw, &actual))
+			assert.Equal(t, *frame, actual)
+
+			results, err := ec.TraceBlockByNumber(context.Background(), big.NewInt(1), tc.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.config, *config)
+			assert.Len(t, results, len(txHashes))
+			for j, result := range results {
+				assert.Equal(t, txHashes[j], result.TxHash, "Ensuring traces are returned in order")
+				assert.Empty(t, result.Error)
+			}
+		})
+	}
+}
+
+func Test_CallFrame_Walk(t *testing.T) {
+	frame := CallFrame{
+		Type: "CALL",
+		Calls: []CallFrame{
+			{Type: "DELEGATECALL", Calls: []CallFrame{{Type: "SELFDESTRUCT"}}},
+			{Type: "selfdestruct"},
+		},
+	}
+
+	var types []string
+	destructs := 0
+	frame.Walk(func(cf *CallFrame) {
+		types = append(types, cf.Type)
+		if cf.IsSelfDestruct() {
+			destructs++
+		}
+	})
+
+	assert.Equal(t, []string{"CALL", "DELEGATECALL", "SELFDESTRUCT", "selfdestruct"}, types,
+		"Ensuring frames are walked depth first")
+	assert.Equal(t, 2, destructs)
+}
@@ -0,0 +1,354 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+// Builder errors
+const (
+	missingOracleErr      = "chain must start with an oracle"
+	duplicateOracleErr    = "chain already has an oracle; %s can't be added"
+	notOracleErr          = "%s register is not an oracle"
+	notPipeErr            = "%s register is not a pipe"
+	unsupportedPipeErr    = "%s register depends on %v; chains only support pipes with a single dependency"
+	dependencyMismatchErr = "%s register can't read %s data; expected %s"
+	missingStageErr       = "params must follow an oracle or pipe"
+	missingSinkErr        = "chain has no sink"
+	duplicateSinkErr      = "chain already has a sink"
+	missingClientErr      = "oracle requires a client"
+	chainRunningErr       = "chain has already been run"
+)
+
+// stage ... Single component of the chain; either a register component or a filter
+type stage struct {
+	register  *registry.DataRegister
+	params    models.Params
+	predicate pipeline.Predicate
+}
+
+// Builder ... Fluent API used to construct a linear chain of components by hand; handles channel creation,
+// directive IDs and event loop startup. Embedders that don't need control over the chain's shape should
+// prefer the ETL manager, which also resolves dependencies & shares components
+// E.G, NewBuilder(ctx).WithClient(c).Oracle(GethBlock).Pipe(ContractCreateTX).Sink(out).Build()
+type Builder struct {
+	ctx context.Context
+
+	network    models.Network
+	oracleType pipeline.OracleType
+	oracleCfg  *config.OracleConfig
+	client     client.EthClientInterface
+	errs       chan<- pipeline.ComponentError
+
+	stages []*stage
+	sink   chan models.TransitData
+
+	// First error encountered while building; returned by Build
+	err error
+}
+
+// NewBuilder ... Initializer; chains default to reading live layer 1 data
+func NewBuilder(ctx context.Context) *Builder {
+	return &Builder{
+		ctx:        ctx,
+		network:    models.Layer1,
+		oracleType: pipeline.LiveOracle,
+		oracleCfg:  &config.OracleConfig{},
+		stages:     make([]*stage, 0),
+	}
+}
+
+// fail ... Records the error unless an earlier error was already recorded
+func (b *Builder) fail(err error) *Builder {
+	if b.err == nil {
+		b.err = err
+	}
+
+	return b
+}
+
+// WithNetwork ... Sets the network that the chain's components are identified by
+func (b *Builder) WithNetwork(n models.Network) *Builder {
+	b.network = n
+	return b
+}
+
+// WithOracleType ... Sets the oracle's reading mode
+func (b *Builder) WithOracleType(ot pipeline.OracleType) *Builder {
+	b.oracleType = ot
+	return b
+}
+
+// WithOracleConfig ... Sets the endpoint & height range read by the oracle
+func (b *Builder) WithOracleConfig(cfg *config.OracleConfig) *Builder {
+	b.oracleCfg = cfg
+	return b
+}
+
+// WithClient ... Sets the client used by the oracle
+func (b *Builder) WithClient(c client.EthClientInterface) *Builder {
+	b.client = c
+	return b
+}
+
+// WithErrorChannel ... Sets the channel that every component reports runtime errors to
+func (b *Builder) WithErrorChannel(errs chan<- pipeline.ComponentError) *Builder {
+	b.errs = errs
+	return b
+}
+
+// lastRegister ... Returns the register of the most recently added register stage
+func (b *Builder) lastRegister() *registry.DataRegister {
+	for i := len(b.stages) - 1; i >= 0; i-- {
+		if b.stages[i].register != nil {
+			return b.stages[i].register
+		}
+	}
+
+	return nil
+}
+
+// Oracle ... Starts the chain with the oracle register
+func (b *Builder) Oracle(rt models.RegisterType) *Builder {
+	dr, err := registry.GetRegister(rt)
+	if err != nil {
+		return b.fail(err)
+	}
+
+	if dr.ComponentType != models.Oracle {
+		return b.fail(fmt.Errorf(notOracleErr, rt))
+	}
+
+	if len(b.stages) > 0 {
+		return b.fail(fmt.Errorf(duplicateOracleErr, rt))
+	}
+
+	b.stages = append(b.stages, &stage{register: dr})
+	return b
+}
+
+// Pipe ... Appends the pipe register; the pipe must depend solely on the previous register
+func (b *Builder) Pipe(rt models.RegisterType) *Builder {
+	dr, err := registry.GetRegister(rt)
+	if err != nil {
+		return b.fail(err)
+	}
+
+	if dr.ComponentType != models.Pipe {
+		return b.fail(fmt.Errorf(notPipeErr, rt))
+	}
+
+	prev := b.lastRegister()
+	if prev == nil {
+		return b.fail(fmt.Errorf(missingOracleErr))
+	}
+
+	deps := dr.DependencyTypes()
+	if len(deps) != 1 {
+		return b.fail(fmt.Errorf(unsupportedPipeErr, rt, deps))
+	}
+
+	if deps[0] != prev.DataType {
+		return b.fail(fmt.Errorf(dependencyMismatchErr, rt, prev.DataType, deps[0]))
+	}
+
+	b.stages = append(b.stages, &stage{register: dr})
+	return b
+}
+
+// Params ... Sets the params passed to the constructor of the most recently added oracle or pipe
+func (b *Builder) Params(params models.Params) *Builder {
+	if len(b.stages) == 0 || b.stages[len(b.stages)-1].register == nil {
+		return b.fail(fmt.Errorf(missingStageErr))
+	}
+
+	b.stages[len(b.stages)-1].params = params
+	return b
+}
+
+// Filter ... Appends a filter that drops data not matching the predicate
+func (b *Builder) Filter(predicate pipeline.Predicate) *Builder {
+	if len(b.stages) == 0 {
+		return b.fail(fmt.Errorf(missingOracleErr))
+	}
+
+	b.stages = append(b.stages, &stage{predicate: predicate})
+	return b
+}
+
+// Sink ... Sets the channel that the chain's output is written to
+func (b *Builder) Sink(out chan models.TransitData) *Builder {
+	if b.sink != nil {
+		return b.fail(fmt.Errorf(duplicateSinkErr))
+	}
+
+	b.sink = out
+	return b
+}
+
+// Build ... Constructs & wires every component; returns the first error encountered while building
+func (b *Builder) Build() (*Chain, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if len(b.stages) == 0 {
+		return nil, fmt.Errorf(missingOracleErr)
+	}
+
+	if b.sink == nil {
+		return nil, fmt.Errorf(missingSinkErr)
+	}
+
+	if b.client == nil {
+		return nil, fmt.Errorf(missingClientErr)
+	}
+
+	chain := &Chain{components: make([]pipeline.Component, 0, len(b.stages))}
+	if err := b.wire(chain); err != nil {
+		// Components that were already constructed hold routines bound to the builder's context
+		for _, component := range chain.components {
+			component.Close()
+		}
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+// wire ... Constructs every stage's component, directing its output onto the input of the component that
+// follows it; the last component's output is directed onto the sink
+func (b *Builder) wire(chain *Chain) error {
+	var inputChan chan models.TransitData
+	var rt models.RegisterType
+
+	for i, s := range b.stages {
+		// Filters are identified by the register type of the data they filter
+		if s.register != nil {
+			rt = s.register.DataType
+		}
+
+		component, err := b.construct(s, rt, inputChan)
+		if err != nil {
+			return err
+		}
+		chain.components = append(chain.components, component)
+
+		if i > 0 {
+			if dErr := chain.components[i-1].AddDirective(i, inputChan); dErr != nil {
+				return dErr
+			}
+		}
+
+		if i < len(b.stages)-1 {
+			inputChan = models.NewTransitChannel()
+		}
+	}
+
+	return chain.components[len(chain.components)-1].AddDirective(len(b.stages), b.sink)
+}
+
+// construct ... Constructs, identifies and configures the stage's component
+func (b *Builder) construct(s *stage, rt models.RegisterType,
+	inputChan chan models.TransitData) (pipeline.Component, error) {
+	var component pipeline.Component
+	var err error
+
+	switch {
+	case s.predicate != nil:
+		component, err = pipeline.NewFilter(b.ctx, s.predicate, inputChan)
+
+	case s.register.ComponentType == models.Oracle:
+		init, ok := s.register.ComponentConstructor.(pipeline.OracleConstructor)
+		if !ok {
+			return nil, fmt.Errorf("could not read oracle constructor for register: %s", rt)
+		}
+
+		component, err = init(b.ctx, b.oracleType, b.oracleCfg, b.client, s.params)
+
+	default:
+		init, ok := s.register.ComponentConstructor.(pipeline.PipeConstructorFunc)
+		if !ok {
+			return nil, fmt.Errorf("could not read pipe constructor for register: %s", rt)
+		}
+
+		component, err = init(b.ctx, inputChan, s.params)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	component.SetID(models.NewComponentID(b.network, models.PipelineType(b.oracleType), rt))
+	if b.errs != nil {
+		component.SetErrorChannel(b.errs)
+	}
+
+	return component, nil
+}
+
+// Chain ... Linear set of wired components produced by a builder
+type Chain struct {
+	mu         sync.Mutex
+	running    bool
+	waitGroup  sync.WaitGroup
+	components []pipeline.Component
+}
+
+// Components ... Returns the chain's components in the order that data flows through them
+func (c *Chain) Components() []pipeline.Component {
+	components := make([]pipeline.Component, len(c.components))
+	copy(components, c.components)
+	return components
+}
+
+// Run ... Spawns every component's event loop; consumers are started before their producers so
+// that no producer blocks on an unread channel. Chains can only be run once
+func (c *Chain) Run() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.running {
+		return fmt.Errorf(chainRunningErr)
+	}
+	c.running = true
+
+	for i := len(c.components) - 1; i >= 0; i-- {
+		component := c.components[i]
+
+		c.waitGroup.Add(1)
+		go func() {
+			defer c.waitGroup.Done()
+
+			if err := component.EventLoop(); err != nil {
+				if log := logging.NoContext(); log != nil {
+					log.Error("Received error from component event loop",
+						zap.String("component_id", component.ID().String()), zap.Error(err))
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// Close ... Closes every component, consumers before their producers, and waits for their event loops to exit
+func (c *Chain) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := len(c.components) - 1; i >= 0; i-- {
+		c.components[i].Close()
+	}
+
+	c.waitGroup.Wait()
+}
@@ -0,0 +1,131 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Builder_Validation(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := make(chan models.TransitData)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		builder     *Builder
+		expectedErr string
+	}{
+		{
+			name:        "Missing Oracle",
+			description: "Chains must start with an oracle",
+			builder:     NewBuilder(ctx).Pipe(registry.ContractCreateTX).Sink(sink),
+			expectedErr: missingOracleErr,
+		},
+		{
+			name:        "Pipe As Oracle",
+			description: "Pipe registers can't be used as oracles",
+			builder:     NewBuilder(ctx).Oracle(registry.ContractCreateTX),
+			expectedErr: fmt.Sprintf(notOracleErr, registry.ContractCreateTX),
+		},
+		{
+			name:        "Dependency Mismatch",
+			description: "Pipes must read the previous register's data",
+			builder:     NewBuilder(ctx).Oracle(registry.EventLog).Pipe(registry.ContractCreateTX),
+			expectedErr: fmt.Sprintf(dependencyMismatchErr, registry.ContractCreateTX, registry.EventLog, registry.GethBlock),
+		},
+		{
+			name:        "Misplaced Params",
+			description: "Params can't follow a filter",
+			builder: NewBuilder(ctx).Oracle(registry.GethBlock).
+				Filter(func(models.TransitData) (bool, error) { return true, nil }).Params(models.Params{}),
+			expectedErr: missingStageErr,
+		},
+		{
+			name:        "Missing Sink",
+			description: "Chains must have a sink",
+			builder:     NewBuilder(ctx).WithClient(new(mocks.EthClient)).Oracle(registry.GethBlock),
+			expectedErr: missingSinkErr,
+		},
+		{
+			name:        "Missing Client",
+			description: "Chains must have an oracle client",
+			builder:     NewBuilder(ctx).Oracle(registry.GethBlock).Sink(sink),
+			expectedErr: missingClientErr,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			_, err := tc.builder.Build()
+			assert.Error(t, err, tc.description)
+			assert.Equal(t, tc.expectedErr, err.Error())
+		})
+	}
+}
+
+func Test_Builder_Chain(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	header := &types.Header{Number: big.NewInt(1)}
+	createTx := types.NewTx(&types.LegacyTx{Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)})
+	block := types.NewBlock(header, []*types.Transaction{createTx}, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, "endpoint").Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	sink := make(chan models.TransitData)
+
+	chain, err := NewBuilder(ctx).
+		WithNetwork(models.Layer2).
+		WithClient(testClient).
+		WithOracleConfig(&config.OracleConfig{RPCEndpoint: "endpoint", StartHeight: big.NewInt(1)}).
+		Oracle(registry.GethBlock).
+		Pipe(registry.ContractCreateTX).
+		Filter(func(td models.TransitData) (bool, error) { return td.Height != nil, nil }).
+		Sink(sink).
+		Build()
+	assert.NoError(t, err)
+
+	components := chain.Components()
+	assert.Len(t, components, 3)
+	assert.Equal(t, []int{1}, components[0].Directives())
+	assert.Equal(t, registry.ContractCreateTX, components[2].ID().RegisterType,
+		"Ensuring filters are identified by the register they filter")
+
+	assert.NoError(t, chain.Run())
+	assert.Error(t, chain.Run(), "Ensuring chains can only be run once")
+	defer chain.Close()
+
+	select {
+	case td := <-sink:
+		assert.Equal(t, registry.ContractCreateTX, td.Type)
+		assert.Equal(t, models.Layer2, td.Network)
+		assert.Equal(t, components[1].ID(), td.OriginID)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for chain output")
+	}
+}
@@ -0,0 +1,132 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Store ... Persists the last fully processed height per oracle key
+type Store interface {
+	// Get ... Returns the last processed height for the key; false if no checkpoint exists
+	Get(key string) (*big.Int, bool, error)
+	// Set ... Records the last processed height for the key
+	Set(key string, height *big.Int) error
+}
+
+// MemoryStore ... Non-persistent store; checkpoints are lost on restart
+type MemoryStore struct {
+	mu      sync.RWMutex
+	heights map[string]*big.Int
+}
+
+// NewMemoryStore ... Initializer
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{heights: make(map[string]*big.Int)}
+}
+
+// Get ... Returns the last processed height for the key
+func (ms *MemoryStore) Get(key string) (*big.Int, bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	height, found := ms.heights[key]
+	if !found {
+		return nil, false, nil
+	}
+
+	return new(big.Int).Set(height), true, nil
+}
+
+// Set ... Records the last processed height for the key
+func (ms *MemoryStore) Set(key string, height *big.Int) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.heights[key] = new(big.Int).Set(height)
+	return nil
+}
+
+// FileStore ... Store backed by a single JSON file; the file is rewritten atomically on every update
+type FileStore struct {
+	mu      sync.Mutex
+	path    string
+	heights map[string]string
+}
+
+// NewFileStore ... Initializer; loads existing checkpoints from the path if the file exists
+func NewFileStore(path string) (*FileStore, error) {
+	fs := &FileStore{
+		path:    path,
+		heights: make(map[string]string),
+	}
+
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fs, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if decodeErr := json.Unmarshal(bytes, &fs.heights); decodeErr != nil {
+		return nil, fmt.Errorf("could not decode checkpoint file %s: %w", path, decodeErr)
+	}
+
+	return fs, nil
+}
+
+// Get ... Returns the last processed height for the key
+func (fs *FileStore) Get(key string) (*big.Int, bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	val, found := fs.heights[key]
+	if !found {
+		return nil, false, nil
+	}
+
+	height, success := new(big.Int).SetString(val, 10)
+	if !success {
+		return nil, false, fmt.Errorf("invalid checkpoint height for key %s: %s", key, val)
+	}
+
+	return height, true, nil
+}
+
+// Set ... Records the last processed height for the key and flushes all checkpoints to disk
+func (fs *FileStore) Set(key string, height *big.Int) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.heights[key] = height.String()
+
+	bytes, err := json.Marshal(fs.heights)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a crash never leaves a partially written checkpoint file
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, writeErr := tmp.Write(bytes); writeErr != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return writeErr
+	}
+
+	if closeErr := tmp.Close(); closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		return closeErr
+	}
+
+	return os.Rename(tmp.Name(), fs.path)
+}
@@ -0,0 +1,42 @@
+package checkpoint
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+
+	store, err := NewFileStore(path)
+	assert.NoError(t, err, "Ensuring a missing file yields an empty store")
+
+	_, found, err := store.Get("layer1:live:GETH_BLOCK")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	assert.NoError(t, store.Set("layer1:live:GETH_BLOCK", big.NewInt(0x420)))
+	assert.NoError(t, store.Set("layer2:live:EVENT_LOG", big.NewInt(0x69)))
+
+	// Reload from disk to ensure checkpoints survive restarts
+	reloaded, err := NewFileStore(path)
+	assert.NoError(t, err)
+
+	height, found, err := reloaded.Get("layer1:live:GETH_BLOCK")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, big.NewInt(0x420), height)
+
+	height, found, err = reloaded.Get("layer2:live:EVENT_LOG")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, big.NewInt(0x69), height)
+
+	assert.NoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+	_, err = NewFileStore(path)
+	assert.Error(t, err, "Ensuring corrupt checkpoint files are rejected")
+}
@@ -0,0 +1,109 @@
+package etl
+
+import (
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+)
+
+const (
+	// deadLetterCapacity ... Number of dead letters retained before the oldest are evicted
+	deadLetterCapacity = 1000
+)
+
+// DeadLetter ... Transit data that a component failed to process along with the failure
+type DeadLetter struct {
+	ID          uint64
+	ComponentID models.ComponentID
+	Input       models.TransitData
+	Err         error
+	Timestamp   time.Time
+}
+
+// deadLetterQueue ... Bounded in-memory store of dead letters in arrival order
+type deadLetterQueue struct {
+	mu      sync.Mutex
+	nextID  uint64
+	letters []DeadLetter
+}
+
+// newDeadLetterQueue ... Initializer
+func newDeadLetterQueue() *deadLetterQueue {
+	return &deadLetterQueue{letters: make([]DeadLetter, 0)}
+}
+
+// add ... Stores the component error's input; errors without an input are ignored
+func (dlq *deadLetterQueue) add(cErr pipeline.ComponentError) {
+	if cErr.Input == nil {
+		return
+	}
+
+	dlq.mu.Lock()
+	defer dlq.mu.Unlock()
+
+	dlq.nextID++
+	dlq.letters = append(dlq.letters, DeadLetter{
+		ID:          dlq.nextID,
+		ComponentID: cErr.ComponentID,
+		Input:       *cErr.Input,
+		Err:         cErr.Err,
+		Timestamp:   cErr.Timestamp,
+	})
+
+	if len(dlq.letters) > deadLetterCapacity {
+		dlq.letters = dlq.letters[len(dlq.letters)-deadLetterCapacity:]
+	}
+}
+
+// list ... Returns the dead letters of the components in arrival order
+func (dlq *deadLetterQueue) list(components map[models.ComponentID]struct{}) []DeadLetter {
+	dlq.mu.Lock()
+	defer dlq.mu.Unlock()
+
+	letters := make([]DeadLetter, 0)
+	for _, letter := range dlq.letters {
+		if _, found := components[letter.ComponentID]; found {
+			letters = append(letters, letter)
+		}
+	}
+
+	return letters
+}
+
+// remove ... Removes & returns the dead letter if it belongs to one of the components
+func (dlq *deadLetterQueue) remove(components map[models.ComponentID]struct{}, id uint64) (DeadLetter, bool) {
+	dlq.mu.Lock()
+	defer dlq.mu.Unlock()
+
+	for i, letter := range dlq.letters {
+		if letter.ID != id {
+			continue
+		}
+
+		if _, found := components[letter.ComponentID]; !found {
+			return DeadLetter{}, false
+		}
+
+		dlq.letters = append(dlq.letters[:i], dlq.letters[i+1:]...)
+		return letter, true
+	}
+
+	return DeadLetter{}, false
+}
+
+// purge ... Removes every dead letter of the components
+func (dlq *deadLetterQueue) purge(components map[models.ComponentID]struct{}) {
+	dlq.mu.Lock()
+	defer dlq.mu.Unlock()
+
+	kept := make([]DeadLetter, 0, len(dlq.letters))
+	for _, letter := range dlq.letters {
+		if _, found := components[letter.ComponentID]; !found {
+			kept = append(kept, letter)
+		}
+	}
+
+	dlq.letters = kept
+}
@@ -0,0 +1,106 @@
+package etl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/sink"
+	"gopkg.in/yaml.v3"
+)
+
+// PipelineDefinition ... Declarative pipeline instantiated at boot along with the sinks its output is written to
+type PipelineDefinition struct {
+	// Unique name used to identify the pipeline's output
+	Name string `json:"name"`
+	PipelineRequest
+
+	Sinks []sink.Config `json:"sinks"`
+}
+
+// Definitions ... Contents of a pipeline definitions file
+type Definitions struct {
+	Pipelines []PipelineDefinition `json:"pipelines"`
+}
+
+// LoadDefinitions ... Reads & validates a YAML or JSON definitions file; the format is inferred from
+// the file extension. YAML files use the same field names as JSON files
+func LoadDefinitions(path string) (*Definitions, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+
+	case ".yaml", ".yml":
+		if raw, err = yamlToJSON(raw); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", path, err)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported definitions file extension: %s", filepath.Ext(path))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	defs := &Definitions{}
+	if dErr := dec.Decode(defs); dErr != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", path, dErr)
+	}
+
+	if vErr := defs.validate(); vErr != nil {
+		return nil, fmt.Errorf("invalid definitions in %s: %w", path, vErr)
+	}
+
+	return defs, nil
+}
+
+// yamlToJSON ... Converts the YAML document to JSON so that definitions are decoded using their JSON tags
+func yamlToJSON(raw []byte) ([]byte, error) {
+	var doc any
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(doc)
+}
+
+// validate ... Ensures every definition is uniquely named, requests a constructable pipeline and has a valid sink
+func (defs *Definitions) validate() error {
+	names := make(map[string]struct{}, len(defs.Pipelines))
+
+	for i, def := range defs.Pipelines {
+		if def.Name == "" {
+			return fmt.Errorf("pipeline %d has no name", i)
+		}
+
+		if _, found := names[def.Name]; found {
+			return fmt.Errorf("pipeline name %s is used more than once", def.Name)
+		}
+		names[def.Name] = struct{}{}
+
+		req := def.PipelineRequest
+		req.withDefaults()
+		if err := req.validate(); err != nil {
+			return fmt.Errorf("pipeline %s: %w", def.Name, err)
+		}
+
+		if len(def.Sinks) == 0 {
+			return fmt.Errorf("pipeline %s has no sinks", def.Name)
+		}
+
+		for _, cfg := range def.Sinks {
+			if err := cfg.Validate(); err != nil {
+				return fmt.Errorf("pipeline %s: %w", def.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package etl
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/sink"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LoadDefinitions(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		file    string
+		content string
+		valid   bool
+	}{
+		{
+			name:        "YAML Definitions",
+			description: "YAML files should be decoded using the JSON field names",
+			file:        "pipelines.yaml",
+			content: `
+pipelines:
+  - name: creations
+    network: layer1
+    register_type: CONTRACT_CREATE_TX
+    oracle_type: backtest
+    start_height: 10
+    end_height: 20
+    params:
+      window_size: 5
+    sinks:
+      - type: log
+      - type: file
+        path: out.jsonl
+`,
+			valid: true,
+		},
+		{
+			name:        "JSON Definitions",
+			description: "JSON files should be decoded directly",
+			file:        "pipelines.json",
+			content: `{"pipelines": [{"name": "creations", "network": "layer1", "register_type": "CONTRACT_CREATE_TX",
+				"oracle_type": "backtest", "start_height": 10, "end_height": 20, "params": {"window_size": 5},
+				"sinks": [{"type": "log"}, {"type": "file", "path": "out.jsonl"}]}]}`,
+			valid: true,
+		},
+		{
+			name:        "Unknown Field",
+			description: "Misspelt fields should be rejected rather than silently ignored",
+			file:        "pipelines.yaml",
+			content:     "pipelines:\n  - name: a\n    registertype: GETH_BLOCK\n    sinks: [{type: log}]\n",
+		},
+		{
+			name:        "Duplicate Names",
+			description: "Pipeline names should be unique",
+			file:        "pipelines.yaml",
+			content: "pipelines:\n  - {name: a, register_type: GETH_BLOCK, sinks: [{type: log}]}\n" +
+				"  - {name: a, register_type: GETH_BLOCK, sinks: [{type: log}]}\n",
+		},
+		{
+			name:        "Missing Sinks",
+			description: "Pipelines without sinks should be rejected",
+			file:        "pipelines.yaml",
+			content:     "pipelines:\n  - {name: a, register_type: GETH_BLOCK}\n",
+		},
+		{
+			name:        "Invalid Request",
+			description: "Pipelines requesting unknown registers should be rejected",
+			file:        "pipelines.yaml",
+			content:     "pipelines:\n  - {name: a, register_type: UNKNOWN, sinks: [{type: log}]}\n",
+		},
+		{
+			name:        "Invalid Sink",
+			description: "File sinks without a path should be rejected",
+			file:        "pipelines.yaml",
+			content:     "pipelines:\n  - {name: a, register_type: GETH_BLOCK, sinks: [{type: file}]}\n",
+		},
+		{
+			name:        "Unsupported Extension",
+			description: "Files that are neither YAML nor JSON should be rejected",
+			file:        "pipelines.toml",
+			content:     "",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			defs, err := LoadDefinitions(path)
+			if !tc.valid {
+				assert.Error(t, err, tc.description)
+				return
+			}
+
+			assert.NoError(t, err, tc.description)
+			assert.Equal(t, &Definitions{Pipelines: []PipelineDefinition{{
+				Name: "creations",
+				PipelineRequest: PipelineRequest{
+					Network:      models.Layer1,
+					RegisterType: registry.ContractCreateTX,
+					OracleType:   pipeline.BacktestOracle,
+					StartHeight:  big.NewInt(10),
+					EndHeight:    big.NewInt(20),
+					Params:       models.Params{"window_size": float64(5)},
+				},
+				Sinks: []sink.Config{{Type: sink.LogSink}, {Type: sink.FileSink, Path: "out.jsonl"}},
+			}}}, defs)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package etl
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+const (
+	// OutputNodeType ... Node type of the channels that pipelines write their terminal data to; every other
+	// node is a component
+	OutputNodeType = "output"
+)
+
+// GraphNode ... Component, or pipeline output channel, within the component graph
+type GraphNode struct {
+	ID       string              `json:"id"`
+	Type     string              `json:"type"`
+	Network  models.Network      `json:"network,omitempty"`
+	Register models.RegisterType `json:"register,omitempty"`
+
+	// Pipelines containing the node in ascending order
+	Pipelines []PipelineID `json:"pipelines"`
+	// Read activity of oracles; empty for every other node
+	State models.PipelineState `json:"state,omitempty"`
+}
+
+// GraphEdge ... Output directive from a producer component onto a consumer's input channel
+type GraphEdge struct {
+	Directive int        `json:"directive"`
+	From      string     `json:"from"`
+	To        string     `json:"to"`
+	Pipeline  PipelineID `json:"pipeline"`
+	// False while the owning pipeline is paused and the directive is detached
+	Attached bool `json:"attached"`
+}
+
+// GraphPipeline ... Pipeline summary within the component graph
+type GraphPipeline struct {
+	ID       PipelineID           `json:"id"`
+	Register models.RegisterType  `json:"register"`
+	State    models.PipelineState `json:"state"`
+}
+
+// Graph ... Point in time snapshot of every pipeline's wired components
+type Graph struct {
+	Pipelines []GraphPipeline `json:"pipelines"`
+	Nodes     []GraphNode     `json:"nodes"`
+	Edges     []GraphEdge     `json:"edges"`
+}
+
+// outputNodeID ... Returns the node ID of the pipeline's output channel
+func outputNodeID(id PipelineID) string {
+	return fmt.Sprintf("%s:%d", OutputNodeType, id)
+}
+
+// Graph ... Returns a snapshot of the components, directives and states of every pipeline; nodes are
+// listed in pipeline creation & dependency order and edges in the order their directives were created
+func (m *Manager) Graph() Graph {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := make([]PipelineID, 0, len(m.pipelines))
+	for id := range m.pipelines {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	consumers := make(map[chan models.TransitData]models.ComponentID, len(m.inputs))
+	for cid, inputChan := range m.inputs {
+		consumers[inputChan] = cid
+	}
+
+	g := Graph{
+		Pipelines: make([]GraphPipeline, 0, len(ids)),
+		Nodes:     make([]GraphNode, 0),
+		Edges:     make([]GraphEdge, 0),
+	}
+	nodes := make(map[string]int)
+
+	for _, id := range ids {
+		p := m.pipelines[id]
+
+		state, _ := m.states.state(id)
+		g.Pipelines = append(g.Pipelines, GraphPipeline{ID: id, Register: p.Cfg.DataType, State: state})
+
+		for _, component := range p.Components {
+			cid := component.ID()
+			if i, found := nodes[cid.String()]; found {
+				g.Nodes[i].Pipelines = append(g.Nodes[i].Pipelines, id)
+				continue
+			}
+
+			node := GraphNode{
+				ID:        cid.String(),
+				Type:      component.Type().String(),
+				Network:   cid.Network,
+				Register:  cid.RegisterType,
+				Pipelines: []PipelineID{id},
+			}
+			if component.Type() == models.Oracle {
+				node.State = m.states.activityOf(cid)
+			}
+
+			nodes[node.ID] = len(g.Nodes)
+			g.Nodes = append(g.Nodes, node)
+		}
+
+		output := outputNodeID(id)
+		nodes[output] = len(g.Nodes)
+		g.Nodes = append(g.Nodes, GraphNode{ID: output, Type: OutputNodeType, Pipelines: []PipelineID{id}})
+
+		for _, e := range p.edges {
+			to := output
+			if cid, found := consumers[e.outChan]; found {
+				to = cid.String()
+			}
+
+			g.Edges = append(g.Edges, GraphEdge{
+				Directive: e.id,
+				From:      e.producer.ID().String(),
+				To:        to,
+				Pipeline:  id,
+				Attached:  !p.paused,
+			})
+		}
+	}
+
+	sort.Slice(g.Edges, func(i, j int) bool { return g.Edges[i].Directive < g.Edges[j].Directive })
+	return g
+}
+
+// JSON ... Serializes the graph as indented JSON
+func (g Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// DOT ... Serializes the graph in the Graphviz DOT language; detached directives are drawn dashed
+func (g Graph) DOT() string {
+	var sb strings.Builder
+
+	sb.WriteString("digraph pessimism {\n")
+	sb.WriteString("  rankdir=LR;\n")
+
+	for _, node := range g.Nodes {
+		label := node.ID
+		shape := "box"
+
+		if node.Type == OutputNodeType {
+			shape = "ellipse"
+		} else {
+			label = fmt.Sprintf("%s\\n%s %s", node.Register, node.Network, node.Type)
+			if node.State != "" {
+				label = fmt.Sprintf("%s\\n%s", label, node.State)
+			}
+		}
+
+		fmt.Fprintf(&sb, "  %s [label=\"%s\", shape=%s];\n", quoteDOT(node.ID), label, shape)
+	}
+
+	for _, e := range g.Edges {
+		style := "solid"
+		if !e.Attached {
+			style = "dashed"
+		}
+
+		fmt.Fprintf(&sb, "  %s -> %s [label=\"%d\", style=%s];\n", quoteDOT(e.From), quoteDOT(e.To), e.Directive, style)
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// quoteDOT ... Returns the ID as a quoted DOT identifier
+func quoteDOT(id string) string {
+	return "\"" + strings.ReplaceAll(id, "\"", "\\\"") + "\""
+}
@@ -0,0 +1,90 @@
+package etl
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Manager_Graph(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	newCfg := func(rt models.RegisterType) *PipelineConfig {
+		return &PipelineConfig{
+			Network:    models.Layer1,
+			DataType:   rt,
+			OracleType: pipeline.LiveOracle,
+			OracleCfg:  &config.OracleConfig{RPCEndpoint: "endpoint"},
+		}
+	}
+
+	first, err := manager.CreatePipeline(newCfg(registry.ContractCreateTX), make(chan models.TransitData, 10))
+	assert.NoError(t, err)
+
+	second, err := manager.CreatePipeline(newCfg(registry.LowActivityBlock), make(chan models.TransitData, 10))
+	assert.NoError(t, err)
+	assert.NoError(t, manager.PausePipeline(second))
+
+	p1, err := manager.GetPipeline(first)
+	assert.NoError(t, err)
+	p2, err := manager.GetPipeline(second)
+	assert.NoError(t, err)
+
+	g := manager.Graph()
+	assert.Len(t, g.Pipelines, 2)
+	assert.Equal(t, models.PausedState, g.Pipelines[1].State)
+
+	// Shared oracle, two pipes and two outputs
+	assert.Len(t, g.Nodes, 5)
+	oracle := g.Nodes[0]
+	assert.Equal(t, p1.Components[0].ID().String(), oracle.ID)
+	assert.Equal(t, "oracle", oracle.Type)
+	assert.Equal(t, []PipelineID{first, second}, oracle.Pipelines, "Ensuring shared nodes list every pipeline")
+	assert.NotEmpty(t, oracle.State)
+
+	assert.Equal(t, []GraphEdge{
+		{Directive: 1, From: oracle.ID, To: p1.Components[1].ID().String(), Pipeline: first, Attached: true},
+		{Directive: 2, From: p1.Components[1].ID().String(), To: outputNodeID(first), Pipeline: first, Attached: true},
+		{Directive: 3, From: oracle.ID, To: p2.Components[1].ID().String(), Pipeline: second},
+		{Directive: 4, From: p2.Components[1].ID().String(), To: outputNodeID(second), Pipeline: second},
+	}, g.Edges)
+
+	raw, err := g.JSON()
+	assert.NoError(t, err)
+
+	var decoded Graph
+	assert.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, g, decoded, "Ensuring the JSON export round trips")
+
+	dot := g.DOT()
+	assert.True(t, strings.HasPrefix(dot, "digraph pessimism {"))
+	assert.Contains(t, dot, "\""+oracle.ID+"\" -> \""+p1.Components[1].ID().String()+"\" [label=\"1\", style=solid];")
+	assert.Contains(t, dot, "[label=\"4\", style=dashed];", "Ensuring detached directives are dashed")
+}
@@ -0,0 +1,1146 @@
+package etl
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/checkpoint"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// ErrorTopic ... Event bus topic that pipeline.ComponentError payloads are published to
+	ErrorTopic events.Topic = "component_error"
+
+	// errorBufferSize ... Number of component errors queued before further errors are dropped
+	errorBufferSize = 100
+	// defaultReplayTimeout ... Period a replayed dead letter waits for its component to read it
+	defaultReplayTimeout = 5 * time.Second
+)
+
+// PipelineID ... Unique identifier assigned to every pipeline constructed by the manager
+type PipelineID int
+
+// ClientFactory ... Constructs a new client for every oracle component
+type ClientFactory = func() client.EthClientInterface
+
+// PipelineConfig ... Configuration used to construct a pipeline for a terminal register type
+type PipelineConfig struct {
+	Network    models.Network
+	DataType   models.RegisterType
+	OracleType pipeline.OracleType
+	OracleCfg  *config.OracleConfig
+	// Params passed to the terminal register component; dependency components only receive register params
+	Params models.Params
+
+	// Output queueing used by every component constructed for the pipeline; components are only shared
+	// between pipelines using the same queueing
+	BufferSize int
+	Overflow   pipeline.OverflowPolicy
+	// Number of transit data grouped per channel send; consumers of batched pipelines must
+	// unwrap outputs using models.Unbatch
+	BatchSize int
+
+	// Number of concurrent transforms run by every stateless pipe constructed for the pipeline;
+	// stateful pipes always transform sequentially
+	Workers       int
+	DeliveryOrder pipeline.DeliveryOrder
+
+	// Filters applied in order to the terminal register's data before it's written to the output
+	Filters []FilterConfig
+
+	// Priority of every directive added by the pipeline; high priority pipelines are served ahead of
+	// other pipelines reading from the same shared components
+	Priority models.Priority
+}
+
+// FilterConfig ... Configuration used to construct a filter component
+type FilterConfig struct {
+	Type   registry.FilterType
+	Params models.Params
+}
+
+// Pipeline ... Set of wired components that produce the terminal register's data
+type Pipeline struct {
+	ID  PipelineID
+	Cfg *PipelineConfig
+
+	// Components in construction order; the terminal register component is followed by the pipeline's filters
+	Components []pipeline.Component
+
+	// Directives added by the pipeline onto its own or shared components; detached while paused
+	edges  []edge
+	paused bool
+}
+
+// edge ... Output directive from a producer component onto a consumer's channel
+type edge struct {
+	producer pipeline.Component
+	id       int
+	outChan  chan models.TransitData
+	priority models.Priority
+}
+
+// attach ... Adds the directive to the producer's router using the edge's priority
+func (e edge) attach() error {
+	if err := e.producer.AddDirective(e.id, e.outChan); err != nil {
+		return err
+	}
+
+	if e.priority == models.NormalPriority {
+		return nil
+	}
+
+	prioritizable, ok := e.producer.(pipeline.Prioritizable)
+	if !ok {
+		return nil
+	}
+
+	return prioritizable.SetPriority(e.id, e.priority)
+}
+
+// detach ... Removes the directive from the producer's router
+func (e edge) detach() error {
+	return e.producer.RemoveDirective(e.id)
+}
+
+// ManagerOption ...
+type ManagerOption = func(*Manager)
+
+// WithCheckpointStore ... Persists oracle heights to the store so that pipelines resume where they left off
+func WithCheckpointStore(store checkpoint.Store) ManagerOption {
+	return func(m *Manager) {
+		m.checkpoints = store
+	}
+}
+
+// WithEventBus ... Publishes pipeline state transitions to the bus rather than a manager owned bus
+func WithEventBus(bus *events.Bus) ManagerOption {
+	return func(m *Manager) {
+		m.bus = bus
+	}
+}
+
+// WithRegisterParams ... Passes the params to every component of their register type; params of pipeline
+// configs take precedence
+func WithRegisterParams(params map[models.RegisterType]models.Params) ManagerOption {
+	return func(m *Manager) {
+		m.registerParams = make(map[models.RegisterType]models.Params, len(params))
+		for rt, p := range params {
+			m.registerParams[rt] = p
+		}
+	}
+}
+
+// WithBackoff ... Retries the failed RPC calls of every oracle with the backoff; the oracle config's number of
+// retries takes precedence when positive. Failed calls aren't retried by default
+func WithBackoff(backoff client.Backoff) ManagerOption {
+	return func(m *Manager) {
+		m.backoff = backoff
+	}
+}
+
+// WithTimeouts ... Bounds every RPC call attempt of every oracle by the timeout of its kind; calls are only
+// bounded by their callers by default
+func WithTimeouts(timeouts client.Timeouts) ManagerOption {
+	return func(m *Manager) {
+		m.timeouts = timeouts
+	}
+}
+
+// WithLimiters ... Shares the RPC endpoint rate limiters with other clients (E.G, those of invariants); the
+// limits of the manager's networks are set on them
+func WithLimiters(limiters *client.Limiters) ManagerOption {
+	return func(m *Manager) {
+		m.limiters = limiters
+	}
+}
+
+// WithFetchBatchSize ... Fetches blocks in batches of size per RPC request while backtesting pipelines
+// submitted to the manager; blocks are fetched individually by default
+func WithFetchBatchSize(size int) ManagerOption {
+	return func(m *Manager) {
+		m.fetchBatchSize = size
+	}
+}
+
+// WithBlockCache ... Serves the headers & blocks read by oracles from the cache so that pipelines reading the
+// same network only fetch each block once; blocks aren't cached by default
+func WithBlockCache(cache *client.BlockCache) ManagerOption {
+	return func(m *Manager) {
+		m.cache = cache
+	}
+}
+
+// WithMetrics ... Records the outcome & latency of every RPC call sent by oracles; calls aren't recorded by default
+func WithMetrics(metrics *client.Metrics) ManagerOption {
+	return func(m *Manager) {
+		m.metrics = metrics
+	}
+}
+
+// Manager ... ETL subsystem used to construct, wire and run pipelines
+type Manager struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	newClient ClientFactory
+
+	// Optional; oracle heights aren't checkpointed when nil
+	checkpoints checkpoint.Store
+	// Network settings used by pipelines created through SubmitPipeline
+	networks map[models.Network]config.NetworkConfig
+	// Backoff between the retries of failed oracle RPC calls
+	backoff client.Backoff
+	// Upper bounds on every oracle RPC call attempt by kind
+	timeouts client.Timeouts
+	// Rate limiters of every RPC endpoint read by oracles
+	limiters *client.Limiters
+	// Blocks fetched per batched RPC request by backtesting oracles of submitted pipelines
+	fetchBatchSize int
+	// Optional; headers & blocks read by oracles aren't cached when nil
+	cache *client.BlockCache
+	// Optional; RPC calls sent by oracles aren't recorded when nil
+	metrics *client.Metrics
+	// Params of every component of a register type; merged under pipeline config params
+	registerParams map[models.RegisterType]models.Params
+
+	bus    *events.Bus
+	states *stateTracker
+
+	// Errors reported by every component; routed to the bus
+	errs chan pipeline.ComponentError
+	// Inputs that components failed to process; replayed onto the component's input channel
+	deadLetters *deadLetterQueue
+
+	mu        sync.RWMutex
+	waitGroup *sync.WaitGroup
+
+	nextID    int
+	nextDirID int
+	pipelines map[PipelineID]*Pipeline
+
+	// Running components that can be reused by subsequently created pipelines
+	shared map[componentKey]pipeline.Component
+	// Input channels of every component that reads from upstream components
+	inputs map[models.ComponentID]chan models.TransitData
+	// Context every register component was constructed with; cancelling it releases resources bound to it
+	contexts map[models.ComponentID]componentContext
+
+	// Period a replayed dead letter waits for its component to read it before failing
+	replayTimeout time.Duration
+}
+
+// componentContext ... Context a register component was constructed with; done once the component is closed
+type componentContext struct {
+	done   <-chan struct{}
+	cancel context.CancelFunc
+}
+
+// NewManager ... Initializer
+func NewManager(ctx context.Context, newClient ClientFactory, opts ...ManagerOption) *Manager {
+	ctx, cancel := context.WithCancel(ctx)
+
+	m := &Manager{
+		ctx:       ctx,
+		cancel:    cancel,
+		newClient: newClient,
+		waitGroup: &sync.WaitGroup{},
+		networks:  make(map[models.Network]config.NetworkConfig),
+		pipelines: make(map[PipelineID]*Pipeline),
+		shared:    make(map[componentKey]pipeline.Component),
+		errs:      make(chan pipeline.ComponentError, errorBufferSize),
+		inputs:    make(map[models.ComponentID]chan models.TransitData),
+		contexts:  make(map[models.ComponentID]componentContext),
+
+		replayTimeout: defaultReplayTimeout,
+
+		deadLetters: newDeadLetterQueue(),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.bus == nil {
+		m.bus = events.NewBus()
+	}
+
+	if m.limiters == nil {
+		m.limiters = client.NewLimiters()
+	}
+
+	for _, nc := range m.networks {
+		m.limiters.SetLimit(nc.RPCEndpoint, nc.RateLimit)
+	}
+	m.states = newStateTracker(m.bus)
+
+	m.waitGroup.Add(1)
+	go m.routeErrors()
+
+	return m
+}
+
+// routeErrors ... Logs & publishes every component error to the bus until the manager is shut down
+func (m *Manager) routeErrors() {
+	defer m.waitGroup.Done()
+
+	for {
+		select {
+		case cErr := <-m.errs:
+			logging.WithContext(m.ctx).Warn("Received component error",
+				zap.String("component_id", cErr.ComponentID.String()), zap.String("kind", cErr.Kind.String()),
+				zap.Error(cErr.Err))
+
+			m.deadLetters.add(cErr)
+			m.bus.Publish(ErrorTopic, cErr)
+
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// Events ... Returns the bus that pipeline state transitions & component errors are published to
+func (m *Manager) Events() *events.Bus {
+	return m.bus
+}
+
+// GetState ... Returns the pipeline's lifecycle state; fail if no pipeline exists
+func (m *Manager) GetState(id PipelineID) (models.PipelineState, error) {
+	state, found := m.states.state(id)
+	if !found {
+		return "", fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	return state, nil
+}
+
+// States ... Returns the lifecycle state of every pipeline
+func (m *Manager) States() map[PipelineID]models.PipelineState {
+	return m.states.states()
+}
+
+// newDirectiveID ... Returns a manager unique output directive ID
+func (m *Manager) newDirectiveID() int {
+	m.nextDirID++
+	return m.nextDirID
+}
+
+// newOracleClient ... Returns the client of an oracle; subscriptions are sent over a separate connection to
+// the oracle's WS endpoint when configured
+func (m *Manager) newOracleClient(oc *config.OracleConfig) client.EthClientInterface {
+	backoff := m.backoff
+	if oc != nil && oc.NumOfRetries > 0 {
+		backoff.Retries = oc.NumOfRetries
+	}
+
+	meter := func(ec client.EthClientInterface) client.EthClientInterface {
+		if m.metrics == nil {
+			return ec
+		}
+
+		return client.NewMeteredClient(ec, m.metrics)
+	}
+
+	// Retries are rate limited & recorded along with every other request; attempts are bounded once sent
+	var ec client.EthClientInterface = client.NewRetryClient(client.NewRateLimitedClient(
+		client.NewTimeoutClient(meter(m.newClient()), m.timeouts), m.limiters), backoff)
+
+	// Chain IDs are read with retries, mismatches fail the dial at once
+	verify := func(ec client.EthClientInterface) client.EthClientInterface {
+		if oc == nil || oc.ChainID == 0 {
+			return ec
+		}
+
+		return client.NewChainVerifiedClient(ec, oc.ChainID)
+	}
+
+	ec = verify(ec)
+	if oc != nil && oc.WSEndpoint != "" {
+		ec = client.NewDualClient(ec, verify(meter(m.newClient())), oc.WSEndpoint)
+	}
+
+	// Cached headers & blocks are served without waiting for the rate limiter
+	if m.cache != nil {
+		ec = client.NewCachedClient(ec, m.cache)
+	}
+
+	return ec
+}
+
+// oracleConfig ... Returns a copy of the oracle config using the manager's RPC backoff unless one is set
+func (m *Manager) oracleConfig(oc *config.OracleConfig) *config.OracleConfig {
+	if oc == nil {
+		return nil
+	}
+
+	copied := *oc
+	if copied.Backoff == (client.Backoff{}) {
+		copied.Backoff = m.backoff
+	}
+
+	return &copied
+}
+
+// constructComponent ... Constructs a single register component using its declared constructor type
+func (m *Manager) constructComponent(ctx context.Context, dr *registry.DataRegister, cfg *PipelineConfig,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	switch dr.ComponentType {
+	case models.Oracle:
+		init, ok := dr.ComponentConstructor.(pipeline.OracleConstructor)
+		if !ok {
+			return nil, fmt.Errorf("could not read oracle constructor for register: %s", dr.DataType)
+		}
+
+		return init(ctx, cfg.OracleType, m.oracleConfig(cfg.OracleCfg), m.newOracleClient(cfg.OracleCfg), params)
+
+	case models.Pipe:
+		init, ok := dr.ComponentConstructor.(pipeline.PipeConstructorFunc)
+		if !ok {
+			return nil, fmt.Errorf("could not read pipe constructor for register: %s", dr.DataType)
+		}
+
+		return init(ctx, inputChan, params)
+
+	default:
+		return nil, fmt.Errorf("unsupported component type for register: %s", dr.DataType)
+	}
+}
+
+// builtFilter ... Filter component along with the channel it reads from
+type builtFilter struct {
+	pipeline.Component
+	inputChan chan models.TransitData
+}
+
+// filterPredicates ... Constructs the predicates of every configured filter
+func filterPredicates(cfg *PipelineConfig) ([]pipeline.Predicate, error) {
+	predicates := make([]pipeline.Predicate, 0, len(cfg.Filters))
+
+	for _, fc := range cfg.Filters {
+		fr, err := registry.GetFilter(fc.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		predicate, err := fr.Constructor(fc.Params)
+		if err != nil {
+			return nil, fmt.Errorf("could not construct %s filter: %w", fc.Type, err)
+		}
+
+		predicates = append(predicates, predicate)
+	}
+
+	return predicates, nil
+}
+
+// constructFilter ... Constructs and configures a filter component for the pipeline's terminal register data
+func (m *Manager) constructFilter(cfg *PipelineConfig, predicate pipeline.Predicate) (*builtFilter, error) {
+	inputChan := models.NewTransitChannel()
+
+	filter, err := pipeline.NewFilter(m.ctx, predicate, inputChan)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.SetID(models.NewComponentID(cfg.Network, models.PipelineType(cfg.OracleType), cfg.DataType))
+	filter.SetErrorChannel(m.errs)
+
+	if rErr := filter.Configure(pipeline.WithBufferSize(cfg.BufferSize),
+		pipeline.WithOverflowPolicy(cfg.Overflow), pipeline.WithBatchSize(cfg.BatchSize)); rErr != nil {
+		filter.Close()
+		return nil, rErr
+	}
+
+	return &builtFilter{Component: filter, inputChan: inputChan}, nil
+}
+
+// componentKey ... Identifies components that produce identical data and can therefore be shared across
+// pipelines; every config that changes how a component reads, queues or emits data is part of the key
+type componentKey struct {
+	network      models.Network
+	pipelineType models.PipelineType
+	registerType models.RegisterType
+
+	bufferSize    int
+	overflow      pipeline.OverflowPolicy
+	batchSize     int
+	workers       int
+	deliveryOrder pipeline.DeliveryOrder
+
+	endpoint          string
+	wsEndpoint        string
+	chainID           uint64
+	startHeight       string
+	endHeight         string
+	numOfRetries      int
+	oracleBatchSize   int
+	fetchBatchSize    int
+	confirmationDepth uint64
+	pollInterval      time.Duration
+}
+
+// newComponentKey ... Returns the sharing key for a register component constructed using the pipeline config
+func newComponentKey(cfg *PipelineConfig, rt models.RegisterType) componentKey {
+	key := componentKey{
+		network:      cfg.Network,
+		pipelineType: models.PipelineType(cfg.OracleType),
+		registerType: rt,
+
+		bufferSize:    cfg.BufferSize,
+		overflow:      cfg.Overflow,
+		batchSize:     cfg.BatchSize,
+		workers:       cfg.Workers,
+		deliveryOrder: cfg.DeliveryOrder,
+	}
+
+	oc := cfg.OracleCfg
+	if oc == nil {
+		return key
+	}
+
+	key.endpoint, key.wsEndpoint, key.chainID = oc.RPCEndpoint, oc.WSEndpoint, oc.ChainID
+	if oc.StartHeight != nil {
+		key.startHeight = oc.StartHeight.String()
+	}
+	if oc.EndHeight != nil {
+		key.endHeight = oc.EndHeight.String()
+	}
+
+	key.numOfRetries = oc.NumOfRetries
+	key.oracleBatchSize, key.fetchBatchSize = oc.BatchSize, oc.FetchBatchSize
+	key.confirmationDepth, key.pollInterval = oc.ConfirmationDepth, oc.PollInterval
+
+	return key
+}
+
+// checkpointKey ... Returns a restart stable checkpoint key; heights are excluded so that checkpoints take
+// precedence over the configured start height and the endpoint is hashed to keep credentials out of the store
+func (key componentKey) checkpointKey() string {
+	endpoint := sha256.Sum256([]byte(key.endpoint))
+	return fmt.Sprintf("%s:%s:%s:%x", key.network, key.pipelineType, key.registerType, endpoint[:8])
+}
+
+// CreatePipeline ... Walks the terminal register's dependency chain, constructs every component, wires
+// their channels and starts their event loops; terminal register data is written to the output channel.
+// Components constructed without pipeline config params are shared with any existing pipeline that uses the same
+// network, pipeline type, queueing and oracle config rather than being constructed again
+func (m *Manager) CreatePipeline(cfg *PipelineConfig, output chan models.TransitData) (PipelineID, error) {
+	path, err := registry.GetDependencyPath(cfg.DataType)
+	if err != nil {
+		return 0, err
+	}
+
+	if cfg.OracleCfg != nil {
+		if vErr := cfg.OracleCfg.Validate(); vErr != nil {
+			return 0, vErr
+		}
+	}
+
+	// Filters are resolved up front so that invalid filter configs fail before any component is constructed
+	predicates, err := filterPredicates(cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Shutdown cancels the context while holding the lock so no pipeline can be created afterwards
+	if m.ctx.Err() != nil {
+		return 0, fmt.Errorf("manager is shut down")
+	}
+
+	components := make([]pipeline.Component, 0, len(path))
+	built := make(map[models.RegisterType]pipeline.Component, len(path))
+
+	created := make([]pipeline.Component, 0, len(path))
+	createdKeys := make(map[componentKey]pipeline.Component)
+	isNew := make(map[models.RegisterType]bool, len(path))
+
+	edges := make([]edge, 0, len(path))
+
+	// Directives onto already running components are only added once every new component is running
+	runningEdges := make([]edge, 0)
+
+	// Failures before the pipeline is registered undo the partial assembly; nothing has been started yet
+	committed := false
+	defer func() {
+		if committed {
+			return
+		}
+
+		for _, e := range edges {
+			_ = e.detach()
+		}
+
+		for i := len(created) - 1; i >= 0; i-- {
+			m.closeComponent(created[i])
+		}
+	}()
+
+	for i, dr := range path {
+		var params models.Params
+		if i == len(path)-1 {
+			params = cfg.Params
+		}
+
+		key := newComponentKey(cfg, dr.DataType)
+		// Register params are the same for every pipeline so only pipeline config params prevent sharing
+		shareable := len(params) == 0
+		params = m.registerParams[dr.DataType].Merge(params)
+
+		if shared, found := m.shared[key]; shareable && found {
+			logging.WithContext(m.ctx).Debug("Reusing shared component",
+				zap.String("component_id", shared.ID().String()))
+
+			built[dr.DataType] = shared
+			components = append(components, shared)
+			continue
+		}
+
+		inputChan := models.NewTransitChannel()
+
+		ctx, cancel := context.WithCancel(m.ctx)
+		component, cErr := m.constructComponent(ctx, dr, cfg, inputChan, params)
+		if cErr != nil {
+			cancel()
+			return 0, cErr
+		}
+
+		component.SetID(models.NewComponentID(cfg.Network, models.PipelineType(cfg.OracleType), dr.DataType))
+		component.SetErrorChannel(m.errs)
+
+		m.contexts[component.ID()] = componentContext{done: ctx.Done(), cancel: cancel}
+		if component.Type() != models.Oracle {
+			m.inputs[component.ID()] = inputChan
+		}
+		created = append(created, component)
+
+		if reporter, ok := component.(pipeline.ActivityReporter); ok {
+			cid := component.ID()
+			reporter.OnActivity(func(state models.PipelineState) {
+				m.states.oracleActivity(cid, state)
+			})
+		}
+
+		if m.checkpoints != nil && component.Type() == models.Oracle {
+			if cp, ok := component.(pipeline.Checkpointable); ok && cp.SupportsCheckpoints() {
+				if cpErr := cp.EnableCheckpoints(m.checkpoints, key.checkpointKey()); cpErr != nil {
+					return 0, cpErr
+				}
+			}
+		}
+
+		if rErr := component.Configure(pipeline.WithBufferSize(cfg.BufferSize),
+			pipeline.WithOverflowPolicy(cfg.Overflow), pipeline.WithBatchSize(cfg.BatchSize)); rErr != nil {
+			return 0, rErr
+		}
+
+		if pool, ok := component.(pipeline.Parallelizable); ok && cfg.Workers > 1 && !dr.Stateful {
+			if wErr := pool.SetWorkers(cfg.Workers, cfg.DeliveryOrder); wErr != nil {
+				return 0, wErr
+			}
+		}
+
+		// Subscribe the component's input to each of its dependencies
+		for _, dep := range dr.DependencyTypes() {
+			e := edge{producer: built[dep], id: m.newDirectiveID(), outChan: inputChan, priority: cfg.Priority}
+			if !isNew[dep] {
+				runningEdges = append(runningEdges, e)
+				continue
+			}
+
+			if dErr := e.attach(); dErr != nil {
+				return 0, dErr
+			}
+			edges = append(edges, e)
+		}
+
+		if shareable {
+			createdKeys[key] = component
+		}
+
+		built[dr.DataType] = component
+		isNew[dr.DataType] = true
+		components = append(components, component)
+	}
+
+	// Filters are never shared as they're specific to the pipeline's output
+	producerIsNew := isNew[cfg.DataType]
+	for _, predicate := range predicates {
+		filter, fErr := m.constructFilter(cfg, predicate)
+		if fErr != nil {
+			return 0, fErr
+		}
+
+		m.inputs[filter.ID()] = filter.inputChan
+		created = append(created, filter.Component)
+
+		e := edge{producer: components[len(components)-1], id: m.newDirectiveID(), outChan: filter.inputChan,
+			priority: cfg.Priority}
+		if producerIsNew {
+			if dErr := e.attach(); dErr != nil {
+				return 0, dErr
+			}
+			edges = append(edges, e)
+		} else {
+			runningEdges = append(runningEdges, e)
+		}
+
+		producerIsNew = true
+		components = append(components, filter.Component)
+	}
+
+	terminal := edge{producer: components[len(components)-1], id: m.newDirectiveID(), outChan: output,
+		priority: cfg.Priority}
+	if producerIsNew {
+		if dErr := terminal.attach(); dErr != nil {
+			return 0, dErr
+		}
+		edges = append(edges, terminal)
+	} else {
+		runningEdges = append(runningEdges, terminal)
+	}
+
+	committed = true
+
+	m.nextID++
+	id := PipelineID(m.nextID)
+
+	p := &Pipeline{
+		ID:         id,
+		Cfg:        cfg,
+		Components: components,
+	}
+	m.pipelines[id] = p
+
+	for key, component := range createdKeys {
+		m.shared[key] = component
+	}
+
+	oracles := make([]models.ComponentID, 0)
+	for _, component := range components {
+		if component.Type() == models.Oracle {
+			oracles = append(oracles, component.ID())
+		}
+	}
+	m.states.track(id, oracles)
+
+	// Start consumers before producers so that no producer blocks on an unread channel
+	for i := len(created) - 1; i >= 0; i-- {
+		m.runComponent(id, created[i])
+	}
+
+	for _, e := range runningEdges {
+		if dErr := e.attach(); dErr != nil {
+			// The pipeline is already running so it's torn down like any other rather than rolled back
+			p.edges = edges
+			if rErr := m.removePipeline(p); rErr != nil {
+				logging.WithContext(m.ctx).Error("Could not remove partially attached pipeline",
+					zap.Int("id", int(id)), zap.Error(rErr))
+			}
+
+			return 0, dErr
+		}
+		edges = append(edges, e)
+	}
+
+	p.edges = edges
+
+	logging.WithContext(m.ctx).Info("Created pipeline",
+		zap.Int("id", int(id)), zap.String("type", string(cfg.DataType)),
+		zap.Int("components", len(components)), zap.Int("shared", len(components)-len(created)))
+
+	return id, nil
+}
+
+// runComponent ... Spawns the component event loop on a separate go routine
+func (m *Manager) runComponent(id PipelineID, component pipeline.Component) {
+	m.waitGroup.Add(1)
+
+	go func() {
+		defer m.waitGroup.Done()
+
+		if err := component.EventLoop(); err != nil {
+			logging.WithContext(m.ctx).Error("Received error from component event loop",
+				zap.Int("pipeline", int(id)), zap.String("component_id", component.ID().String()), zap.Error(err))
+		}
+	}()
+}
+
+// GetPipeline ... Returns the pipeline for the ID; fail if no pipeline exists
+func (m *Manager) GetPipeline(id PipelineID) (*Pipeline, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p, found := m.pipelines[id]
+	if !found {
+		return nil, fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	return p, nil
+}
+
+// ComponentStats ... Returns the throughput & latency of every component within the pipeline in dependency
+// order; statistics of shared components cover every pipeline containing them
+func (m *Manager) ComponentStats(id PipelineID) ([]pipeline.ComponentStats, error) {
+	p, err := m.GetPipeline(id)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]pipeline.ComponentStats, 0, len(p.Components))
+	for _, component := range p.Components {
+		if reporter, ok := component.(pipeline.StatsReporter); ok {
+			stats = append(stats, reporter.Stats())
+		}
+	}
+
+	return stats, nil
+}
+
+// Stats ... Returns the throughput & latency of every running component keyed by ID; shared components are
+// reported once
+func (m *Manager) Stats() map[models.ComponentID]pipeline.ComponentStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make(map[models.ComponentID]pipeline.ComponentStats)
+	for _, p := range m.pipelines {
+		for _, component := range p.Components {
+			if _, found := stats[component.ID()]; found {
+				continue
+			}
+
+			if reporter, ok := component.(pipeline.StatsReporter); ok {
+				stats[component.ID()] = reporter.Stats()
+			}
+		}
+	}
+
+	return stats
+}
+
+// PausePipeline ... Freezes ingestion for the pipeline; its directives are detached so that shared
+// components keep serving other pipelines, and oracles used only by paused pipelines stop reading while
+// retaining their height cursor. Data produced by shared components while paused is not delivered
+func (m *Manager) PausePipeline(id PipelineID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, found := m.pipelines[id]
+	if !found {
+		return fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	if p.paused {
+		return fmt.Errorf("pipeline %d is already paused", id)
+	}
+
+	p.paused = true
+
+	for _, e := range p.edges {
+		if err := e.detach(); err != nil {
+			return err
+		}
+	}
+
+	for _, component := range p.Components {
+		if pausable, ok := component.(pipeline.Pausable); ok && !m.inUse(component) {
+			pausable.Pause()
+		}
+	}
+
+	m.states.setPaused(id, true)
+	logging.WithContext(m.ctx).Info("Paused pipeline", zap.Int("id", int(id)))
+	return nil
+}
+
+// ResumePipeline ... Reattaches the pipeline's directives and resumes its oracles
+func (m *Manager) ResumePipeline(id PipelineID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, found := m.pipelines[id]
+	if !found {
+		return fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	if !p.paused {
+		return fmt.Errorf("pipeline %d is not paused", id)
+	}
+
+	p.paused = false
+
+	for _, e := range p.edges {
+		if err := e.attach(); err != nil {
+			return err
+		}
+	}
+
+	for _, component := range p.Components {
+		if pausable, ok := component.(pipeline.Pausable); ok {
+			pausable.Resume()
+		}
+	}
+
+	m.states.setPaused(id, false)
+	logging.WithContext(m.ctx).Info("Resumed pipeline", zap.Int("id", int(id)))
+	return nil
+}
+
+// componentSet ... Returns the IDs of every component within the pipeline
+func (p *Pipeline) componentSet() map[models.ComponentID]struct{} {
+	set := make(map[models.ComponentID]struct{}, len(p.Components))
+	for _, component := range p.Components {
+		set[component.ID()] = struct{}{}
+	}
+
+	return set
+}
+
+// DeadLetters ... Returns the inputs that the pipeline's components failed to process in arrival order;
+// dead letters of shared components are visible to every pipeline containing them
+func (m *Manager) DeadLetters(id PipelineID) ([]DeadLetter, error) {
+	p, err := m.GetPipeline(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.deadLetters.list(p.componentSet()), nil
+}
+
+// DiscardDeadLetter ... Removes the dead letter from the pipeline's queue
+func (m *Manager) DiscardDeadLetter(id PipelineID, letterID uint64) error {
+	p, err := m.GetPipeline(id)
+	if err != nil {
+		return err
+	}
+
+	if _, found := m.deadLetters.remove(p.componentSet(), letterID); !found {
+		return fmt.Errorf("no dead letter %d exists for pipeline %d", letterID, id)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetter ... Removes the dead letter and resends its input to the component that failed to
+// process it; inputs that fail again are re-added as new dead letters. Fail if the component is closed
+// or doesn't read the input within the replay timeout, in which case the input is re-added
+func (m *Manager) ReplayDeadLetter(id PipelineID, letterID uint64) error {
+	p, err := m.GetPipeline(id)
+	if err != nil {
+		return err
+	}
+
+	letter, found := m.deadLetters.remove(p.componentSet(), letterID)
+	if !found {
+		return fmt.Errorf("no dead letter %d exists for pipeline %d", letterID, id)
+	}
+
+	m.mu.RLock()
+	inputChan, found := m.inputs[letter.ComponentID]
+	cc := m.contexts[letter.ComponentID]
+	m.mu.RUnlock()
+
+	if !found {
+		return fmt.Errorf("component %s has no input to replay dead letters onto", letter.ComponentID)
+	}
+
+	timeout := time.NewTimer(m.replayTimeout)
+	defer timeout.Stop()
+
+	// The manager lock isn't held while sending as the component may be blocked on its own consumers;
+	// the send is abandoned once the component is closed or doesn't read the input in time
+	select {
+	case inputChan <- letter.Input:
+		return nil
+	case <-cc.done:
+		return fmt.Errorf("component %s was closed before dead letter %d was replayed",
+			letter.ComponentID, letterID)
+	case <-timeout.C:
+		// Re-added under a new ID so that the input isn't lost while the component is still running
+		m.deadLetters.add(pipeline.ComponentError{ComponentID: letter.ComponentID, Timestamp: letter.Timestamp,
+			Err: letter.Err, Input: &letter.Input})
+		return fmt.Errorf("component %s didn't read dead letter %d within %s",
+			letter.ComponentID, letterID, m.replayTimeout)
+	case <-m.ctx.Done():
+		return m.ctx.Err()
+	}
+}
+
+// IsPaused ... Returns true if the pipeline is paused; fail if no pipeline exists
+func (m *Manager) IsPaused(id PipelineID) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p, found := m.pipelines[id]
+	if !found {
+		return false, fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	return p.paused, nil
+}
+
+// inUse ... Returns true if any active pipeline contains the component
+func (m *Manager) inUse(component pipeline.Component) bool {
+	for _, p := range m.pipelines {
+		if p.paused {
+			continue
+		}
+
+		for _, c := range p.Components {
+			if c.ID() == component.ID() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RemovePipeline ... Detaches the pipeline's directives and closes every component that isn't used by another
+// pipeline in reverse dependency order; shared components keep serving the remaining pipelines
+func (m *Manager) RemovePipeline(id PipelineID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, found := m.pipelines[id]
+	if !found {
+		return fmt.Errorf("no pipeline exists for id: %d", id)
+	}
+
+	return m.removePipeline(p)
+}
+
+// removePipeline ... Removes the pipeline and closes its unshared components; the write lock must be held
+func (m *Manager) removePipeline(p *Pipeline) error {
+	id := p.ID
+
+	// Paused pipelines have already detached their directives
+	if !p.paused {
+		for _, e := range p.edges {
+			if err := e.detach(); err != nil {
+				return err
+			}
+		}
+	}
+
+	delete(m.pipelines, id)
+
+	// Components are listed in dependency order; consumers are closed before their producers
+	closed := make(map[models.ComponentID]struct{})
+	for i := len(p.Components) - 1; i >= 0; i-- {
+		component := p.Components[i]
+		if m.contains(component) {
+			continue
+		}
+
+		m.closeComponent(component)
+		closed[component.ID()] = struct{}{}
+	}
+
+	for key, component := range m.shared {
+		if _, found := closed[component.ID()]; found {
+			delete(m.shared, key)
+		}
+	}
+
+	// Retained oracles only read by paused pipelines stop reading
+	for _, component := range p.Components {
+		if _, found := closed[component.ID()]; found {
+			continue
+		}
+
+		if pausable, ok := component.(pipeline.Pausable); ok && !m.inUse(component) {
+			pausable.Pause()
+		}
+	}
+
+	m.deadLetters.purge(closed)
+	m.states.terminate(id)
+	m.states.untrack(id)
+
+	logging.WithContext(m.ctx).Info("Removed pipeline",
+		zap.Int("id", int(id)), zap.Int("closed", len(closed)),
+		zap.Int("retained", len(p.Components)-len(closed)))
+	return nil
+}
+
+// closeComponent ... Closes the component and cancels the context it was constructed with so that resources
+// bound to it, such as WASM runtimes, are released
+func (m *Manager) closeComponent(component pipeline.Component) {
+	component.Close()
+
+	if cc, found := m.contexts[component.ID()]; found {
+		cc.cancel()
+		delete(m.contexts, component.ID())
+	}
+
+	delete(m.inputs, component.ID())
+}
+
+// contains ... Returns true if any pipeline, paused or not, contains the component
+func (m *Manager) contains(component pipeline.Component) bool {
+	for _, p := range m.pipelines {
+		for _, c := range p.Components {
+			if c.ID() == component.ID() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Shutdown ... Closes every component in reverse topological order so that consumers are stopped before
+// their producers; shared components are closed once
+func (m *Manager) Shutdown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make([]PipelineID, 0, len(m.pipelines))
+	for id := range m.pipelines {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// Pipelines are created in ID order and every pipeline lists its components in dependency order;
+	// concatenating them yields a topological order across all pipelines
+	seen := make(map[models.ComponentID]struct{})
+	ordered := make([]pipeline.Component, 0)
+
+	for _, id := range ids {
+		for _, component := range m.pipelines[id].Components {
+			if _, found := seen[component.ID()]; found {
+				continue
+			}
+
+			seen[component.ID()] = struct{}{}
+			ordered = append(ordered, component)
+		}
+	}
+
+	for i := len(ordered) - 1; i >= 0; i-- {
+		ordered[i].Close()
+	}
+
+	for _, id := range ids {
+		m.states.terminate(id)
+	}
+
+	m.cancel()
+	m.waitGroup.Wait()
+}
@@ -0,0 +1,746 @@
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Manager_CreatePipeline(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	createTx := types.NewTx(&types.LegacyTx{Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)})
+	block := types.NewBlock(header, []*types.Transaction{createTx}, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, "pass test").Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	output := make(chan models.TransitData)
+
+	id, err := manager.CreatePipeline(&PipelineConfig{
+		Network:    models.Layer1,
+		DataType:   registry.ContractCreateTX,
+		OracleType: pipeline.LiveOracle,
+		OracleCfg: &config.OracleConfig{
+			RPCEndpoint: "pass test",
+			StartHeight: big.NewInt(1),
+			EndHeight:   big.NewInt(1),
+		},
+	}, output)
+	assert.NoError(t, err)
+
+	p, err := manager.GetPipeline(id)
+	assert.NoError(t, err)
+	assert.Len(t, p.Components, 2, "Ensuring oracle and pipe components were constructed")
+	assert.Equal(t, p.Components[0].Type(), models.Oracle)
+	assert.Equal(t, p.Components[1].Type(), models.Pipe)
+	assert.Equal(t, p.Components[0].ID().RegisterType, registry.GethBlock)
+	assert.Equal(t, p.Components[1].ID().Network, models.Layer1)
+	assert.NotEqual(t, p.Components[0].ID().UUID, p.Components[1].ID().UUID)
+
+	select {
+	case td := <-output:
+		assert.Equal(t, td.Type, registry.ContractCreateTX)
+		assert.Equal(t, td.Value.(*types.Transaction).Hash(), createTx.Hash()) //nolint:errcheck // test assertion
+		assert.Equal(t, td.OriginID, p.Components[1].ID(), "Ensuring output is stamped with the terminal component ID")
+		assert.Equal(t, models.Layer1, td.Network)
+		assert.Equal(t, big.NewInt(1), td.Height, "Ensuring block height is propagated from the oracle")
+		assert.Equal(t, uint64(1), td.Sequence)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pipeline output")
+	}
+
+	stats, err := manager.ComponentStats(id)
+	assert.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, p.Components[0].ID(), stats[0].ComponentID)
+	assert.NotZero(t, stats[1].ItemsIn, "Ensuring pipe input is counted")
+
+	// Oracles count outputs once their transit returns, which may trail the pipe's read
+	assert.Eventually(t, func() bool {
+		stats, _ = manager.ComponentStats(id)
+		return stats[0].ItemsOut > 0
+	}, 5*time.Second, 5*time.Millisecond, "Ensuring oracle output is counted")
+
+	all := manager.Stats()
+	assert.Len(t, all, 2)
+	assert.Equal(t, models.Pipe, all[p.Components[1].ID()].Type)
+
+	_, err = manager.CreatePipeline(&PipelineConfig{DataType: "UNKNOWN"}, output)
+	assert.Error(t, err)
+}
+
+func Test_Manager_CreatePipeline_Rollback(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	newCfg := func(rt models.RegisterType, params models.Params) *PipelineConfig {
+		return &PipelineConfig{
+			Network:    models.Layer1,
+			DataType:   rt,
+			OracleType: pipeline.LiveOracle,
+			OracleCfg:  &config.OracleConfig{RPCEndpoint: "endpoint", StartHeight: big.NewInt(1)},
+			Params:     params,
+		}
+	}
+
+	// The oracle & contract creation pipe are constructed and wired before the terminal pipe rejects its params
+	invalid := newCfg(registry.ContractCreationRate, models.Params{registry.CreationWindowParam: 0})
+
+	_, err := manager.CreatePipeline(invalid, make(chan models.TransitData, 10))
+	assert.Error(t, err)
+	assert.Empty(t, manager.pipelines)
+	assert.Empty(t, manager.shared, "Ensuring partially assembled components aren't shared")
+	assert.Empty(t, manager.inputs, "Ensuring partially assembled components are freed")
+	assert.Empty(t, manager.contexts)
+
+	blockID, err := manager.CreatePipeline(newCfg(registry.GethBlock, nil), make(chan models.TransitData, 100))
+	assert.NoError(t, err)
+
+	oracle := manager.pipelines[blockID].Components[0]
+	directives := oracle.Directives()
+
+	_, err = manager.CreatePipeline(invalid, make(chan models.TransitData, 10))
+	assert.Error(t, err)
+	assert.Len(t, manager.pipelines, 1)
+	assert.Len(t, manager.shared, 1, "Ensuring only the existing oracle remains shared")
+	assert.Empty(t, manager.inputs)
+	assert.Len(t, manager.contexts, 1)
+	assert.Equal(t, directives, oracle.Directives(), "Ensuring the shared oracle's directives are untouched")
+
+	// Occupying the ID of the next directive onto the running oracle fails the pipeline after it's started
+	assert.NoError(t, oracle.AddDirective(manager.nextDirID+1, make(chan models.TransitData, 100)))
+
+	_, err = manager.CreatePipeline(newCfg(registry.ContractCreateTX, nil), make(chan models.TransitData, 10))
+	assert.Error(t, err)
+	assert.Len(t, manager.pipelines, 1, "Ensuring the started pipeline is torn down")
+	assert.Len(t, manager.States(), 1, "Ensuring the started pipeline is no longer tracked")
+	assert.Len(t, manager.shared, 1)
+	assert.Empty(t, manager.inputs)
+	assert.Len(t, manager.contexts, 1)
+}
+
+func Test_Manager_SharedComponents(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	newCfg := func(rt models.RegisterType, endpoint string) *PipelineConfig {
+		return &PipelineConfig{
+			Network:    models.Layer1,
+			DataType:   rt,
+			OracleType: pipeline.LiveOracle,
+			OracleCfg: &config.OracleConfig{
+				RPCEndpoint: endpoint,
+				StartHeight: big.NewInt(1),
+				EndHeight:   big.NewInt(1),
+			},
+		}
+	}
+
+	getPipeline := func(cfg *PipelineConfig) *Pipeline {
+		id, err := manager.CreatePipeline(cfg, make(chan models.TransitData, 10))
+		assert.NoError(t, err)
+
+		p, err := manager.GetPipeline(id)
+		assert.NoError(t, err)
+		return p
+	}
+
+	first := getPipeline(newCfg(registry.ContractCreateTX, "endpoint"))
+	second := getPipeline(newCfg(registry.LowActivityBlock, "endpoint"))
+	third := getPipeline(newCfg(registry.LowActivityBlock, "endpoint"))
+	other := getPipeline(newCfg(registry.ContractCreateTX, "other endpoint"))
+
+	assert.Equal(t, first.Components[0].ID(), second.Components[0].ID(), "Ensuring oracle is shared across pipelines")
+	assert.NotEqual(t, first.Components[1].ID(), second.Components[1].ID())
+	assert.Equal(t, second.Components[1].ID(), third.Components[1].ID(), "Ensuring param-less pipes are shared")
+	assert.NotEqual(t, first.Components[0].ID(), other.Components[0].ID(), "Ensuring oracle configs are respected")
+
+	deeper := newCfg(registry.LowActivityBlock, "endpoint")
+	deeper.OracleCfg.ConfirmationDepth = 2
+	assert.NotEqual(t, second.Components[0].ID(), getPipeline(deeper).Components[0].ID(),
+		"Ensuring oracles reading at other confirmation depths aren't shared")
+
+	parallel := newCfg(registry.LowActivityBlock, "endpoint")
+	parallel.Workers, parallel.BufferSize = 4, 100
+	assert.NotEqual(t, second.Components[1].ID(), getPipeline(parallel).Components[1].ID(),
+		"Ensuring pipes with other queueing or workers aren't shared")
+}
+
+func Test_Manager_RegisterParams(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(&types.Header{Number: big.NewInt(1)}, nil)
+	testClient.On("FilterLogs", mock.Anything, mock.Anything).Return([]types.Log{}, nil)
+
+	newClient := func() client.EthClientInterface {
+		return testClient
+	}
+
+	newCfg := func(params models.Params) *PipelineConfig {
+		return &PipelineConfig{
+			Network:    models.Layer1,
+			DataType:   registry.DisputeGame,
+			OracleType: pipeline.LiveOracle,
+			OracleCfg: &config.OracleConfig{
+				RPCEndpoint: "endpoint",
+				StartHeight: big.NewInt(1),
+				EndHeight:   big.NewInt(1),
+			},
+			Params: params,
+		}
+	}
+
+	unconfigured := NewManager(context.Background(), newClient)
+	defer unconfigured.Shutdown()
+
+	_, err := unconfigured.CreatePipeline(newCfg(nil), make(chan models.TransitData, 10))
+	assert.Error(t, err, "Ensuring the factory param is required")
+
+	manager := NewManager(context.Background(), newClient, WithRegisterParams(map[models.RegisterType]models.Params{
+		registry.DisputeGame: {registry.FactoryParam: "0x0000000000000000000000000000000000000001"},
+	}))
+	defer manager.Shutdown()
+
+	first, err := manager.CreatePipeline(newCfg(nil), make(chan models.TransitData, 10))
+	assert.NoError(t, err, "Ensuring register params are passed to the constructor")
+
+	second, err := manager.CreatePipeline(newCfg(nil), make(chan models.TransitData, 10))
+	assert.NoError(t, err)
+
+	overridden, err := manager.CreatePipeline(newCfg(models.Params{
+		registry.FactoryParam: "0x0000000000000000000000000000000000000002"}),
+		make(chan models.TransitData, 10))
+	assert.NoError(t, err, "Ensuring pipeline params take precedence")
+
+	_, err = manager.CreatePipeline(newCfg(models.Params{registry.FactoryParam: "not an address"}),
+		make(chan models.TransitData, 10))
+	assert.Error(t, err, "Ensuring pipeline params aren't hidden by register params")
+
+	pipelines := make([]*Pipeline, 0, 3)
+	for _, id := range []PipelineID{first, second, overridden} {
+		p, gErr := manager.GetPipeline(id)
+		assert.NoError(t, gErr)
+		pipelines = append(pipelines, p)
+	}
+
+	assert.Equal(t, pipelines[0].Components[1].ID(), pipelines[1].Components[1].ID(),
+		"Ensuring components only configured by register params are shared")
+	assert.NotEqual(t, pipelines[0].Components[1].ID(), pipelines[2].Components[1].ID())
+}
+
+func Test_Manager_Filters(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	createTx := types.NewTx(&types.LegacyTx{Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)})
+	block := types.NewBlock(header, []*types.Transaction{createTx}, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	newCfg := func(filterParams models.Params) *PipelineConfig {
+		return &PipelineConfig{
+			Network:    models.Layer1,
+			DataType:   registry.ContractCreateTX,
+			OracleType: pipeline.LiveOracle,
+			OracleCfg: &config.OracleConfig{
+				RPCEndpoint: "endpoint",
+				StartHeight: big.NewInt(1),
+				EndHeight:   big.NewInt(1),
+			},
+			Filters: []FilterConfig{{Type: registry.HeightRangeFilter, Params: filterParams}},
+		}
+	}
+
+	kept := make(chan models.TransitData, 10)
+	dropped := make(chan models.TransitData, 10)
+
+	// Register the dropping pipeline first so that the kept pipeline's filter is wired onto a running pipe
+	_, err := manager.CreatePipeline(newCfg(models.Params{"max": 0}), dropped)
+	assert.NoError(t, err)
+
+	id, err := manager.CreatePipeline(newCfg(models.Params{"min": 1}), kept)
+	assert.NoError(t, err)
+
+	p, err := manager.GetPipeline(id)
+	assert.NoError(t, err)
+	assert.Len(t, p.Components, 3)
+	assert.Equal(t, models.Filter, p.Components[2].Type())
+
+	select {
+	case td := <-kept:
+		assert.Equal(t, registry.ContractCreateTX, td.Type)
+		assert.Equal(t, p.Components[1].ID(), td.OriginID, "Ensuring filters retain the producer's provenance")
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for filtered pipeline output")
+	}
+
+	select {
+	case td := <-dropped:
+		t.Fatalf("received unexpected filtered output: %+v", td)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	_, err = manager.CreatePipeline(&PipelineConfig{
+		DataType: registry.ContractCreateTX,
+		Filters:  []FilterConfig{{Type: "UNKNOWN"}},
+	}, kept)
+	assert.Error(t, err)
+}
+
+func Test_Manager_PauseResume(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	output := make(chan models.TransitData)
+
+	// No end height is set so that the oracle produces blocks until shutdown
+	id, err := manager.CreatePipeline(&PipelineConfig{
+		Network:    models.Layer1,
+		DataType:   registry.GethBlock,
+		OracleType: pipeline.LiveOracle,
+		OracleCfg: &config.OracleConfig{
+			RPCEndpoint: "pass test",
+			StartHeight: big.NewInt(1),
+		},
+	}, output)
+	assert.NoError(t, err)
+
+	select {
+	case <-output:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pipeline output")
+	}
+
+	assert.Eventually(t, func() bool {
+		state, stateErr := manager.GetState(id)
+		return stateErr == nil && state == models.LiveState
+	}, 5*time.Second, 10*time.Millisecond, "Ensuring pipeline is live once synced to the tip")
+
+	assert.NoError(t, manager.PausePipeline(id))
+
+	state, err := manager.GetState(id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.PausedState, state)
+
+	assert.Error(t, manager.PausePipeline(id), "Ensuring a paused pipeline can't be paused again")
+
+	paused, err := manager.IsPaused(id)
+	assert.NoError(t, err)
+	assert.True(t, paused)
+
+	select {
+	case <-output:
+		t.Fatal("received output from paused pipeline")
+	case <-time.After(time.Second):
+	}
+
+	assert.NoError(t, manager.ResumePipeline(id))
+	assert.Error(t, manager.ResumePipeline(id), "Ensuring an active pipeline can't be resumed")
+
+	select {
+	case <-output:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for resumed pipeline output")
+	}
+}
+
+func Test_Manager_ComponentErrors(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return((*types.Block)(nil), errors.New("rpc timeout"))
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	sub := manager.Events().Subscribe(10)
+	defer manager.Events().Unsubscribe(sub.ID)
+
+	_, err := manager.CreatePipeline(&PipelineConfig{
+		Network:    models.Layer1,
+		DataType:   registry.GethBlock,
+		OracleType: pipeline.LiveOracle,
+		OracleCfg:  &config.OracleConfig{RPCEndpoint: "endpoint", StartHeight: big.NewInt(1)},
+	}, make(chan models.TransitData))
+	assert.NoError(t, err)
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-sub.Events:
+			if event.Topic != ErrorTopic {
+				continue
+			}
+
+			cErr, ok := event.Payload.(pipeline.ComponentError)
+			assert.True(t, ok)
+			assert.Equal(t, pipeline.TransientErr, cErr.Kind, "Ensuring RPC failures are reported as transient")
+			assert.ErrorContains(t, cErr, "rpc timeout")
+			assert.Equal(t, registry.GethBlock, cErr.ComponentID.RegisterType)
+			return
+
+		case <-timeout:
+			t.Fatal("timed out waiting for component error")
+		}
+	}
+}
+
+func Test_Manager_DeadLetters(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	id, err := manager.CreatePipeline(&PipelineConfig{
+		Network:    models.Layer1,
+		DataType:   registry.ContractCreateTX,
+		OracleType: pipeline.LiveOracle,
+		OracleCfg: &config.OracleConfig{
+			RPCEndpoint: "endpoint",
+			StartHeight: big.NewInt(1),
+			EndHeight:   big.NewInt(1),
+		},
+	}, make(chan models.TransitData, 10))
+	assert.NoError(t, err)
+
+	p, err := manager.GetPipeline(id)
+	assert.NoError(t, err)
+
+	// Feed the pipe a value that can't be asserted as a block
+	bad := models.TransitData{Type: registry.GethBlock, Value: "not a block"}
+	manager.inputs[p.Components[1].ID()] <- bad
+
+	awaitLetters := func(count int) []DeadLetter {
+		var letters []DeadLetter
+		assert.Eventually(t, func() bool {
+			letters, err = manager.DeadLetters(id)
+			return err == nil && len(letters) == count
+		}, 5*time.Second, 10*time.Millisecond)
+		return letters
+	}
+
+	letters := awaitLetters(1)
+	assert.Equal(t, p.Components[1].ID(), letters[0].ComponentID)
+	assert.Equal(t, bad.Value, letters[0].Input.Value)
+	assert.Error(t, letters[0].Err)
+
+	// Replayed inputs that fail again are re-added as new dead letters
+	assert.NoError(t, manager.ReplayDeadLetter(id, letters[0].ID))
+	replayed := awaitLetters(1)
+	assert.NotEqual(t, letters[0].ID, replayed[0].ID)
+
+	assert.NoError(t, manager.DiscardDeadLetter(id, replayed[0].ID))
+	assert.Error(t, manager.DiscardDeadLetter(id, replayed[0].ID))
+	assert.Error(t, manager.ReplayDeadLetter(id, replayed[0].ID))
+
+	letters, err = manager.DeadLetters(id)
+	assert.NoError(t, err)
+	assert.Empty(t, letters)
+
+	// Replays onto components that don't read their input fail rather than block
+	manager.replayTimeout = 10 * time.Millisecond
+	pipe := p.Components[1].ID()
+
+	manager.inputs[pipe] <- bad
+	stalled := awaitLetters(1)
+
+	manager.mu.Lock()
+	running := manager.inputs[pipe]
+	manager.inputs[pipe] = make(chan models.TransitData)
+	manager.mu.Unlock()
+
+	assert.ErrorContains(t, manager.ReplayDeadLetter(id, stalled[0].ID), "didn't read dead letter")
+	retained := awaitLetters(1)
+	assert.NotEqual(t, stalled[0].ID, retained[0].ID, "Ensuring unread inputs are re-added")
+
+	manager.mu.Lock()
+	manager.contexts[pipe].cancel()
+	manager.mu.Unlock()
+
+	manager.replayTimeout = time.Minute
+	assert.ErrorContains(t, manager.ReplayDeadLetter(id, retained[0].ID), "was closed")
+
+	manager.mu.Lock()
+	manager.inputs[pipe] = running
+	manager.mu.Unlock()
+}
+
+func Test_Manager_RemovePipeline(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, mock.Anything).Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	})
+	defer manager.Shutdown()
+
+	// No end height is set so that the shared oracle produces blocks until closed
+	newCfg := func(rt models.RegisterType) *PipelineConfig {
+		return &PipelineConfig{
+			Network:    models.Layer1,
+			DataType:   rt,
+			OracleType: pipeline.LiveOracle,
+			OracleCfg:  &config.OracleConfig{RPCEndpoint: "endpoint", StartHeight: big.NewInt(1)},
+		}
+	}
+
+	blocks := make(chan models.TransitData, 100)
+
+	txID, err := manager.CreatePipeline(newCfg(registry.ContractCreateTX), make(chan models.TransitData, 100))
+	assert.NoError(t, err)
+	blockID, err := manager.CreatePipeline(newCfg(registry.GethBlock), blocks)
+	assert.NoError(t, err)
+
+	txPipeline, err := manager.GetPipeline(txID)
+	assert.NoError(t, err)
+	oracle, pipe := txPipeline.Components[0], txPipeline.Components[1]
+
+	assert.NoError(t, manager.RemovePipeline(txID))
+	assert.Error(t, manager.RemovePipeline(txID), "Ensuring pipelines can only be removed once")
+
+	_, err = manager.GetPipeline(txID)
+	assert.Error(t, err)
+	_, err = manager.GetState(txID)
+	assert.Error(t, err, "Ensuring removed pipelines are no longer tracked")
+
+	assert.NotContains(t, manager.inputs, pipe.ID(), "Ensuring unshared components are freed")
+	assert.Equal(t, []int{manager.pipelines[blockID].edges[0].id}, oracle.Directives(),
+		"Ensuring the removed pipeline's directive is detached from the shared oracle")
+
+	// Drain blocks produced before removal; the shared oracle must keep serving the remaining pipeline
+	for len(blocks) > 0 {
+		<-blocks
+	}
+
+	select {
+	case td := <-blocks:
+		assert.Equal(t, oracle.ID(), td.OriginID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for shared oracle output")
+	}
+
+	assert.NoError(t, manager.RemovePipeline(blockID))
+	assert.Empty(t, manager.shared, "Ensuring closed components are no longer shared")
+	assert.Empty(t, manager.pipelines)
+}
+
+func Test_Manager_SubmitPipeline(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+	testClient := new(mocks.EthClient)
+	testClient.On("DialContext", mock.Anything, "l1 endpoint").Return(nil)
+	testClient.On("HeaderByNumber", mock.Anything, mock.Anything).Return(header, nil)
+	testClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(block, nil)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return testClient
+	}, WithNetworks(map[models.Network]config.NetworkConfig{
+		models.Layer1: {RPCEndpoint: "l1 endpoint", ConfirmationDepth: 2},
+	}))
+
+	var tests = []struct {
+		name        string
+		description string
+		req         PipelineRequest
+		valid       bool
+	}{
+		{
+			name:        "Live Request",
+			description: "Requests default to a live oracle reading from the network's endpoint",
+			req:         PipelineRequest{Network: models.Layer1, RegisterType: registry.ContractCreateTX},
+			valid:       true,
+		},
+		{
+			name:        "Backtest Request",
+			description: "Backtest requests within a valid height range are created",
+			req: PipelineRequest{Network: models.Layer1, RegisterType: registry.GethBlock,
+				OracleType: pipeline.BacktestOracle, StartHeight: big.NewInt(1), EndHeight: big.NewInt(1)},
+			valid: true,
+		},
+		{
+			name:        "Unknown Register",
+			description: "Requests for unknown register types are rejected",
+			req:         PipelineRequest{Network: models.Layer1, RegisterType: "UNKNOWN"},
+		},
+		{
+			name:        "Unconfigured Network",
+			description: "Requests for networks without an endpoint are rejected",
+			req:         PipelineRequest{Network: models.Layer2, RegisterType: registry.GethBlock},
+		},
+		{
+			name:        "Unknown Oracle Type",
+			description: "Requests for unknown oracle types are rejected",
+			req:         PipelineRequest{Network: models.Layer1, RegisterType: registry.GethBlock, OracleType: "replay"},
+		},
+		{
+			name:        "Missing End Height",
+			description: "Backtest requests without an end height are rejected",
+			req: PipelineRequest{Network: models.Layer1, RegisterType: registry.GethBlock,
+				OracleType: pipeline.BacktestOracle, StartHeight: big.NewInt(1)},
+		},
+		{
+			name:        "Inverted Height Range",
+			description: "Requests whose start height exceeds their end height are rejected",
+			req: PipelineRequest{Network: models.Layer1, RegisterType: registry.GethBlock,
+				OracleType: pipeline.BacktestOracle, StartHeight: big.NewInt(2), EndHeight: big.NewInt(1)},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			id, err := manager.SubmitPipeline(tc.req, make(chan models.TransitData, 10))
+			if !tc.valid {
+				assert.Error(t, err, tc.description)
+				return
+			}
+
+			assert.NoError(t, err, tc.description)
+
+			p, err := manager.GetPipeline(id)
+			assert.NoError(t, err)
+			assert.Equal(t, "l1 endpoint", p.Cfg.OracleCfg.RPCEndpoint)
+			assert.Equal(t, uint64(2), p.Cfg.OracleCfg.ConfirmationDepth)
+		})
+	}
+
+	manager.Shutdown()
+
+	_, err := manager.SubmitPipeline(PipelineRequest{Network: models.Layer1, RegisterType: registry.GethBlock},
+		make(chan models.TransitData, 10))
+	assert.Error(t, err, "Ensuring pipelines can't be created once the manager is shut down")
+}
+
+func Test_Manager_SetEndpoint(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	manager := NewManager(context.Background(), func() client.EthClientInterface {
+		return new(mocks.EthClient)
+	}, WithNetworks(map[models.Network]config.NetworkConfig{
+		models.Layer1: {RPCEndpoint: "http://l1:8545"},
+	}))
+	defer manager.Shutdown()
+
+	for _, endpoint := range []string{"l2:8545", "ftp://l2", "http://", "://"} {
+		assert.Error(t, manager.SetEndpoint(models.Layer2, endpoint), endpoint)
+	}
+
+	assert.NoError(t, manager.SetEndpoint(models.Layer2, "wss://l2/ws"))
+	assert.Equal(t, map[models.Network]string{
+		models.Layer1: "http://l1:8545",
+		models.Layer2: "wss://l2/ws",
+	}, manager.Endpoints())
+}
+
+func Test_Manager_OracleConfig(t *testing.T) {
+	backoff := client.Backoff{Retries: 5, Base: time.Millisecond}
+	manager := NewManager(context.Background(), nil, WithBackoff(backoff))
+	defer manager.Shutdown()
+
+	assert.Nil(t, manager.oracleConfig(nil))
+
+	oc := &config.OracleConfig{RPCEndpoint: "endpoint"}
+	assert.Equal(t, backoff, manager.oracleConfig(oc).Backoff, "Ensuring oracles use the RPC backoff")
+	assert.Zero(t, oc.Backoff, "Ensuring the pipeline's oracle config isn't modified")
+
+	oc.Backoff = client.DefaultBackoff()
+	assert.Equal(t, client.DefaultBackoff(), manager.oracleConfig(oc).Backoff,
+		"Ensuring explicitly configured backoffs take precedence")
+}
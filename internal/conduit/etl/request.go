@@ -0,0 +1,148 @@
+package etl
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/config"
+)
+
+// PipelineRequest ... User facing description of a pipeline to create while the manager is running;
+// resolved into a PipelineConfig using the manager's network settings
+type PipelineRequest struct {
+	Network      models.Network      `json:"network"`
+	RegisterType models.RegisterType `json:"register_type"`
+	// Defaults to a live oracle when empty
+	OracleType pipeline.OracleType `json:"oracle_type,omitempty"`
+
+	// Inclusive height range read by the pipeline's oracles; backtests require both heights
+	// and backfills require a start height
+	StartHeight *big.Int `json:"start_height,omitempty"`
+	EndHeight   *big.Int `json:"end_height,omitempty"`
+
+	Params   models.Params   `json:"params,omitempty"`
+	Priority models.Priority `json:"priority,omitempty"`
+}
+
+// withDefaults ... Fills in omitted optional fields
+func (pr *PipelineRequest) withDefaults() {
+	if pr.OracleType == "" {
+		pr.OracleType = pipeline.LiveOracle
+	}
+}
+
+// validate ... Ensures the request describes a constructable pipeline
+func (pr *PipelineRequest) validate() error {
+	if _, err := registry.GetRegister(pr.RegisterType); err != nil {
+		return err
+	}
+
+	switch pr.OracleType {
+	case pipeline.LiveOracle:
+
+	case pipeline.BacktestOracle:
+		if pr.StartHeight == nil || pr.EndHeight == nil {
+			return fmt.Errorf("%s pipelines require a start & end height", pr.OracleType)
+		}
+
+	case pipeline.BackfillOracle:
+		if pr.StartHeight == nil {
+			return fmt.Errorf("%s pipelines require a start height", pr.OracleType)
+		}
+
+	default:
+		return fmt.Errorf("unknown oracle type: %s", pr.OracleType)
+	}
+
+	if pr.StartHeight != nil && pr.StartHeight.Sign() < 0 {
+		return fmt.Errorf("start height must be non-negative; got %s", pr.StartHeight)
+	}
+
+	if pr.StartHeight != nil && pr.EndHeight != nil && pr.StartHeight.Cmp(pr.EndHeight) > 0 {
+		return fmt.Errorf("start height %s exceeds end height %s", pr.StartHeight, pr.EndHeight)
+	}
+
+	return nil
+}
+
+// WithNetworks ... Sets the RPC endpoint, confirmation depth & poll interval of the oracles of requested
+// pipelines for every network
+func WithNetworks(networks map[models.Network]config.NetworkConfig) ManagerOption {
+	return func(m *Manager) {
+		m.networks = make(map[models.Network]config.NetworkConfig, len(networks))
+		for network, nc := range networks {
+			m.networks[network] = nc
+		}
+	}
+}
+
+// Endpoints ... Returns the RPC endpoint that oracles of requested pipelines read from for every network
+func (m *Manager) Endpoints() map[models.Network]string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	endpoints := make(map[models.Network]string, len(m.networks))
+	for network, nc := range m.networks {
+		endpoints[network] = nc.RPCEndpoint
+	}
+
+	return endpoints
+}
+
+// SetEndpoint ... Sets the RPC endpoint that oracles of pipelines requested from now on read from for the
+// network; running pipelines keep reading from their endpoint. The network's other settings, including its
+// rate limit, are kept. Fail if the endpoint isn't an HTTP or WebSocket URL
+func (m *Manager) SetEndpoint(network models.Network, endpoint string) error {
+	if err := config.ValidateEndpoint(endpoint); err != nil {
+		return fmt.Errorf("invalid %s endpoint: %w", network, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nc := m.networks[network]
+	nc.RPCEndpoint = endpoint
+	m.networks[network] = nc
+	m.limiters.SetLimit(endpoint, nc.RateLimit)
+	return nil
+}
+
+// SubmitPipeline ... Validates the request and creates its pipeline on the running manager; terminal
+// register data is written to the output channel. Fails once the manager has been shut down
+func (m *Manager) SubmitPipeline(req PipelineRequest, output chan models.TransitData) (PipelineID, error) {
+	req.withDefaults()
+	if err := req.validate(); err != nil {
+		return 0, fmt.Errorf("invalid pipeline request: %w", err)
+	}
+
+	m.mu.RLock()
+	nc, found := m.networks[req.Network]
+	m.mu.RUnlock()
+
+	if !found {
+		return 0, fmt.Errorf("no RPC endpoint is configured for network: %s", req.Network)
+	}
+
+	cfg := &PipelineConfig{
+		Network:    req.Network,
+		DataType:   req.RegisterType,
+		OracleType: req.OracleType,
+		OracleCfg: &config.OracleConfig{
+			RPCEndpoint:       nc.RPCEndpoint,
+			WSEndpoint:        nc.WSEndpoint,
+			ChainID:           nc.ChainID,
+			StartHeight:       req.StartHeight,
+			EndHeight:         req.EndHeight,
+			FetchBatchSize:    m.fetchBatchSize,
+			ConfirmationDepth: nc.ConfirmationDepth,
+			PollInterval:      nc.PollInterval,
+		},
+		Params:   req.Params,
+		Priority: req.Priority,
+	}
+
+	return m.CreatePipeline(cfg, output)
+}
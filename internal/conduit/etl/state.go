@@ -0,0 +1,227 @@
+package etl
+
+import (
+	"sync"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/events"
+)
+
+// StateTopic ... Event bus topic that pipeline state transitions are published to
+const StateTopic events.Topic = "pipeline_state"
+
+// StateTransition ... Event payload published on every pipeline state change
+type StateTransition struct {
+	Pipeline PipelineID           `json:"pipeline"`
+	From     models.PipelineState `json:"from"`
+	To       models.PipelineState `json:"to"`
+}
+
+// activityRank ... Orders oracle activity; a pipeline is only as advanced as its least advanced oracle
+var activityRank = map[models.PipelineState]int{
+	models.BootingState:     0,
+	models.BackfillingState: 1,
+	models.SyncingState:     2,
+	models.LiveState:        3,
+}
+
+// trackedPipeline ... Inputs used to derive a pipeline's state
+type trackedPipeline struct {
+	oracles    []models.ComponentID
+	paused     bool
+	terminated bool
+
+	state models.PipelineState
+}
+
+// stateTracker ... Derives pipeline states from the activity of their oracles and broadcasts transitions;
+// uses its own lock as oracle activity is reported from oracle go routines
+type stateTracker struct {
+	mu  sync.Mutex
+	bus *events.Bus
+
+	activity  map[models.ComponentID]models.PipelineState
+	members   map[models.ComponentID][]PipelineID
+	pipelines map[PipelineID]*trackedPipeline
+}
+
+// newStateTracker ... Initializer
+func newStateTracker(bus *events.Bus) *stateTracker {
+	return &stateTracker{
+		bus:       bus,
+		activity:  make(map[models.ComponentID]models.PipelineState),
+		members:   make(map[models.ComponentID][]PipelineID),
+		pipelines: make(map[PipelineID]*trackedPipeline),
+	}
+}
+
+// track ... Starts tracking a pipeline that reads from the given oracles
+func (st *stateTracker) track(id PipelineID, oracles []models.ComponentID) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.pipelines[id] = &trackedPipeline{oracles: oracles}
+	for _, cid := range oracles {
+		st.members[cid] = append(st.members[cid], id)
+	}
+
+	st.refresh(id)
+}
+
+// oracleActivity ... Records an oracle activity transition and refreshes every pipeline that reads from it
+func (st *stateTracker) oracleActivity(cid models.ComponentID, state models.PipelineState) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.activity[cid] = state
+	for _, id := range st.members[cid] {
+		st.refresh(id)
+	}
+}
+
+// setPaused ... Marks the pipeline as paused or resumed
+func (st *stateTracker) setPaused(id PipelineID, paused bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if tp, found := st.pipelines[id]; found {
+		tp.paused = paused
+		st.refresh(id)
+	}
+}
+
+// terminate ... Marks the pipeline as terminated; terminal state
+func (st *stateTracker) terminate(id PipelineID) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if tp, found := st.pipelines[id]; found {
+		tp.terminated = true
+		st.refresh(id)
+	}
+}
+
+// untrack ... Stops tracking the pipeline; activity of oracles no longer read by any pipeline is forgotten
+func (st *stateTracker) untrack(id PipelineID) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	tp, found := st.pipelines[id]
+	if !found {
+		return
+	}
+
+	for _, cid := range tp.oracles {
+		members := st.members[cid]
+		for i, member := range members {
+			if member == id {
+				members = append(members[:i], members[i+1:]...)
+				break
+			}
+		}
+
+		if len(members) == 0 {
+			delete(st.members, cid)
+			delete(st.activity, cid)
+			continue
+		}
+
+		st.members[cid] = members
+	}
+
+	delete(st.pipelines, id)
+}
+
+// state ... Returns the current pipeline state
+func (st *stateTracker) state(id PipelineID) (models.PipelineState, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	tp, found := st.pipelines[id]
+	if !found {
+		return "", false
+	}
+
+	return tp.state, true
+}
+
+// states ... Returns the current state of every tracked pipeline
+func (st *stateTracker) states() map[PipelineID]models.PipelineState {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	states := make(map[PipelineID]models.PipelineState, len(st.pipelines))
+	for id, tp := range st.pipelines {
+		states[id] = tp.state
+	}
+
+	return states
+}
+
+// activityOf ... Returns the last activity reported by the oracle; booting when none was reported
+func (st *stateTracker) activityOf(cid models.ComponentID) models.PipelineState {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if activity, found := st.activity[cid]; found {
+		return activity
+	}
+
+	return models.BootingState
+}
+
+// refresh ... Re-derives the pipeline state and publishes a transition on change; lock must be held
+func (st *stateTracker) refresh(id PipelineID) {
+	tp := st.pipelines[id]
+
+	next := st.derive(tp)
+	if next == tp.state {
+		return
+	}
+
+	prev := tp.state
+	tp.state = next
+
+	st.bus.Publish(StateTopic, StateTransition{
+		Pipeline: id,
+		From:     prev,
+		To:       next,
+	})
+}
+
+// derive ... Returns the pipeline state given its inputs; a pipeline is terminated once every oracle
+// has terminated and otherwise takes the least advanced activity of its running oracles
+func (st *stateTracker) derive(tp *trackedPipeline) models.PipelineState {
+	if tp.terminated || tp.state == models.TerminatedState {
+		return models.TerminatedState
+	}
+
+	if tp.paused {
+		return models.PausedState
+	}
+
+	least := models.LiveState
+	running := 0
+
+	for _, cid := range tp.oracles {
+		activity, found := st.activity[cid]
+		if !found {
+			activity = models.BootingState
+		}
+
+		if activity == models.TerminatedState {
+			continue
+		}
+
+		running++
+		if activityRank[activity] < activityRank[least] {
+			least = activity
+		}
+	}
+
+	if running == 0 && len(tp.oracles) > 0 {
+		return models.TerminatedState
+	}
+
+	return least
+}
@@ -0,0 +1,79 @@
+package etl
+
+import (
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StateTracker(t *testing.T) {
+	bus := events.NewBus()
+	sub := bus.Subscribe(100)
+
+	st := newStateTracker(bus)
+
+	l1 := models.NewComponentID(models.Layer1, models.Live, "GETH_BLOCK")
+	l2 := models.NewComponentID(models.Layer1, models.Live, "EVENT_LOG")
+
+	st.track(1, []models.ComponentID{l1, l2})
+	st.track(2, []models.ComponentID{l1})
+
+	assertState := func(id PipelineID, expected models.PipelineState) {
+		state, found := st.state(id)
+		assert.True(t, found)
+		assert.Equal(t, expected, state)
+	}
+
+	assertState(1, models.BootingState)
+
+	// Pipelines are only as advanced as their least advanced oracle
+	st.oracleActivity(l1, models.LiveState)
+	st.oracleActivity(l2, models.BackfillingState)
+	assertState(1, models.BackfillingState)
+	assertState(2, models.LiveState)
+
+	st.oracleActivity(l2, models.SyncingState)
+	assertState(1, models.SyncingState)
+
+	st.setPaused(1, true)
+	assertState(1, models.PausedState)
+
+	st.setPaused(1, false)
+	st.oracleActivity(l2, models.LiveState)
+	assertState(1, models.LiveState)
+
+	// Pipeline terminates once all of its oracles have terminated
+	st.oracleActivity(l1, models.TerminatedState)
+	assertState(1, models.LiveState)
+	assertState(2, models.TerminatedState)
+
+	st.oracleActivity(l1, models.LiveState)
+	assertState(2, models.TerminatedState)
+
+	assert.Equal(t, map[PipelineID]models.PipelineState{
+		1: models.LiveState,
+		2: models.TerminatedState,
+	}, st.states())
+
+	expected := []StateTransition{
+		{Pipeline: 1, From: "", To: models.BootingState},
+		{Pipeline: 2, From: "", To: models.BootingState},
+		{Pipeline: 2, From: models.BootingState, To: models.LiveState},
+		{Pipeline: 1, From: models.BootingState, To: models.BackfillingState},
+		{Pipeline: 1, From: models.BackfillingState, To: models.SyncingState},
+		{Pipeline: 1, From: models.SyncingState, To: models.PausedState},
+		{Pipeline: 1, From: models.PausedState, To: models.SyncingState},
+		{Pipeline: 1, From: models.SyncingState, To: models.LiveState},
+		{Pipeline: 2, From: models.LiveState, To: models.TerminatedState},
+	}
+
+	for _, transition := range expected {
+		event := <-sub.Events
+		assert.Equal(t, StateTopic, event.Topic)
+		assert.Equal(t, transition, event.Payload)
+	}
+
+	assert.Len(t, sub.Events, 0, "Ensuring no further transitions were published")
+}
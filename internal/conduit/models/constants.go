@@ -7,6 +7,36 @@ const (
 	Oracle   ComponentType = 0
 	Pipe     ComponentType = 1
 	Conveyor ComponentType = 2
+	Filter   ComponentType = 3
+	Join     ComponentType = 4
+)
+
+// String ... Returns the component type's name
+func (ct ComponentType) String() string {
+	switch ct {
+	case Oracle:
+		return "oracle"
+	case Pipe:
+		return "pipe"
+	case Conveyor:
+		return "conveyor"
+	case Filter:
+		return "filter"
+	case Join:
+		return "join"
+	default:
+		return "unknown"
+	}
+}
+
+// Priority ... Delivery precedence of transit data & output directives under backpressure
+type Priority int
+
+const (
+	// NormalPriority ... Bulk data delivered in arrival order
+	NormalPriority Priority = iota
+	// HighPriority ... Critical data delivered ahead of queued normal priority data
+	HighPriority
 )
 
 type FetchType int
@@ -21,3 +51,15 @@ type Timeouts int
 const (
 	EthClientTimeout Timeouts = 20 // in seconds
 )
+
+// PipelineState ... Lifecycle state of a pipeline; oracles report the subset of states that describe their reads
+type PipelineState string
+
+const (
+	BootingState     PipelineState = "booting"
+	BackfillingState PipelineState = "backfilling"
+	SyncingState     PipelineState = "syncing"
+	LiveState        PipelineState = "live"
+	PausedState      PipelineState = "paused"
+	TerminatedState  PipelineState = "terminated"
+)
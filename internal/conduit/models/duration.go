@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration ... JSON friendly time.Duration; encoded as a Go duration string (E.G, "90s") and decoded
+// from either a duration string or a number of seconds
+type Duration time.Duration
+
+// MarshalJSON ... Encodes the duration as a Go duration string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON ... Decodes a duration string or a number of seconds
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var val any
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	switch v := val.(type) {
+	case float64:
+		*d = Duration(v * float64(time.Second))
+		return nil
+
+	case string:
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+
+		*d = Duration(parsed)
+		return nil
+
+	default:
+		return fmt.Errorf("invalid duration: %s", data)
+	}
+}
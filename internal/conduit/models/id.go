@@ -0,0 +1,48 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Network ... Chain network that a component's data originates from
+type Network string
+
+const (
+	Layer1 Network = "layer1"
+	Layer2 Network = "layer2"
+)
+
+// PipelineType ... Execution mode of the pipeline that a component belongs to
+type PipelineType string
+
+const (
+	Live     PipelineType = "live"
+	Backtest PipelineType = "backtest"
+	Backfill PipelineType = "backfill"
+)
+
+// ComponentID ... Structured identifier stamped on every pipeline component; used to trace
+// data and logs back to their producer when multiple pipelines run concurrently
+type ComponentID struct {
+	Network      Network
+	PipelineType PipelineType
+	RegisterType RegisterType
+	UUID         uuid.UUID
+}
+
+// NewComponentID ... Initializer; every call yields a unique ID
+func NewComponentID(n Network, pt PipelineType, rt RegisterType) ComponentID {
+	return ComponentID{
+		Network:      n,
+		PipelineType: pt,
+		RegisterType: rt,
+		UUID:         uuid.New(),
+	}
+}
+
+// String ... Returns a human readable form of the ID; E.g, layer1:live:GETH_BLOCK:<uuid>
+func (id ComponentID) String() string {
+	return fmt.Sprintf("%s:%s:%s:%s", id.Network, id.PipelineType, id.RegisterType, id.UUID)
+}
@@ -1,16 +1,32 @@
 package models
 
 import (
+	"fmt"
+	"math/big"
 	"time"
 )
 
 type RegisterType string
 
+// BatchType ... Register type of transit data that wraps a batch of transit data
+const BatchType RegisterType = "BATCH"
+
 type TransitData struct {
 	Timestamp time.Time
 
 	Type  RegisterType
 	Value any
+
+	// Provenance metadata stamped by the emitting component
+	OriginID ComponentID
+	Network  Network
+	// Height of the block the data was derived from; nil when not derived from a block
+	Height *big.Int
+	// Sequence ... Monotonically increasing per emitting component, starting at 1
+	Sequence uint64
+
+	// Priority ... Delivery precedence over other data transited by the same component
+	Priority Priority
 }
 
 type TransitChannel = chan TransitData
@@ -18,3 +34,51 @@ type TransitChannel = chan TransitData
 func NewTransitChannel() TransitChannel {
 	return make(chan TransitData)
 }
+
+// NewBatch ... Wraps the transit data into a single batch that can be transited using one channel send;
+// the batch inherits the timestamp & height of its last item and the highest priority of its items
+func NewBatch(batch []TransitData) TransitData {
+	td := TransitData{
+		Type:  BatchType,
+		Value: batch,
+	}
+
+	if len(batch) > 0 {
+		td.Timestamp = batch[len(batch)-1].Timestamp
+		td.Height = batch[len(batch)-1].Height
+	}
+
+	for _, item := range batch {
+		if item.Priority > td.Priority {
+			td.Priority = item.Priority
+		}
+	}
+
+	return td
+}
+
+// IsBatch ... Returns true if the transit data wraps a batch
+func (td TransitData) IsBatch() bool {
+	return td.Type == BatchType
+}
+
+// Unbatch ... Returns the items of a batch or a single item slice when the transit data isn't a batch
+func Unbatch(td TransitData) []TransitData {
+	if batch, isBatch := td.Value.([]TransitData); isBatch && td.IsBatch() {
+		return batch
+	}
+
+	return []TransitData{td}
+}
+
+// ValueAs ... Returns the transit data value as the concrete type T; fail with a descriptive
+// error when the value holds any other type
+func ValueAs[T any](td TransitData) (T, error) {
+	val, success := td.Value.(T)
+	if !success {
+		var expected T
+		return expected, fmt.Errorf("transit data of type %s holds %T value; expected %T", td.Type, td.Value, expected)
+	}
+
+	return val, nil
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValueAs(t *testing.T) {
+	td := TransitData{Type: "TEST", Value: 0x420}
+
+	val, err := ValueAs[int](td)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x420, val)
+
+	str, err := ValueAs[string](td)
+	assert.Error(t, err, "Ensuring mismatched value types are surfaced rather than silently zeroed")
+	assert.Equal(t, "", str)
+	assert.Contains(t, err.Error(), "TEST")
+}
+
+func Test_Duration_JSON(t *testing.T) {
+	var durations struct {
+		Str Duration `json:"str"`
+		Num Duration `json:"num"`
+	}
+
+	err := json.Unmarshal([]byte(`{"str": "1m30s", "num": 2.5}`), &durations)
+	assert.NoError(t, err)
+	assert.Equal(t, Duration(90*time.Second), durations.Str)
+	assert.Equal(t, Duration(2500*time.Millisecond), durations.Num)
+
+	encoded, err := json.Marshal(durations)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"str": "1m30s", "num": "2.5s"}`, string(encoded))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"str": "soon"}`), &durations))
+	assert.Error(t, json.Unmarshal([]byte(`{"str": true}`), &durations))
+}
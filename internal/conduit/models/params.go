@@ -0,0 +1,343 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Params ... Parameter mapping passed to register component constructors; values can either be native
+// Go types or their decoded JSON equivalents (i.e. strings, float64s, and []any)
+type Params map[string]any
+
+// Parameter errors
+const (
+	paramTypeErr     = "param %s has invalid type %T; expected %s"
+	paramMissingErr  = "param %s is required"
+	paramOverflowErr = "param %s value %v overflows int"
+	paramNegativeErr = "param %s must not be negative; got %d"
+)
+
+// Has ... Returns true if the key exists
+func (p Params) Has(key string) bool {
+	_, found := p[key]
+	return found
+}
+
+// Merge ... Returns a copy of the params with every override set; nil when both are empty
+func (p Params) Merge(overrides Params) Params {
+	if len(p) == 0 && len(overrides) == 0 {
+		return nil
+	}
+
+	merged := make(Params, len(p)+len(overrides))
+	for key, val := range p {
+		merged[key] = val
+	}
+
+	for key, val := range overrides {
+		merged[key] = val
+	}
+
+	return merged
+}
+
+// String ... Returns the string value for the key or the default if absent
+func (p Params) String(key string, def string) (string, error) {
+	val, found := p[key]
+	if !found {
+		return def, nil
+	}
+
+	str, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf(paramTypeErr, key, val, "string")
+	}
+
+	return str, nil
+}
+
+// Int ... Returns the int value for the key or the default if absent; fail if the value isn't an
+// integer or overflows int
+func (p Params) Int(key string, def int) (int, error) {
+	val, found := p[key]
+	if !found {
+		return def, nil
+	}
+
+	switch v := val.(type) {
+	case int:
+		return v, nil
+	case int64:
+		if v < math.MinInt || v > math.MaxInt {
+			return 0, fmt.Errorf(paramOverflowErr, key, v)
+		}
+		return int(v), nil
+	case uint64:
+		if v > math.MaxInt {
+			return 0, fmt.Errorf(paramOverflowErr, key, v)
+		}
+		return int(v), nil
+	case float64:
+		if math.Trunc(v) != v {
+			return 0, fmt.Errorf(paramTypeErr, key, val, "integer")
+		}
+		// MaxInt isn't representable as a float64 & rounds up to the first overflowing value
+		if v < math.MinInt || v >= -math.MinInt {
+			return 0, fmt.Errorf(paramOverflowErr, key, v)
+		}
+		return int(v), nil
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("param %s: %w", key, err)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf(paramTypeErr, key, val, "int")
+	}
+}
+
+// NonNegativeInt ... Returns the int value for the key or the default if absent; fail if the value is negative
+func (p Params) NonNegativeInt(key string, def int) (int, error) {
+	n, err := p.Int(key, def)
+	if err != nil {
+		return 0, err
+	}
+
+	if n < 0 {
+		return 0, fmt.Errorf(paramNegativeErr, key, n)
+	}
+
+	return n, nil
+}
+
+// Float ... Returns the float64 value for the key or the default if absent
+func (p Params) Float(key string, def float64) (float64, error) {
+	val, found := p[key]
+	if !found {
+		return def, nil
+	}
+
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf(paramTypeErr, key, val, "float64")
+	}
+}
+
+// Duration ... Returns the duration value for the key or the default if absent; strings are parsed
+// as Go durations (E.G, "90s") and numbers are interpreted as seconds
+func (p Params) Duration(key string, def time.Duration) (time.Duration, error) {
+	val, found := p[key]
+	if !found {
+		return def, nil
+	}
+
+	switch v := val.(type) {
+	case time.Duration:
+		return v, nil
+	case int:
+		return time.Duration(v) * time.Second, nil
+	case float64:
+		return time.Duration(v * float64(time.Second)), nil
+	case string:
+		return time.ParseDuration(v)
+	default:
+		return 0, fmt.Errorf(paramTypeErr, key, val, "time.Duration")
+	}
+}
+
+// BigInt ... Returns the big int value for the key or the default if absent;
+// strings can either be decimal or 0x prefixed hex
+func (p Params) BigInt(key string, def *big.Int) (*big.Int, error) {
+	val, found := p[key]
+	if !found {
+		return def, nil
+	}
+
+	switch v := val.(type) {
+	case *big.Int:
+		return v, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case float64:
+		// Converted exactly so that integral values beyond the int64 range (e.g. wei amounts) are kept
+		if math.IsNaN(v) {
+			return nil, fmt.Errorf(paramTypeErr, key, val, "integer")
+		}
+		f := new(big.Float).SetFloat64(v)
+		if !f.IsInt() {
+			return nil, fmt.Errorf(paramTypeErr, key, val, "integer")
+		}
+		bi, _ := f.Int(nil)
+		return bi, nil
+	case json.Number:
+		bi, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("param %s has invalid integer value %s", key, v)
+		}
+		return bi, nil
+	case string:
+		bi, ok := new(big.Int).SetString(v, 0)
+		if !ok {
+			return nil, fmt.Errorf("param %s has invalid integer value %s", key, v)
+		}
+		return bi, nil
+	default:
+		return nil, fmt.Errorf(paramTypeErr, key, val, "*big.Int")
+	}
+}
+
+// toAddress ... Converts a single param value to an address
+func toAddress(key string, val any) (common.Address, error) {
+	switch v := val.(type) {
+	case common.Address:
+		return v, nil
+	case string:
+		if !common.IsHexAddress(v) {
+			return common.Address{}, fmt.Errorf("param %s has invalid address value %s", key, v)
+		}
+		return common.HexToAddress(v), nil
+	default:
+		return common.Address{}, fmt.Errorf(paramTypeErr, key, val, "common.Address")
+	}
+}
+
+// Address ... Returns the address value for the key or the zero address if absent
+func (p Params) Address(key string) (common.Address, error) {
+	val, found := p[key]
+	if !found {
+		return common.Address{}, nil
+	}
+
+	return toAddress(key, val)
+}
+
+// RequiredAddress ... Returns the address value for the key; fail if absent
+func (p Params) RequiredAddress(key string) (common.Address, error) {
+	if !p.Has(key) {
+		return common.Address{}, fmt.Errorf(paramMissingErr, key)
+	}
+
+	return p.Address(key)
+}
+
+// Addresses ... Returns the address slice value for the key or an empty slice if absent
+func (p Params) Addresses(key string) ([]common.Address, error) {
+	val, found := p[key]
+	if !found {
+		return []common.Address{}, nil
+	}
+
+	var items []any
+
+	switch v := val.(type) {
+	case []common.Address:
+		return v, nil
+	case []string:
+		for _, str := range v {
+			items = append(items, str)
+		}
+	case []any:
+		items = v
+	default:
+		return nil, fmt.Errorf(paramTypeErr, key, val, "[]common.Address")
+	}
+
+	addresses := make([]common.Address, 0, len(items))
+	for _, item := range items {
+		addr, err := toAddress(key, item)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, addr)
+	}
+
+	return addresses, nil
+}
+
+// toHash ... Converts a single param value to a 32 byte hash
+func toHash(key string, val any) (common.Hash, error) {
+	switch v := val.(type) {
+	case common.Hash:
+		return v, nil
+	case string:
+		b, err := hexutil.Decode(v)
+		if err != nil || len(b) != common.HashLength {
+			return common.Hash{}, fmt.Errorf("param %s has invalid hash value %s", key, v)
+		}
+		return common.BytesToHash(b), nil
+	default:
+		return common.Hash{}, fmt.Errorf(paramTypeErr, key, val, "common.Hash")
+	}
+}
+
+// Topics ... Returns the positional log topic filters for the key or nil if absent; every position is
+// either a single topic, a list of alternative topics or null to match any topic
+func (p Params) Topics(key string) ([][]common.Hash, error) {
+	val, found := p[key]
+	if !found {
+		return nil, nil
+	}
+
+	var positions []any
+
+	switch v := val.(type) {
+	case [][]common.Hash:
+		return v, nil
+	case []string:
+		for _, str := range v {
+			positions = append(positions, str)
+		}
+	case []any:
+		positions = v
+	default:
+		return nil, fmt.Errorf(paramTypeErr, key, val, "[][]common.Hash")
+	}
+
+	topics := make([][]common.Hash, len(positions))
+	for i, pos := range positions {
+		var alternatives []any
+
+		switch v := pos.(type) {
+		case nil:
+			continue
+		case []string:
+			for _, str := range v {
+				alternatives = append(alternatives, str)
+			}
+		case []any:
+			alternatives = v
+		default:
+			alternatives = []any{v}
+		}
+
+		for _, alt := range alternatives {
+			hash, err := toHash(key, alt)
+			if err != nil {
+				return nil, err
+			}
+
+			topics[i] = append(topics[i], hash)
+		}
+	}
+
+	return topics, nil
+}
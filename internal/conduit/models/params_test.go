@@ -0,0 +1,273 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Params_JSONDecoded(t *testing.T) {
+	var params Params
+	err := json.Unmarshal([]byte(`{
+		"name": "watcher",
+		"count": 5,
+		"ratio": 2.5,
+		"timeout": "1m30s",
+		"interval": 2,
+		"amount": "0x10",
+		"address": "0x0000000000000000000000000000000000000420",
+		"addresses": ["0x0000000000000000000000000000000000000420", "0x0000000000000000000000000000000000000069"]
+	}`), &params)
+	assert.NoError(t, err)
+
+	name, err := params.String("name", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "watcher", name)
+
+	count, err := params.Int("count", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	ratio, err := params.Float("ratio", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, ratio)
+
+	timeout, err := params.Duration("timeout", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	interval, err := params.Duration("interval", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Second, interval, "Ensuring numbers are interpreted as seconds")
+
+	amount, err := params.BigInt("amount", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(16), amount)
+
+	addr, err := params.RequiredAddress("address")
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x420"), addr)
+
+	addrs, err := params.Addresses("addresses")
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{common.HexToAddress("0x420"), common.HexToAddress("0x69")}, addrs)
+}
+
+func Test_Params_DefaultsAndErrors(t *testing.T) {
+	params := Params{
+		"count":   2.5,
+		"address": "not an address",
+	}
+
+	def, err := params.Int("missing", 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, def, "Ensuring default is returned for absent keys")
+
+	_, err = params.Int("count", 0)
+	assert.Error(t, err, "Ensuring fractional values aren't truncated to integers")
+
+	_, err = params.Address("address")
+	assert.Error(t, err)
+
+	_, err = params.RequiredAddress("missing")
+	assert.EqualError(t, err, "param missing is required")
+
+	var nilParams Params
+	addrs, err := nilParams.Addresses("addresses")
+	assert.NoError(t, err, "Ensuring nil params are safe to read")
+	assert.Empty(t, addrs)
+}
+
+func Test_Params_Int(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		val         any
+		nonNegative bool
+
+		expected int
+		err      string
+	}{
+		{
+			name:        "Integral Float",
+			description: "Integral JSON numbers should be converted",
+			val:         float64(42),
+			expected:    42,
+		},
+		{
+			name:        "Fractional Float",
+			description: "Fractional JSON numbers shouldn't be truncated",
+			val:         2.5,
+			err:         "param key has invalid type float64; expected integer",
+		},
+		{
+			name:        "Overflowing Float",
+			description: "JSON numbers beyond the int range shouldn't wrap",
+			val:         1e19,
+			err:         "param key value 1e+19 overflows int",
+		},
+		{
+			name:        "Infinite Float",
+			description: "Infinite numbers should overflow",
+			val:         math.Inf(-1),
+			err:         "param key value -Inf overflows int",
+		},
+		{
+			name:        "Overflowing Uint",
+			description: "YAML decoded values beyond the int range shouldn't wrap",
+			val:         uint64(math.MaxUint64),
+			err:         "param key value 18446744073709551615 overflows int",
+		},
+		{
+			name:        "Max Uint",
+			description: "The largest int should be converted from a uint64",
+			val:         uint64(math.MaxInt),
+			expected:    math.MaxInt,
+		},
+		{
+			name:        "Overflowing String",
+			description: "Strings beyond the int range should be rejected",
+			val:         "99999999999999999999",
+			err:         `param key: strconv.Atoi: parsing "99999999999999999999": value out of range`,
+		},
+		{
+			name:        "Negative",
+			description: "Negative values should be returned by Int",
+			val:         -3,
+			expected:    -3,
+		},
+		{
+			name:        "Rejected Negative",
+			description: "Negative values should be rejected by NonNegativeInt",
+			val:         float64(-3),
+			nonNegative: true,
+			err:         "param key must not be negative; got -3",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			params := Params{"key": tc.val}
+
+			get := params.Int
+			if tc.nonNegative {
+				get = params.NonNegativeInt
+			}
+
+			n, err := get("key", 0)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, n)
+		})
+	}
+}
+
+func Test_Params_Merge(t *testing.T) {
+	var empty Params
+	assert.Nil(t, empty.Merge(nil), "Ensuring merging empty params is nil")
+
+	base := Params{"threshold": 10, "window": "1m"}
+	merged := base.Merge(Params{"threshold": 20})
+
+	assert.Equal(t, Params{"threshold": 20, "window": "1m"}, merged, "Ensuring overrides take precedence")
+	assert.Equal(t, 10, base["threshold"], "Ensuring the params aren't modified")
+}
+
+func Test_Params_BigInt(t *testing.T) {
+	wei, _ := new(big.Int).SetString("10000000000000000000", 10)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		val any
+
+		expected *big.Int
+		err      string
+	}{
+		{
+			name:        "Integral Float",
+			description: "Integral JSON numbers should be converted",
+			val:         float64(42),
+			expected:    big.NewInt(42),
+		},
+		{
+			name:        "Large Float",
+			description: "Integral JSON numbers beyond the int64 range should be converted exactly",
+			val:         1e19,
+			expected:    wei,
+		},
+		{
+			name:        "Fractional Float",
+			description: "Fractional JSON numbers shouldn't be truncated",
+			val:         2.5,
+			err:         "param key has invalid type float64; expected integer",
+		},
+		{
+			name:        "Infinite Float",
+			description: "Infinite numbers should be rejected",
+			val:         math.Inf(1),
+			err:         "param key has invalid type float64; expected integer",
+		},
+		{
+			name:        "NaN",
+			description: "NaN should be rejected",
+			val:         math.NaN(),
+			err:         "param key has invalid type float64; expected integer",
+		},
+		{
+			name:        "Int64",
+			description: "YAML decoded int64s should be converted",
+			val:         int64(math.MinInt64),
+			expected:    big.NewInt(math.MinInt64),
+		},
+		{
+			name:        "Uint64",
+			description: "YAML decoded uint64s beyond the int64 range should be converted",
+			val:         uint64(10000000000000000000),
+			expected:    wei,
+		},
+		{
+			name:        "JSON Number",
+			description: "Numbers decoded with UseNumber should be converted without losing precision",
+			val:         json.Number("10000000000000000001"),
+			expected:    new(big.Int).Add(wei, big.NewInt(1)),
+		},
+		{
+			name:        "Fractional JSON Number",
+			description: "Fractional numbers decoded with UseNumber should be rejected",
+			val:         json.Number("2.5"),
+			err:         "param key has invalid integer value 2.5",
+		},
+		{
+			name:        "Hex String",
+			description: "0x prefixed strings should be parsed as hex",
+			val:         "0x10",
+			expected:    big.NewInt(16),
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			n, err := Params{"key": tc.val}.BigInt("key", nil)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, tc.expected.Cmp(n), "expected %s; got %s", tc.expected, n)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+// ErrorKind ... Classifies component errors so that consumers can decide how to recover from them
+type ErrorKind int
+
+const (
+	// TransientErr ... Temporary failure that is expected to resolve itself; E.g, RPC timeouts
+	TransientErr ErrorKind = iota
+	// FatalErr ... Failure that the component can't recover from without intervention; E.g, invalid config
+	FatalErr
+	// DataErr ... Failure to process a single piece of transit data; the component keeps running
+	DataErr
+)
+
+// String ... Returns the kind's name
+func (ek ErrorKind) String() string {
+	switch ek {
+	case TransientErr:
+		return "transient"
+	case FatalErr:
+		return "fatal"
+	case DataErr:
+		return "data"
+	default:
+		return "unknown"
+	}
+}
+
+// kindError ... Error tagged with its kind
+type kindError struct {
+	kind ErrorKind
+	err  error
+}
+
+// Error ... Returns the underlying error message
+func (ke *kindError) Error() string {
+	return ke.err.Error()
+}
+
+// Unwrap ... Returns the underlying error
+func (ke *kindError) Unwrap() error {
+	return ke.err
+}
+
+// NewTransientError ... Tags the error as transient
+func NewTransientError(err error) error {
+	return &kindError{kind: TransientErr, err: err}
+}
+
+// NewFatalError ... Tags the error as fatal
+func NewFatalError(err error) error {
+	return &kindError{kind: FatalErr, err: err}
+}
+
+// NewDataError ... Tags the error as a data error
+func NewDataError(err error) error {
+	return &kindError{kind: DataErr, err: err}
+}
+
+// KindOf ... Returns the kind the error was tagged with or the default kind for untagged errors
+func KindOf(err error, def ErrorKind) ErrorKind {
+	var ke *kindError
+	if errors.As(err, &ke) {
+		return ke.kind
+	}
+
+	return def
+}
+
+// withDefaultKind ... Tags the error with the kind unless it's already tagged
+func withDefaultKind(err error, kind ErrorKind) error {
+	var ke *kindError
+	if errors.As(err, &ke) {
+		return err
+	}
+
+	return &kindError{kind: kind, err: err}
+}
+
+// ComponentError ... Error reported by a running component
+type ComponentError struct {
+	ComponentID models.ComponentID
+	Kind        ErrorKind
+	Timestamp   time.Time
+	Err         error
+
+	// Input that couldn't be processed; nil when the error isn't tied to an input
+	Input *models.TransitData
+}
+
+// Error ... Returns the error message prefixed by its origin
+func (ce ComponentError) Error() string {
+	return fmt.Sprintf("%s error from component %s: %s", ce.Kind, ce.ComponentID, ce.Err)
+}
+
+// Unwrap ... Returns the underlying error
+func (ce ComponentError) Unwrap() error {
+	return ce.Err
+}
+
+// ErrorReporter ... Implemented by oracle definitions that report errors which don't stop their routines
+type ErrorReporter interface {
+	// OnError ... Registers a function invoked with every recoverable routine error
+	OnError(fn func(err error))
+}
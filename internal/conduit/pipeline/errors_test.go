@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_KindOf(t *testing.T) {
+	base := errors.New("connection refused")
+
+	assert.Equal(t, TransientErr, KindOf(NewTransientError(base), DataErr))
+	assert.Equal(t, FatalErr, KindOf(fmt.Errorf("wrapped: %w", NewFatalError(base)), DataErr),
+		"Ensuring tags are found within wrapped errors")
+	assert.Equal(t, DataErr, KindOf(base, DataErr), "Ensuring untagged errors use the default kind")
+
+	assert.ErrorIs(t, NewTransientError(base), base)
+	assert.Equal(t, TransientErr, KindOf(withDefaultKind(NewTransientError(base), FatalErr), FatalErr),
+		"Ensuring existing tags aren't overridden")
+}
+
+func Test_Pipe_ReportErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	inputChan := make(chan models.TransitData)
+	errs := make(chan ComponentError, 1)
+
+	failing := func(td models.TransitData) ([]models.TransitData, error) {
+		return nil, fmt.Errorf("could not transform %s", td.Type)
+	}
+
+	testPipe, err := NewPipe(ctx, failing, inputChan)
+	assert.NoError(t, err)
+
+	id := models.NewComponentID(models.Layer1, models.Live, "TEST")
+	testPipe.SetID(id)
+	testPipe.SetErrorChannel(errs)
+
+	go func() {
+		_ = testPipe.EventLoop()
+	}()
+	defer testPipe.Close()
+
+	inputChan <- models.TransitData{Type: "TEST"}
+
+	select {
+	case cErr := <-errs:
+		assert.Equal(t, id, cErr.ComponentID)
+		assert.Equal(t, DataErr, cErr.Kind, "Ensuring untagged transform errors are data errors")
+		assert.EqualError(t, cErr.Err, "could not transform TEST")
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for component error")
+	}
+}
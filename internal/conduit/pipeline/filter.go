@@ -0,0 +1,111 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/zap"
+)
+
+// Predicate ... Returns true if the transit data should be kept
+type Predicate func(td models.TransitData) (bool, error)
+
+// Filter ... Lightweight component that drops transit data not matching its predicate; kept data is
+// transited unmodified so that it retains the provenance of the component that produced it
+// E.G, (ORACLE || PIPE || FILTER) -> FILTER
+type Filter struct {
+	ctx       context.Context
+	predicate Predicate
+
+	// Channel that a filter is subscribed to for new data events
+	inputChan chan models.TransitData
+
+	lifecycle
+	metaData
+	stats
+	*OutputRouter
+}
+
+// NewFilter ... Initializer
+func NewFilter(ctx context.Context, predicate Predicate,
+	inputChan chan models.TransitData) (Component, error) {
+	router, err := NewOutputRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go router.haltOnDone(ctx)
+
+	return &Filter{
+		ctx:          ctx,
+		lifecycle:    newLifecycle(cancel),
+		predicate:    predicate,
+		inputChan:    inputChan,
+		OutputRouter: router,
+	}, nil
+}
+
+// Type ... Returns component type
+func (f *Filter) Type() models.ComponentType {
+	return models.Filter
+}
+
+// Close ... Stops the event loop; the input channel is owned by the upstream wiring and is left open
+func (f *Filter) Close() {
+	f.lifecycle.stop()
+}
+
+// Stats ... Returns the filter's throughput & latency
+func (f *Filter) Stats() ComponentStats {
+	return f.stats.snapshot(f.ID(), f.Type())
+}
+
+// EventLoop ... Driver loop that reads from the input channel and transits every item matching the predicate;
+// predicate errors are logged and the offending item is dropped
+func (f *Filter) EventLoop() error {
+	defer f.lifecycle.begin()()
+
+	log := ctxzap.Extract(f.ctx).With(zap.String("component_id", f.ID().String()))
+	for {
+		select {
+		case inputData := <-f.inputChan:
+			items := models.Unbatch(inputData)
+			f.recordIn(len(items))
+
+			kept := make([]models.TransitData, 0)
+			for _, item := range items {
+				start := time.Now()
+				match, err := f.predicate(item)
+				f.observe(start)
+
+				if err != nil {
+					log.Error("error applying filter predicate", zap.Error(err))
+					f.reportInputErr(err, item)
+					continue
+				}
+
+				if match {
+					kept = append(kept, item)
+				}
+			}
+
+			if len(kept) == 0 {
+				continue
+			}
+
+			if err := f.OutputRouter.TransitOutputs(kept); err != nil {
+				log.Warn("failed to transit output", zap.Error(err))
+				f.reportErr(err, TransientErr)
+				continue
+			}
+
+			f.recordOut(len(kept))
+
+		case <-f.ctx.Done():
+			return nil
+		}
+	}
+}
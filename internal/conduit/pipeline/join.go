@@ -0,0 +1,306 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/zap"
+)
+
+const (
+	// minJoinSweepInterval ... Lower bound on how often buffered join items are checked for expiry
+	minJoinSweepInterval = 10 * time.Millisecond
+)
+
+// KeyFunc ... Returns the correlation key of transit data; data keyed with an empty key is irrelevant
+// to the join and discarded
+type KeyFunc func(td models.TransitData) (string, error)
+
+// HeightKey ... Correlates transit data by the height of the block it was derived from
+func HeightKey(td models.TransitData) (string, error) {
+	if td.Height == nil {
+		return "", fmt.Errorf(missingHeightKeyErr, td.Type)
+	}
+
+	return td.Height.String(), nil
+}
+
+// JoinSpec ... Configuration of a join component
+type JoinSpec struct {
+	// Register type of the emitted pairs
+	OutputType models.RegisterType
+
+	LeftKey  KeyFunc
+	RightKey KeyFunc
+
+	// Duration that an item is buffered for before being emitted unmatched; items never expire when zero
+	Timeout time.Duration
+}
+
+// JoinedPair ... Value of transit data emitted by a join; one side is nil when the pair is unmatched
+type JoinedPair struct {
+	Key   string
+	Left  *models.TransitData
+	Right *models.TransitData
+}
+
+// Matched ... Returns true if both sides of the pair are present
+func (jp JoinedPair) Matched() bool {
+	return jp.Left != nil && jp.Right != nil
+}
+
+// joinItem ... Buffered item awaiting a match
+type joinItem struct {
+	td      models.TransitData
+	arrived time.Time
+}
+
+// joinSide ... Items of a single side awaiting a match, queued per key in arrival order
+type joinSide struct {
+	key     KeyFunc
+	pending map[string][]joinItem
+}
+
+// pop ... Removes & returns the oldest item buffered for the key
+func (js *joinSide) pop(key string) (models.TransitData, bool) {
+	items := js.pending[key]
+	if len(items) == 0 {
+		return models.TransitData{}, false
+	}
+
+	if len(items) == 1 {
+		delete(js.pending, key)
+	} else {
+		js.pending[key] = items[1:]
+	}
+
+	return items[0].td, true
+}
+
+// expire ... Removes & returns every item buffered before the cutoff, keyed by their correlation key
+func (js *joinSide) expire(cutoff time.Time) map[string][]models.TransitData {
+	expired := make(map[string][]models.TransitData)
+
+	for key, items := range js.pending {
+		i := 0
+		for i < len(items) && items[i].arrived.Before(cutoff) {
+			expired[key] = append(expired[key], items[i].td)
+			i++
+		}
+
+		if i == len(items) {
+			delete(js.pending, key)
+		} else if i > 0 {
+			js.pending[key] = items[i:]
+		}
+	}
+
+	return expired
+}
+
+// Join ... Component that correlates transit data read from two inputs and emits matched pairs
+// E.G, (ORACLE || PIPE) + (ORACLE || PIPE) -> JOIN
+type Join struct {
+	ctx  context.Context
+	spec JoinSpec
+
+	leftChan  chan models.TransitData
+	rightChan chan models.TransitData
+
+	left  *joinSide
+	right *joinSide
+
+	lifecycle
+	metaData
+	stats
+	*OutputRouter
+}
+
+// NewJoin ... Initializer
+func NewJoin(ctx context.Context, spec JoinSpec,
+	leftChan chan models.TransitData, rightChan chan models.TransitData) (Component, error) {
+	if spec.LeftKey == nil || spec.RightKey == nil {
+		return nil, fmt.Errorf(missingJoinKeyErr)
+	}
+
+	if spec.Timeout < 0 {
+		return nil, fmt.Errorf(invalidJoinTimeoutErr, spec.Timeout)
+	}
+
+	router, err := NewOutputRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go router.haltOnDone(ctx)
+
+	return &Join{
+		ctx:          ctx,
+		lifecycle:    newLifecycle(cancel),
+		spec:         spec,
+		leftChan:     leftChan,
+		rightChan:    rightChan,
+		left:         &joinSide{key: spec.LeftKey, pending: make(map[string][]joinItem)},
+		right:        &joinSide{key: spec.RightKey, pending: make(map[string][]joinItem)},
+		OutputRouter: router,
+	}, nil
+}
+
+// Type ... Returns component type
+func (j *Join) Type() models.ComponentType {
+	return models.Join
+}
+
+// Close ... Stops the event loop; buffered items are discarded
+func (j *Join) Close() {
+	j.lifecycle.stop()
+}
+
+// Stats ... Returns the join's throughput & latency
+func (j *Join) Stats() ComponentStats {
+	return j.stats.snapshot(j.ID(), j.Type())
+}
+
+// EventLoop ... Driver loop that buffers inputs from both sides until they're matched or expire
+func (j *Join) EventLoop() error {
+	defer j.lifecycle.begin()()
+
+	log := ctxzap.Extract(j.ctx).With(zap.String("component_id", j.ID().String()))
+
+	// Expiry is disabled by leaving the sweep channel nil
+	var sweep <-chan time.Time
+	if j.spec.Timeout > 0 {
+		interval := j.spec.Timeout / 2
+		if interval < minJoinSweepInterval {
+			interval = minJoinSweepInterval
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		sweep = ticker.C
+	}
+
+	for {
+		select {
+		case inputData := <-j.leftChan:
+			j.emit(j.ingest(inputData, j.left, j.right, true, log), log)
+
+		case inputData := <-j.rightChan:
+			j.emit(j.ingest(inputData, j.right, j.left, false, log), log)
+
+		case now := <-sweep:
+			j.emit(j.expire(now), log)
+
+		case <-j.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// ingest ... Matches every item of a possibly batched input against the opposite side's buffer;
+// items without a match are buffered
+func (j *Join) ingest(inputData models.TransitData, own, other *joinSide, isLeft bool,
+	log *zap.Logger) []models.TransitData {
+	items := models.Unbatch(inputData)
+	j.recordIn(len(items))
+
+	outputs := make([]models.TransitData, 0)
+	for _, item := range items {
+		item := item
+
+		start := time.Now()
+		key, err := own.key(item)
+		if err != nil {
+			log.Error("error computing join key", zap.Error(err))
+			j.reportInputErr(err, item)
+			j.observe(start)
+			continue
+		}
+
+		if key == "" {
+			j.observe(start)
+			continue
+		}
+
+		match, found := other.pop(key)
+		switch {
+		case !found:
+			own.pending[key] = append(own.pending[key], joinItem{td: item, arrived: time.Now()})
+		case isLeft:
+			outputs = append(outputs, j.pair(key, &item, &match))
+		default:
+			outputs = append(outputs, j.pair(key, &match, &item))
+		}
+
+		j.observe(start)
+	}
+
+	return outputs
+}
+
+// expire ... Returns unmatched pairs for every item buffered longer than the timeout
+func (j *Join) expire(now time.Time) []models.TransitData {
+	cutoff := now.Add(-j.spec.Timeout)
+	outputs := make([]models.TransitData, 0)
+
+	for key, items := range j.left.expire(cutoff) {
+		for i := range items {
+			outputs = append(outputs, j.pair(key, &items[i], nil))
+		}
+	}
+
+	for key, items := range j.right.expire(cutoff) {
+		for i := range items {
+			outputs = append(outputs, j.pair(key, nil, &items[i]))
+		}
+	}
+
+	return outputs
+}
+
+// pair ... Wraps the sides into transit data; the pair inherits the height of its left side when present
+// and the highest priority of its sides
+func (j *Join) pair(key string, left, right *models.TransitData) models.TransitData {
+	td := models.TransitData{
+		Timestamp: time.Now(),
+		Type:      j.spec.OutputType,
+		Value:     JoinedPair{Key: key, Left: left, Right: right},
+	}
+
+	if left != nil {
+		td.Height = left.Height
+	} else if right != nil {
+		td.Height = right.Height
+	}
+
+	for _, side := range []*models.TransitData{left, right} {
+		if side != nil && side.Priority > td.Priority {
+			td.Priority = side.Priority
+		}
+	}
+
+	return td
+}
+
+// emit ... Stamps & transits the outputs
+func (j *Join) emit(outputs []models.TransitData, log *zap.Logger) {
+	if len(outputs) == 0 {
+		return
+	}
+
+	for i := range outputs {
+		j.stamp(&outputs[i])
+	}
+
+	if err := j.OutputRouter.TransitOutputs(outputs); err != nil {
+		log.Warn("failed to transit output", zap.Error(err))
+		j.reportErr(err, TransientErr)
+		return
+	}
+
+	j.recordOut(len(outputs))
+}
@@ -0,0 +1,131 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Join(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leftChan, rightChan := make(chan models.TransitData), make(chan models.TransitData)
+	outChan := make(chan models.TransitData, 10)
+	errs := make(chan ComponentError, 10)
+
+	_, err := NewJoin(ctx, JoinSpec{LeftKey: HeightKey}, leftChan, rightChan)
+	assert.Error(t, err, "Ensuring both key functions are required")
+
+	join, err := NewJoin(ctx, JoinSpec{
+		OutputType: "L1_L2_BLOCKS",
+		LeftKey:    HeightKey,
+		RightKey:   HeightKey,
+		Timeout:    50 * time.Millisecond,
+	}, leftChan, rightChan)
+	assert.NoError(t, err)
+	assert.Equal(t, models.Join, join.Type())
+
+	join.SetErrorChannel(errs)
+	assert.NoError(t, join.AddDirective(0x420, outChan))
+
+	go func() {
+		_ = join.EventLoop()
+	}()
+	defer join.Close()
+
+	newData := func(rt models.RegisterType, height int64) models.TransitData {
+		return models.TransitData{Type: rt, Height: big.NewInt(height)}
+	}
+
+	receive := func() JoinedPair {
+		select {
+		case output := <-outChan:
+			assert.Equal(t, models.RegisterType("L1_L2_BLOCKS"), output.Type)
+
+			pair, vErr := models.ValueAs[JoinedPair](output)
+			assert.NoError(t, vErr)
+			return pair
+
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for join output")
+			return JoinedPair{}
+		}
+	}
+
+	// Right side arrives first and is held until its left side is read
+	rightChan <- newData("L2", 1)
+	leftChan <- models.NewBatch([]models.TransitData{newData("L1", 2), newData("L1", 1)})
+
+	pair := receive()
+	assert.True(t, pair.Matched())
+	assert.Equal(t, "1", pair.Key)
+	assert.Equal(t, models.RegisterType("L1"), pair.Left.Type)
+	assert.Equal(t, models.RegisterType("L2"), pair.Right.Type)
+
+	// Height 2 never receives a right side and is emitted unmatched once it expires
+	pair = receive()
+	assert.False(t, pair.Matched())
+	assert.Equal(t, "2", pair.Key)
+	assert.Nil(t, pair.Right)
+	assert.Equal(t, big.NewInt(2), pair.Left.Height)
+
+	// Data without a height can't be keyed
+	rightChan <- models.TransitData{Type: "L2"}
+
+	select {
+	case cErr := <-errs:
+		assert.Equal(t, DataErr, cErr.Kind)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for join key error")
+	}
+}
+
+func Test_Join_EmptyKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leftChan, rightChan := make(chan models.TransitData), make(chan models.TransitData)
+	outChan := make(chan models.TransitData, 10)
+
+	// Odd heights are irrelevant to the left side
+	oddless := func(td models.TransitData) (string, error) {
+		if td.Height.Bit(0) == 1 {
+			return "", nil
+		}
+
+		return HeightKey(td)
+	}
+
+	join, err := NewJoin(ctx, JoinSpec{LeftKey: oddless, RightKey: HeightKey}, leftChan, rightChan)
+	assert.NoError(t, err)
+	assert.NoError(t, join.AddDirective(0x420, outChan))
+
+	go func() {
+		_ = join.EventLoop()
+	}()
+	defer join.Close()
+
+	leftChan <- models.TransitData{Height: big.NewInt(1)}
+	leftChan <- models.TransitData{Height: big.NewInt(2)}
+	rightChan <- models.TransitData{Height: big.NewInt(1)}
+	rightChan <- models.TransitData{Height: big.NewInt(2)}
+
+	select {
+	case output := <-outChan:
+		pair, vErr := models.ValueAs[JoinedPair](output)
+		assert.NoError(t, vErr)
+		assert.True(t, pair.Matched())
+		assert.Equal(t, "2", pair.Key)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for join output")
+	}
+
+	// The discarded left item never matched the right side of height 1
+	assert.Equal(t, 0, len(outChan))
+}
@@ -2,9 +2,11 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sync"
 
+	"github.com/base-org/pessimism/internal/conduit/checkpoint"
 	"github.com/base-org/pessimism/internal/conduit/models"
 	"github.com/base-org/pessimism/internal/logging"
 	"go.uber.org/zap"
@@ -18,6 +20,36 @@ type OracleDefinition interface {
 	ReadRoutine(ctx context.Context, componentChan chan models.TransitData) error
 }
 
+// HeightReader ... Implemented by oracle definitions that sequentially read blocks by height;
+// required by the backtest & backfill oracle types
+type HeightReader interface {
+	// HeightRange ... Returns the configured inclusive start & end heights; nil heights are unbounded
+	HeightRange() (*big.Int, *big.Int)
+	// LatestHeight ... Returns the current chain tip height
+	LatestHeight(ctx context.Context) (*big.Int, error)
+	// SetCurrentHeight ... Sets the height that the read routine will process next
+	SetCurrentHeight(height *big.Int)
+}
+
+// HeightReporter ... Implemented by oracle definitions that report every fully transited height
+type HeightReporter interface {
+	// OnHeightProcessed ... Registers a function invoked after all data for a height has been transited
+	OnHeightProcessed(fn func(height *big.Int))
+}
+
+// Checkpointable ... Implemented by components that can persist & resume their read height
+type Checkpointable interface {
+	// SupportsCheckpoints ... Returns true if the component reads by height & can therefore be checkpointed
+	SupportsCheckpoints() bool
+	EnableCheckpoints(store checkpoint.Store, key string) error
+}
+
+// ActivityReporter ... Implemented by components that report their read activity using pipeline states
+type ActivityReporter interface {
+	// OnActivity ... Registers a function invoked on every activity state transition
+	OnActivity(fn func(state models.PipelineState))
+}
+
 // OracleOption ...
 type OracleOption = func(*Oracle)
 
@@ -29,6 +61,20 @@ type Oracle struct {
 	ot        OracleType
 	waitGroup *sync.WaitGroup
 
+	// Height following the last checkpoint; nil when no checkpoint was found
+	resumeHeight *big.Int
+	checkpoints  checkpoint.Store
+	cpKey        string
+
+	// Activity state is only accessed from the read routine go routine
+	activityFn func(state models.PipelineState)
+	activity   models.PipelineState
+	syncTarget *big.Int
+
+	lifecycle
+	pauser
+	metaData
+	stats
 	*OutputRouter
 }
 
@@ -37,6 +83,11 @@ func (o *Oracle) Type() models.ComponentType {
 	return models.Oracle
 }
 
+// Stats ... Returns the oracle's throughput
+func (o *Oracle) Stats() ComponentStats {
+	return o.stats.snapshot(o.ID(), o.Type())
+}
+
 // NewOracle ... Initializer
 func NewOracle(ctx context.Context, ot OracleType,
 	od OracleDefinition, opts ...OracleOption) (Component, error) {
@@ -45,30 +96,47 @@ func NewOracle(ctx context.Context, ot OracleType,
 		return nil, err
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	go router.haltOnDone(ctx)
+
 	o := &Oracle{
 		ctx:          ctx,
+		lifecycle:    newLifecycle(cancel),
+		pauser:       newPauser(),
 		od:           od,
 		ot:           ot,
 		waitGroup:    &sync.WaitGroup{},
+		activity:     models.BootingState,
 		OutputRouter: router,
 	}
 
+	if reporter, ok := od.(HeightReporter); ok {
+		reporter.OnHeightProcessed(o.heightProcessed)
+	}
+
+	if reporter, ok := od.(ErrorReporter); ok {
+		reporter.OnError(func(err error) {
+			o.reportErr(err, TransientErr)
+		})
+	}
+
 	for _, opt := range opts {
 		opt(o)
 	}
 
 	if cfgErr := od.ConfigureRoutine(); cfgErr != nil {
-		return nil, cfgErr
+		cancel()
+		return nil, withDefaultKind(cfgErr, FatalErr)
 	}
 
 	return o, nil
 }
 
-// TODO (#22) : Add closure logic to all component types
-
 // Close ... This function is called at the end when processes related to oracle need to shut down
 func (o *Oracle) Close() {
-	logging.WithContext(o.ctx).Info("Waiting for oracle goroutines to be done.")
+	logging.WithContext(o.ctx).Info("Waiting for oracle goroutines to be done.",
+		zap.String("component_id", o.ID().String()))
+	o.lifecycle.stop()
 	o.waitGroup.Wait()
 	logging.WithContext(o.ctx).Info("Oracle goroutines have exited.")
 }
@@ -76,25 +144,289 @@ func (o *Oracle) Close() {
 // EventLoop ... Component loop that actively waits and transits register data
 // from a channel that the definition's read routine writes to
 func (o *Oracle) EventLoop() error {
+	defer o.lifecycle.begin()()
+
 	oracleChannel := make(chan models.TransitData)
 
 	// Spawn read routine process
 	o.waitGroup.Add(1)
 	go func() {
 		defer o.waitGroup.Done()
-		if err := o.od.ReadRoutine(o.ctx, oracleChannel); err != nil {
-			logging.WithContext(o.ctx).Error("Received error from read routine", zap.Error(err))
+		defer o.setActivity(models.TerminatedState)
+
+		if err := o.runRoutine(oracleChannel); err != nil {
+			logging.WithContext(o.ctx).Error("Received error from read routine",
+				zap.String("component_id", o.ID().String()), zap.Error(err))
+			// Read routines only return when they can't continue
+			o.reportErr(err, FatalErr)
 		}
 	}()
 
 	for {
+		// Reading is halted while paused, blocking the read routine at its current height
+		input := oracleChannel
+		if o.Paused() {
+			input = nil
+		}
+
 		select {
-		case registerData := <-oracleChannel:
-			o.OutputRouter.TransitOutput(registerData)
+		case <-o.toggled:
+			continue
+
+		case registerData := <-input:
+			// Definitions may emit batches; every batched item is stamped individually
+			items := models.Unbatch(registerData)
+			o.recordIn(len(items))
+
+			for i := range items {
+				o.stamp(&items[i])
+			}
+
+			if err := o.OutputRouter.TransitOutputs(items); err != nil {
+				logging.WithContext(o.ctx).Warn("Failed to transit oracle output",
+					zap.String("component_id", o.ID().String()), zap.Error(err))
+				o.reportErr(err, TransientErr)
+				continue
+			}
+
+			o.recordOut(len(items))
 
 		case <-o.ctx.Done():
+			// Drain the channel until the read routine exits so that it never blocks on an unread send
+			go func() {
+				for range oracleChannel {
+					// Discard values read after shutdown
+				}
+			}()
+
+			o.waitGroup.Wait()
 			close(oracleChannel)
 			return nil
 		}
 	}
 }
+
+// SupportsCheckpoints ... Returns true if the oracle definition reads & reports heights
+func (o *Oracle) SupportsCheckpoints() bool {
+	_, isReader := o.od.(HeightReader)
+	_, isReporter := o.od.(HeightReporter)
+
+	return isReader && isReporter
+}
+
+// EnableCheckpoints ... Resumes reading from the height following the key's checkpoint, if one exists, and
+// records every processed height to the store; must be called before the event loop is started
+func (o *Oracle) EnableCheckpoints(store checkpoint.Store, key string) error {
+	if !o.SupportsCheckpoints() {
+		return fmt.Errorf(checkpointSupportErr)
+	}
+
+	last, found, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		o.resumeHeight = new(big.Int).Add(last, big.NewInt(1))
+		o.od.(HeightReader).SetCurrentHeight(new(big.Int).Set(o.resumeHeight))
+
+		logging.WithContext(o.ctx).Info("Resuming oracle from checkpoint",
+			zap.String("component_id", o.ID().String()), zap.String("height", o.resumeHeight.String()))
+	}
+
+	o.checkpoints = store
+	o.cpKey = key
+	return nil
+}
+
+// OnActivity ... Registers a function invoked on every activity state transition; must be called
+// before the event loop is started
+func (o *Oracle) OnActivity(fn func(state models.PipelineState)) {
+	o.activityFn = fn
+}
+
+// setActivity ... Transitions the activity state, notifying the registered function on change
+func (o *Oracle) setActivity(state models.PipelineState) {
+	if o.activity == state {
+		return
+	}
+
+	o.activity = state
+	if o.activityFn != nil {
+		o.activityFn(state)
+	}
+}
+
+// startSync ... Enters the syncing state until the given height is processed; definitions that can't
+// report processed heights are considered live immediately
+func (o *Oracle) startSync(target *big.Int) {
+	if _, ok := o.od.(HeightReporter); !ok || target == nil {
+		o.setActivity(models.LiveState)
+		return
+	}
+
+	o.syncTarget = target
+	o.setActivity(models.SyncingState)
+}
+
+// heightProcessed ... Invoked by the definition after every fully transited height; records &
+// checkpoints the height and transitions from syncing to live once the chain tip has been reached
+func (o *Oracle) heightProcessed(height *big.Int) {
+	o.stats.recordHeight(height)
+
+	if o.checkpoints != nil {
+		if err := o.checkpoints.Set(o.cpKey, height); err != nil {
+			logging.WithContext(o.ctx).Error("Failed to checkpoint oracle height",
+				zap.String("component_id", o.ID().String()), zap.Error(err))
+			o.reportErr(err, TransientErr)
+		}
+	}
+
+	if o.activity != models.SyncingState || height.Cmp(o.syncTarget) < 0 {
+		return
+	}
+
+	hr, ok := o.od.(HeightReader)
+	if !ok {
+		o.setActivity(models.LiveState)
+		return
+	}
+
+	// The tip may have advanced while syncing; re-check it once the previous target is reached
+	tip, err := hr.LatestHeight(o.ctx)
+	if err != nil {
+		logging.WithContext(o.ctx).Warn("Failed to fetch latest height while syncing",
+			zap.String("component_id", o.ID().String()), zap.Error(err))
+		o.reportErr(err, TransientErr)
+		return
+	}
+
+	if height.Cmp(tip) >= 0 {
+		o.setActivity(models.LiveState)
+		return
+	}
+
+	o.syncTarget = tip
+}
+
+// heightRange ... Returns the definition's height range with the start replaced by the checkpoint resume height
+func (o *Oracle) heightRange(hr HeightReader) (*big.Int, *big.Int) {
+	start, end := hr.HeightRange()
+	if o.resumeHeight != nil {
+		start = o.resumeHeight
+	}
+
+	if start != nil {
+		start = new(big.Int).Set(start)
+	}
+
+	return start, end
+}
+
+// runRoutine ... Runs the definition routine(s) that correspond to the oracle type
+func (o *Oracle) runRoutine(oracleChannel chan models.TransitData) error {
+	switch o.ot {
+	case LiveOracle:
+		var target *big.Int
+		if hr, ok := o.od.(HeightReader); ok {
+			// Reads starting from a fixed height must first catch up to the tip
+			if start, _ := o.heightRange(hr); start != nil {
+				target, _ = hr.LatestHeight(o.ctx)
+			}
+		}
+
+		o.startSync(target)
+		return o.od.ReadRoutine(o.ctx, oracleChannel)
+
+	case BacktestOracle:
+		hr, ok := o.od.(HeightReader)
+		if !ok {
+			return fmt.Errorf(heightReaderErr, o.ot)
+		}
+
+		start, end := o.heightRange(hr)
+		if start == nil {
+			return fmt.Errorf(missingStartHeightErr, o.ot)
+		}
+
+		if end == nil {
+			tip, err := hr.LatestHeight(o.ctx)
+			if err != nil {
+				return err
+			}
+			end = tip
+		}
+
+		// Checkpoint is already past the end of the range
+		if start.Cmp(end) > 0 {
+			return nil
+		}
+
+		o.setActivity(models.BackfillingState)
+		return o.od.BackTestRoutine(o.ctx, oracleChannel, new(big.Int).Set(start), new(big.Int).Set(end))
+
+	case BackfillOracle:
+		return o.backfill(oracleChannel)
+
+	default:
+		return fmt.Errorf(unsupportedOracleErr, o.ot)
+	}
+}
+
+// backfill ... Backtests from the start height to the chain tip observed at startup and then hands off
+// to the read routine at the following height; every height is transited exactly once
+func (o *Oracle) backfill(oracleChannel chan models.TransitData) error {
+	hr, ok := o.od.(HeightReader)
+	if !ok {
+		return fmt.Errorf(heightReaderErr, o.ot)
+	}
+
+	start, end := o.heightRange(hr)
+	if start == nil {
+		return fmt.Errorf(missingStartHeightErr, o.ot)
+	}
+
+	tip, err := hr.LatestHeight(o.ctx)
+	if err != nil {
+		return err
+	}
+
+	// The configured range ends before the tip; no live reading is required
+	if end != nil && end.Cmp(tip) <= 0 {
+		if start.Cmp(end) > 0 {
+			return nil
+		}
+
+		o.setActivity(models.BackfillingState)
+		return o.od.BackTestRoutine(o.ctx, oracleChannel, new(big.Int).Set(start), new(big.Int).Set(end))
+	}
+
+	if start.Cmp(tip) > 0 {
+		o.startSync(nil)
+		logging.WithContext(o.ctx).Info("Oracle start height is beyond the tip; starting live reads",
+			zap.String("component_id", o.ID().String()))
+	} else {
+		o.setActivity(models.BackfillingState)
+		logging.WithContext(o.ctx).Info("Starting oracle backfill",
+			zap.String("component_id", o.ID().String()), zap.String("start", start.String()),
+			zap.String("tip", tip.String()))
+
+		btErr := o.od.BackTestRoutine(o.ctx, oracleChannel, new(big.Int).Set(start), new(big.Int).Set(tip))
+		if btErr != nil {
+			return btErr
+		}
+
+		// Backtest routines return early on shutdown
+		if o.ctx.Err() != nil {
+			return nil
+		}
+
+		hr.SetCurrentHeight(new(big.Int).Add(tip, big.NewInt(1)))
+		o.startSync(tip)
+
+		logging.WithContext(o.ctx).Info("Oracle backfill complete; transitioning to live reads",
+			zap.String("component_id", o.ID().String()))
+	}
+
+	return o.od.ReadRoutine(o.ctx, oracleChannel)
+}
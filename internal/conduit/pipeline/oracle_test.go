@@ -0,0 +1,180 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/checkpoint"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// heightODef ... Oracle definition that transits block heights; live reads transit three heights
+type heightODef struct {
+	start, end *big.Int
+	tip        *big.Int
+	currHeight *big.Int
+
+	processed func(*big.Int)
+}
+
+func (od *heightODef) ConfigureRoutine() error {
+	return nil
+}
+
+func (od *heightODef) BackTestRoutine(ctx context.Context, componentChan chan models.TransitData,
+	startHeight *big.Int, endHeight *big.Int) error {
+	for h := startHeight.Int64(); h <= endHeight.Int64(); h++ {
+		componentChan <- models.TransitData{Type: "backtest", Value: h}
+		if od.processed != nil {
+			od.processed(big.NewInt(h))
+		}
+	}
+	return nil
+}
+
+func (od *heightODef) ReadRoutine(ctx context.Context, componentChan chan models.TransitData) error {
+	for h := od.currHeight.Int64(); h < od.currHeight.Int64()+3; h++ {
+		componentChan <- models.TransitData{Type: "live", Value: h}
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+func (od *heightODef) HeightRange() (*big.Int, *big.Int) {
+	return od.start, od.end
+}
+
+func (od *heightODef) LatestHeight(_ context.Context) (*big.Int, error) {
+	return od.tip, nil
+}
+
+func (od *heightODef) SetCurrentHeight(height *big.Int) {
+	od.currHeight = height
+}
+
+func (od *heightODef) OnHeightProcessed(fn func(*big.Int)) {
+	od.processed = fn
+}
+
+func Test_Oracle_Backfill(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		od       *heightODef
+		expected []models.TransitData
+	}{
+		{
+			name:        "Backfill Handoff Test",
+			description: "When the start height is behind the tip, heights through the tip should be backtested before live reads start at the next height",
+
+			od: &heightODef{start: big.NewInt(1), tip: big.NewInt(3)},
+			expected: []models.TransitData{
+				{Type: "backtest", Value: int64(1)},
+				{Type: "backtest", Value: int64(2)},
+				{Type: "backtest", Value: int64(3)},
+				{Type: "live", Value: int64(4)},
+				{Type: "live", Value: int64(5)},
+				{Type: "live", Value: int64(6)},
+			},
+		},
+		{
+			name:        "Bounded Backfill Test",
+			description: "When the end height is behind the tip, only the configured range should be backtested",
+
+			od: &heightODef{start: big.NewInt(1), end: big.NewInt(2), tip: big.NewInt(3)},
+			expected: []models.TransitData{
+				{Type: "backtest", Value: int64(1)},
+				{Type: "backtest", Value: int64(2)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			outChan := make(chan models.TransitData)
+
+			oracle, err := NewOracle(ctx, BackfillOracle, tc.od)
+			assert.NoError(t, err)
+			assert.NoError(t, oracle.AddDirective(0x420, outChan))
+
+			go func() {
+				_ = oracle.EventLoop()
+			}()
+			defer oracle.Close()
+
+			for _, expected := range tc.expected {
+				select {
+				case actual := <-outChan:
+					assert.Equal(t, expected.Type, actual.Type)
+					assert.Equal(t, expected.Value, actual.Value)
+
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for oracle output")
+				}
+			}
+
+			select {
+			case actual := <-outChan:
+				t.Fatalf("received unexpected oracle output: %+v", actual)
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func Test_Oracle_Checkpoint(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := checkpoint.NewMemoryStore()
+	assert.NoError(t, store.Set("test", big.NewInt(2)))
+
+	od := &heightODef{start: big.NewInt(1), end: big.NewInt(4), tip: big.NewInt(10)}
+	oracle, err := NewOracle(ctx, BacktestOracle, od)
+	assert.NoError(t, err)
+
+	cp, ok := oracle.(Checkpointable)
+	assert.True(t, ok)
+	assert.NoError(t, cp.EnableCheckpoints(store, "test"))
+
+	outChan := make(chan models.TransitData)
+	assert.NoError(t, oracle.AddDirective(0x420, outChan))
+
+	go func() {
+		_ = oracle.EventLoop()
+	}()
+	defer oracle.Close()
+
+	// Heights up to & including the checkpoint should be skipped
+	for _, expected := range []int64{3, 4} {
+		select {
+		case actual := <-outChan:
+			assert.Equal(t, expected, actual.Value)
+
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for oracle output")
+		}
+	}
+
+	assert.Eventually(t, func() bool {
+		height, found, getErr := store.Get("test")
+		return getErr == nil && found && height.Int64() == 4
+	}, 5*time.Second, 10*time.Millisecond, "Ensuring last processed height is checkpointed")
+
+	height := oracle.(StatsReporter).Stats().Height
+	assert.NotNil(t, height, "Ensuring last processed height is reported")
+	assert.Equal(t, int64(4), height.Int64())
+}
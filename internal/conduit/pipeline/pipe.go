@@ -2,6 +2,9 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/base-org/pessimism/internal/conduit/models"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -16,6 +19,31 @@ func WithRouter(router *OutputRouter) PipeOption {
 	}
 }
 
+// WithWorkers ... Runs the transform function across a pool of workers; sizes of one or less
+// transform inputs sequentially within the event loop
+func WithWorkers(size int, order DeliveryOrder) PipeOption {
+	return func(p *Pipe) {
+		p.workers = size
+		p.order = order
+	}
+}
+
+// DeliveryOrder ... Order in which outputs of pooled transforms are transited
+type DeliveryOrder int
+
+const (
+	// OrderedDelivery ... Outputs are transited in the order that their inputs were received
+	OrderedDelivery DeliveryOrder = iota
+	// UnorderedDelivery ... Outputs are transited as soon as their transform completes
+	UnorderedDelivery
+)
+
+// Parallelizable ... Implemented by components that can process inputs across a pool of workers
+type Parallelizable interface {
+	// SetWorkers ... Sets the worker pool size & delivery order; must be called before the event loop is started
+	SetWorkers(size int, order DeliveryOrder) error
+}
+
 // TransformFunc ... Generic transformation function
 type TranformFunc func(data models.TransitData) ([]models.TransitData, error)
 
@@ -26,9 +54,16 @@ type Pipe struct {
 	ctx   context.Context
 	tform TranformFunc
 
+	// Number of concurrent transforms; transform functions must be safe for concurrent use when above one
+	workers int
+	order   DeliveryOrder
+
 	// Channel that a pipe is subscribed to for new data events
 	inputChan chan models.TransitData
 
+	lifecycle
+	metaData
+	stats
 	*OutputRouter
 }
 
@@ -43,8 +78,11 @@ func NewPipe(ctx context.Context, tform TranformFunc,
 		return nil, err
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+
 	pipe := &Pipe{
 		ctx:          ctx,
+		lifecycle:    newLifecycle(cancel),
 		tform:        tform,
 		inputChan:    inputChan,
 		OutputRouter: router,
@@ -54,6 +92,8 @@ func NewPipe(ctx context.Context, tform TranformFunc,
 		opt(pipe)
 	}
 
+	go pipe.OutputRouter.haltOnDone(ctx)
+
 	return pipe, nil
 }
 
@@ -62,29 +102,49 @@ func (p *Pipe) Type() models.ComponentType {
 	return models.Pipe
 }
 
+// Close ... Stops the event loop; the input channel is owned by the upstream wiring and is left open
 func (p *Pipe) Close() {
+	p.lifecycle.stop()
+}
+
+// Stats ... Returns the pipe's throughput & latency
+func (p *Pipe) Stats() ComponentStats {
+	return p.stats.snapshot(p.ID(), p.Type())
+}
+
+// SetWorkers ... Sets the worker pool size & delivery order
+func (p *Pipe) SetWorkers(size int, order DeliveryOrder) error {
+	if size < 1 {
+		return fmt.Errorf(invalidWorkerCountErr, size)
+	}
+
+	if p.lifecycle.started.Load() {
+		return fmt.Errorf(pipeRunningErr)
+	}
+
+	p.workers = size
+	p.order = order
+	return nil
 }
 
 // EventLoop ... Driver loop for component that actively subscribes
 // to an input channel where transit data is read, transformed, and transitte
 // to downstream components
 func (p *Pipe) EventLoop() error {
-	log := ctxzap.Extract(p.ctx)
+	defer p.lifecycle.begin()()
+
+	log := ctxzap.Extract(p.ctx).With(zap.String("component_id", p.ID().String()))
+	if p.workers > 1 {
+		p.runPool(log)
+		return nil
+	}
+
 	for {
 		select {
 		// Input has been fed to the component
 		case inputData := <-p.inputChan:
 			log.Info("Got input data")
-			outputData, err := p.tform(inputData)
-			if err != nil {
-				// TODO - Introduce prometheus call here
-				// TODO - Introduce go standard logging (I,E. zap) debug call
-				log.Error("error transforming", zap.Error(err))
-				continue
-			}
-
-			log.Info("Transiting output")
-			p.OutputRouter.TransitOutputs(outputData)
+			p.emit(p.transformInput(inputData, log), log)
 
 		// Manager is telling us to shutdown
 		case <-p.ctx.Done():
@@ -92,3 +152,157 @@ func (p *Pipe) EventLoop() error {
 		}
 	}
 }
+
+// poolJob ... Input handed to a pool worker
+type poolJob struct {
+	seq   uint64
+	input models.TransitData
+}
+
+// poolResult ... Outputs of a single pooled transform
+type poolResult struct {
+	seq     uint64
+	outputs []models.TransitData
+}
+
+// runPool ... Dispatches inputs to a pool of transform workers whose results are transited by a single
+// emitter so that output sequences remain monotonic; blocks until the pipe is shut down
+func (p *Pipe) runPool(log *zap.Logger) {
+	jobs := make(chan poolJob)
+	results := make(chan poolResult, p.workers)
+
+	workerGroup := &sync.WaitGroup{}
+	for i := 0; i < p.workers; i++ {
+		workerGroup.Add(1)
+
+		go func() {
+			defer workerGroup.Done()
+
+			for job := range jobs {
+				select {
+				case results <- poolResult{seq: job.seq, outputs: p.transformInput(job.input, log)}:
+				case <-p.ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	emitted := make(chan struct{})
+	go func() {
+		defer close(emitted)
+		p.emitResults(results, log)
+	}()
+
+	var seq uint64
+
+dispatch:
+	for {
+		select {
+		case inputData := <-p.inputChan:
+			select {
+			case jobs <- poolJob{seq: seq, input: inputData}:
+				seq++
+			case <-p.ctx.Done():
+				break dispatch
+			}
+
+		case <-p.ctx.Done():
+			break dispatch
+		}
+	}
+
+	close(jobs)
+	workerGroup.Wait()
+	close(results)
+	<-emitted
+}
+
+// emitResults ... Transits pooled results until the results channel is closed; ordered delivery
+// holds results back until the results of every preceding input have been transited
+func (p *Pipe) emitResults(results <-chan poolResult, log *zap.Logger) {
+	if p.order == UnorderedDelivery {
+		for result := range results {
+			p.emit(result.outputs, log)
+		}
+		return
+	}
+
+	var next uint64
+	pending := make(map[uint64][]models.TransitData)
+
+	for result := range results {
+		pending[result.seq] = result.outputs
+
+		for outputs, found := pending[next]; found; outputs, found = pending[next] {
+			delete(pending, next)
+			next++
+
+			p.emit(outputs, log)
+		}
+	}
+}
+
+// transformInput ... Transforms every item of a possibly batched input; batched inputs have their
+// outputs transited together
+func (p *Pipe) transformInput(inputData models.TransitData, log *zap.Logger) []models.TransitData {
+	items := models.Unbatch(inputData)
+	p.recordIn(len(items))
+
+	outputData := make([]models.TransitData, 0)
+	for _, item := range items {
+		start := time.Now()
+		outputData = append(outputData, p.transform(item, log)...)
+		p.observe(start)
+	}
+
+	return outputData
+}
+
+// emit ... Stamps & transits the outputs
+func (p *Pipe) emit(outputData []models.TransitData, log *zap.Logger) {
+	if len(outputData) == 0 {
+		return
+	}
+
+	for i := range outputData {
+		p.stamp(&outputData[i])
+	}
+
+	log.Info("Transiting output")
+	if err := p.OutputRouter.TransitOutputs(outputData); err != nil {
+		log.Warn("failed to transit output", zap.Error(err))
+		p.reportErr(err, TransientErr)
+		return
+	}
+
+	p.recordOut(len(outputData))
+}
+
+// transform ... Applies the transform function to a single input; transform errors are logged
+// and yield no outputs
+func (p *Pipe) transform(inputData models.TransitData, log *zap.Logger) []models.TransitData {
+	outputData, err := p.tform(inputData)
+	if err != nil {
+		// TODO - Introduce prometheus call here
+		log.Error("error transforming", zap.Error(err))
+		p.reportInputErr(err, inputData)
+		return nil
+	}
+
+	// Outputs are derived from the input unless the transform states otherwise
+	for i := range outputData {
+		if outputData[i].Height == nil {
+			outputData[i].Height = inputData.Height
+		}
+		if outputData[i].Timestamp.IsZero() {
+			outputData[i].Timestamp = inputData.Timestamp
+		}
+		// Data derived from critical data is at least as critical
+		if outputData[i].Priority < inputData.Priority {
+			outputData[i].Priority = inputData.Priority
+		}
+	}
+
+	return outputData
+}
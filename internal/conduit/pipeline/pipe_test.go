@@ -110,3 +110,177 @@ func Test_Pipe_OPBlockToTransactions(t *testing.T) {
 	}
 
 }
+
+func Test_Pipe_Close(t *testing.T) {
+	inputChan := make(chan models.TransitData)
+
+	// Output channel is never read from, leaving the pipe blocked on transit
+	router, err := NewOutputRouter(WithDirective(0x420, make(chan models.TransitData)))
+	assert.NoError(t, err)
+
+	identity := func(td models.TransitData) ([]models.TransitData, error) {
+		return []models.TransitData{td}, nil
+	}
+
+	testPipe, err := NewPipe(context.Background(), identity, inputChan, WithRouter(router))
+	assert.NoError(t, err)
+
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		assert.NoError(t, testPipe.EventLoop())
+	}()
+
+	inputChan <- models.TransitData{Type: "String Beanz"}
+
+	closed := make(chan struct{})
+	go func() {
+		testPipe.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pipe to close")
+	}
+
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event loop to exit")
+	}
+
+	testPipe.Close()
+}
+
+func Test_Pipe_Batch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	inputChan := make(chan models.TransitData)
+	outChan := make(chan models.TransitData, 10)
+
+	router, err := NewOutputRouter(WithBatchSize(10), WithDirective(0x420, outChan))
+	assert.NoError(t, err)
+
+	double := func(td models.TransitData) ([]models.TransitData, error) {
+		val, vErr := models.ValueAs[int](td)
+		if vErr != nil {
+			return nil, vErr
+		}
+
+		return []models.TransitData{{Type: "doubled", Value: val * 2}}, nil
+	}
+
+	testPipe, err := NewPipe(ctx, double, inputChan, WithRouter(router))
+	assert.NoError(t, err)
+
+	go func() {
+		_ = testPipe.EventLoop()
+	}()
+	defer testPipe.Close()
+
+	// Invalid items are dropped without failing the rest of the batch
+	inputChan <- models.NewBatch([]models.TransitData{
+		{Type: "input", Value: 1},
+		{Type: "input", Value: "invalid"},
+		{Type: "input", Value: 3},
+	})
+
+	select {
+	case output := <-outChan:
+		items := models.Unbatch(output)
+		assert.Len(t, items, 2)
+		assert.Equal(t, 2, items[0].Value)
+		assert.Equal(t, 6, items[1].Value)
+		assert.Equal(t, uint64(1), items[0].Sequence)
+		assert.Equal(t, uint64(2), items[1].Sequence)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pipe output")
+	}
+}
+
+func Test_Pipe_Workers(t *testing.T) {
+	const inputs = 20
+
+	// Earlier inputs take longer to transform so that pooled transforms complete out of order
+	slowIdentity := func(td models.TransitData) ([]models.TransitData, error) {
+		val, err := models.ValueAs[int](td)
+		if err != nil {
+			return nil, err
+		}
+
+		time.Sleep(time.Duration(inputs-val) * time.Millisecond)
+		return []models.TransitData{{Type: "output", Value: val}}, nil
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		order DeliveryOrder
+	}{
+		{
+			name:        "Ordered Delivery Test",
+			description: "Outputs should be transited in input order regardless of transform completion order",
+
+			order: OrderedDelivery,
+		},
+		{
+			name:        "Unordered Delivery Test",
+			description: "Every output should be transited with monotonic sequences",
+
+			order: UnorderedDelivery,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			inputChan := make(chan models.TransitData)
+			outChan := make(chan models.TransitData, inputs)
+
+			router, err := NewOutputRouter(WithDirective(0x420, outChan))
+			assert.NoError(t, err)
+
+			testPipe, err := NewPipe(ctx, slowIdentity, inputChan, WithRouter(router))
+			assert.NoError(t, err)
+			assert.Error(t, testPipe.(Parallelizable).SetWorkers(0, tc.order))   //nolint:errcheck // test assertion
+			assert.NoError(t, testPipe.(Parallelizable).SetWorkers(4, tc.order)) //nolint:errcheck // test assertion
+
+			go func() {
+				_ = testPipe.EventLoop()
+			}()
+			defer testPipe.Close()
+
+			for val := 0; val < inputs; val++ {
+				inputChan <- models.TransitData{Type: "input", Value: val}
+			}
+
+			seen := make(map[int]bool, inputs)
+			for seq := 1; seq <= inputs; seq++ {
+				select {
+				case output := <-outChan:
+					assert.Equal(t, uint64(seq), output.Sequence)
+
+					val, vErr := models.ValueAs[int](output)
+					assert.NoError(t, vErr)
+					seen[val] = true
+
+					if tc.order == OrderedDelivery {
+						assert.Equal(t, seq-1, val)
+					}
+
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for pipe output")
+				}
+			}
+
+			assert.Len(t, seen, inputs)
+		})
+	}
+}
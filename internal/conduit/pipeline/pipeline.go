@@ -1,7 +1,13 @@
 package pipeline
 
 import (
+	"context"
+	"sync/atomic"
+	"time"
+
 	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
 )
 
 // Component ... Generalized interface that all pipeline components must adhere to
@@ -9,9 +15,169 @@ type Component interface {
 	// Routing functionality for downstream communication
 	AddDirective(id int, outChan chan models.TransitData) error
 	RemoveDirective(id int) error
+	Directives() []int
+	Configure(opts ...RouterOption) error
 
 	// EventLoop ... Component driver function; spun up as separate go routine
 	EventLoop() error
 	Type() models.ComponentType
+	// Close ... Cancels the component's routines and blocks until they have exited; safe to call more than once
 	Close()
+
+	// Identity functionality; IDs are assigned by the constructing manager prior to running
+	ID() models.ComponentID
+	SetID(id models.ComponentID)
+
+	// SetErrorChannel ... Sets the channel that runtime errors are reported to; must be called before running
+	SetErrorChannel(errs chan<- ComponentError)
+}
+
+// Pausable ... Implemented by components that can temporarily stop ingesting data without losing state
+type Pausable interface {
+	Pause()
+	Resume()
+	Paused() bool
+}
+
+// pauser ... Pause state shared by pausable components; event loops wait on toggled to re-evaluate the state
+type pauser struct {
+	paused  atomic.Bool
+	toggled chan struct{}
+}
+
+// newPauser ... Initializer
+func newPauser() pauser {
+	return pauser{toggled: make(chan struct{}, 1)}
+}
+
+// Pause ... Stops the component from ingesting data
+func (ps *pauser) Pause() {
+	if ps.paused.CompareAndSwap(false, true) {
+		ps.notify()
+	}
+}
+
+// Resume ... Resumes data ingestion
+func (ps *pauser) Resume() {
+	if ps.paused.CompareAndSwap(true, false) {
+		ps.notify()
+	}
+}
+
+// Paused ... Returns true if the component is paused
+func (ps *pauser) Paused() bool {
+	return ps.paused.Load()
+}
+
+// notify ... Wakes the event loop without blocking; pending notifications are coalesced
+func (ps *pauser) notify() {
+	select {
+	case ps.toggled <- struct{}{}:
+	default:
+	}
+}
+
+// metaData ... Identity & reporting state shared by all component types
+type metaData struct {
+	id       models.ComponentID
+	sequence atomic.Uint64
+
+	// Optional; errors aren't reported when nil
+	errs chan<- ComponentError
+}
+
+// ID ... Returns the component ID
+func (md *metaData) ID() models.ComponentID {
+	return md.id
+}
+
+// SetID ... Assigns the component ID; must be called before the event loop is started
+func (md *metaData) SetID(id models.ComponentID) {
+	md.id = id
+}
+
+// SetErrorChannel ... Sets the channel that runtime errors are reported to
+func (md *metaData) SetErrorChannel(errs chan<- ComponentError) {
+	md.errs = errs
+}
+
+// reportErr ... Reports the error using its tagged kind or the default kind if untagged
+func (md *metaData) reportErr(err error, def ErrorKind) {
+	if err == nil {
+		return
+	}
+
+	md.sendErr(ComponentError{
+		ComponentID: md.id,
+		Kind:        KindOf(err, def),
+		Timestamp:   time.Now(),
+		Err:         err,
+	})
+}
+
+// reportInputErr ... Reports a failure to process the input; the input is attached so that it can be
+// inspected & replayed
+func (md *metaData) reportInputErr(err error, input models.TransitData) {
+	md.sendErr(ComponentError{
+		ComponentID: md.id,
+		Kind:        KindOf(err, DataErr),
+		Timestamp:   time.Now(),
+		Err:         err,
+		Input:       &input,
+	})
+}
+
+// sendErr ... Writes the error to the error channel; errors are dropped rather than blocking
+// the component when the channel is full
+func (md *metaData) sendErr(cErr ComponentError) {
+	if md.errs == nil {
+		return
+	}
+
+	select {
+	case md.errs <- cErr:
+	default:
+		// Logger is unset when running outside of the application
+		if log := logging.NoContext(); log != nil {
+			log.Warn("Dropped component error as the error channel is full",
+				zap.String("component_id", md.id.String()), zap.Error(cErr.Err))
+		}
+	}
+}
+
+// lifecycle ... Event loop state shared by all component types; allows the loop to be stopped
+// from a separate go routine than the one running it
+type lifecycle struct {
+	cancel  context.CancelFunc
+	started atomic.Bool
+	exited  chan struct{}
+}
+
+// newLifecycle ... Initializer
+func newLifecycle(cancel context.CancelFunc) lifecycle {
+	return lifecycle{
+		cancel: cancel,
+		exited: make(chan struct{}),
+	}
+}
+
+// begin ... Marks the event loop as running; the returned function must be deferred by the loop
+func (lc *lifecycle) begin() func() {
+	lc.started.Store(true)
+	return func() { close(lc.exited) }
+}
+
+// stop ... Cancels the component context and waits for a running event loop to exit
+func (lc *lifecycle) stop() {
+	lc.cancel()
+	if lc.started.Load() {
+		<-lc.exited
+	}
+}
+
+// stamp ... Sets provenance metadata on transit data emitted by the component
+func (md *metaData) stamp(td *models.TransitData) {
+	td.OriginID = md.id
+	td.Network = md.id.Network
+	td.Sequence = md.sequence.Add(1)
 }
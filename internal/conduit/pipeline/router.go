@@ -1,7 +1,12 @@
 package pipeline
 
 import (
+	"context"
 	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"sync/atomic"
 
 	"github.com/base-org/pessimism/internal/conduit/models"
 )
@@ -14,16 +19,140 @@ func WithDirective(componentID int, outChan chan models.TransitData) RouterOptio
 	}
 }
 
+// WithBufferSize ... Queues up to size transit data per directive so that a slow consumer doesn't
+// stall the component; must be applied before any directive is added
+func WithBufferSize(size int) RouterOption {
+	return func(r *OutputRouter) error {
+		if size < 0 {
+			return fmt.Errorf(invalidBufferSizeErr, size)
+		}
+
+		if len(r.directives) > 0 {
+			return fmt.Errorf(routerConfiguredErr)
+		}
+
+		r.bufferSize = size
+		return nil
+	}
+}
+
+// WithOverflowPolicy ... Sets the behavior used when a directive can't immediately accept transit data
+func WithOverflowPolicy(policy OverflowPolicy) RouterOption {
+	return func(r *OutputRouter) error {
+		r.policy = policy
+		return nil
+	}
+}
+
+// WithBatchSize ... Groups transited data into batches of up to size items so that each batch only costs
+// a single channel send per directive; sizes of one or less disable batching
+func WithBatchSize(size int) RouterOption {
+	return func(r *OutputRouter) error {
+		if size < 0 {
+			return fmt.Errorf(invalidBatchSizeErr, size)
+		}
+
+		r.batchSize = size
+		return nil
+	}
+}
+
+// WithRoutingMode ... Sets how transit data is distributed across directives
+func WithRoutingMode(mode RoutingMode) RouterOption {
+	return func(r *OutputRouter) error {
+		r.mode = mode
+		return nil
+	}
+}
+
+// WithPartitionKey ... Enables partitioned routing where data sharing a key is always delivered to the
+// same directive for as long as the set of directives is unchanged
+func WithPartitionKey(fn PartitionKeyFunc) RouterOption {
+	return func(r *OutputRouter) error {
+		r.mode = PartitionedRouting
+		r.partitionKey = fn
+		return nil
+	}
+}
+
+// RoutingMode ... Distribution of transit data across a router's directives
+type RoutingMode int
+
+const (
+	// BroadcastRouting ... Every directive receives every piece of transit data
+	BroadcastRouting RoutingMode = iota
+	// PartitionedRouting ... Every piece of transit data is delivered to a single directive; data is
+	// distributed round-robin unless a partition key function is set
+	PartitionedRouting
+)
+
+// PartitionKeyFunc ... Returns the key used to assign transit data to a directive under partitioned routing
+type PartitionKeyFunc func(td models.TransitData) string
+
+// OverflowPolicy ... Behavior used when a directive's queue (or unbuffered channel) is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock ... Wait until the directive can accept the data
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest ... Evict the oldest queued data to make room; equivalent to drop newest when unbuffered
+	OverflowDropOldest
+	// OverflowDropNewest ... Discard the data being transited
+	OverflowDropNewest
+	// OverflowError ... Discard the data being transited and return an error
+	OverflowError
+)
+
+// Prioritizable ... Implemented by components whose output directives can be served ahead of others
+type Prioritizable interface {
+	// SetPriority ... Sets the directive's priority; fail if no directive exists for the ID
+	SetPriority(id int, priority models.Priority) error
+}
+
+// directive ... Output destination; buffered directives are fed by a forwarding routine
+// that drains the queues into the destination channel
+type directive struct {
+	outChan  chan models.TransitData
+	priority models.Priority
+
+	// nil when unbuffered; high priority data is queued separately so that it's forwarded ahead of
+	// normal priority data
+	queue  chan models.TransitData
+	urgent chan models.TransitData
+
+	// Closed once the directive is removed; aborts in-flight transits & the forwarding routine
+	stop chan struct{}
+}
+
 // OutputRouter ... Used as a lookup for components to know where to send output data to
-// Adding and removing directives is the equivalent of adding an edge between two nodes using standard graph theory
+// Adding and removing directives is the equivalent of adding an edge between two nodes using standard graph theory;
+// directives can be safely altered while the owning component is running
 type OutputRouter struct {
-	outChans map[int]chan models.TransitData
+	mu         sync.RWMutex
+	directives map[int]*directive
+
+	bufferSize int
+	batchSize  int
+	policy     OverflowPolicy
+
+	mode         RoutingMode
+	partitionKey PartitionKeyFunc
+	// Sorted directive IDs; gives partitioned routing a stable directive order
+	order      []int
+	roundRobin atomic.Uint64
+	// Directive IDs ordered by descending priority then ID; the order that directives are served in
+	ranked []int
+
+	// Closed once the owning component shuts down; aborts in-flight transits & forwarding routines
+	done     chan struct{}
+	haltOnce sync.Once
 }
 
 // NewOutputRouter ... Initializer
 func NewOutputRouter(opts ...RouterOption) (*OutputRouter, error) {
 	router := &OutputRouter{
-		make(map[int]chan models.TransitData),
+		directives: make(map[int]*directive),
+		done:       make(chan struct{}),
 	}
 
 	for _, opt := range opts {
@@ -35,38 +164,323 @@ func NewOutputRouter(opts ...RouterOption) (*OutputRouter, error) {
 	return router, nil
 }
 
-// TransitOutput ... Sends single piece of transitData to all inner mapping value channels
-func (router *OutputRouter) TransitOutput(data models.TransitData) {
-	// NOTE - Consider introducing a fail-safe timeout to ensure that freezing on clogged chanel buffers is recognized
-	for _, channel := range router.outChans {
-		channel <- data
+// Configure ... Applies router options after construction; used by the ETL manager to set
+// queueing behavior on registry constructed components before they're running
+func (router *OutputRouter) Configure(opts ...RouterOption) error {
+	for _, opt := range opts {
+		if err := opt(router); err != nil {
+			return err
+		}
 	}
+
+	return nil
+}
+
+// halt ... Aborts all in-flight transits and stops forwarding routines; safe to call more than once
+func (router *OutputRouter) halt() {
+	router.haltOnce.Do(func() { close(router.done) })
+}
+
+// haltOnDone ... Halts the router once the context is done; blocks until then
+func (router *OutputRouter) haltOnDone(ctx context.Context) {
+	<-ctx.Done()
+	router.halt()
+}
+
+// TransitOutput ... Sends single piece of transitData to all inner mapping value channels; an error
+// is returned if any directive overflowed while using the error overflow policy
+func (router *OutputRouter) TransitOutput(data models.TransitData) error {
+	return router.TransitOutputs(models.Unbatch(data))
 }
 
-// TransitOutputs ... Sends slice of transitData to all inner mapping value channels
-func (router *OutputRouter) TransitOutputs(dataSlice []models.TransitData) {
+// TransitOutputs ... Sends slice of transitData to all inner mapping value channels; data is
+// grouped into batches when batching is enabled
+func (router *OutputRouter) TransitOutputs(dataSlice []models.TransitData) error {
 	// NOTE - Consider introducing a fail-safe timeout to ensure that freezing on clogged chanel buffers is recognized
+	var err error
+	for _, d := range router.deliveries(dataSlice) {
+		if tErr := router.transit(d.id, d.dir, d.data); tErr != nil {
+			err = tErr
+		}
+	}
+
+	return err
+}
+
+// delivery ... Transit data bound for a single directive
+type delivery struct {
+	id   int
+	dir  *directive
+	data models.TransitData
+}
+
+// deliveries ... Resolves the directives that the data is sent to in the order that they're served;
+// the read lock is only held while resolving so that a stalled consumer never blocks directive changes
+func (router *OutputRouter) deliveries(dataSlice []models.TransitData) []delivery {
+	router.mu.RLock()
+	defer router.mu.RUnlock()
+
+	if router.mode == PartitionedRouting {
+		return router.partition(dataSlice)
+	}
+
+	groups := router.group(dataSlice)
+
+	ds := make([]delivery, 0, len(groups)*len(router.ranked))
+	for _, data := range groups {
+		for _, id := range router.ranked {
+			ds = append(ds, delivery{id: id, dir: router.directives[id], data: data})
+		}
+	}
+
+	return ds
+}
+
+// partition ... Assigns every item to a single directive and groups each directive's items;
+// read lock must be held
+func (router *OutputRouter) partition(dataSlice []models.TransitData) []delivery {
+	if len(router.order) == 0 {
+		return nil
+	}
+
+	assigned := make(map[int][]models.TransitData, len(router.order))
+	for _, data := range dataSlice {
+		for _, item := range models.Unbatch(data) {
+			id := router.assign(item)
+			assigned[id] = append(assigned[id], item)
+		}
+	}
+
+	ds := make([]delivery, 0, len(assigned))
+	for _, id := range router.ranked {
+		for _, data := range router.group(assigned[id]) {
+			ds = append(ds, delivery{id: id, dir: router.directives[id], data: data})
+		}
+	}
+
+	return ds
+}
+
+// assign ... Returns the ID of the directive that the item is partitioned to; read lock must be held
+func (router *OutputRouter) assign(item models.TransitData) int {
+	if router.partitionKey == nil {
+		next := router.roundRobin.Add(1) - 1
+		return router.order[next%uint64(len(router.order))]
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(router.partitionKey(item)))
+
+	return router.order[hash.Sum32()%uint32(len(router.order))]
+}
+
+// group ... Flattens any batches within the slice, moves higher priority items ahead of lower priority
+// items while retaining their relative order and regroups the items using the router's batch size;
+// batches never mix priorities and single item groups are transited unwrapped
+func (router *OutputRouter) group(dataSlice []models.TransitData) []models.TransitData {
+	items := make([]models.TransitData, 0, len(dataSlice))
 	for _, data := range dataSlice {
-		router.TransitOutput(data)
+		items = append(items, models.Unbatch(data)...)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Priority > items[j].Priority
+	})
+
+	if router.batchSize <= 1 {
+		return items
+	}
+
+	groups := make([]models.TransitData, 0, len(items)/router.batchSize+1)
+	for start := 0; start < len(items); {
+		end := start + 1
+		for end < len(items) && end-start < router.batchSize && items[end].Priority == items[start].Priority {
+			end++
+		}
+
+		if end-start == 1 {
+			groups = append(groups, items[start])
+		} else {
+			groups = append(groups, models.NewBatch(items[start:end:end]))
+		}
+
+		start = end
+	}
+
+	return groups
+}
+
+// transit ... Writes data to a single directive using the router's overflow policy; data bound for a
+// directive that has since been removed is discarded
+func (router *OutputRouter) transit(id int, dir *directive, data models.TransitData) error {
+	select {
+	case <-dir.stop:
+		return nil
+	default:
+	}
+
+	target := dir.outChan
+	if dir.queue != nil {
+		target = dir.queue
+		if data.Priority >= models.HighPriority {
+			target = dir.urgent
+		}
+	}
+
+	switch router.policy {
+	case OverflowDropOldest:
+		for {
+			select {
+			case target <- data:
+				return nil
+			default:
+			}
+
+			// Never read from unbuffered destinations as they're owned by the consumer
+			if dir.queue == nil {
+				return nil
+			}
+
+			select {
+			case <-target:
+			default:
+			}
+		}
+
+	case OverflowDropNewest:
+		select {
+		case target <- data:
+		default:
+		}
+		return nil
+
+	case OverflowError:
+		select {
+		case target <- data:
+			return nil
+		default:
+			return fmt.Errorf(dirOverflowErr, id)
+		}
+
+	case OverflowBlock:
+		fallthrough
+	default:
+		select {
+		case target <- data:
+		case <-dir.stop:
+		case <-router.done:
+		}
+		return nil
+	}
+}
+
+// forward ... Drains a buffered directive's queues into its destination channel until stopped;
+// queued high priority data is always forwarded first
+func (router *OutputRouter) forward(dir *directive) {
+	for {
+		var data models.TransitData
+
+		select {
+		case data = <-dir.urgent:
+		default:
+			select {
+			case data = <-dir.urgent:
+			case data = <-dir.queue:
+			case <-dir.stop:
+				return
+			case <-router.done:
+				return
+			}
+		}
+
+		select {
+		case dir.outChan <- data:
+		case <-dir.stop:
+			return
+		case <-router.done:
+			return
+		}
 	}
 }
 
 // AddDirective ... Inserts a new output directive given an ID and channel; fail on key collision
 func (router *OutputRouter) AddDirective(componentID int, outChan chan models.TransitData) error {
-	if _, found := router.outChans[componentID]; found {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+
+	if _, found := router.directives[componentID]; found {
 		return fmt.Errorf(dirAlreadyExistsErr, componentID)
 	}
 
-	router.outChans[componentID] = outChan
+	dir := &directive{outChan: outChan, stop: make(chan struct{})}
+	if router.bufferSize > 0 {
+		dir.queue = make(chan models.TransitData, router.bufferSize)
+		dir.urgent = make(chan models.TransitData, router.bufferSize)
+
+		go router.forward(dir)
+	}
+
+	router.directives[componentID] = dir
+	router.sortDirectives()
 	return nil
 }
 
-// RemoveDirective ... Removes an output directive given an ID; fail if no key found
+// RemoveDirective ... Removes an output directive given an ID; fail if no key found.
+// Data still queued for a buffered directive is discarded and in-flight transits to it are aborted
 func (router *OutputRouter) RemoveDirective(componentID int) error {
-	if _, found := router.outChans[componentID]; !found {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+
+	dir, found := router.directives[componentID]
+	if !found {
 		return fmt.Errorf(dirNotFoundErr, componentID)
 	}
 
-	delete(router.outChans, componentID)
+	close(dir.stop)
+	delete(router.directives, componentID)
+	router.sortDirectives()
 	return nil
 }
+
+// SetPriority ... Sets the directive's priority; higher priority directives are served first so that
+// their consumers aren't delayed by slower, lower priority consumers
+func (router *OutputRouter) SetPriority(componentID int, priority models.Priority) error {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+
+	dir, found := router.directives[componentID]
+	if !found {
+		return fmt.Errorf(dirNotFoundErr, componentID)
+	}
+
+	dir.priority = priority
+	router.sortDirectives()
+	return nil
+}
+
+// sortDirectives ... Rebuilds the sorted & ranked directive ID orders; write lock must be held
+func (router *OutputRouter) sortDirectives() {
+	router.order = make([]int, 0, len(router.directives))
+	for id := range router.directives {
+		router.order = append(router.order, id)
+	}
+
+	sort.Ints(router.order)
+
+	router.ranked = make([]int, len(router.order))
+	copy(router.ranked, router.order)
+
+	sort.SliceStable(router.ranked, func(i, j int) bool {
+		return router.directives[router.ranked[i]].priority > router.directives[router.ranked[j]].priority
+	})
+}
+
+// Directives ... Returns the sorted IDs of all output directives currently held by the router
+func (router *OutputRouter) Directives() []int {
+	router.mu.RLock()
+	defer router.mu.RUnlock()
+
+	ids := make([]int, len(router.order))
+	copy(ids, router.order)
+	return ids
+}
@@ -35,7 +35,7 @@ func Test_Add_Remove_Directive(t *testing.T) {
 
 					assert.NoError(t, err, "Ensuring that no error when adding new directive")
 
-					_, exists := router.outChans[id]
+					_, exists := router.directives[id]
 					assert.True(t, exists, "Ensuring that key exists")
 				}
 			},
@@ -81,7 +81,7 @@ func Test_Add_Remove_Directive(t *testing.T) {
 
 				assert.NoError(t, err, "Ensuring that no error is thrown when removing an existing directive")
 
-				_, exists := router.outChans[0x420]
+				_, exists := router.directives[0x420]
 				assert.False(t, exists, "Ensuring that key is removed from mapping")
 			},
 		}, {
@@ -104,6 +104,26 @@ func Test_Add_Remove_Directive(t *testing.T) {
 				assert.Error(t, err, "Ensuring that an error is thrown when trying to remove a non-existent directive")
 				assert.Equal(t, err.Error(), fmt.Sprintf(dirNotFoundErr, 0x69))
 			},
+		}, {
+			name:        "Directives Introspection Test",
+			description: "When directives are added and removed, Directives should return the sorted remaining directive keys",
+
+			constructionLogic: func() *OutputRouter {
+				router, _ := NewOutputRouter()
+				for _, id := range []int{0x666, 0x42, 0x420, 0x69} {
+					_ = router.AddDirective(id, make(chan models.TransitData))
+				}
+				return router
+			},
+
+			testLogic: func(t *testing.T, router *OutputRouter) {
+				assert.Equal(t, []int{0x42, 0x69, 0x420, 0x666}, router.Directives())
+
+				err := router.RemoveDirective(0x69)
+				assert.NoError(t, err)
+
+				assert.Equal(t, []int{0x42, 0x420, 0x666}, router.Directives(), "Ensuring removed key is no longer listed")
+			},
 		},
 	}
 
@@ -161,3 +181,449 @@ func Test_Transit_Output(t *testing.T) {
 	}
 
 }
+
+func Test_Overflow_Policies(t *testing.T) {
+	newData := func(val int) models.TransitData {
+		return models.TransitData{Type: "String Beanz", Value: val}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		testLogic func(*testing.T)
+	}{
+		{
+			name:        "Drop Newest Test",
+			description: "When an unbuffered directive isn't being read from, data should be dropped without blocking",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData)
+				router, err := NewOutputRouter(WithOverflowPolicy(OverflowDropNewest), WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+
+				assert.NoError(t, router.TransitOutput(newData(1)))
+			},
+		},
+		{
+			name:        "Error Test",
+			description: "When a directive can't accept data under the error policy, an overflow error should be returned",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData)
+				router, err := NewOutputRouter(WithOverflowPolicy(OverflowError), WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+
+				err = router.TransitOutput(newData(1))
+				assert.Error(t, err)
+				assert.Equal(t, err.Error(), fmt.Sprintf(dirOverflowErr, 0x420))
+			},
+		},
+		{
+			name:        "Drop Oldest Test",
+			description: "When a buffered directive is full, the oldest queued data should be evicted",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData)
+				router, err := NewOutputRouter(WithBufferSize(2), WithOverflowPolicy(OverflowDropOldest),
+					WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+				defer router.halt()
+
+				// First value is taken by the forwarding routine which blocks on the unread channel
+				assert.NoError(t, router.TransitOutput(newData(0)))
+				time.Sleep(10 * time.Millisecond)
+
+				for i := 1; i <= 4; i++ {
+					assert.NoError(t, router.TransitOutput(newData(i)))
+				}
+
+				for _, expected := range []int{0, 3, 4} {
+					assert.Equal(t, newData(expected), <-outChan)
+				}
+			},
+		},
+		{
+			name:        "Late Buffer Configuration Test",
+			description: "When buffering is configured after directives are added, an error should be returned",
+
+			testLogic: func(t *testing.T) {
+				router, err := NewOutputRouter(WithDirective(0x420, make(chan models.TransitData)))
+				assert.NoError(t, err)
+
+				err = router.Configure(WithBufferSize(10))
+				assert.Error(t, err)
+				assert.Equal(t, err.Error(), routerConfiguredErr)
+			},
+		},
+		{
+			name:        "Blocked Consumer Removal Test",
+			description: "When a consumer stalls a blocking transit, it should still be removable and the transit should return",
+
+			testLogic: func(t *testing.T) {
+				router, err := NewOutputRouter(WithDirective(0x420, make(chan models.TransitData)))
+				assert.NoError(t, err)
+				defer router.halt()
+
+				sent := make(chan error)
+				go func() {
+					sent <- router.TransitOutput(newData(1))
+				}()
+
+				// Give the transit time to block on the unread directive
+				time.Sleep(10 * time.Millisecond)
+
+				removed := make(chan error)
+				go func() {
+					removed <- router.RemoveDirective(0x420)
+				}()
+
+				for _, ch := range []chan error{removed, sent} {
+					select {
+					case err := <-ch:
+						assert.NoError(t, err)
+					case <-time.After(5 * time.Second):
+						t.Fatal("timed out waiting on stalled directive removal")
+					}
+				}
+
+				assert.Empty(t, router.Directives())
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			tc.testLogic(t)
+		})
+	}
+}
+
+func Test_Batching(t *testing.T) {
+	newData := func(val int) models.TransitData {
+		return models.TransitData{Type: "String Beanz", Value: val}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		testLogic func(*testing.T)
+	}{
+		{
+			name:        "Grouping Test",
+			description: "When batching is enabled, transited slices should be grouped into batches of up to the batch size",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithBatchSize(2), WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+
+				assert.NoError(t, router.TransitOutputs([]models.TransitData{newData(1), newData(2), newData(3)}))
+
+				first := <-outChan
+				assert.True(t, first.IsBatch())
+				assert.Equal(t, []models.TransitData{newData(1), newData(2)}, models.Unbatch(first))
+
+				// Trailing single item groups are transited unwrapped
+				assert.Equal(t, newData(3), <-outChan)
+			},
+		},
+		{
+			name:        "Unbatching Test",
+			description: "When batching is disabled, batches should be flattened into individual transits",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+
+				batch := models.NewBatch([]models.TransitData{newData(1), newData(2)})
+				assert.NoError(t, router.TransitOutput(batch))
+
+				assert.Equal(t, newData(1), <-outChan)
+				assert.Equal(t, newData(2), <-outChan)
+			},
+		},
+		{
+			name:        "Invalid Batch Size Test",
+			description: "When a negative batch size is provided, an error should be returned",
+
+			testLogic: func(t *testing.T) {
+				_, err := NewOutputRouter(WithBatchSize(-1))
+				assert.Error(t, err)
+				assert.Equal(t, err.Error(), fmt.Sprintf(invalidBatchSizeErr, -1))
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			tc.testLogic(t)
+		})
+	}
+}
+
+func Test_Routing_Modes(t *testing.T) {
+	newData := func(val int) models.TransitData {
+		return models.TransitData{Type: "String Beanz", Value: val}
+	}
+
+	// drain ... Returns every value currently queued on the channel
+	drain := func(ch chan models.TransitData) []any {
+		vals := make([]any, 0)
+		for len(ch) > 0 {
+			vals = append(vals, (<-ch).Value)
+		}
+		return vals
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		testLogic func(*testing.T)
+	}{
+		{
+			name:        "Round Robin Test",
+			description: "When partitioned without a key, data should be distributed evenly in directive ID order",
+
+			testLogic: func(t *testing.T) {
+				first, second := make(chan models.TransitData, 10), make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithRoutingMode(PartitionedRouting),
+					WithDirective(0x69, second), WithDirective(0x42, first))
+				assert.NoError(t, err)
+
+				for i := 0; i < 4; i++ {
+					assert.NoError(t, router.TransitOutput(newData(i)))
+				}
+
+				assert.Equal(t, []any{0, 2}, drain(first))
+				assert.Equal(t, []any{1, 3}, drain(second))
+			},
+		},
+		{
+			name:        "Partition Key Test",
+			description: "When partitioned by key, data sharing a key should always be delivered to the same directive",
+
+			testLogic: func(t *testing.T) {
+				first, second := make(chan models.TransitData, 10), make(chan models.TransitData, 10)
+
+				parity := func(td models.TransitData) string {
+					return fmt.Sprint(td.Value.(int) % 2) //nolint:errcheck // test values are always ints
+				}
+
+				router, err := NewOutputRouter(WithPartitionKey(parity), WithBatchSize(10),
+					WithDirective(0x42, first), WithDirective(0x69, second))
+				assert.NoError(t, err)
+
+				batch := make([]models.TransitData, 0)
+				for i := 0; i < 6; i++ {
+					batch = append(batch, newData(i))
+				}
+				assert.NoError(t, router.TransitOutputs(batch))
+
+				firstOut, secondOut := drain(first), drain(second)
+				assert.Len(t, firstOut, 1, "Ensuring each directive's partition is transited as a single batch")
+				assert.Len(t, secondOut, 1)
+
+				values := func(td any) []int {
+					vals := make([]int, 0)
+					for _, item := range models.Unbatch(models.TransitData{Type: models.BatchType, Value: td}) {
+						vals = append(vals, item.Value.(int)) //nolint:errcheck // test values are always ints
+					}
+					return vals
+				}
+
+				partitions := [][]int{values(firstOut[0]), values(secondOut[0])}
+				assert.ElementsMatch(t, [][]int{{0, 2, 4}, {1, 3, 5}}, partitions)
+			},
+		},
+		{
+			name:        "Broadcast Test",
+			description: "When broadcasting, every directive should receive every piece of data",
+
+			testLogic: func(t *testing.T) {
+				first, second := make(chan models.TransitData, 10), make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithRoutingMode(BroadcastRouting),
+					WithDirective(0x42, first), WithDirective(0x69, second))
+				assert.NoError(t, err)
+
+				assert.NoError(t, router.TransitOutputs([]models.TransitData{newData(0), newData(1)}))
+				assert.Equal(t, []any{0, 1}, drain(first))
+				assert.Equal(t, []any{0, 1}, drain(second))
+			},
+		},
+		{
+			name:        "Stalled Partition Test",
+			description: "When a partitioned transit is blocked on a stalled directive, directives should remain alterable",
+
+			testLogic: func(t *testing.T) {
+				stalled, healthy := make(chan models.TransitData), make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithRoutingMode(PartitionedRouting),
+					WithDirective(0x42, stalled), WithDirective(0x69, healthy))
+				assert.NoError(t, err)
+				defer router.halt()
+
+				sent := make(chan error)
+				go func() {
+					sent <- router.TransitOutput(newData(0))
+				}()
+
+				// Give the transit time to block on the stalled directive
+				time.Sleep(10 * time.Millisecond)
+
+				altered := make(chan error)
+				go func() {
+					if err := router.SetPriority(0x69, models.HighPriority); err != nil {
+						altered <- err
+						return
+					}
+					altered <- router.RemoveDirective(0x42)
+				}()
+
+				for _, ch := range []chan error{altered, sent} {
+					select {
+					case err := <-ch:
+						assert.NoError(t, err)
+					case <-time.After(5 * time.Second):
+						t.Fatal("timed out altering directives of a stalled router")
+					}
+				}
+
+				assert.Equal(t, []int{0x69}, router.Directives())
+				assert.NoError(t, router.TransitOutput(newData(1)))
+				assert.Equal(t, []any{1}, drain(healthy))
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			tc.testLogic(t)
+		})
+	}
+}
+
+func Test_Priority_Routing(t *testing.T) {
+	newData := func(val int, priority models.Priority) models.TransitData {
+		return models.TransitData{Type: "String Beanz", Value: val, Priority: priority}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		testLogic func(*testing.T)
+	}{
+		{
+			name:        "Item Ordering Test",
+			description: "When a slice mixes priorities, high priority items should be transited first in their original order",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+
+				assert.NoError(t, router.TransitOutputs([]models.TransitData{
+					newData(1, models.NormalPriority), newData(2, models.HighPriority),
+					newData(3, models.NormalPriority), newData(4, models.HighPriority),
+				}))
+
+				for _, expected := range []int{2, 4, 1, 3} {
+					assert.Equal(t, expected, (<-outChan).Value)
+				}
+			},
+		},
+		{
+			name:        "Batch Separation Test",
+			description: "When batching is enabled, batches should never mix priorities",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData, 10)
+				router, err := NewOutputRouter(WithBatchSize(3), WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+
+				assert.NoError(t, router.TransitOutputs([]models.TransitData{
+					newData(1, models.NormalPriority), newData(2, models.HighPriority), newData(3, models.NormalPriority),
+				}))
+
+				assert.Equal(t, newData(2, models.HighPriority), <-outChan)
+
+				batch := <-outChan
+				assert.True(t, batch.IsBatch())
+				assert.Equal(t, models.NormalPriority, batch.Priority)
+				assert.Len(t, models.Unbatch(batch), 2)
+			},
+		},
+		{
+			name:        "Urgent Queue Test",
+			description: "When a buffered directive is backed up, high priority data should be forwarded ahead of queued data",
+
+			testLogic: func(t *testing.T) {
+				outChan := make(chan models.TransitData)
+				router, err := NewOutputRouter(WithBufferSize(4), WithDirective(0x420, outChan))
+				assert.NoError(t, err)
+				defer router.halt()
+
+				// First value is taken by the forwarding routine which blocks on the unread channel
+				assert.NoError(t, router.TransitOutput(newData(0, models.NormalPriority)))
+				time.Sleep(10 * time.Millisecond)
+
+				assert.NoError(t, router.TransitOutput(newData(1, models.NormalPriority)))
+				assert.NoError(t, router.TransitOutput(newData(2, models.NormalPriority)))
+				assert.NoError(t, router.TransitOutput(newData(3, models.HighPriority)))
+
+				for _, expected := range []int{0, 3, 1, 2} {
+					assert.Equal(t, expected, (<-outChan).Value)
+				}
+			},
+		},
+		{
+			name:        "Directive Priority Test",
+			description: "When a high priority directive is set, it should be served before a blocked low priority directive",
+
+			testLogic: func(t *testing.T) {
+				bulkChan := make(chan models.TransitData)
+				criticalChan := make(chan models.TransitData, 1)
+
+				router, err := NewOutputRouter(WithDirective(1, bulkChan), WithDirective(2, criticalChan))
+				assert.NoError(t, err)
+				defer router.halt()
+
+				assert.NoError(t, router.SetPriority(2, models.HighPriority))
+
+				// Blocks on the unread bulk directive until the router is halted
+				go func() {
+					_ = router.TransitOutput(newData(1, models.NormalPriority))
+				}()
+
+				select {
+				case td := <-criticalChan:
+					assert.Equal(t, 1, td.Value)
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for high priority directive")
+				}
+			},
+		},
+		{
+			name:        "Unknown Directive Test",
+			description: "When setting the priority of an unknown directive, an error should be returned",
+
+			testLogic: func(t *testing.T) {
+				router, err := NewOutputRouter()
+				assert.NoError(t, err)
+
+				err = router.SetPriority(0x420, models.HighPriority)
+				assert.Error(t, err)
+				assert.Equal(t, err.Error(), fmt.Sprintf(dirNotFoundErr, 0x420))
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			tc.testLogic(t)
+		})
+	}
+}
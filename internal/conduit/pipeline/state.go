@@ -0,0 +1,161 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+// StatefulPipeDefinition ... Provides pipes with a keyed state store whose entries expire once they fall
+// outside of a sliding window of block heights; used to express rate based heuristics
+type StatefulPipeDefinition interface {
+	// Transform ... Transforms a single input; the state has already been advanced to the input's height
+	Transform(td models.TransitData, state *WindowStore) ([]models.TransitData, error)
+}
+
+// windowEntry ... Single value recorded for a key at a height
+type windowEntry struct {
+	height uint64
+	value  float64
+}
+
+// WindowStore ... Keyed state store holding values recorded within the last size block heights;
+// not safe for concurrent use as it's only accessed from the owning pipe's event loop
+type WindowStore struct {
+	size    uint64
+	current uint64
+
+	entries map[string][]windowEntry
+}
+
+// NewWindowStore ... Initializer; size is the number of block heights, including the current one, retained
+func NewWindowStore(size uint64) (*WindowStore, error) {
+	if size == 0 {
+		return nil, fmt.Errorf(invalidWindowSizeErr)
+	}
+
+	return &WindowStore{
+		size:    size,
+		entries: make(map[string][]windowEntry),
+	}, nil
+}
+
+// Height ... Returns the current height of the window
+func (ws *WindowStore) Height() uint64 {
+	return ws.current
+}
+
+// Size ... Returns the number of block heights retained by the window
+func (ws *WindowStore) Size() uint64 {
+	return ws.size
+}
+
+// Start ... Returns the lowest height retained by the window
+func (ws *WindowStore) Start() uint64 {
+	if ws.current+1 < ws.size {
+		return 0
+	}
+
+	return ws.current + 1 - ws.size
+}
+
+// Advance ... Moves the window to the height and evicts entries that fall outside of it; heights
+// lower than the current height are ignored so that reorged or replayed data can't rewind the window
+func (ws *WindowStore) Advance(height uint64) {
+	if height <= ws.current {
+		return
+	}
+
+	ws.current = height
+	for key := range ws.entries {
+		ws.evict(key)
+	}
+}
+
+// evict ... Removes the key's expired entries; keys without entries are deleted to bound memory usage
+func (ws *WindowStore) evict(key string) {
+	entries := ws.entries[key]
+
+	i := 0
+	for i < len(entries) && !ws.inWindow(entries[i].height) {
+		i++
+	}
+
+	if i == len(entries) {
+		delete(ws.entries, key)
+		return
+	}
+
+	ws.entries[key] = entries[i:]
+}
+
+// inWindow ... Returns true if the height is within the last size heights
+func (ws *WindowStore) inWindow(height uint64) bool {
+	return height+ws.size > ws.current
+}
+
+// Add ... Records the value for the key at the current height
+func (ws *WindowStore) Add(key string, value float64) {
+	ws.entries[key] = append(ws.entries[key], windowEntry{height: ws.current, value: value})
+}
+
+// Incr ... Records a single occurrence for the key at the current height
+func (ws *WindowStore) Incr(key string) {
+	ws.Add(key, 1)
+}
+
+// Count ... Returns the number of values recorded for the key within the window
+func (ws *WindowStore) Count(key string) int {
+	return len(ws.entries[key])
+}
+
+// Sum ... Returns the sum of values recorded for the key within the window
+func (ws *WindowStore) Sum(key string) float64 {
+	sum := 0.0
+	for _, entry := range ws.entries[key] {
+		sum += entry.value
+	}
+
+	return sum
+}
+
+// LastSeen ... Returns the height at which the key was last recorded; false if the key
+// hasn't been recorded within the window
+func (ws *WindowStore) LastSeen(key string) (uint64, bool) {
+	entries := ws.entries[key]
+	if len(entries) == 0 {
+		return 0, false
+	}
+
+	return entries[len(entries)-1].height, true
+}
+
+// Len ... Returns the number of keys with values recorded within the window
+func (ws *WindowStore) Len() int {
+	return len(ws.entries)
+}
+
+// NewStatefulPipe ... Initializer; inputs must carry a height as it's used to slide the state window
+func NewStatefulPipe(ctx context.Context, def StatefulPipeDefinition, window uint64,
+	inputChan chan models.TransitData, opts ...PipeOption) (Component, error) {
+	state, err := NewWindowStore(window)
+	if err != nil {
+		return nil, err
+	}
+
+	tform := func(td models.TransitData) ([]models.TransitData, error) {
+		if td.Height == nil {
+			return nil, fmt.Errorf(missingHeightErr, td.Type)
+		}
+
+		if !td.Height.IsUint64() {
+			return nil, fmt.Errorf(invalidHeightErr, td.Height)
+		}
+
+		state.Advance(td.Height.Uint64())
+		return def.Transform(td, state)
+	}
+
+	return NewPipe(ctx, tform, inputChan, opts...)
+}
@@ -0,0 +1,48 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WindowStore(t *testing.T) {
+	_, err := NewWindowStore(0)
+	assert.Error(t, err)
+
+	ws, err := NewWindowStore(3)
+	assert.NoError(t, err)
+
+	ws.Advance(1)
+	ws.Add("a", 2)
+	ws.Incr("b")
+
+	ws.Advance(2)
+	ws.Add("a", 3)
+
+	assert.Equal(t, 2, ws.Count("a"))
+	assert.Equal(t, 5.0, ws.Sum("a"))
+	assert.Equal(t, 2, ws.Len())
+
+	last, found := ws.LastSeen("a")
+	assert.True(t, found)
+	assert.Equal(t, uint64(2), last)
+
+	// Replayed heights shouldn't rewind the window
+	ws.Advance(1)
+	assert.Equal(t, uint64(2), ws.Height())
+
+	// Height 1 values fall outside of the window once height 4 is reached
+	ws.Advance(4)
+	assert.Equal(t, uint64(2), ws.Start())
+	assert.Equal(t, 1, ws.Count("a"))
+	assert.Equal(t, 3.0, ws.Sum("a"))
+
+	_, found = ws.LastSeen("b")
+	assert.False(t, found)
+	assert.Equal(t, 1, ws.Len(), "Ensuring expired keys are evicted")
+
+	ws.Advance(10)
+	assert.Equal(t, 0, ws.Len())
+	assert.Equal(t, 0.0, ws.Sum("a"))
+}
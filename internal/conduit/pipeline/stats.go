@@ -0,0 +1,123 @@
+package pipeline
+
+import (
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+// ComponentStats ... Point in time snapshot of a component's throughput & latency
+type ComponentStats struct {
+	ComponentID models.ComponentID
+	Type        models.ComponentType
+
+	// Number of unbatched items read & successfully transited
+	ItemsIn  uint64
+	ItemsOut uint64
+
+	// Time spent processing inputs, excluding the time spent transiting outputs downstream;
+	// zero for oracles as their inputs are produced by the definition's read routine
+	AvgLatency time.Duration
+	MaxLatency time.Duration
+
+	// Time of the last input read or output transited; zero if the component has never been active
+	LastActivity time.Time
+
+	// Last height fully transited by oracles that report processed heights; nil for every other component
+	// and oracles yet to process a height
+	Height *big.Int
+}
+
+// IdleFor ... Returns the duration since the component was last active; zero if it has never been active
+func (cs ComponentStats) IdleFor(now time.Time) time.Duration {
+	if cs.LastActivity.IsZero() {
+		return 0
+	}
+
+	return now.Sub(cs.LastActivity)
+}
+
+// StatsReporter ... Implemented by components that track their throughput & latency
+type StatsReporter interface {
+	Stats() ComponentStats
+}
+
+// stats ... Lock free throughput & latency counters shared by all component types; updated from
+// event loop and worker go routines while being read from the managing go routine
+type stats struct {
+	itemsIn  atomic.Uint64
+	itemsOut atomic.Uint64
+
+	processed    atomic.Uint64
+	totalLatency atomic.Int64
+	maxLatency   atomic.Int64
+
+	// Unix nanoseconds; zero if the component has never been active
+	lastActivity atomic.Int64
+
+	// Nil until a height has been processed
+	height atomic.Pointer[big.Int]
+}
+
+// recordIn ... Records items read by the component
+func (s *stats) recordIn(n int) {
+	s.itemsIn.Add(uint64(n))
+	s.touch()
+}
+
+// recordOut ... Records items transited by the component
+func (s *stats) recordOut(n int) {
+	s.itemsOut.Add(uint64(n))
+	s.touch()
+}
+
+// observe ... Records the processing latency of a single input that began processing at the start time
+func (s *stats) observe(start time.Time) {
+	latency := int64(time.Since(start))
+
+	s.processed.Add(1)
+	s.totalLatency.Add(latency)
+
+	for current := s.maxLatency.Load(); latency > current; current = s.maxLatency.Load() {
+		if s.maxLatency.CompareAndSwap(current, latency) {
+			break
+		}
+	}
+}
+
+// recordHeight ... Records the last height fully transited by the component
+func (s *stats) recordHeight(height *big.Int) {
+	s.height.Store(new(big.Int).Set(height))
+}
+
+// touch ... Marks the component as active
+func (s *stats) touch() {
+	s.lastActivity.Store(time.Now().UnixNano())
+}
+
+// snapshot ... Returns the current counters
+func (s *stats) snapshot(id models.ComponentID, ct models.ComponentType) ComponentStats {
+	cs := ComponentStats{
+		ComponentID: id,
+		Type:        ct,
+		ItemsIn:     s.itemsIn.Load(),
+		ItemsOut:    s.itemsOut.Load(),
+		MaxLatency:  time.Duration(s.maxLatency.Load()),
+	}
+
+	if processed := s.processed.Load(); processed > 0 {
+		cs.AvgLatency = time.Duration(s.totalLatency.Load() / int64(processed))
+	}
+
+	if last := s.lastActivity.Load(); last != 0 {
+		cs.LastActivity = time.Unix(0, last)
+	}
+
+	if height := s.height.Load(); height != nil {
+		cs.Height = new(big.Int).Set(height)
+	}
+
+	return cs
+}
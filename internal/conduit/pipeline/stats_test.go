@@ -0,0 +1,71 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Pipe_Stats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	outputChan := make(chan models.TransitData, 10)
+	inputChan := make(chan models.TransitData)
+
+	router, err := NewOutputRouter(WithDirective(0, outputChan))
+	assert.NoError(t, err)
+
+	// Odd values fail to transform
+	tform := func(td models.TransitData) ([]models.TransitData, error) {
+		time.Sleep(time.Millisecond)
+
+		if td.Value.(int)%2 == 1 { //nolint:errcheck // test transform
+			return nil, fmt.Errorf("odd value")
+		}
+		return []models.TransitData{td}, nil
+	}
+
+	testPipe, err := NewPipe(ctx, tform, inputChan, WithRouter(router))
+	assert.NoError(t, err)
+
+	reporter, ok := testPipe.(StatsReporter)
+	assert.True(t, ok, "Ensuring pipes report statistics")
+	assert.True(t, reporter.Stats().LastActivity.IsZero(), "Ensuring idle components have no activity")
+	assert.Zero(t, reporter.Stats().IdleFor(time.Now()))
+
+	go func() {
+		_ = testPipe.EventLoop()
+	}()
+
+	batch := make([]models.TransitData, 0)
+	for i := 0; i < 4; i++ {
+		batch = append(batch, models.TransitData{Type: "TEST", Value: i})
+	}
+	inputChan <- models.NewBatch(batch)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-outputChan:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for pipe output")
+		}
+	}
+
+	assert.Eventually(t, func() bool {
+		return reporter.Stats().ItemsOut == 2
+	}, 5*time.Second, 5*time.Millisecond)
+
+	stats := reporter.Stats()
+	assert.Equal(t, testPipe.ID(), stats.ComponentID)
+	assert.Equal(t, models.Pipe, stats.Type)
+	assert.Equal(t, uint64(4), stats.ItemsIn, "Ensuring batched items are counted individually")
+	assert.GreaterOrEqual(t, stats.AvgLatency, time.Millisecond)
+	assert.GreaterOrEqual(t, stats.MaxLatency, stats.AvgLatency)
+	assert.False(t, stats.LastActivity.IsZero())
+	assert.Equal(t, time.Minute, stats.IdleFor(stats.LastActivity.Add(time.Minute)))
+}
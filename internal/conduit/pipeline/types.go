@@ -16,20 +16,57 @@ const (
 	BacktestOracle OracleType = "backtest"
 	// LiveOracle ... Represents an oracle used for powering some live invariant
 	LiveOracle OracleType = "live"
+	// BackfillOracle ... Represents an oracle that backtests from its start height to the chain tip
+	// before transitioning into live reading
+	BackfillOracle OracleType = "backfill"
 )
 
 // OutputRouter specific errors
 const (
 	dirAlreadyExistsErr = "%d directive key already exists within component router mapping"
 	dirNotFoundErr      = "no directive key %d exists within component router mapping"
+	dirOverflowErr      = "directive key %d overflowed; transit data was dropped"
+
+	invalidBufferSizeErr = "router buffer size must be non-negative, got %d"
+	invalidBatchSizeErr  = "router batch size must be non-negative, got %d"
+	routerConfiguredErr  = "router buffering must be configured before directives are added"
+)
+
+// Pipe specific errors
+const (
+	invalidWorkerCountErr = "pipe worker count must be at least one, got %d"
+	pipeRunningErr        = "pipe workers must be set before the event loop is started"
+)
+
+// Join specific errors
+const (
+	missingJoinKeyErr     = "join requires both a left & right key function"
+	invalidJoinTimeoutErr = "join timeout must be non-negative, got %s"
+	missingHeightKeyErr   = "height keyed joins require transit data heights; %s data has none"
+)
+
+// Stateful pipe specific errors
+const (
+	invalidWindowSizeErr = "state window size must be at least one block"
+	missingHeightErr     = "stateful pipes require transit data heights; %s data has none"
+	invalidHeightErr     = "transit data height %s exceeds the supported state window range"
+)
+
+// Oracle specific errors
+const (
+	heightReaderErr       = "oracle type %s requires a definition that implements HeightReader"
+	unsupportedOracleErr  = "unsupported oracle type: %s"
+	missingStartHeightErr = "oracle type %s requires a start height"
+	checkpointSupportErr  = "oracle definition must implement HeightReader & HeightReporter to support checkpoints"
 )
 
 // Generalized component constructor types
 type (
 	// OracleConstructor ... Type declaration that a registry oracle component constructor must adhere to
 	OracleConstructor = func(ctx context.Context, ot OracleType, cfg *config.OracleConfig,
-		client client.EthClientInterface) (Component, error)
+		client client.EthClientInterface, params models.Params) (Component, error)
 
 	// PipeConstructorFunc ... Type declaration that a registry pipe component constructor must adhere to
-	PipeConstructorFunc = func(ctx context.Context, inputChan chan models.TransitData) (Component, error)
+	PipeConstructorFunc = func(ctx context.Context, inputChan chan models.TransitData,
+		params models.Params) (Component, error)
 )
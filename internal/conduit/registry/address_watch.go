@@ -0,0 +1,88 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// AddressesParam ... Addresses that transactions are flagged for
+	AddressesParam = "addresses"
+)
+
+// addressWatchList ... Set of addresses that a single address watch pipe flags transactions for
+type addressWatchList map[common.Address]struct{}
+
+// newAddressWatchList ... Initializer
+func newAddressWatchList(addresses []common.Address) addressWatchList {
+	wl := make(addressWatchList, len(addresses))
+	for _, addr := range addresses {
+		wl[addr] = struct{}{}
+	}
+
+	return wl
+}
+
+// contains ... Returns true if the address exists within the watch-list
+func (wl addressWatchList) contains(addr common.Address) bool {
+	_, found := wl[addr]
+	return found
+}
+
+// touches ... Returns true if the transaction is sent from, sent to, or creates a contract at a watched address
+func (wl addressWatchList) touches(tx *types.Transaction) bool {
+	if tx.To() != nil && wl.contains(*tx.To()) {
+		return true
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return false
+	}
+
+	if wl.contains(from) {
+		return true
+	}
+
+	return tx.To() == nil && wl.contains(crypto.CreateAddress(from, tx.Nonce()))
+}
+
+// extractWatchedTxs ... Extracts all block transactions touching the watch-list
+func (wl addressWatchList) extractWatchedTxs(td models.TransitData) ([]models.TransitData, error) {
+	asBlock, err := models.ValueAs[types.Block](td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	watchedTxs := make([]models.TransitData, 0)
+
+	for _, tx := range asBlock.Transactions() {
+		if wl.touches(tx) {
+			watchedTxs = append(watchedTxs, models.TransitData{
+				Timestamp: td.Timestamp,
+				Type:      AddressWatchTX,
+				Value:     tx,
+			})
+		}
+	}
+
+	return watchedTxs, nil
+}
+
+// NewAddressWatchPipe ... Initializer; every constructed pipe holds its own watch-list
+// so multiple watch-lists can run concurrently
+func NewAddressWatchPipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	addresses, err := params.Addresses(AddressesParam)
+	if err != nil {
+		return nil, err
+	}
+
+	wl := newAddressWatchList(addresses)
+	return pipeline.NewPipe(ctx, wl.extractWatchedTxs, inputChan)
+}
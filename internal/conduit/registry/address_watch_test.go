@@ -0,0 +1,105 @@
+package registry
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AddressWatch_ExtractWatchedTxs(t *testing.T) {
+	chainID := big.NewInt(10)
+	signer := types.NewEIP155Signer(chainID)
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	watched := common.HexToAddress("0x420")
+	unwatched := common.HexToAddress("0x69")
+
+	signTx := func(nonce uint64, to *common.Address) *types.Transaction {
+		tx, signErr := types.SignTx(types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			To:       to,
+			Value:    big.NewInt(1),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+		}), signer, key)
+		assert.NoError(t, signErr)
+		return tx
+	}
+
+	toWatched := signTx(0, &watched)
+	toUnwatched := signTx(1, &unwatched)
+	create := signTx(2, nil)
+
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1)},
+		[]*types.Transaction{toWatched, toUnwatched, create}, nil, nil, trie.NewStackTrie(nil))
+
+	td := models.TransitData{
+		Timestamp: time.Date(1969, time.April, 1, 4, 20, 0, 0, time.Local),
+		Type:      GethBlock,
+		Value:     *block,
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		addresses   []common.Address
+		expectedTxs []*types.Transaction
+	}{
+		{
+			name:        "To Address Match Test",
+			description: "Only transactions sent to a watched address should be extracted",
+
+			addresses:   []common.Address{watched},
+			expectedTxs: []*types.Transaction{toWatched},
+		},
+		{
+			name:        "From Address Match Test",
+			description: "All transactions sent from a watched address should be extracted",
+
+			addresses:   []common.Address{sender},
+			expectedTxs: []*types.Transaction{toWatched, toUnwatched, create},
+		},
+		{
+			name:        "Created Address Match Test",
+			description: "Contract creation transactions deploying to a watched address should be extracted",
+
+			addresses:   []common.Address{crypto.CreateAddress(sender, 2)},
+			expectedTxs: []*types.Transaction{create},
+		},
+		{
+			name:        "Empty Watch-list Test",
+			description: "No transactions should be extracted when the watch-list is empty",
+
+			addresses:   nil,
+			expectedTxs: []*types.Transaction{},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			wl := newAddressWatchList(tc.addresses)
+
+			outputs, err := wl.extractWatchedTxs(td)
+			assert.NoError(t, err)
+			assert.Len(t, outputs, len(tc.expectedTxs))
+
+			for j, output := range outputs {
+				assert.Equal(t, output.Type, AddressWatchTX)
+				assert.Equal(t, output.Timestamp, td.Timestamp)
+				assert.Equal(t, output.Value.(*types.Transaction).Hash(), tc.expectedTxs[j].Hash()) //nolint:errcheck // test assertion
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package registry
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/base-org/pessimism/internal/conduit/models"
 	"github.com/base-org/pessimism/internal/conduit/pipeline"
@@ -10,9 +9,9 @@ import (
 )
 
 func extractContractCreateTxs(td models.TransitData) ([]models.TransitData, error) {
-	asBlock, success := td.Value.(types.Block)
-	if !success {
-		return []models.TransitData{}, fmt.Errorf("could not convert to block")
+	asBlock, err := models.ValueAs[types.Block](td)
+	if err != nil {
+		return []models.TransitData{}, err
 	}
 
 	nilTxs := make([]models.TransitData, 0)
@@ -30,7 +29,8 @@ func extractContractCreateTxs(td models.TransitData) ([]models.TransitData, erro
 	return nilTxs, nil
 }
 
+// NewCreateContractTxPipe ... Initializer; accepts no params
 func NewCreateContractTxPipe(ctx context.Context,
-	inputChan chan models.TransitData) (pipeline.Component, error) {
+	inputChan chan models.TransitData, _ models.Params) (pipeline.Component, error) {
 	return pipeline.NewPipe(ctx, extractContractCreateTxs, inputChan)
 }
@@ -0,0 +1,90 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// CreationWindowParam ... Number of blocks over which contract creations are counted
+	CreationWindowParam = "window"
+	// MaxCreationsParam ... Number of contract creations per deployer allowed within the window
+	MaxCreationsParam = "max_creations"
+
+	defaultCreationWindow = 10
+	defaultMaxCreations   = 3
+)
+
+// ContractCreationRateEvent ... Emitted when a deployer exceeds the allowed number of contract
+// creations within the block window
+type ContractCreationRateEvent struct {
+	Deployer    common.Address
+	Count       int
+	StartHeight uint64
+	EndHeight   uint64
+}
+
+// creationRateDef ... Stateful pipe definition counting contract creations per deployer
+type creationRateDef struct {
+	maxCreations int
+}
+
+// Transform ... Counts the transaction's deployer within the window and emits an event once the
+// deployer exceeds the limit; a single event is emitted per deployer until its count falls back within it
+func (crd *creationRateDef) Transform(td models.TransitData,
+	state *pipeline.WindowStore) ([]models.TransitData, error) {
+	tx, err := models.ValueAs[*types.Transaction](td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	deployer, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return []models.TransitData{}, fmt.Errorf("could not recover deployer of tx %s: %w", tx.Hash(), err)
+	}
+
+	key := deployer.Hex()
+	state.Incr(key)
+
+	if state.Count(key) != crd.maxCreations+1 {
+		return []models.TransitData{}, nil
+	}
+
+	return []models.TransitData{{
+		Timestamp: td.Timestamp,
+		Type:      ContractCreationRate,
+		Value: ContractCreationRateEvent{
+			Deployer:    deployer,
+			Count:       state.Count(key),
+			StartHeight: state.Start(),
+			EndHeight:   state.Height(),
+		},
+	}}, nil
+}
+
+// NewContractCreationRatePipe ... Initializer; the pipe emits an event when a single deployer creates
+// more than the maximum number of contracts within the block window
+func NewContractCreationRatePipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	window, err := params.Int(CreationWindowParam, defaultCreationWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	maxCreations, err := params.Int(MaxCreationsParam, defaultMaxCreations)
+	if err != nil {
+		return nil, err
+	}
+
+	if window < 1 || maxCreations < 0 {
+		return nil, fmt.Errorf("invalid contract creation rate thresholds; window: %d, maxCreations: %d",
+			window, maxCreations)
+	}
+
+	return pipeline.NewStatefulPipe(ctx, &creationRateDef{maxCreations: maxCreations}, uint64(window), inputChan)
+}
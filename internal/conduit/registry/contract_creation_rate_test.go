@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CreationRate_Transform(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	newCreateTD := func(nonce uint64) models.TransitData {
+		tx, signErr := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: nonce, Gas: 21000, GasPrice: big.NewInt(1)})
+		assert.NoError(t, signErr)
+
+		return models.TransitData{Type: ContractCreateTX, Value: tx}
+	}
+
+	crd := &creationRateDef{maxCreations: 2}
+	state, err := pipeline.NewWindowStore(10)
+	assert.NoError(t, err)
+
+	state.Advance(100)
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		outputs, tErr := crd.Transform(newCreateTD(nonce), state)
+		assert.NoError(t, tErr)
+		assert.Empty(t, outputs, "Ensuring creations within the limit don't emit events")
+	}
+
+	state.Advance(105)
+	outputs, err := crd.Transform(newCreateTD(2), state)
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 1)
+
+	event, err := models.ValueAs[ContractCreationRateEvent](outputs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, deployer, event.Deployer)
+	assert.Equal(t, 3, event.Count)
+	assert.Equal(t, uint64(96), event.StartHeight)
+	assert.Equal(t, uint64(105), event.EndHeight)
+
+	outputs, err = crd.Transform(newCreateTD(3), state)
+	assert.NoError(t, err)
+	assert.Empty(t, outputs, "Ensuring a single event is emitted while the deployer exceeds the limit")
+
+	// Earlier creations fall outside of the window
+	state.Advance(115)
+	outputs, err = crd.Transform(newCreateTD(4), state)
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+	assert.Equal(t, 1, state.Count(deployer.Hex()))
+}
@@ -0,0 +1,168 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// FactoryParam ... DisputeGameFactory contract address
+	FactoryParam = "factory"
+)
+
+var (
+	// disputeGameCreatedSig ... DisputeGameFactory game creation event topic
+	disputeGameCreatedSig = crypto.Keccak256Hash([]byte("DisputeGameCreated(address,uint32,bytes32)"))
+	// disputeGameMoveSig ... FaultDisputeGame attack/defend event topic
+	disputeGameMoveSig = crypto.Keccak256Hash([]byte("Move(uint256,bytes32,address)"))
+	// disputeGameResolvedSig ... FaultDisputeGame resolution event topic
+	disputeGameResolvedSig = crypto.Keccak256Hash([]byte("Resolved(uint8)"))
+)
+
+// All dispute game events index every argument
+const (
+	disputeGameCreatedTopics  = 4
+	disputeGameMoveTopics     = 4
+	disputeGameResolvedTopics = 2
+)
+
+// DisputeGameAction ... Dispute game lifecycle action
+type DisputeGameAction string
+
+const (
+	GameCreated  DisputeGameAction = "created"
+	GameMove     DisputeGameAction = "move"
+	GameResolved DisputeGameAction = "resolved"
+)
+
+// GameStatus ... FaultDisputeGame resolution status
+type GameStatus uint8
+
+const (
+	GameInProgress    GameStatus = 0
+	GameChallengerWon GameStatus = 1
+	GameDefenderWon   GameStatus = 2
+)
+
+// DisputeGameEvent ... Decoded dispute game factory or game event; only the fields relevant to
+// the action are populated
+type DisputeGameEvent struct {
+	Action DisputeGameAction
+	Game   common.Address
+	Height uint64
+	TxHash common.Hash
+
+	// Creation fields
+	GameType  uint32
+	RootClaim common.Hash
+
+	// Move fields
+	ParentIndex *big.Int
+	Claim       common.Hash
+	Claimant    common.Address
+
+	// Resolution fields
+	Status GameStatus
+}
+
+// disputeGameTracker ... Decodes factory creation events and tracks created games so that
+// their moves & resolutions can be decoded
+type disputeGameTracker struct {
+	factory common.Address
+	games   map[common.Address]struct{}
+}
+
+// decodeLog ... Decodes dispute game events emitted by the factory or any game created by it
+func (dgt *disputeGameTracker) decodeLog(td models.TransitData) ([]models.TransitData, error) {
+	asLog, err := models.ValueAs[types.Log](td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	if asLog.Removed || len(asLog.Topics) == 0 {
+		return []models.TransitData{}, nil
+	}
+
+	event := DisputeGameEvent{
+		Height: asLog.BlockNumber,
+		TxHash: asLog.TxHash,
+	}
+
+	_, isGame := dgt.games[asLog.Address]
+
+	switch {
+	case asLog.Address == dgt.factory && asLog.Topics[0] == disputeGameCreatedSig:
+		if len(asLog.Topics) != disputeGameCreatedTopics {
+			return []models.TransitData{}, malformedDisputeLog("DisputeGameCreated", asLog)
+		}
+
+		event.Action = GameCreated
+		event.Game = common.BytesToAddress(asLog.Topics[1].Bytes())
+		event.GameType = uint32(asLog.Topics[2].Big().Uint64())
+		event.RootClaim = asLog.Topics[3]
+
+		dgt.games[event.Game] = struct{}{}
+
+	case isGame && asLog.Topics[0] == disputeGameMoveSig:
+		if len(asLog.Topics) != disputeGameMoveTopics {
+			return []models.TransitData{}, malformedDisputeLog("Move", asLog)
+		}
+
+		event.Action = GameMove
+		event.Game = asLog.Address
+		event.ParentIndex = asLog.Topics[1].Big()
+		event.Claim = asLog.Topics[2]
+		event.Claimant = common.BytesToAddress(asLog.Topics[3].Bytes())
+
+	case isGame && asLog.Topics[0] == disputeGameResolvedSig:
+		if len(asLog.Topics) != disputeGameResolvedTopics {
+			return []models.TransitData{}, malformedDisputeLog("Resolved", asLog)
+		}
+
+		event.Action = GameResolved
+		event.Game = asLog.Address
+		event.Status = GameStatus(asLog.Topics[1].Big().Uint64())
+
+		// Resolved games can no longer emit events
+		delete(dgt.games, asLog.Address)
+
+	default:
+		return []models.TransitData{}, nil
+	}
+
+	return []models.TransitData{{
+		Timestamp: td.Timestamp,
+		Type:      DisputeGame,
+		Value:     event,
+	}}, nil
+}
+
+// malformedDisputeLog ... Returns an error for a dispute game event whose topics don't match its signature
+func malformedDisputeLog(event string, log types.Log) error {
+	return fmt.Errorf("malformed %s log emitted by %s in tx %s; expected indexed arguments, got %d topics",
+		event, log.Address.Hex(), log.TxHash.Hex(), len(log.Topics))
+}
+
+// NewDisputeGamePipe ... Initializer; the pipe decodes events for all dispute games
+// created by the DisputeGameFactory
+func NewDisputeGamePipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	factory, err := params.RequiredAddress(FactoryParam)
+	if err != nil {
+		return nil, err
+	}
+
+	dgt := &disputeGameTracker{
+		factory: factory,
+		games:   make(map[common.Address]struct{}),
+	}
+
+	return pipeline.NewPipe(ctx, dgt.decodeLog, inputChan)
+}
@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DisputeGameTracker_DecodeLog(t *testing.T) {
+	factory := common.HexToAddress("0x420")
+	game := common.HexToAddress("0x69")
+	unknown := common.HexToAddress("0x666")
+	claimant := common.HexToAddress("0x42")
+	claim := common.HexToHash("0xc1a1")
+
+	logTD := func(addr common.Address, removed bool, topics ...common.Hash) models.TransitData {
+		return models.TransitData{Timestamp: time.Now(), Type: EventLog, Value: types.Log{
+			Address: addr, Topics: topics, BlockNumber: 7, Removed: removed,
+		}}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		input models.TransitData
+
+		expected *DisputeGameEvent
+		tracked  bool
+		err      bool
+	}{
+		{
+			name:        "Game Created",
+			description: "When the factory creates a game, a created event should be emitted and the game tracked",
+			input: logTD(factory, false, disputeGameCreatedSig, unknown.Hash(),
+				common.BigToHash(big.NewInt(1)), claim),
+			expected: &DisputeGameEvent{Action: GameCreated, Game: unknown, Height: 7, GameType: 1, RootClaim: claim},
+			tracked:  true,
+		},
+		{
+			name:        "Game Move",
+			description: "When a tracked game emits a move, a move event should be emitted",
+			input: logTD(game, false, disputeGameMoveSig, common.BigToHash(big.NewInt(3)), claim,
+				claimant.Hash()),
+			expected: &DisputeGameEvent{Action: GameMove, Game: game, Height: 7, ParentIndex: big.NewInt(3),
+				Claim: claim, Claimant: claimant},
+			tracked: true,
+		},
+		{
+			name:        "Game Resolved",
+			description: "When a tracked game resolves, a resolved event should be emitted and the game untracked",
+			input:       logTD(game, false, disputeGameResolvedSig, common.BigToHash(big.NewInt(2))),
+			expected:    &DisputeGameEvent{Action: GameResolved, Game: game, Height: 7, Status: GameDefenderWon},
+		},
+		{
+			name:        "Unknown Game",
+			description: "When an untracked contract emits a game event, nothing should be emitted",
+			input: logTD(unknown, false, disputeGameMoveSig, common.BigToHash(big.NewInt(3)), claim,
+				claimant.Hash()),
+			tracked: true,
+		},
+		{
+			name:        "Removed Log",
+			description: "When a game event is reorged out, nothing should be emitted and the game remain tracked",
+			input:       logTD(game, true, disputeGameResolvedSig, common.BigToHash(big.NewInt(2))),
+			tracked:     true,
+		},
+		{
+			name:        "Malformed Log",
+			description: "When a game event is missing indexed arguments, an error should be returned",
+			input:       logTD(game, false, disputeGameMoveSig, common.BigToHash(big.NewInt(3))),
+			tracked:     true,
+			err:         true,
+		},
+		{
+			name:        "Invalid Input",
+			description: "When transit data isn't a log, an error should be returned",
+			input:       models.TransitData{Value: "not a log"},
+			tracked:     true,
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			dgt := &disputeGameTracker{
+				factory: factory,
+				games:   map[common.Address]struct{}{game: {}},
+			}
+
+			outputs, err := dgt.decodeLog(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tc.expected == nil {
+				assert.Empty(t, outputs)
+			} else {
+				assert.Len(t, outputs, 1)
+				assert.Equal(t, DisputeGame, outputs[0].Type)
+				assert.Equal(t, *tc.expected, outputs[0].Value)
+			}
+
+			tracked := game
+			if tc.expected != nil && tc.expected.Action == GameCreated {
+				tracked = tc.expected.Game
+			}
+
+			_, exists := dgt.games[tracked]
+			assert.Equal(t, tc.tracked, exists)
+		})
+	}
+}
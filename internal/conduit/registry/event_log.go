@@ -0,0 +1,224 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// TopicsParam ... Optional; positional topic filters of the transited logs, every position is either a
+// topic, a list of alternative topics or null to match any topic
+const TopicsParam = "topics"
+
+// EventLogODef ... EventLog register oracle definition used to drive oracle component;
+// transits every log emitted within a block as an individual piece of transit data. Logs can be
+// filtered by the emitting contract (AddressesParam) and by topic (TopicsParam)
+type EventLogODef struct {
+	cfg        *config.OracleConfig
+	client     client.EthClientInterface
+	currHeight *big.Int
+	// Nil unless filtered by the params
+	addresses []common.Address
+	topics    [][]common.Hash
+
+	heightCallback
+	errorCallback
+}
+
+// NewEventLogOracle ... Initializer
+func NewEventLogOracle(ctx context.Context, ot pipeline.OracleType, cfg *config.OracleConfig,
+	client client.EthClientInterface, params models.Params) (pipeline.Component, error) {
+	od, err := newEventLogODef(cfg, client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return pipeline.NewOracle(ctx, ot, od)
+}
+
+// newEventLogODef ... Initializes the definition with the log filters of the params
+func newEventLogODef(cfg *config.OracleConfig, client client.EthClientInterface,
+	params models.Params) (*EventLogODef, error) {
+	od := &EventLogODef{cfg: cfg, currHeight: nil, client: client}
+
+	addresses, err := params.Addresses(AddressesParam)
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) > 0 {
+		od.addresses = addresses
+	}
+
+	if od.topics, err = params.Topics(TopicsParam); err != nil {
+		return nil, err
+	}
+
+	return od, nil
+}
+
+// ConfigureRoutine ... Dials the oracle's RPC endpoint
+func (oracle *EventLogODef) ConfigureRoutine() error {
+	ctxTimeout, ctxCancel := context.WithTimeout(context.Background(),
+		time.Second*time.Duration(models.EthClientTimeout))
+	defer ctxCancel()
+
+	logging.WithContext(ctxTimeout).Info("Setting up event log client")
+
+	return oracle.client.DialContext(ctxTimeout, oracle.cfg.RPCEndpoint)
+}
+
+// HeightRange ... Returns the configured inclusive start & end heights
+func (oracle *EventLogODef) HeightRange() (*big.Int, *big.Int) {
+	return oracle.cfg.StartHeight, oracle.cfg.EndHeight
+}
+
+// LatestHeight ... Returns the current chain tip height
+func (oracle *EventLogODef) LatestHeight(ctx context.Context) (*big.Int, error) {
+	header, err := oracle.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Number, nil
+}
+
+// SetCurrentHeight ... Sets the height that the read routine will process next
+func (oracle *EventLogODef) SetCurrentHeight(height *big.Int) {
+	oracle.currHeight = height
+}
+
+// transitLogs ... Fetches all logs for a single block height and writes them to the component channel
+func (oracle *EventLogODef) transitLogs(ctx context.Context, componentChan chan models.TransitData,
+	height *big.Int) error {
+	logs, err := oracle.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: height,
+		ToBlock:   height,
+		Addresses: oracle.addresses,
+		Topics:    oracle.topics,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, log := range logs {
+		componentChan <- models.TransitData{
+			Timestamp: time.Now(),
+			Type:      EventLog,
+			Value:     log,
+			Height:    new(big.Int).SetUint64(log.BlockNumber),
+		}
+	}
+
+	return nil
+}
+
+// BackTestRoutine ... Sequentially transits logs for every block within the inclusive height range
+func (oracle *EventLogODef) BackTestRoutine(ctx context.Context, componentChan chan models.TransitData,
+	startHeight *big.Int, endHeight *big.Int) error {
+	if endHeight.Cmp(startHeight) < 0 {
+		return pipeline.NewFatalError(errors.New("start height cannot be more than the end height"))
+	}
+
+	ticker := newPollTicker(oracle.cfg.PollInterval)
+	defer ticker.Stop()
+
+	height := new(big.Int).Set(startHeight)
+
+	for {
+		select {
+		case <-ticker.C:
+			ticker.refresh()
+			if err := oracle.transitLogs(ctx, componentChan, height); err != nil {
+				logging.WithContext(ctx).Error("problem fetching logs", zap.Error(err))
+				oracle.reportError(newFetchError("logs", height, err))
+				continue
+			}
+			oracle.reportHeight(height)
+
+			if height.Cmp(endHeight) == 0 {
+				logging.WithContext(ctx).Info("Completed back-test routine.")
+				return nil
+			}
+
+			height.Add(height, big.NewInt(1))
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// ReadRoutine ... Sequentially polls go-ethereum compatible execution client for block headers
+// & writes all logs emitted within each newly observed block to output listener components
+func (oracle *EventLogODef) ReadRoutine(ctx context.Context, componentChan chan models.TransitData) error {
+	if oracle.cfg.EndHeight != nil && oracle.cfg.StartHeight == nil {
+		return pipeline.NewFatalError(errors.New("cannot start with latest block height with end height configured"))
+	}
+
+	if oracle.cfg.EndHeight != nil && oracle.cfg.EndHeight.Cmp(oracle.cfg.StartHeight) < 0 {
+		return pipeline.NewFatalError(errors.New("start height cannot be more than the end height"))
+	}
+
+	// A previously set height (E.G, from a completed backfill) takes precedence over the start height
+	if oracle.currHeight == nil && oracle.cfg.StartHeight != nil {
+		oracle.currHeight = new(big.Int).Set(oracle.cfg.StartHeight)
+	}
+
+	// New heads are subscribed to when a WS endpoint is configured
+	ticker := newHeadTicker(ctx, oracle.client, oracle.cfg.PollInterval, oracle.cfg.Subscribable())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			height, confirmed, cErr := confirmedHeight(ctx, oracle.client, oracle.currHeight,
+				oracle.cfg.ConfirmationDepth)
+			if cErr != nil {
+				logging.WithContext(ctx).Error("problem fetching latest header", zap.Error(cErr))
+				oracle.reportError(newFetchError("latest header", nil, cErr))
+				continue
+			}
+
+			// Wait until the height is the confirmation depth behind the chain tip
+			if !confirmed {
+				continue
+			}
+
+			// Nil height resolves to the latest block header
+			header, err := oracle.client.HeaderByNumber(ctx, height)
+			if err != nil {
+				logging.WithContext(ctx).Error("problem fetching header", zap.Error(err))
+				oracle.reportError(newFetchError("header", height, err))
+				continue
+			}
+
+			if logErr := oracle.transitLogs(ctx, componentChan, header.Number); logErr != nil {
+				logging.WithContext(ctx).Error("problem fetching logs", zap.Error(logErr))
+				oracle.reportError(newFetchError("logs", header.Number, logErr))
+				continue
+			}
+			oracle.reportHeight(header.Number)
+
+			// check has to be done here to include the end height block
+			if oracle.cfg.EndHeight != nil && header.Number.Cmp(oracle.cfg.EndHeight) == 0 {
+				return nil
+			}
+
+			oracle.currHeight = new(big.Int).Add(header.Number, big.NewInt(1))
+			ticker.next()
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// expectLogs ... Sets up a single block log filter expectation returning one log emitted at the height
+func expectLogs(ec *mocks.EthClient, height int64) {
+	ec.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+		FromBlock: big.NewInt(height),
+		ToBlock:   big.NewInt(height),
+	}).Return([]types.Log{{Address: common.HexToAddress("0x420"), BlockNumber: uint64(height)}}, nil).Once()
+}
+
+// newEventLogTestOracle ... Returns an event log oracle that records every reported height & error
+func newEventLogTestOracle(ec *mocks.EthClient, cfg *config.OracleConfig) (*EventLogODef, *[]int64, *[]error) {
+	od := &EventLogODef{cfg: cfg, client: ec}
+
+	heights, errs := make([]int64, 0), make([]error, 0)
+	od.OnHeightProcessed(func(height *big.Int) { heights = append(heights, height.Int64()) })
+	od.OnError(func(err error) { errs = append(errs, err) })
+
+	return od, &heights, &errs
+}
+
+// transitedHeights ... Closes the channel and returns the heights of all transited logs
+func transitedHeights(outChan chan models.TransitData) []int64 {
+	close(outChan)
+
+	heights := make([]int64, 0)
+	for td := range outChan {
+		heights = append(heights, td.Height.Int64())
+	}
+
+	return heights
+}
+
+func Test_EventLog_BackTestRoutine(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		setup func(*mocks.EthClient)
+		start int64
+		end   int64
+
+		errMsg   string
+		expected []int64
+		errs     int
+	}{
+		{
+			name:        "Invalid Range",
+			description: "When the start height is after the end height, a fatal error should be returned",
+			setup:       func(*mocks.EthClient) {},
+			start:       2,
+			end:         1,
+			errMsg:      "start height cannot be more than the end height",
+		},
+		{
+			name:        "Single Block Filters",
+			description: "Logs should be filtered one block at a time through the end height",
+			setup: func(ec *mocks.EthClient) {
+				for height := int64(5); height <= 7; height++ {
+					expectLogs(ec, height)
+				}
+			},
+			start:    5,
+			end:      7,
+			expected: []int64{5, 6, 7},
+		},
+		{
+			name:        "Failed Fetch Retry",
+			description: "When a filter fails, the error should be reported and the same height retried",
+			setup: func(ec *mocks.EthClient) {
+				ec.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(5),
+					ToBlock:   big.NewInt(5),
+				}).Return(nil, errors.New("no logs for you")).Once()
+				expectLogs(ec, 5)
+			},
+			start:    5,
+			end:      5,
+			expected: []int64{5},
+			errs:     1,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ec := new(mocks.EthClient)
+			tc.setup(ec)
+
+			od, heights, errs := newEventLogTestOracle(ec, &config.OracleConfig{PollInterval: time.Millisecond})
+			outChan := make(chan models.TransitData, 10)
+
+			err := od.BackTestRoutine(context.Background(), outChan, big.NewInt(tc.start), big.NewInt(tc.end))
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, transitedHeights(outChan))
+			assert.Equal(t, tc.expected, *heights, "Ensuring every processed height is reported")
+			assert.Len(t, *errs, tc.errs)
+			ec.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_EventLog_ReadRoutine(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	// expectHeader ... Sets up a header expectation for the height
+	expectHeader := func(ec *mocks.EthClient, height int64) {
+		ec.On("HeaderByNumber", mock.Anything, big.NewInt(height)).
+			Return(&types.Header{Number: big.NewInt(height)}, nil).Once()
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		setup      func(*mocks.EthClient)
+		cfg        *config.OracleConfig
+		currHeight *big.Int
+
+		errMsg   string
+		expected []int64
+	}{
+		{
+			name:        "End Without Start",
+			description: "When only an end height is configured, a fatal error should be returned",
+			setup:       func(*mocks.EthClient) {},
+			cfg:         &config.OracleConfig{EndHeight: big.NewInt(5)},
+			errMsg:      "cannot start with latest block height with end height configured",
+		},
+		{
+			name:        "Invalid Range",
+			description: "When the start height is after the end height, a fatal error should be returned",
+			setup:       func(*mocks.EthClient) {},
+			cfg:         &config.OracleConfig{StartHeight: big.NewInt(6), EndHeight: big.NewInt(5)},
+			errMsg:      "start height cannot be more than the end height",
+		},
+		{
+			name:        "Start To End",
+			description: "Logs should be filtered for every height from the start height through the end height",
+			setup: func(ec *mocks.EthClient) {
+				for height := int64(5); height <= 6; height++ {
+					expectHeader(ec, height)
+					expectLogs(ec, height)
+				}
+			},
+			cfg: &config.OracleConfig{StartHeight: big.NewInt(5), EndHeight: big.NewInt(6),
+				PollInterval: time.Millisecond},
+			expected: []int64{5, 6},
+		},
+		{
+			name:        "Resumed Cursor",
+			description: "When a height has already been set, the routine should resume from it over the start height",
+			setup: func(ec *mocks.EthClient) {
+				expectHeader(ec, 6)
+				expectLogs(ec, 6)
+			},
+			cfg: &config.OracleConfig{StartHeight: big.NewInt(5), EndHeight: big.NewInt(6),
+				PollInterval: time.Millisecond},
+			currHeight: big.NewInt(6),
+			expected:   []int64{6},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ec := new(mocks.EthClient)
+			tc.setup(ec)
+
+			od, heights, _ := newEventLogTestOracle(ec, tc.cfg)
+			if tc.currHeight != nil {
+				od.SetCurrentHeight(tc.currHeight)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			outChan := make(chan models.TransitData, 10)
+
+			err := od.ReadRoutine(ctx, outChan)
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NoError(t, ctx.Err(), "Ensuring the routine stopped at the end height")
+			assert.Equal(t, tc.expected, transitedHeights(outChan))
+			assert.Equal(t, tc.expected, *heights)
+			ec.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_EventLog_Filters(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	transfer := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approval := common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+	owner := common.HexToHash("0x420")
+
+	var tests = []struct {
+		name        string
+		description string
+
+		params models.Params
+
+		errMsg    string
+		addresses []common.Address
+		topics    [][]common.Hash
+	}{
+		{
+			name:        "Unfiltered",
+			description: "Every log should be filtered for when no filter params are set",
+		},
+		{
+			name:        "Address & Topic Filters",
+			description: "Addresses & positional topics should be passed to the log filter",
+			params: models.Params{
+				AddressesParam: []any{common.HexToAddress("0x420").Hex()},
+				TopicsParam:    []any{[]any{transfer.Hex(), approval.Hex()}, nil, owner.Hex()},
+			},
+			addresses: []common.Address{common.HexToAddress("0x420")},
+			topics:    [][]common.Hash{{transfer, approval}, nil, {owner}},
+		},
+		{
+			name:        "Invalid Topic",
+			description: "Topics that aren't 32 byte hashes should be rejected",
+			params:      models.Params{TopicsParam: []any{"0x420"}},
+			errMsg:      "param topics has invalid hash value 0x420",
+		},
+		{
+			name:        "Invalid Address",
+			description: "Invalid addresses should be rejected",
+			params:      models.Params{AddressesParam: []any{"0x42z"}},
+			errMsg:      "param addresses has invalid address value 0x42z",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ec := new(mocks.EthClient)
+
+			od, err := newEventLogODef(&config.OracleConfig{}, ec, tc.params)
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			ec.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+				FromBlock: big.NewInt(5),
+				ToBlock:   big.NewInt(5),
+				Addresses: tc.addresses,
+				Topics:    tc.topics,
+			}).Return([]types.Log{}, nil).Once()
+
+			assert.NoError(t, od.transitLogs(context.Background(), make(chan models.TransitData), big.NewInt(5)))
+			ec.AssertExpectations(t)
+		})
+	}
+}
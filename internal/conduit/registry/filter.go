@@ -0,0 +1,185 @@
+package registry
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FilterType ... Identifies a configurable filter predicate
+type FilterType string
+
+const (
+	HeightRangeFilter FilterType = "HEIGHT_RANGE"
+	AddressFilter     FilterType = "ADDRESS"
+	FieldEqualsFilter FilterType = "FIELD_EQUALS"
+)
+
+// FilterConstructor ... Builds a filter predicate from its params
+type FilterConstructor = func(params models.Params) (pipeline.Predicate, error)
+
+// FilterRegister ... Metadata used to construct a filter predicate from config
+type FilterRegister struct {
+	FilterType FilterType
+	// Human readable summary presented to users when discovering filters
+	Description string
+	Constructor FilterConstructor
+}
+
+// filters ... Lookup of all known filter registers keyed by filter type
+var filters = map[FilterType]*FilterRegister{
+	HeightRangeFilter: {
+		FilterType:  HeightRangeFilter,
+		Description: "Keeps data derived from blocks within the inclusive min & max heights",
+		Constructor: newHeightRangePredicate,
+	},
+	AddressFilter: {
+		FilterType:  AddressFilter,
+		Description: "Keeps logs emitted by, or transactions sent to, any of the addresses",
+		Constructor: newAddressPredicate,
+	},
+	FieldEqualsFilter: {
+		FilterType:  FieldEqualsFilter,
+		Description: "Keeps data whose value has a field matching the given value; comparison is case insensitive",
+		Constructor: newFieldEqualsPredicate,
+	},
+}
+
+// GetFilter ... Returns the filter register for the type; fail if no filter exists
+func GetFilter(ft FilterType) (*FilterRegister, error) {
+	fr, found := filters[ft]
+	if !found {
+		return nil, fmt.Errorf("no filter could be found for type: %s", ft)
+	}
+
+	return fr, nil
+}
+
+// ListFilterTypes ... Returns all known filter types in lexicographical order
+func ListFilterTypes() []FilterType {
+	fts := make([]FilterType, 0, len(filters))
+	for ft := range filters {
+		fts = append(fts, ft)
+	}
+
+	sort.Slice(fts, func(i, j int) bool {
+		return fts[i] < fts[j]
+	})
+
+	return fts
+}
+
+// newHeightRangePredicate ... Accepts optional min & max params; data without a height is dropped
+func newHeightRangePredicate(params models.Params) (pipeline.Predicate, error) {
+	minHeight, err := params.BigInt("min", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	maxHeight, err := params.BigInt("max", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if minHeight == nil && maxHeight == nil {
+		return nil, fmt.Errorf("%s filter requires a min or max param", HeightRangeFilter)
+	}
+
+	return func(td models.TransitData) (bool, error) {
+		if td.Height == nil {
+			return false, nil
+		}
+
+		return inRange(td.Height, minHeight, maxHeight), nil
+	}, nil
+}
+
+// inRange ... Returns true if the height is within the inclusive bounds; nil bounds are unbounded
+func inRange(height, minHeight, maxHeight *big.Int) bool {
+	if minHeight != nil && height.Cmp(minHeight) < 0 {
+		return false
+	}
+
+	return maxHeight == nil || height.Cmp(maxHeight) <= 0
+}
+
+// newAddressPredicate ... Accepts a required addresses param
+func newAddressPredicate(params models.Params) (pipeline.Predicate, error) {
+	addresses, err := params.Addresses("addresses")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("%s filter requires at least one address", AddressFilter)
+	}
+
+	watched := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		watched[addr] = struct{}{}
+	}
+
+	return func(td models.TransitData) (bool, error) {
+		var addr common.Address
+
+		switch val := td.Value.(type) {
+		case types.Log:
+			addr = val.Address
+
+		case *types.Transaction:
+			if val.To() == nil {
+				return false, nil
+			}
+			addr = *val.To()
+
+		default:
+			return false, fmt.Errorf("%s filter does not support value type %T", AddressFilter, td.Value)
+		}
+
+		_, found := watched[addr]
+		return found, nil
+	}, nil
+}
+
+// newFieldEqualsPredicate ... Accepts required field & value params; the field must be an exported
+// struct field of the transit data value
+func newFieldEqualsPredicate(params models.Params) (pipeline.Predicate, error) {
+	field, err := params.String("field", "")
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := params.String("value", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if field == "" || !params.Has("value") {
+		return nil, fmt.Errorf("%s filter requires field & value params", FieldEqualsFilter)
+	}
+
+	return func(td models.TransitData) (bool, error) {
+		val := reflect.ValueOf(td.Value)
+		for val.Kind() == reflect.Pointer && !val.IsNil() {
+			val = val.Elem()
+		}
+
+		if val.Kind() != reflect.Struct {
+			return false, fmt.Errorf("%s filter requires a struct value; got %T", FieldEqualsFilter, td.Value)
+		}
+
+		fv := val.FieldByName(field)
+		if !fv.IsValid() || !fv.CanInterface() {
+			return false, fmt.Errorf("%T value has no exported field %s", td.Value, field)
+		}
+
+		return strings.EqualFold(fmt.Sprint(fv.Interface()), expected), nil
+	}, nil
+}
@@ -0,0 +1,125 @@
+package registry
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Filters(t *testing.T) {
+	watched := common.HexToAddress("0x420")
+	other := common.HexToAddress("0x69")
+
+	var tests = []struct {
+		name        string
+		description string
+
+		ft     FilterType
+		params models.Params
+		td     models.TransitData
+
+		constructErr bool
+		expected     bool
+		expectedErr  bool
+	}{
+		{
+			name:        "Height In Range Test",
+			description: "Data derived from a height within the bounds should be kept",
+
+			ft:       HeightRangeFilter,
+			params:   models.Params{"min": 1, "max": "0x10"},
+			td:       models.TransitData{Height: big.NewInt(16)},
+			expected: true,
+		},
+		{
+			name:        "Height Out Of Range Test",
+			description: "Data derived from a height outside the bounds should be dropped",
+
+			ft:       HeightRangeFilter,
+			params:   models.Params{"min": 17},
+			td:       models.TransitData{Height: big.NewInt(16)},
+			expected: false,
+		},
+		{
+			name:        "Missing Height Bounds Test",
+			description: "Height filters without bounds should fail construction",
+
+			ft:           HeightRangeFilter,
+			params:       models.Params{},
+			constructErr: true,
+		},
+		{
+			name:        "Address Log Match Test",
+			description: "Logs emitted by a watched address should be kept",
+
+			ft:       AddressFilter,
+			params:   models.Params{"addresses": []string{watched.Hex()}},
+			td:       models.TransitData{Value: types.Log{Address: watched}},
+			expected: true,
+		},
+		{
+			name:        "Address Tx Mismatch Test",
+			description: "Transactions sent to an unwatched address should be dropped",
+
+			ft:       AddressFilter,
+			params:   models.Params{"addresses": []string{watched.Hex()}},
+			td:       models.TransitData{Value: types.NewTx(&types.LegacyTx{To: &other})},
+			expected: false,
+		},
+		{
+			name:        "Address Unsupported Value Test",
+			description: "Values without an address should return an error",
+
+			ft:          AddressFilter,
+			params:      models.Params{"addresses": []string{watched.Hex()}},
+			td:          models.TransitData{Value: 0x420},
+			expectedErr: true,
+		},
+		{
+			name:        "Field Equals Match Test",
+			description: "Struct values with a matching field should be kept regardless of case",
+
+			ft:       FieldEqualsFilter,
+			params:   models.Params{"field": "Token", "value": watched.Hex()},
+			td:       models.TransitData{Value: MintBurnEvent{Token: watched, Change: Mint}},
+			expected: true,
+		},
+		{
+			name:        "Field Equals Missing Field Test",
+			description: "Struct values without the field should return an error",
+
+			ft:          FieldEqualsFilter,
+			params:      models.Params{"field": "Unknown", "value": "1"},
+			td:          models.TransitData{Value: &MintBurnEvent{}},
+			expectedErr: true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			fr, err := GetFilter(tc.ft)
+			assert.NoError(t, err)
+
+			predicate, err := fr.Constructor(tc.params)
+			if tc.constructErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			match, err := predicate(tc.td)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, match)
+		})
+	}
+}
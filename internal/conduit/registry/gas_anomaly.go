@@ -0,0 +1,137 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// WindowSizeParam ... Number of previous blocks used to compute gas usage statistics
+	WindowSizeParam = "window_size"
+	// MaxDeviationsParam ... Number of standard deviations from the mean considered anomalous
+	MaxDeviationsParam = "max_deviations"
+
+	// defaultGasWindowSize ... Number of previous blocks used to compute gas usage statistics
+	defaultGasWindowSize = 100
+	// defaultGasDeviations ... Number of standard deviations from the mean considered anomalous
+	defaultGasDeviations = 3.0
+)
+
+// GasAnomalyEvent ... Emitted when a block's gas usage deviates from the rolling window mean
+type GasAnomalyEvent struct {
+	Height     *big.Int
+	GasUsed    uint64
+	Mean       float64
+	StdDev     float64
+	Deviations float64
+}
+
+// gasUsageWindow ... Fixed size ring buffer of per-block gas used values
+type gasUsageWindow struct {
+	maxDeviations float64
+
+	values []float64
+	next   int
+	filled bool
+}
+
+// newGasUsageWindow ... Initializer
+func newGasUsageWindow(size int, maxDeviations float64) *gasUsageWindow {
+	return &gasUsageWindow{
+		maxDeviations: maxDeviations,
+		values:        make([]float64, size),
+	}
+}
+
+// add ... Inserts a value into the window, overwriting the oldest value when full
+func (gw *gasUsageWindow) add(val float64) {
+	gw.values[gw.next] = val
+	gw.next = (gw.next + 1) % len(gw.values)
+
+	if gw.next == 0 {
+		gw.filled = true
+	}
+}
+
+// stats ... Returns the mean and population standard deviation of the window
+func (gw *gasUsageWindow) stats() (float64, float64) {
+	var sum float64
+	for _, val := range gw.values {
+		sum += val
+	}
+	mean := sum / float64(len(gw.values))
+
+	var variance float64
+	for _, val := range gw.values {
+		variance += (val - mean) * (val - mean)
+	}
+
+	return mean, math.Sqrt(variance / float64(len(gw.values)))
+}
+
+// trackBlock ... Evaluates the block's gas used against the rolling window before adding it;
+// no evaluation occurs until the window is filled or when the window has no variance
+func (gw *gasUsageWindow) trackBlock(td models.TransitData) ([]models.TransitData, error) {
+	asBlock, err := models.ValueAs[types.Block](td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	gasUsed := asBlock.GasUsed()
+	defer gw.add(float64(gasUsed))
+
+	if !gw.filled {
+		return []models.TransitData{}, nil
+	}
+
+	mean, stdDev := gw.stats()
+	if stdDev == 0 {
+		return []models.TransitData{}, nil
+	}
+
+	deviations := math.Abs(float64(gasUsed)-mean) / stdDev
+	if deviations <= gw.maxDeviations {
+		return []models.TransitData{}, nil
+	}
+
+	return []models.TransitData{{
+		Timestamp: td.Timestamp,
+		Type:      GasUsageAnomaly,
+		Value: GasAnomalyEvent{
+			Height:     asBlock.Number(),
+			GasUsed:    gasUsed,
+			Mean:       mean,
+			StdDev:     stdDev,
+			Deviations: deviations,
+		},
+	}}, nil
+}
+
+// NewGasAnomalyPipe ... Initializer; the pipe emits an event when a block's gas used deviates
+// more than the max standard deviations from the mean of the previous window of blocks
+func NewGasAnomalyPipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	windowSize, err := params.Int(WindowSizeParam, defaultGasWindowSize)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDeviations, err := params.Float(MaxDeviationsParam, defaultGasDeviations)
+	if err != nil {
+		return nil, err
+	}
+
+	if windowSize < 2 || maxDeviations <= 0 {
+		return nil, fmt.Errorf("invalid gas anomaly thresholds; windowSize: %d, maxDeviations: %f",
+			windowSize, maxDeviations)
+	}
+
+	gw := newGasUsageWindow(windowSize, maxDeviations)
+	return pipeline.NewPipe(ctx, gw.trackBlock, inputChan)
+}
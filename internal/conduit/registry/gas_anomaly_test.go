@@ -0,0 +1,44 @@
+package registry
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GasUsageWindow_TrackBlock(t *testing.T) {
+	gw := newGasUsageWindow(4, 2)
+
+	newBlockTD := func(height int64, gasUsed uint64) models.TransitData {
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(height), GasUsed: gasUsed})
+		return models.TransitData{Timestamp: time.Now(), Type: GethBlock, Value: *block}
+	}
+
+	// Window isn't evaluated until filled
+	for i, gasUsed := range []uint64{90, 110, 90, 110} {
+		outputs, err := gw.trackBlock(newBlockTD(int64(i), gasUsed))
+		assert.NoError(t, err)
+		assert.Empty(t, outputs, "Ensuring no anomaly is emitted before window is filled")
+	}
+
+	// Mean of 100 with a std dev of 10; 115 is within 2 deviations
+	outputs, err := gw.trackBlock(newBlockTD(4, 115))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	// Window is now [115, 110, 90, 110]; 500 is well outside of 2 deviations
+	outputs, err = gw.trackBlock(newBlockTD(5, 500))
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 1)
+
+	event, success := outputs[0].Value.(GasAnomalyEvent)
+	assert.True(t, success)
+	assert.Equal(t, outputs[0].Type, GasUsageAnomaly)
+	assert.Equal(t, event.Height, big.NewInt(5))
+	assert.Equal(t, event.GasUsed, uint64(500))
+	assert.Greater(t, event.Deviations, 2.0)
+}
@@ -3,6 +3,7 @@ package registry
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -15,9 +16,85 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	pollInterval = 200
-)
+// heightCallback ... Embedded by oracle definitions to report fully transited heights
+type heightCallback struct {
+	fn func(height *big.Int)
+}
+
+// OnHeightProcessed ... Registers a function invoked after all data for a height has been transited
+func (hc *heightCallback) OnHeightProcessed(fn func(height *big.Int)) {
+	hc.fn = fn
+}
+
+// reportHeight ... Invokes the registered callback, if any, with a copy of the height
+func (hc *heightCallback) reportHeight(height *big.Int) {
+	if hc.fn != nil {
+		hc.fn(new(big.Int).Set(height))
+	}
+}
+
+// errorCallback ... Embedded by oracle definitions to report errors that don't stop their routines
+type errorCallback struct {
+	errFn func(err error)
+}
+
+// OnError ... Registers a function invoked with every recoverable routine error
+func (ec *errorCallback) OnError(fn func(err error)) {
+	ec.errFn = fn
+}
+
+// reportError ... Invokes the registered callback, if any, with the error
+func (ec *errorCallback) reportError(err error) {
+	if ec.errFn != nil {
+		ec.errFn(err)
+	}
+}
+
+// newFetchError ... Returns a transient error describing a failed or mistyped RPC fetch
+func newFetchError(kind string, height *big.Int, err error) error {
+	if err == nil {
+		err = fmt.Errorf("unexpected %s type", kind)
+	}
+
+	return pipeline.NewTransientError(fmt.Errorf("could not fetch %s at height %v: %w", kind, height, err))
+}
+
+// blockBatch ... Accumulates backtested blocks so that they can be transited using a single channel send
+type blockBatch struct {
+	size  int
+	items []models.TransitData
+}
+
+// newBlockBatch ... Initializer; sizes of one or less transit every block individually
+func newBlockBatch(size int) *blockBatch {
+	if size < 1 {
+		size = 1
+	}
+
+	return &blockBatch{size: size, items: make([]models.TransitData, 0, size)}
+}
+
+// add ... Appends a block to the batch
+func (bb *blockBatch) add(td models.TransitData) {
+	bb.items = append(bb.items, td)
+}
+
+// full ... Returns true once the batch holds size blocks
+func (bb *blockBatch) full() bool {
+	return len(bb.items) >= bb.size
+}
+
+// flush ... Returns the accumulated blocks as transit data and starts a new batch
+func (bb *blockBatch) flush() models.TransitData {
+	items := bb.items
+	bb.items = make([]models.TransitData, 0, bb.size)
+
+	if len(items) == 1 {
+		return items[0]
+	}
+
+	return models.NewBatch(items)
+}
 
 // TODO(#21): Verify config validity during Oracle construction
 // GethBlockODef ...GethBlock register oracle definition used to drive oracle component
@@ -25,11 +102,14 @@ type GethBlockODef struct {
 	cfg        *config.OracleConfig
 	client     client.EthClientInterface
 	currHeight *big.Int
+
+	heightCallback
+	errorCallback
 }
 
 // NewGethBlockOracle ... Initializer
-func NewGethBlockOracle(ctx context.Context,
-	ot pipeline.OracleType, cfg *config.OracleConfig, client client.EthClientInterface) (pipeline.Component, error) {
+func NewGethBlockOracle(ctx context.Context, ot pipeline.OracleType, cfg *config.OracleConfig,
+	client client.EthClientInterface, _ models.Params) (pipeline.Component, error) {
 	od := &GethBlockODef{cfg: cfg, currHeight: nil, client: client}
 	return pipeline.NewOracle(ctx, ot, od)
 }
@@ -49,76 +129,164 @@ func (oracle *GethBlockODef) ConfigureRoutine() error {
 	return nil
 }
 
-// getCurrentHeightFromNetwork ... Gets the current height of the network and will not quit until found
-func (oracle *GethBlockODef) getCurrentHeightFromNetwork(ctx context.Context) *types.Header {
-	for {
+// HeightRange ... Returns the configured inclusive start & end heights
+func (oracle *GethBlockODef) HeightRange() (*big.Int, *big.Int) {
+	return oracle.cfg.StartHeight, oracle.cfg.EndHeight
+}
+
+// LatestHeight ... Returns the current chain tip height
+func (oracle *GethBlockODef) LatestHeight(ctx context.Context) (*big.Int, error) {
+	header, err := oracle.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Number, nil
+}
+
+// SetCurrentHeight ... Sets the height that the read routine will process next
+func (oracle *GethBlockODef) SetCurrentHeight(height *big.Int) {
+	oracle.currHeight = height
+}
+
+// getCurrentHeightFromNetwork ... Gets the current height of the network, backing off between failed
+// attempts using the configured backoff; only fails once the context is done
+func (oracle *GethBlockODef) getCurrentHeightFromNetwork(ctx context.Context) (*types.Header, error) {
+	backoff := oracle.cfg.Backoff
+	if backoff == (client.Backoff{}) {
+		backoff = client.DefaultBackoff()
+	}
+	backoff.Retries = -1
+
+	return client.RetryValue(ctx, backoff, func() (*types.Header, error) {
 		header, err := oracle.client.HeaderByNumber(ctx, nil)
 		if err != nil {
 			logging.WithContext(ctx).Error("problem fetching current height from network", zap.Error(err))
-			continue
+			oracle.reportError(newFetchError("latest header", nil, err))
 		}
-		return header
-	}
+
+		return header, err
+	})
 }
 
 // BackTestRoutine ...
 func (oracle *GethBlockODef) BackTestRoutine(ctx context.Context, componentChan chan models.TransitData,
 	startHeight *big.Int, endHeight *big.Int) error {
 	if endHeight.Cmp(startHeight) < 0 {
-		return errors.New("start height cannot be more than the end height")
+		return pipeline.NewFatalError(errors.New("start height cannot be more than the end height"))
 	}
 
-	currentHeader := oracle.getCurrentHeightFromNetwork(ctx)
+	currentHeader, hErr := oracle.getCurrentHeightFromNetwork(ctx)
+	if hErr != nil {
+		return hErr
+	}
 
 	if startHeight.Cmp(currentHeader.Number) == 1 {
-		return errors.New("start height cannot be more than the latest height from network")
+		return pipeline.NewFatalError(errors.New("start height cannot be more than the latest height from network"))
 	}
 
-	ticker := time.NewTicker(pollInterval * time.Millisecond)
+	ticker := newPollTicker(oracle.cfg.PollInterval)
+	defer ticker.Stop()
+
 	height := startHeight
+	batch := newBlockBatch(oracle.cfg.BatchSize)
 
 	for {
 		select {
 		case <-ticker.C:
+			ticker.refresh()
 
-			headerAsInterface, err := oracle.fetchData(ctx, height, models.FetchHeader)
-			headerAsserted, headerAssertedOk := headerAsInterface.(*types.Header)
-
-			if err != nil || !headerAssertedOk {
-				logging.WithContext(ctx).Error("problem fetching or asserting header", zap.NamedError("headerFetch", err),
-					zap.Bool("headerAsserted", headerAssertedOk))
+			blocks, fErr := oracle.fetchBlocks(ctx, height, endHeight)
+			if fErr != nil {
 				continue
 			}
 
-			blockAsInterface, err := oracle.fetchData(ctx, headerAsserted.Number, models.FetchBlock)
-			blockAsserted, blockAssertedOk := blockAsInterface.(*types.Block)
+			for _, block := range blocks {
+				// TODO - Add support for database persistence
+				batch.add(models.TransitData{
+					Timestamp: time.Now(),
+					Type:      GethBlock,
+					Value:     *block,
+					Height:    block.Number(),
+				})
+
+				last := height.Cmp(endHeight) == 0
+				if batch.full() || last {
+					componentChan <- batch.flush()
+					oracle.reportHeight(block.Number())
+				}
+
+				if last {
+					logging.WithContext(ctx).Info("Completed back-test routine.")
+					return nil
+				}
 
-			if err != nil || !blockAssertedOk {
-				logging.WithContext(ctx).Error("problem fetching or asserting block", zap.NamedError("blockFetch", err),
-					zap.Bool("blockAsserted", blockAssertedOk))
-				continue
-			}
-
-			// TODO - Add support for database persistence
-			componentChan <- models.TransitData{
-				Timestamp: time.Now(),
-				Type:      GethBlock,
-				Value:     *blockAsserted,
-			}
-
-			if height.Cmp(endHeight) == 0 {
-				logging.WithContext(ctx).Info("Completed back-test routine.")
-				return nil
+				height.Add(height, big.NewInt(1))
 			}
 
-			height.Add(height, big.NewInt(1))
-
 		case <-ctx.Done():
 			return nil
 		}
 	}
 }
 
+// fetchBlocks ... Fetches the blocks from the height through the end height; up to the fetch batch size
+// blocks are fetched using a single batched RPC request when configured, otherwise only the block at the height
+func (oracle *GethBlockODef) fetchBlocks(ctx context.Context, height, endHeight *big.Int) ([]*types.Block, error) {
+	if oracle.cfg.FetchBatchSize <= 1 {
+		block, err := oracle.fetchBlock(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.Block{block}, nil
+	}
+
+	numbers := make([]*big.Int, 0, oracle.cfg.FetchBatchSize)
+	for n := new(big.Int).Set(height); n.Cmp(endHeight) <= 0 && len(numbers) < oracle.cfg.FetchBatchSize; {
+		numbers = append(numbers, n)
+		n = new(big.Int).Add(n, big.NewInt(1))
+	}
+
+	blocks, err := oracle.client.BlocksByNumber(ctx, numbers)
+	if err != nil {
+		logging.WithContext(ctx).Error("problem fetching block batch", zap.Error(err),
+			zap.String("from", height.String()), zap.Int("count", len(numbers)))
+		fErr := newFetchError("blocks", height, err)
+		oracle.reportError(fErr)
+		return nil, fErr
+	}
+
+	return blocks, nil
+}
+
+// fetchBlock ... Fetches the header & then the block at the height
+func (oracle *GethBlockODef) fetchBlock(ctx context.Context, height *big.Int) (*types.Block, error) {
+	headerAsInterface, err := oracle.fetchData(ctx, height, models.FetchHeader)
+	headerAsserted, headerAssertedOk := headerAsInterface.(*types.Header)
+
+	if err != nil || !headerAssertedOk {
+		logging.WithContext(ctx).Error("problem fetching or asserting header", zap.NamedError("headerFetch", err),
+			zap.Bool("headerAsserted", headerAssertedOk))
+		fErr := newFetchError("header", height, err)
+		oracle.reportError(fErr)
+		return nil, fErr
+	}
+
+	blockAsInterface, err := oracle.fetchData(ctx, headerAsserted.Number, models.FetchBlock)
+	blockAsserted, blockAssertedOk := blockAsInterface.(*types.Block)
+
+	if err != nil || !blockAssertedOk {
+		logging.WithContext(ctx).Error("problem fetching or asserting block", zap.NamedError("blockFetch", err),
+			zap.Bool("blockAsserted", blockAssertedOk))
+		fErr := newFetchError("block", headerAsserted.Number, err)
+		oracle.reportError(fErr)
+		return nil, fErr
+	}
+
+	return blockAsserted, nil
+}
+
 // getHeightToProcess ...
 //
 //	Check if current height is nil, if it is, then check if starting height is provided:
@@ -158,26 +326,43 @@ func (oracle *GethBlockODef) ReadRoutine(ctx context.Context, componentChan chan
 	// NOTE - Might need improvements in future as the project takes shape.
 
 	if oracle.cfg.EndHeight != nil && oracle.cfg.StartHeight == nil {
-		return errors.New("cannot start with latest block height with end height configured")
+		return pipeline.NewFatalError(errors.New("cannot start with latest block height with end height configured"))
 	}
 
-	if oracle.cfg.EndHeight.Cmp(oracle.cfg.StartHeight) < 0 {
-		return errors.New("start height cannot be more than the end height")
+	if oracle.cfg.EndHeight != nil && oracle.cfg.EndHeight.Cmp(oracle.cfg.StartHeight) < 0 {
+		return pipeline.NewFatalError(errors.New("start height cannot be more than the end height"))
 	}
 
 	// Now fetching current height from the network
-	currentHeader := oracle.getCurrentHeightFromNetwork(ctx)
+	currentHeader, hErr := oracle.getCurrentHeightFromNetwork(ctx)
+	if hErr != nil {
+		return hErr
+	}
 
-	if oracle.cfg.StartHeight.Cmp(currentHeader.Number) == 1 {
-		return errors.New("start height cannot be more than the latest height from network")
+	if oracle.cfg.StartHeight != nil && oracle.cfg.StartHeight.Cmp(currentHeader.Number) == 1 {
+		return pipeline.NewFatalError(errors.New("start height cannot be more than the latest height from network"))
 	}
 
-	ticker := time.NewTicker(pollInterval * time.Millisecond)
+	// New heads are subscribed to when a WS endpoint is configured
+	ticker := newHeadTicker(ctx, oracle.client, oracle.cfg.PollInterval, oracle.cfg.Subscribable())
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ticker.C:
 
-			height := oracle.getHeightToProcess(ctx)
+			height, confirmed, cErr := confirmedHeight(ctx, oracle.client, oracle.getHeightToProcess(ctx),
+				oracle.cfg.ConfirmationDepth)
+			if cErr != nil {
+				logging.WithContext(ctx).Error("problem fetching latest header", zap.Error(cErr))
+				oracle.reportError(newFetchError("latest header", nil, cErr))
+				continue
+			}
+
+			// Wait until the height is the confirmation depth behind the chain tip
+			if !confirmed {
+				continue
+			}
 
 			headerAsInterface, err := oracle.fetchData(ctx, height, models.FetchHeader)
 			headerAsserted, headerAssertedOk := headerAsInterface.(*types.Header)
@@ -185,6 +370,7 @@ func (oracle *GethBlockODef) ReadRoutine(ctx context.Context, componentChan chan
 			if err != nil || !headerAssertedOk {
 				logging.WithContext(ctx).Error("problem fetching or asserting header", zap.NamedError("headerFetch", err),
 					zap.Bool("headerAsserted", headerAssertedOk))
+				oracle.reportError(newFetchError("header", height, err))
 				continue
 			}
 
@@ -194,6 +380,7 @@ func (oracle *GethBlockODef) ReadRoutine(ctx context.Context, componentChan chan
 			if err != nil || !blockAssertedOk {
 				logging.WithContext(ctx).Error("problem fetching or asserting block", zap.NamedError("blockFetch", err),
 					zap.Bool("blockAsserted", blockAssertedOk))
+				oracle.reportError(newFetchError("block", headerAsserted.Number, err))
 				continue
 			}
 
@@ -202,7 +389,9 @@ func (oracle *GethBlockODef) ReadRoutine(ctx context.Context, componentChan chan
 				Timestamp: time.Now(),
 				Type:      GethBlock,
 				Value:     *blockAsserted,
+				Height:    blockAsserted.Number(),
 			}
+			oracle.reportHeight(headerAsserted.Number)
 
 			// check has to be done here to include the end height block
 			if oracle.cfg.EndHeight != nil && height.Cmp(oracle.cfg.EndHeight) == 0 {
@@ -217,6 +406,7 @@ func (oracle *GethBlockODef) ReadRoutine(ctx context.Context, componentChan chan
 			}
 
 			oracle.currHeight = height
+			ticker.next()
 
 		case <-ctx.Done():
 			return nil
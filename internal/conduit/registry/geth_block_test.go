@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/client/mocks"
 	"github.com/base-org/pessimism/internal/conduit/models"
 	"github.com/base-org/pessimism/internal/conduit/pipeline"
 	"github.com/base-org/pessimism/internal/config"
@@ -18,42 +21,20 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-type EthClientMocked struct {
-	mock.Mock
-}
-
-func (ec *EthClientMocked) DialContext(ctx context.Context, rawURL string) error {
-	args := ec.Called(ctx, rawURL)
-	return args.Error(0)
-}
-
-func (ec *EthClientMocked) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
-	args := ec.Called(ctx, number)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*types.Header), args.Error(1)
-}
-
-func (ec *EthClientMocked) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	args := ec.Called(ctx, number)
-	return args.Get(0).(*types.Block), args.Error(1)
-}
-
 func Test_ConfigureRoutine_Error(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	logging.NewLogger(nil, false)
 	defer cancel()
 
-	testObj := new(EthClientMocked)
+	testObj := new(mocks.EthClient)
 
 	// setup expectations
 	testObj.On("DialContext", mock.Anything, "error handle test").Return(errors.New("error handle test"))
 
 	_, err := NewGethBlockOracle(ctx, pipeline.LiveOracle, &config.OracleConfig{
 		RPCEndpoint: "error handle test",
-	}, testObj)
+	}, testObj, nil)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "error handle test")
 }
@@ -64,14 +45,14 @@ func Test_ConfigureRoutine_Pass(t *testing.T) {
 	logging.NewLogger(nil, false)
 	defer cancel()
 
-	testObj := new(EthClientMocked)
+	testObj := new(mocks.EthClient)
 
 	// setup expectations
 	testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
 
 	newGethBlockOracleCreated, err := NewGethBlockOracle(ctx, pipeline.LiveOracle, &config.OracleConfig{
 		RPCEndpoint: "pass test",
-	}, testObj)
+	}, testObj, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, newGethBlockOracleCreated.Type(), models.Oracle)
 }
@@ -82,7 +63,7 @@ func Test_GetCurrentHeightFromNetwork(t *testing.T) {
 	logging.NewLogger(nil, false)
 	defer cancel()
 
-	testObj := new(EthClientMocked)
+	testObj := new(mocks.EthClient)
 
 	// setup expectations
 	testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
@@ -97,7 +78,30 @@ func Test_GetCurrentHeightFromNetwork(t *testing.T) {
 		NumOfRetries: 3,
 	}, currHeight: nil, client: testObj}
 
-	assert.Equal(t, od.getCurrentHeightFromNetwork(ctx).Number, header.Number)
+	current, err := od.getCurrentHeightFromNetwork(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, current.Number, header.Number)
+}
+
+func Test_GetCurrentHeightFromNetwork_Backoff(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	testObj := new(mocks.EthClient)
+	testObj.On("HeaderByNumber", mock.Anything, mock.Anything).Return(nil, errors.New("rpc down")).Twice()
+	testObj.On("HeaderByNumber", mock.Anything, mock.Anything).Return(&types.Header{Number: big.NewInt(5)}, nil)
+
+	// The default backoff would wait at least 150ms before the third attempt
+	od := &GethBlockODef{cfg: &config.OracleConfig{
+		Backoff: client.Backoff{Base: time.Millisecond, Cap: time.Millisecond},
+	}, client: testObj}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	current, err := od.getCurrentHeightFromNetwork(ctx)
+	assert.NoError(t, err, "Ensuring the configured backoff is used between attempts")
+	assert.Equal(t, big.NewInt(5), current.Number)
+	testObj.AssertNumberOfCalls(t, "HeaderByNumber", 3)
 }
 
 func Test_GetHeightToProcess(t *testing.T) {
@@ -105,7 +109,7 @@ func Test_GetHeightToProcess(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	logging.NewLogger(nil, false)
 	defer cancel()
-	testObj := new(EthClientMocked)
+	testObj := new(mocks.EthClient)
 	testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
 	header := types.Header{
 		ParentHash: common.HexToHash("0x123456789"),
@@ -144,7 +148,7 @@ func Test_Backroutine(t *testing.T) {
 			description: "Check if network height check is less than starting height",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 				header := types.Header{
 					ParentHash: common.HexToHash("0x123456789"),
 					Number:     big.NewInt(5),
@@ -178,7 +182,7 @@ func Test_Backroutine(t *testing.T) {
 			description: "Ending height cannot be less than the Starting height",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 
 				// setup expectations
 				testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
@@ -210,7 +214,7 @@ func Test_Backroutine(t *testing.T) {
 		//	description: "Check if the header fetch retry fails after 3 retries, total 4 tries.",
 		//
 		//	constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-		//		testObj := new(EthClientMocked)
+		//		testObj := new(mocks.EthClient)
 		//
 		//		// setup expectations
 		//		testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
@@ -240,7 +244,7 @@ func Test_Backroutine(t *testing.T) {
 			description: "Backroutine works and channel should have 4 messages waiting.",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 				header := types.Header{
 					ParentHash: common.HexToHash("0x123456789"),
 					Number:     big.NewInt(7),
@@ -276,6 +280,49 @@ func Test_Backroutine(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:        "Batched fetch test",
+			description: "Blocks should be fetched in batches of up to the fetch batch size until the end height",
+
+			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
+				testObj := new(mocks.EthClient)
+				header := types.Header{Number: big.NewInt(10)}
+
+				blocks := make([]*types.Block, 3)
+				for i := range blocks {
+					blocks[i] = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(int64(5 + i))})
+				}
+
+				testObj.On("HeaderByNumber", mock.Anything, mock.Anything).Return(&header, nil)
+				testObj.On("BlocksByNumber", mock.Anything,
+					[]*big.Int{big.NewInt(5), big.NewInt(6)}).Return(blocks[:2], nil).Once()
+				testObj.On("BlocksByNumber", mock.Anything,
+					[]*big.Int{big.NewInt(7)}).Return(blocks[2:], nil).Once()
+
+				od := &GethBlockODef{cfg: &config.OracleConfig{
+					RPCEndpoint:    "pass test",
+					FetchBatchSize: 2,
+				}, currHeight: nil, client: testObj}
+
+				outChan := make(chan models.TransitData, 3)
+
+				return od, outChan
+			},
+
+			testLogic: func(t *testing.T, od *GethBlockODef, outChan chan models.TransitData) {
+				err := od.BackTestRoutine(context.Background(), outChan, big.NewInt(5), big.NewInt(7))
+				assert.NoError(t, err)
+				close(outChan)
+
+				heights := make([]int64, 0, 3)
+				for m := range outChan {
+					heights = append(heights, m.Height.Int64())
+				}
+
+				assert.Equal(t, []int64{5, 6, 7}, heights)
+				od.client.(*mocks.EthClient).AssertExpectations(t)
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -302,7 +349,7 @@ func Test_ReadRoutine(t *testing.T) {
 			description: "Check if network height check is less than starting height",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 				header := types.Header{
 					ParentHash: common.HexToHash("0x123456789"),
 					Number:     big.NewInt(5),
@@ -338,7 +385,7 @@ func Test_ReadRoutine(t *testing.T) {
 			description: "Ending height cannot be less than the Starting height",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 				testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
 				od := &GethBlockODef{cfg: &config.OracleConfig{
 					RPCEndpoint:  "pass test",
@@ -365,7 +412,7 @@ func Test_ReadRoutine(t *testing.T) {
 			description: "Cannot have start height nil, i.e, latest block and end height configured",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 				testObj.On("DialContext", mock.Anything, "pass test").Return(nil)
 				od := &GethBlockODef{cfg: &config.OracleConfig{
 					RPCEndpoint:  "pass test",
@@ -392,7 +439,7 @@ func Test_ReadRoutine(t *testing.T) {
 			description: "Making sure that number of blocks fetched matches the assumption. Number of messages should be 5, in the channel",
 
 			constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-				testObj := new(EthClientMocked)
+				testObj := new(mocks.EthClient)
 				header := types.Header{
 					ParentHash: common.HexToHash("0x123456789"),
 					Number:     big.NewInt(7),
@@ -431,7 +478,7 @@ func Test_ReadRoutine(t *testing.T) {
 		//	description: "Making sure that number of blocks fetched matches the assumption. Number of messages should be 5, in the channel",
 		//
 		//	constructionLogic: func() (*GethBlockODef, chan models.TransitData) {
-		//		testObj := new(EthClientMocked)
+		//		testObj := new(mocks.EthClient)
 		//		header := types.Header{
 		//			ParentHash: common.HexToHash("0x123456789"),
 		//			Number:     big.NewInt(1),
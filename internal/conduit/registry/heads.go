@@ -0,0 +1,139 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// resubscribeInterval ... Period between attempts to subscribe to new heads while polling
+var resubscribeInterval = 30 * time.Second
+
+// errSubscriptionClosed ... Returned once a subscription is closed by the client without an error
+var errSubscriptionClosed = errors.New("subscription closed")
+
+// headTicker ... Ticker of live oracle routines firing on every new chain head while subscribed to the
+// client's new heads; degrades to firing at the poll interval while the subscription can't be established or
+// has dropped & upgrades back once resubscribed. Must be stopped
+type headTicker struct {
+	C <-chan time.Time
+
+	c          chan time.Time
+	subscribed atomic.Bool
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// newHeadTicker ... Initializer; only polls at the poll interval (see newPollTicker) unless subscribe is set
+func newHeadTicker(ctx context.Context, c client.EthClientInterface, fixed time.Duration,
+	subscribe bool) *headTicker {
+	ctx, cancel := context.WithCancel(ctx)
+
+	ticks := make(chan time.Time, 1)
+	ht := &headTicker{C: ticks, c: ticks, cancel: cancel, done: make(chan struct{})}
+
+	go ht.run(ctx, c, newPollTicker(fixed), subscribe)
+	return ht
+}
+
+// Stop ... Stops the ticker & its subscription
+func (ht *headTicker) Stop() {
+	ht.cancel()
+	<-ht.done
+}
+
+// next ... Fires again immediately while subscribed so that routines behind the chain tip catch up without
+// waiting for the next head; called once a height has been processed
+func (ht *headTicker) next() {
+	if ht.subscribed.Load() {
+		ht.fire(time.Now())
+	}
+}
+
+// fire ... Sends the tick unless one is already pending
+func (ht *headTicker) fire(t time.Time) {
+	select {
+	case ht.c <- t:
+	default:
+	}
+}
+
+// run ... Alternates between forwarding new heads while subscribed & polling until the next subscription
+// attempt; returns once the context is done
+func (ht *headTicker) run(ctx context.Context, c client.EthClientInterface, poll *pollTicker, subscribe bool) {
+	defer close(ht.done)
+	defer poll.Stop()
+
+	// Nil channels never fire, so routines only poll when not subscribing
+	var resubscribe <-chan time.Time
+	if subscribe {
+		ticker := time.NewTicker(resubscribeInterval)
+		defer ticker.Stop()
+		resubscribe = ticker.C
+
+		ht.subscribe(ctx, c)
+	}
+
+	for {
+		select {
+		case t := <-poll.C:
+			poll.refresh()
+			ht.fire(t)
+
+		case <-resubscribe:
+			ht.subscribe(ctx, c)
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribe ... Forwards new heads until the subscription fails or the context is done
+func (ht *headTicker) subscribe(ctx context.Context, c client.EthClientInterface) {
+	if err := ht.forwardHeads(ctx, c); ctx.Err() == nil {
+		logging.WithContext(ctx).Warn("New head subscription unavailable, polling", zap.Error(err))
+	}
+}
+
+// forwardHeads ... Fires on every new head until the subscription fails or the context is done; fail with
+// the subscription's error
+func (ht *headTicker) forwardHeads(ctx context.Context, c client.EthClientInterface) error {
+	heads := make(chan *types.Header, 1)
+
+	sub, err := c.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	logging.WithContext(ctx).Info("Subscribed to new heads")
+	ht.subscribed.Store(true)
+	defer ht.subscribed.Store(false)
+
+	// Routines are caught up on subscribing as heads may have been missed while polling
+	ht.fire(time.Now())
+
+	for {
+		select {
+		case <-heads:
+			ht.fire(time.Now())
+
+		case err = <-sub.Err():
+			if err == nil {
+				err = errSubscriptionClosed
+			}
+
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testSubscription ... Subscription failing once an error is sent to it
+type testSubscription struct {
+	errs chan error
+}
+
+func (ts *testSubscription) Err() <-chan error {
+	return ts.errs
+}
+
+func (ts *testSubscription) Unsubscribe() {}
+
+// receiveTick ... Returns true if the ticker fires within the timeout
+func receiveTick(ht *headTicker, timeout time.Duration) bool {
+	select {
+	case <-ht.C:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func Test_HeadTicker(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	defer func(interval time.Duration) { resubscribeInterval = interval }(resubscribeInterval)
+	resubscribeInterval = 20 * time.Millisecond
+
+	sub := &testSubscription{errs: make(chan error, 1)}
+	heads := make(chan chan<- *types.Header, 1)
+
+	testObj := new(mocks.EthClient)
+	testObj.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(nil, errors.New("dial failed")).Once()
+	testObj.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(sub, nil).Run(func(args mock.Arguments) {
+		select {
+		case heads <- args.Get(1).(chan<- *types.Header):
+		default:
+		}
+	})
+
+	ht := newHeadTicker(context.Background(), testObj, 5*time.Millisecond, true)
+	defer ht.Stop()
+
+	assert.True(t, receiveTick(ht, time.Second), "Ensuring the ticker polls while the subscription is unavailable")
+	assert.False(t, ht.subscribed.Load())
+
+	headChan := <-heads
+	assert.Eventually(t, ht.subscribed.Load, time.Second, time.Millisecond,
+		"Ensuring the ticker resubscribes while polling")
+
+	// Drain the ticks sent while polling & on subscribing
+	for receiveTick(ht, 20*time.Millisecond) {
+	}
+
+	headChan <- &types.Header{}
+	assert.True(t, receiveTick(ht, time.Second), "Ensuring the ticker fires on new heads")
+	assert.False(t, receiveTick(ht, 20*time.Millisecond), "Ensuring the ticker doesn't poll while subscribed")
+
+	ht.next()
+	assert.True(t, receiveTick(ht, time.Second), "Ensuring routines can catch up while subscribed")
+
+	sub.errs <- errors.New("connection dropped")
+	select {
+	case <-heads:
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not resubscribe once the subscription dropped")
+	}
+}
+
+func Test_HeadTicker_Polling(t *testing.T) {
+	testObj := new(mocks.EthClient)
+
+	ht := newHeadTicker(context.Background(), testObj, 5*time.Millisecond, false)
+	defer ht.Stop()
+
+	assert.True(t, receiveTick(ht, time.Second))
+	ht.next()
+	testObj.AssertNotCalled(t, "SubscribeNewHead", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,110 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MinTxsParam ... Blocks containing fewer non-deposit transactions than this are considered low-activity
+	MinTxsParam = "min_txs"
+	// StreakParam ... Number of consecutive low-activity blocks required to emit an event
+	StreakParam = "streak"
+
+	// defaultMinBlockTxs ... Blocks containing fewer non-deposit transactions than this are considered low-activity
+	defaultMinBlockTxs = 1
+	// defaultLowActivityStreak ... Number of consecutive low-activity blocks required to emit an event
+	defaultLowActivityStreak = 5
+)
+
+// LowActivityEvent ... Emitted when a streak of consecutive low-activity blocks is observed
+type LowActivityEvent struct {
+	StartHeight *big.Int
+	EndHeight   *big.Int
+	BlockCount  int
+	MinTxs      int
+}
+
+// lowActivityTracker ... Counts consecutive blocks containing fewer than minTxs non-deposit transactions
+type lowActivityTracker struct {
+	minTxs int
+	streak int
+
+	count       int
+	startHeight *big.Int
+}
+
+// trackBlock ... Updates the low-activity streak with the block and emits an event once the streak is reached;
+// a single event is emitted per streak to avoid flooding downstream components
+func (lat *lowActivityTracker) trackBlock(td models.TransitData) ([]models.TransitData, error) {
+	asBlock, err := models.ValueAs[types.Block](td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	if userTxCount(asBlock.Transactions()) >= lat.minTxs {
+		lat.count = 0
+		lat.startHeight = nil
+		return []models.TransitData{}, nil
+	}
+
+	if lat.count == 0 {
+		lat.startHeight = asBlock.Number()
+	}
+	lat.count++
+
+	if lat.count != lat.streak {
+		return []models.TransitData{}, nil
+	}
+
+	return []models.TransitData{{
+		Timestamp: td.Timestamp,
+		Type:      LowActivityBlock,
+		Value: LowActivityEvent{
+			StartHeight: lat.startHeight,
+			EndHeight:   asBlock.Number(),
+			BlockCount:  lat.count,
+			MinTxs:      lat.minTxs,
+		},
+	}}, nil
+}
+
+// userTxCount ... Returns the number of transactions that aren't deposits; every L2 block starts with an
+// L1 attributes deposit so deposits alone don't indicate any activity
+func userTxCount(txs types.Transactions) int {
+	count := 0
+	for _, tx := range txs {
+		if tx.Type() != types.DepositTxType {
+			count++
+		}
+	}
+
+	return count
+}
+
+// NewLowActivityBlockPipe ... Initializer; the pipe emits an event when a streak of consecutive
+// blocks each contain fewer than the minimum number of non-deposit transactions
+func NewLowActivityBlockPipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	minTxs, err := params.Int(MinTxsParam, defaultMinBlockTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	streak, err := params.Int(StreakParam, defaultLowActivityStreak)
+	if err != nil {
+		return nil, err
+	}
+
+	if minTxs < 1 || streak < 1 {
+		return nil, fmt.Errorf("invalid low activity thresholds; minTxs: %d, streak: %d", minTxs, streak)
+	}
+
+	lat := &lowActivityTracker{minTxs: minTxs, streak: streak}
+	return pipeline.NewPipe(ctx, lat.trackBlock, inputChan)
+}
@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LowActivityTracker_Streak(t *testing.T) {
+	lat := &lowActivityTracker{minTxs: 1, streak: 3}
+
+	to := common.HexToAddress("0x420")
+	tx := types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)})
+
+	newBlockTD := func(height int64, txs []*types.Transaction) models.TransitData {
+		block := types.NewBlock(&types.Header{Number: big.NewInt(height)}, txs, nil, nil, trie.NewStackTrie(nil))
+		return models.TransitData{Timestamp: time.Now(), Type: GethBlock, Value: *block}
+	}
+
+	// Active block followed by two empty blocks shouldn't emit anything
+	for height, txs := range [][]*types.Transaction{{tx}, nil, nil} {
+		outputs, err := lat.trackBlock(newBlockTD(int64(height), txs))
+		assert.NoError(t, err)
+		assert.Empty(t, outputs, "Ensuring no event is emitted before streak is reached")
+	}
+
+	// Third consecutive empty block completes the streak
+	outputs, err := lat.trackBlock(newBlockTD(3, nil))
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 1)
+
+	event, success := outputs[0].Value.(LowActivityEvent)
+	assert.True(t, success)
+	assert.Equal(t, outputs[0].Type, LowActivityBlock)
+	assert.Equal(t, event.StartHeight, big.NewInt(1))
+	assert.Equal(t, event.EndHeight, big.NewInt(3))
+	assert.Equal(t, event.BlockCount, 3)
+
+	// Streak continuation shouldn't re-emit
+	outputs, err = lat.trackBlock(newBlockTD(4, nil))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs, "Ensuring only a single event is emitted per streak")
+
+	// Active block resets the streak
+	outputs, err = lat.trackBlock(newBlockTD(5, []*types.Transaction{tx}))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+	assert.Equal(t, lat.count, 0)
+
+	_, err = lat.trackBlock(models.TransitData{Value: "not a block"})
+	assert.Error(t, err)
+}
+
+func Test_LowActivityTracker_DepositOnly(t *testing.T) {
+	lat := &lowActivityTracker{minTxs: 1, streak: 2}
+
+	to := common.HexToAddress("0x420")
+	deposit := types.NewTx(&types.DepositTx{To: &to, Value: big.NewInt(0), Gas: 1_000_000})
+	tx := types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)})
+
+	newBlockTD := func(height int64, txs []*types.Transaction) models.TransitData {
+		block := types.NewBlock(&types.Header{Number: big.NewInt(height)}, txs, nil, nil, trie.NewStackTrie(nil))
+		return models.TransitData{Timestamp: time.Now(), Type: GethBlock, Value: *block}
+	}
+
+	// Blocks containing only the L1 attributes deposit should count towards the streak
+	outputs, err := lat.trackBlock(newBlockTD(1, []*types.Transaction{deposit}))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	outputs, err = lat.trackBlock(newBlockTD(2, []*types.Transaction{deposit, deposit}))
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 1, "Ensuring deposit-only blocks are considered low-activity")
+
+	// A user transaction alongside the deposit resets the streak
+	outputs, err = lat.trackBlock(newBlockTD(3, []*types.Transaction{deposit, tx}))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+	assert.Equal(t, lat.count, 0)
+}
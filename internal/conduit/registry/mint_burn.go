@@ -0,0 +1,193 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// TokensParam ... Token addresses that mints & burns are tracked for
+	TokensParam = "tokens"
+	// MintThresholdParam ... Windowed mint amount that must be exceeded to emit an event; unset disables mint events
+	MintThresholdParam = "mint_threshold"
+	// BurnThresholdParam ... Windowed burn amount that must be exceeded to emit an event; unset disables burn events
+	BurnThresholdParam = "burn_threshold"
+	// BlockWindowParam ... Number of blocks that minted & burned amounts are summed across
+	BlockWindowParam = "block_window"
+
+	// defaultMintBurnWindow ... Number of blocks that minted & burned amounts are summed across
+	defaultMintBurnWindow = 10
+
+	// ERC20 transfers index from & to, leaving the amount as the only data word
+	erc20TransferTopics  = 3
+	erc20TransferDataLen = 32
+)
+
+var (
+	// transferEventSig ... ERC20 Transfer(address,address,uint256) event topic
+	transferEventSig = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	zeroTopic = common.Hash{}
+)
+
+// SupplyChange ... Token supply change direction
+type SupplyChange string
+
+const (
+	Mint SupplyChange = "mint"
+	Burn SupplyChange = "burn"
+)
+
+// MintBurnEvent ... Emitted when the minted or burned amount of a token within the window exceeds the threshold
+type MintBurnEvent struct {
+	Token     common.Address
+	Change    SupplyChange
+	Amount    *big.Int
+	Threshold *big.Int
+	Height    uint64
+	Window    uint64
+}
+
+// MintBurnThresholds ... Per-window amounts that minting or burning must exceed to emit an event;
+// nil thresholds are never exceeded
+type MintBurnThresholds struct {
+	Mint *big.Int
+	Burn *big.Int
+}
+
+// supplyChangeEntry ... Amount minted or burned within a single block
+type supplyChangeEntry struct {
+	height uint64
+	amount *big.Int
+}
+
+// mintBurnTracker ... Sums mint & burn amounts per watched token across a sliding block window
+type mintBurnTracker struct {
+	window     uint64
+	thresholds map[common.Address]MintBurnThresholds
+
+	entries map[common.Address]map[SupplyChange][]supplyChangeEntry
+}
+
+// newMintBurnTracker ... Initializer
+func newMintBurnTracker(window uint64, thresholds map[common.Address]MintBurnThresholds) *mintBurnTracker {
+	return &mintBurnTracker{
+		window:     window,
+		thresholds: thresholds,
+		entries:    make(map[common.Address]map[SupplyChange][]supplyChangeEntry),
+	}
+}
+
+// record ... Adds an amount to the token window and returns the window sums before and after
+func (mbt *mintBurnTracker) record(token common.Address, change SupplyChange,
+	height uint64, amount *big.Int) (*big.Int, *big.Int) {
+	if _, found := mbt.entries[token]; !found {
+		mbt.entries[token] = make(map[SupplyChange][]supplyChangeEntry)
+	}
+
+	// Evict entries that have fallen outside of the window
+	retained := make([]supplyChangeEntry, 0, len(mbt.entries[token][change])+1)
+	for _, entry := range mbt.entries[token][change] {
+		if entry.height+mbt.window > height {
+			retained = append(retained, entry)
+		}
+	}
+
+	before := big.NewInt(0)
+	for _, entry := range retained {
+		before.Add(before, entry.amount)
+	}
+
+	mbt.entries[token][change] = append(retained, supplyChangeEntry{height: height, amount: amount})
+	return before, new(big.Int).Add(before, amount)
+}
+
+// trackLog ... Tracks mints & burns of watched tokens and emits an event when a window sum
+// crosses its threshold; a single event is emitted per crossing
+func (mbt *mintBurnTracker) trackLog(td models.TransitData) ([]models.TransitData, error) {
+	asLog, err := models.ValueAs[types.Log](td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	thresholds, watched := mbt.thresholds[asLog.Address]
+	if !watched || asLog.Removed ||
+		len(asLog.Topics) != erc20TransferTopics || len(asLog.Data) != erc20TransferDataLen ||
+		asLog.Topics[0] != transferEventSig {
+		return []models.TransitData{}, nil
+	}
+
+	var change SupplyChange
+	var threshold *big.Int
+
+	switch {
+	case asLog.Topics[1] == zeroTopic:
+		change, threshold = Mint, thresholds.Mint
+	case asLog.Topics[2] == zeroTopic:
+		change, threshold = Burn, thresholds.Burn
+	default:
+		return []models.TransitData{}, nil
+	}
+
+	before, after := mbt.record(asLog.Address, change, asLog.BlockNumber, new(big.Int).SetBytes(asLog.Data))
+	if threshold == nil || after.Cmp(threshold) <= 0 || before.Cmp(threshold) > 0 {
+		return []models.TransitData{}, nil
+	}
+
+	return []models.TransitData{{
+		Timestamp: td.Timestamp,
+		Type:      MintBurnAnomaly,
+		Value: MintBurnEvent{
+			Token:     asLog.Address,
+			Change:    change,
+			Amount:    after,
+			Threshold: threshold,
+			Height:    asLog.BlockNumber,
+			Window:    mbt.window,
+		},
+	}}, nil
+}
+
+// NewMintBurnPipe ... Initializer; the pipe tracks mints & burns for the watched tokens
+// across a sliding window of blocks using the same thresholds for every token
+func NewMintBurnPipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	tokens, err := params.Addresses(TokensParam)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := params.Int(BlockWindowParam, defaultMintBurnWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	if window < 1 {
+		return nil, fmt.Errorf("mint burn window must be greater than zero")
+	}
+
+	var thresholds MintBurnThresholds
+
+	if thresholds.Mint, err = params.BigInt(MintThresholdParam, nil); err != nil {
+		return nil, err
+	}
+
+	if thresholds.Burn, err = params.BigInt(BurnThresholdParam, nil); err != nil {
+		return nil, err
+	}
+
+	tokenThresholds := make(map[common.Address]MintBurnThresholds, len(tokens))
+	for _, token := range tokens {
+		tokenThresholds[token] = thresholds
+	}
+
+	mbt := newMintBurnTracker(uint64(window), tokenThresholds)
+	return pipeline.NewPipe(ctx, mbt.trackLog, inputChan)
+}
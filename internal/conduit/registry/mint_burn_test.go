@@ -0,0 +1,72 @@
+package registry
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MintBurnTracker_TrackLog(t *testing.T) {
+	token := common.HexToAddress("0x420")
+	holder := common.HexToAddress("0x69").Hash()
+
+	mbt := newMintBurnTracker(3, map[common.Address]MintBurnThresholds{
+		token: {Mint: big.NewInt(100), Burn: nil},
+	})
+
+	newTransferTD := func(addr common.Address, from, to common.Hash, height uint64, amount int64) models.TransitData {
+		return models.TransitData{
+			Timestamp: time.Now(),
+			Type:      EventLog,
+			Value: types.Log{
+				Address:     addr,
+				Topics:      []common.Hash{transferEventSig, from, to},
+				Data:        common.LeftPadBytes(big.NewInt(amount).Bytes(), 32),
+				BlockNumber: height,
+			},
+		}
+	}
+
+	// Mints within the window that don't exceed the threshold
+	for height, amount := range []int64{40, 40} {
+		outputs, err := mbt.trackLog(newTransferTD(token, zeroTopic, holder, uint64(height), amount))
+		assert.NoError(t, err)
+		assert.Empty(t, outputs)
+	}
+
+	// Unwatched tokens and burns without thresholds are ignored
+	outputs, err := mbt.trackLog(newTransferTD(common.HexToAddress("0x1"), zeroTopic, holder, 2, 1000))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	outputs, err = mbt.trackLog(newTransferTD(token, holder, zeroTopic, 2, 1000))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	// Mint that pushes the window sum over the threshold
+	outputs, err = mbt.trackLog(newTransferTD(token, zeroTopic, holder, 2, 30))
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 1)
+
+	event, success := outputs[0].Value.(MintBurnEvent)
+	assert.True(t, success)
+	assert.Equal(t, event.Change, Mint)
+	assert.Equal(t, event.Amount, big.NewInt(110))
+	assert.Equal(t, event.Height, uint64(2))
+
+	// Subsequent mints within an already exceeded window shouldn't re-emit
+	outputs, err = mbt.trackLog(newTransferTD(token, zeroTopic, holder, 2, 1))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	// Older entries have been evicted once the window has moved past them
+	outputs, err = mbt.trackLog(newTransferTD(token, zeroTopic, holder, 10, 1))
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+	assert.Len(t, mbt.entries[token][Mint], 1)
+}
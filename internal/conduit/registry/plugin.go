@@ -0,0 +1,82 @@
+package registry
+
+import (
+	"fmt"
+	"path/filepath"
+	"plugin"
+
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// pluginSymbol ... Function symbol that every register plugin must export
+	pluginSymbol = "Register"
+	// pluginPattern ... Glob pattern used to discover plugin files within the plugin directory
+	pluginPattern = "*.so"
+)
+
+// PluginRegisterFunc ... Signature of the Register symbol exported by register plugins
+type PluginRegisterFunc = func() *DataRegister
+
+// AddRegister ... Validates and inserts a new register; fail on register type collision
+// NOTE - Registers should only be added at startup before any pipelines are constructed
+func AddRegister(dr *DataRegister) error {
+	if _, found := registers[dr.DataType]; found {
+		return fmt.Errorf("register already exists for type: %s", dr.DataType)
+	}
+
+	if err := dr.Validate(); err != nil {
+		return err
+	}
+
+	registers[dr.DataType] = dr
+	return nil
+}
+
+// loadPlugin ... Opens a single plugin file and adds the register returned by its Register symbol
+func loadPlugin(path string) (*DataRegister, error) {
+	plug, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sym, err := plug.Lookup(pluginSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	registerFunc, ok := sym.(PluginRegisterFunc)
+	if !ok {
+		return nil, fmt.Errorf("%s symbol has type %T; expected %T", pluginSymbol, sym, registerFunc)
+	}
+
+	dr := registerFunc()
+	if dr == nil {
+		return nil, fmt.Errorf("%s symbol returned a nil register", pluginSymbol)
+	}
+
+	return dr, AddRegister(dr)
+}
+
+// LoadPlugins ... Loads every Go plugin within the directory and merges their registers into the registry;
+// fails on the first plugin that can't be loaded. Plugins require a cgo enabled build of the same
+// Go toolchain & module versions as the running binary
+func LoadPlugins(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, pluginPattern))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		dr, loadErr := loadPlugin(path)
+		if loadErr != nil {
+			return fmt.Errorf("could not load register plugin %s: %w", path, loadErr)
+		}
+
+		logging.NoContext().Info("Loaded register plugin",
+			zap.String("path", path), zap.String("type", string(dr.DataType)))
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+)
+
+// defaultPollInterval ... Period between the polls of oracle routines unless changed at runtime
+const defaultPollInterval = 200 * time.Millisecond
+
+// pollIntervalNs ... Period between the polls of oracle routines in nanoseconds; the default when zero
+var pollIntervalNs atomic.Int64
+
+// PollInterval ... Returns the period between the polls of oracle routines
+func PollInterval() time.Duration {
+	if ns := pollIntervalNs.Load(); ns > 0 {
+		return time.Duration(ns)
+	}
+
+	return defaultPollInterval
+}
+
+// SetPollInterval ... Sets the period between the polls of every oracle routine, including running ones
+// which pick it up on their next poll; fail if not positive
+func SetPollInterval(d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("poll interval must be positive; got %s", d)
+	}
+
+	pollIntervalNs.Store(int64(d))
+	return nil
+}
+
+// pollTicker ... Ticker firing at the poll interval; follows changes to the interval once refreshed unless
+// the interval is fixed
+type pollTicker struct {
+	*time.Ticker
+	interval time.Duration
+	fixed    bool
+}
+
+// newPollTicker ... Initializer; positive intervals (E.G, a network's poll interval) are fixed rather than
+// following the global poll interval. Must be stopped
+func newPollTicker(fixed time.Duration) *pollTicker {
+	if fixed > 0 {
+		return &pollTicker{Ticker: time.NewTicker(fixed), interval: fixed, fixed: true}
+	}
+
+	interval := PollInterval()
+	return &pollTicker{Ticker: time.NewTicker(interval), interval: interval}
+}
+
+// refresh ... Resets the ticker if the poll interval has changed; called on every tick
+func (pt *pollTicker) refresh() {
+	if pt.fixed {
+		return
+	}
+
+	if interval := PollInterval(); interval != pt.interval {
+		pt.Reset(interval)
+		pt.interval = interval
+	}
+}
+
+// confirmedHeight ... Returns the height that a live routine reads next given the confirmation depth; nil
+// heights resolve to the latest confirmed height. False when the height is within the depth of the chain tip.
+// Heights are returned unchanged when the depth is zero
+func confirmedHeight(ctx context.Context, c client.EthClientInterface, height *big.Int,
+	depth uint64) (*big.Int, bool, error) {
+	if depth == 0 {
+		return height, true, nil
+	}
+
+	header, err := c.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	confirmed := new(big.Int).Sub(header.Number, new(big.Int).SetUint64(depth))
+	if confirmed.Sign() < 0 {
+		return nil, false, nil
+	}
+
+	if height == nil {
+		return confirmed, true, nil
+	}
+
+	return height, height.Cmp(confirmed) <= 0, nil
+}
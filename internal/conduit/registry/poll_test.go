@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_PollInterval(t *testing.T) {
+	defer pollIntervalNs.Store(0)
+
+	assert.Equal(t, defaultPollInterval, PollInterval())
+	assert.Error(t, SetPollInterval(0))
+
+	ticker := newPollTicker(0)
+	defer ticker.Stop()
+
+	assert.NoError(t, SetPollInterval(time.Millisecond))
+	assert.Equal(t, time.Millisecond, PollInterval())
+
+	// Running tickers follow the interval once refreshed
+	<-ticker.C
+	ticker.refresh()
+	assert.Equal(t, time.Millisecond, ticker.interval)
+
+	select {
+	case <-ticker.C:
+	case <-time.After(defaultPollInterval / 2):
+		t.Fatal("ticker did not follow the updated interval")
+	}
+}
+
+func Test_ConfirmedHeight(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		height    *big.Int
+		depth     uint64
+		expected  *big.Int
+		confirmed bool
+	}{
+		{
+			name:        "No Depth",
+			description: "Heights should be unchanged when the depth is zero",
+			height:      nil,
+			depth:       0,
+			expected:    nil,
+			confirmed:   true,
+		},
+		{
+			name:        "Latest",
+			description: "Nil heights should resolve to the latest confirmed height",
+			height:      nil,
+			depth:       3,
+			expected:    big.NewInt(7),
+			confirmed:   true,
+		},
+		{
+			name:        "Confirmed",
+			description: "Heights at the depth behind the tip should be confirmed",
+			height:      big.NewInt(7),
+			depth:       3,
+			expected:    big.NewInt(7),
+			confirmed:   true,
+		},
+		{
+			name:        "Unconfirmed",
+			description: "Heights within the depth of the tip shouldn't be confirmed",
+			height:      big.NewInt(8),
+			depth:       3,
+			expected:    big.NewInt(8),
+			confirmed:   false,
+		},
+		{
+			name:        "Depth Exceeds Chain",
+			description: "Nothing should be confirmed while the chain is shorter than the depth",
+			height:      nil,
+			depth:       20,
+			expected:    nil,
+			confirmed:   false,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ec := new(mocks.EthClient)
+			ec.On("HeaderByNumber", mock.Anything, mock.Anything).Return(&types.Header{Number: big.NewInt(10)}, nil)
+
+			height, confirmed, err := confirmedHeight(context.Background(), ec, tc.height, tc.depth)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, height)
+			assert.Equal(t, tc.confirmed, confirmed)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// PortalParam ... OptimismPortal contract address
+	PortalParam = "portal"
+	// SuperchainConfigParam ... SuperchainConfig contract address
+	SuperchainConfigParam = "superchain_config"
+	// GuardianParam ... Guardian account address
+	GuardianParam = "guardian"
+)
+
+var (
+	// OptimismPortal pause events
+	portalPausedSig   = crypto.Keccak256Hash([]byte("Paused(address)"))
+	portalUnpausedSig = crypto.Keccak256Hash([]byte("Unpaused(address)"))
+
+	// SuperchainConfig pause events
+	superchainPausedSig   = crypto.Keccak256Hash([]byte("Paused(string)"))
+	superchainUnpausedSig = crypto.Keccak256Hash([]byte("Unpaused()"))
+
+	// Production guardians are Safe multisigs; their calls are executed through the Safe's execTransaction
+	safeExecTransaction = mustParseMethod(`[{"type":"function","name":"execTransaction","inputs":[
+		{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},
+		{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},
+		{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},
+		{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],
+		"outputs":[{"name":"success","type":"bool"}]}]`, "execTransaction")
+)
+
+// mustParseMethod ... Parses a single method ABI declared in this package; panics on malformed declarations
+func mustParseMethod(raw string, name string) abi.Method {
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed.Methods[name]
+}
+
+// GuardianAction ... Guardian related action observed against a watched contract
+type GuardianAction string
+
+const (
+	ContractPaused   GuardianAction = "paused"
+	ContractUnpaused GuardianAction = "unpaused"
+	GuardianTx       GuardianAction = "guardian_tx"
+)
+
+// PortalGuardianConfig ... Contracts and guardian account watched by a portal guardian pipe;
+// zero addresses are never matched
+type PortalGuardianConfig struct {
+	Portal           common.Address
+	SuperchainConfig common.Address
+	Guardian         common.Address
+}
+
+// PortalGuardianEvent ... Emitted when a watched contract is paused/unpaused or transacted with by the guardian
+type PortalGuardianEvent struct {
+	Action   GuardianAction
+	Contract common.Address
+	Height   uint64
+	TxHash   common.Hash
+
+	// Populated for guardian transactions only
+	Tx *types.Transaction
+}
+
+// portalGuardianTracker ... Inspects event logs & blocks for guardian actions
+type portalGuardianTracker struct {
+	cfg PortalGuardianConfig
+}
+
+// watched ... Returns true if the address is a non-zero watched contract
+func (pgt *portalGuardianTracker) watched(addr common.Address) bool {
+	return addr != (common.Address{}) && (addr == pgt.cfg.Portal || addr == pgt.cfg.SuperchainConfig)
+}
+
+// inspectLog ... Returns a pause event if the log is a pause or unpause of a watched contract
+func (pgt *portalGuardianTracker) inspectLog(log types.Log) []PortalGuardianEvent {
+	if log.Removed || len(log.Topics) == 0 || !pgt.watched(log.Address) {
+		return nil
+	}
+
+	var action GuardianAction
+
+	switch log.Topics[0] {
+	case portalPausedSig, superchainPausedSig:
+		action = ContractPaused
+	case portalUnpausedSig, superchainUnpausedSig:
+		action = ContractUnpaused
+	default:
+		return nil
+	}
+
+	return []PortalGuardianEvent{{
+		Action:   action,
+		Contract: log.Address,
+		Height:   log.BlockNumber,
+		TxHash:   log.TxHash,
+	}}
+}
+
+// inspectBlock ... Returns an event for every guardian transaction against a watched contract; both
+// transactions sent directly by a guardian account and ones executed through a guardian Safe are matched
+func (pgt *portalGuardianTracker) inspectBlock(block types.Block) []PortalGuardianEvent {
+	events := make([]PortalGuardianEvent, 0)
+	if pgt.cfg.Guardian == (common.Address{}) {
+		return events
+	}
+
+	for _, tx := range block.Transactions() {
+		target, matched := pgt.guardianTarget(tx)
+		if !matched {
+			continue
+		}
+
+		events = append(events, PortalGuardianEvent{
+			Action:   GuardianTx,
+			Contract: target,
+			Height:   block.NumberU64(),
+			TxHash:   tx.Hash(),
+			Tx:       tx,
+		})
+	}
+
+	return events
+}
+
+// guardianTarget ... Returns the watched contract called by the guardian within the transaction
+func (pgt *portalGuardianTracker) guardianTarget(tx *types.Transaction) (common.Address, bool) {
+	if tx.To() == nil {
+		return common.Address{}, false
+	}
+
+	// Safe executions are authorized by owner signatures so the submitting account is irrelevant
+	if *tx.To() == pgt.cfg.Guardian {
+		target, err := safeExecTarget(tx.Data())
+		if err != nil || !pgt.watched(target) {
+			return common.Address{}, false
+		}
+
+		return target, true
+	}
+
+	if !pgt.watched(*tx.To()) {
+		return common.Address{}, false
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil || from != pgt.cfg.Guardian {
+		return common.Address{}, false
+	}
+
+	return *tx.To(), true
+}
+
+// safeExecTarget ... Decodes the destination of a Safe execTransaction call
+func safeExecTarget(data []byte) (common.Address, error) {
+	if !bytes.HasPrefix(data, safeExecTransaction.ID) {
+		return common.Address{}, fmt.Errorf("not an execTransaction call")
+	}
+
+	args, err := safeExecTransaction.Inputs.Unpack(data[len(safeExecTransaction.ID):])
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	target, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("could not decode execTransaction destination")
+	}
+
+	return target, nil
+}
+
+// inspect ... Transform function that accepts both event log and block transit data
+func (pgt *portalGuardianTracker) inspect(td models.TransitData) ([]models.TransitData, error) {
+	var events []PortalGuardianEvent
+
+	switch val := td.Value.(type) {
+	case types.Log:
+		events = pgt.inspectLog(val)
+	case types.Block:
+		events = pgt.inspectBlock(val)
+	default:
+		return []models.TransitData{}, fmt.Errorf("could not convert to log or block")
+	}
+
+	outputs := make([]models.TransitData, 0, len(events))
+	for _, event := range events {
+		outputs = append(outputs, models.TransitData{
+			Timestamp: td.Timestamp,
+			Type:      PortalGuardianAction,
+			Value:     event,
+		})
+	}
+
+	return outputs, nil
+}
+
+// NewPortalGuardianPipe ... Initializer; the pipe watches the configured portal & superchain
+// config contracts and expects both GETH_BLOCK and EVENT_LOG transit data as input. Fail if
+// neither contract is configured
+func NewPortalGuardianPipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	var cfg PortalGuardianConfig
+	var err error
+
+	if cfg.Portal, err = params.Address(PortalParam); err != nil {
+		return nil, err
+	}
+
+	if cfg.SuperchainConfig, err = params.Address(SuperchainConfigParam); err != nil {
+		return nil, err
+	}
+
+	if cfg.Portal == (common.Address{}) && cfg.SuperchainConfig == (common.Address{}) {
+		return nil, fmt.Errorf("param %s or %s is required", PortalParam, SuperchainConfigParam)
+	}
+
+	if cfg.Guardian, err = params.Address(GuardianParam); err != nil {
+		return nil, err
+	}
+
+	pgt := &portalGuardianTracker{cfg: cfg}
+	return pipeline.NewPipe(ctx, pgt.inspect, inputChan)
+}
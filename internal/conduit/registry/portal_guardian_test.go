@@ -0,0 +1,209 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PortalGuardianTracker_Inspect(t *testing.T) {
+	guardianKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	executorKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	portal := common.HexToAddress("0x420")
+	unwatched := common.HexToAddress("0x69")
+	safe := common.HexToAddress("0x666")
+	signer := types.NewLondonSigner(big.NewInt(1))
+
+	// call ... Returns a signed transaction calling the address with the data
+	call := func(key string, to common.Address, data []byte) *types.Transaction {
+		pk := guardianKey
+		if key == "executor" {
+			pk = executorKey
+		}
+
+		tx, sErr := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+			ChainID: big.NewInt(1), To: &to, Data: data,
+		}), signer, pk)
+		assert.NoError(t, sErr)
+		return tx
+	}
+
+	// execTransaction ... Returns Safe execTransaction calldata calling the destination
+	execTransaction := func(to common.Address) []byte {
+		args, pErr := safeExecTransaction.Inputs.Pack(to, big.NewInt(0), []byte{0x84, 0x56, 0xcb, 0x59},
+			uint8(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, common.Address{}, []byte{})
+		assert.NoError(t, pErr)
+		return append(append([]byte{}, safeExecTransaction.ID...), args...)
+	}
+
+	blockTD := func(txs ...*types.Transaction) models.TransitData {
+		block := types.NewBlock(&types.Header{Number: big.NewInt(7)}, txs, nil, nil, trie.NewStackTrie(nil))
+		return models.TransitData{Timestamp: time.Now(), Type: GethBlock, Value: *block}
+	}
+
+	logTD := func(addr common.Address, topic common.Hash, removed bool) models.TransitData {
+		return models.TransitData{Timestamp: time.Now(), Type: EventLog, Value: types.Log{
+			Address: addr, Topics: []common.Hash{topic}, BlockNumber: 7, Removed: removed,
+		}}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		guardian common.Address
+		input    models.TransitData
+
+		expected []PortalGuardianEvent
+		err      bool
+	}{
+		{
+			name:        "Direct Guardian Call",
+			description: "When a guardian account calls the portal directly, a guardian tx event should be emitted",
+			guardian:    crypto.PubkeyToAddress(guardianKey.PublicKey),
+			input:       blockTD(call("guardian", portal, nil)),
+			expected:    []PortalGuardianEvent{{Action: GuardianTx, Contract: portal, Height: 7}},
+		},
+		{
+			name:        "Safe Routed Call",
+			description: "When a guardian Safe executes a call to the portal, a guardian tx event should be emitted",
+			guardian:    safe,
+			input:       blockTD(call("executor", safe, execTransaction(portal))),
+			expected:    []PortalGuardianEvent{{Action: GuardianTx, Contract: portal, Height: 7}},
+		},
+		{
+			name:        "Safe Unwatched Call",
+			description: "When a guardian Safe executes a call to an unwatched contract, nothing should be emitted",
+			guardian:    safe,
+			input:       blockTD(call("executor", safe, execTransaction(unwatched))),
+		},
+		{
+			name:        "Safe Non Exec Call",
+			description: "When a guardian Safe is called with anything other than execTransaction, nothing should be emitted",
+			guardian:    safe,
+			input:       blockTD(call("executor", safe, []byte{0xde, 0xad, 0xbe, 0xef})),
+		},
+		{
+			name:        "Non Guardian Call",
+			description: "When an account other than the guardian calls the portal, nothing should be emitted",
+			guardian:    crypto.PubkeyToAddress(guardianKey.PublicKey),
+			input:       blockTD(call("executor", portal, nil)),
+		},
+		{
+			name:        "Guardian Unwatched Call",
+			description: "When the guardian calls an unwatched contract, nothing should be emitted",
+			guardian:    crypto.PubkeyToAddress(guardianKey.PublicKey),
+			input:       blockTD(call("guardian", unwatched, nil)),
+		},
+		{
+			name:        "Portal Paused Log",
+			description: "When the portal emits a pause event, a paused event should be emitted",
+			guardian:    safe,
+			input:       logTD(portal, portalPausedSig, false),
+			expected:    []PortalGuardianEvent{{Action: ContractPaused, Contract: portal, Height: 7}},
+		},
+		{
+			name:        "Removed Log",
+			description: "When a pause event is reorged out, nothing should be emitted",
+			guardian:    safe,
+			input:       logTD(portal, portalPausedSig, true),
+		},
+		{
+			name:        "Invalid Input",
+			description: "When transit data is neither a block nor a log, an error should be returned",
+			guardian:    safe,
+			input:       models.TransitData{Value: "not a block"},
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			pgt := &portalGuardianTracker{cfg: PortalGuardianConfig{Portal: portal, Guardian: tc.guardian}}
+
+			outputs, err := pgt.inspect(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, outputs, len(tc.expected))
+
+			for j, output := range outputs {
+				event, success := output.Value.(PortalGuardianEvent)
+				assert.True(t, success)
+				assert.Equal(t, PortalGuardianAction, output.Type)
+
+				// Transaction fields are only checked for presence as they're signed with random keys
+				assert.Equal(t, tc.expected[j].Action, event.Action)
+				assert.Equal(t, tc.expected[j].Contract, event.Contract)
+				assert.Equal(t, tc.expected[j].Height, event.Height)
+				if event.Action == GuardianTx {
+					assert.NotNil(t, event.Tx)
+				}
+			}
+		})
+	}
+}
+
+func Test_NewPortalGuardianPipe(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		params models.Params
+		errMsg string
+	}{
+		{
+			name:        "Portal Only",
+			description: "When only the portal is configured, a pipe should be constructed",
+			params:      models.Params{PortalParam: common.HexToAddress("0x420").Hex()},
+		},
+		{
+			name:        "Superchain Config Only",
+			description: "When only the superchain config is configured, a pipe should be constructed",
+			params:      models.Params{SuperchainConfigParam: common.HexToAddress("0x420").Hex()},
+		},
+		{
+			name:        "No Watched Contract",
+			description: "When neither contract is configured, an error should be returned",
+			params:      models.Params{GuardianParam: common.HexToAddress("0x69").Hex()},
+			errMsg:      "param portal or superchain_config is required",
+		},
+		{
+			name:        "Invalid Portal",
+			description: "When the portal isn't an address, an error should be returned",
+			params:      models.Params{PortalParam: "0x42z"},
+			errMsg:      "param portal has invalid address value 0x42z",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			pipe, err := NewPortalGuardianPipe(ctx, make(chan models.TransitData), tc.params)
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, pipe)
+		})
+	}
+}
@@ -2,48 +2,285 @@ package registry
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
 )
 
 const (
-	GethBlock        models.RegisterType = "GETH_BLOCK"
-	ContractCreateTX models.RegisterType = "CONTRACT_CREATE_TX"
+	GethBlock            models.RegisterType = "GETH_BLOCK"
+	EventLog             models.RegisterType = "EVENT_LOG"
+	ContractCreateTX     models.RegisterType = "CONTRACT_CREATE_TX"
+	AddressWatchTX       models.RegisterType = "ADDRESS_WATCH_TX"
+	LowActivityBlock     models.RegisterType = "LOW_ACTIVITY_BLOCK"
+	GasUsageAnomaly      models.RegisterType = "GAS_USAGE_ANOMALY"
+	MintBurnAnomaly      models.RegisterType = "MINT_BURN_ANOMALY"
+	DisputeGame          models.RegisterType = "DISPUTE_GAME"
+	PortalGuardianAction models.RegisterType = "PORTAL_GUARDIAN_ACTION"
+	WasmTransform        models.RegisterType = "WASM_TRANSFORM"
+	ContractCreationRate models.RegisterType = "CONTRACT_CREATION_RATE"
+	SyncStatus           models.RegisterType = "SYNC_STATUS"
+)
+
+// Register dependency errors
+const (
+	oracleDependencyErr     = "%s register is an oracle and cannot have dependencies"
+	missingDependencyErr    = "%s register is a pipe and must have at least one dependency"
+	constructorTypeErr      = "%s register constructor does not match its component type"
+	unsupportedComponentErr = "%s register has an unsupported component type: %s"
+	cyclicDependencyErr     = "%s register has a cyclic dependency"
 )
 
 var (
 	gethBlockReg = &DataRegister{
 		DataType:             GethBlock,
+		Description:          "Polls an execution client for every new block",
 		ComponentType:        models.Oracle,
 		ComponentConstructor: NewGethBlockOracle,
 		Dependencies:         make([]*DataRegister, 0),
 	}
 
+	eventLogReg = &DataRegister{
+		DataType:             EventLog,
+		Description:          "Polls an execution client for every log emitted within each new block",
+		ComponentType:        models.Oracle,
+		ComponentConstructor: NewEventLogOracle,
+		Dependencies:         make([]*DataRegister, 0),
+	}
+
+	syncStatusReg = &DataRegister{
+		DataType:             SyncStatus,
+		Description:          "Polls op-nodes for their unsafe, safe and finalized L2 heads",
+		ComponentType:        models.Oracle,
+		ComponentConstructor: NewSyncStatusOracle,
+		Dependencies:         make([]*DataRegister, 0),
+	}
+
 	contractCreateTXReg = &DataRegister{
 		DataType:             ContractCreateTX,
+		Description:          "Extracts contract creation transactions from blocks",
 		ComponentType:        models.Pipe,
 		ComponentConstructor: NewCreateContractTxPipe,
 		Dependencies:         []*DataRegister{gethBlockReg},
 	}
+
+	contractCreationRateReg = &DataRegister{
+		DataType:             ContractCreationRate,
+		Description:          "Detects deployers creating more than a maximum number of contracts within a block window",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewContractCreationRatePipe,
+		Stateful:             true,
+		Dependencies:         []*DataRegister{contractCreateTXReg},
+	}
+
+	addressWatchTXReg = &DataRegister{
+		DataType:             AddressWatchTX,
+		Description:          "Extracts transactions sent from, sent to, or deploying a watched address",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewAddressWatchPipe,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
+
+	lowActivityBlockReg = &DataRegister{
+		DataType:             LowActivityBlock,
+		Description:          "Detects streaks of consecutive blocks containing few or no non-deposit transactions",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewLowActivityBlockPipe,
+		Stateful:             true,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
+
+	gasUsageAnomalyReg = &DataRegister{
+		DataType:             GasUsageAnomaly,
+		Description:          "Detects block gas usage deviating from a rolling window mean",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewGasAnomalyPipe,
+		Stateful:             true,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
+
+	mintBurnAnomalyReg = &DataRegister{
+		DataType:             MintBurnAnomaly,
+		Description:          "Detects watched token mints or burns exceeding a windowed threshold",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewMintBurnPipe,
+		Stateful:             true,
+		Dependencies:         []*DataRegister{eventLogReg},
+	}
+
+	disputeGameReg = &DataRegister{
+		DataType:             DisputeGame,
+		Description:          "Decodes dispute game creation, move and resolution events",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewDisputeGamePipe,
+		Stateful:             true,
+		Dependencies:         []*DataRegister{eventLogReg},
+	}
+
+	portalGuardianActionReg = &DataRegister{
+		DataType:             PortalGuardianAction,
+		Description:          "Detects portal pauses, unpauses and guardian transactions",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewPortalGuardianPipe,
+		Dependencies:         []*DataRegister{gethBlockReg, eventLogReg},
+	}
+
+	wasmTransformReg = &DataRegister{
+		DataType:             WasmTransform,
+		Description:          "Executes a user supplied WASM module against every block",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewWasmTransformPipe,
+		Stateful:             true,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
 )
 
+// DataRegister ... Register metadata used to construct and wire a pipeline component
 type DataRegister struct {
-	DataType             models.RegisterType
-	ComponentType        models.ComponentType
+	// Register type of the transit data output by the component
+	DataType models.RegisterType
+	// Human readable summary presented to users when discovering registers
+	Description   string
+	ComponentType models.ComponentType
+	// Either a pipeline.OracleConstructor or a pipeline.PipeConstructorFunc depending on ComponentType
 	ComponentConstructor interface{}
-	// TODO - Introduce dependency management logic
+	// Whether the component retains state between inputs; stateful components can't be freely restarted
+	Stateful bool
+	// Registers whose output data is required as component input; always empty for oracles
 	Dependencies []*DataRegister
 }
 
-func GetRegister(rt models.RegisterType) (*DataRegister, error) {
-	switch rt {
-	case GethBlock:
-		return gethBlockReg, nil
+// DependencyTypes ... Returns the register types of all direct dependencies
+func (dr *DataRegister) DependencyTypes() []models.RegisterType {
+	rts := make([]models.RegisterType, 0, len(dr.Dependencies))
+	for _, dep := range dr.Dependencies {
+		rts = append(rts, dep.DataType)
+	}
+
+	return rts
+}
 
-	case ContractCreateTX:
-		return contractCreateTXReg, nil
+// Validate ... Ensures that the register is an oracle or pipe and that its dependencies and constructor agree
+// with its component type
+func (dr *DataRegister) Validate() error {
+	switch dr.ComponentType {
+	case models.Oracle:
+		if len(dr.Dependencies) != 0 {
+			return fmt.Errorf(oracleDependencyErr, dr.DataType)
+		}
+
+		if _, ok := dr.ComponentConstructor.(pipeline.OracleConstructor); !ok {
+			return fmt.Errorf(constructorTypeErr, dr.DataType)
+		}
+
+	case models.Pipe:
+		if len(dr.Dependencies) == 0 {
+			return fmt.Errorf(missingDependencyErr, dr.DataType)
+		}
+
+		if _, ok := dr.ComponentConstructor.(pipeline.PipeConstructorFunc); !ok {
+			return fmt.Errorf(constructorTypeErr, dr.DataType)
+		}
 
 	default:
+		return fmt.Errorf(unsupportedComponentErr, dr.DataType, dr.ComponentType)
+	}
+
+	return nil
+}
+
+// GetDependencyPath ... Returns all registers required to produce the register type in construction order;
+// dependencies always precede their dependents and shared dependencies are only returned once
+func GetDependencyPath(rt models.RegisterType) ([]*DataRegister, error) {
+	path := make([]*DataRegister, 0)
+	visited := make(map[models.RegisterType]bool)
+
+	var visit func(dr *DataRegister) error
+	visit = func(dr *DataRegister) error {
+		if done, seen := visited[dr.DataType]; seen {
+			if !done {
+				return fmt.Errorf(cyclicDependencyErr, dr.DataType)
+			}
+			return nil
+		}
+
+		if err := dr.Validate(); err != nil {
+			return err
+		}
+
+		visited[dr.DataType] = false
+		for _, dep := range dr.Dependencies {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visited[dr.DataType] = true
+
+		path = append(path, dr)
+		return nil
+	}
+
+	register, err := GetRegister(rt)
+	if err != nil {
+		return nil, err
+	}
+
+	if visitErr := visit(register); visitErr != nil {
+		return nil, visitErr
+	}
+
+	return path, nil
+}
+
+// registers ... Lookup of all known registers keyed by their output register type
+var registers = map[models.RegisterType]*DataRegister{
+	GethBlock:            gethBlockReg,
+	EventLog:             eventLogReg,
+	SyncStatus:           syncStatusReg,
+	ContractCreateTX:     contractCreateTXReg,
+	ContractCreationRate: contractCreationRateReg,
+	AddressWatchTX:       addressWatchTXReg,
+	LowActivityBlock:     lowActivityBlockReg,
+	GasUsageAnomaly:      gasUsageAnomalyReg,
+	MintBurnAnomaly:      mintBurnAnomalyReg,
+	DisputeGame:          disputeGameReg,
+	PortalGuardianAction: portalGuardianActionReg,
+	WasmTransform:        wasmTransformReg,
+}
+
+// GetRegister ... Returns the register for the type; fail if no register exists
+func GetRegister(rt models.RegisterType) (*DataRegister, error) {
+	register, found := registers[rt]
+	if !found {
 		return nil, fmt.Errorf("no register could be found for type: %s", rt)
 	}
+
+	return register, nil
+}
+
+// ListRegisterTypes ... Returns all known register types in lexicographical order
+func ListRegisterTypes() []models.RegisterType {
+	rts := make([]models.RegisterType, 0, len(registers))
+	for rt := range registers {
+		rts = append(rts, rt)
+	}
+
+	sort.Slice(rts, func(i, j int) bool {
+		return rts[i] < rts[j]
+	})
+
+	return rts
+}
+
+// GetRegisters ... Returns all known registers ordered by register type
+func GetRegisters() []*DataRegister {
+	rts := ListRegisterTypes()
+
+	drs := make([]*DataRegister, 0, len(rts))
+	for _, rt := range rts {
+		drs = append(drs, registers[rt])
+	}
+
+	return drs
 }
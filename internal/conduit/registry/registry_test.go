@@ -0,0 +1,126 @@
+package registry
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetDependencyPath(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		rt           models.RegisterType
+		expectedPath []models.RegisterType
+	}{
+		{
+			name:        "Oracle Path Test",
+			description: "Oracle registers should resolve to a path containing only themselves",
+
+			rt:           GethBlock,
+			expectedPath: []models.RegisterType{GethBlock},
+		},
+		{
+			name:        "Single Dependency Path Test",
+			description: "Pipe registers should resolve to a path where the oracle dependency precedes the pipe",
+
+			rt:           ContractCreateTX,
+			expectedPath: []models.RegisterType{GethBlock, ContractCreateTX},
+		},
+		{
+			name:        "Multi Dependency Path Test",
+			description: "Pipe registers with several dependencies should have every dependency precede the pipe",
+
+			rt:           PortalGuardianAction,
+			expectedPath: []models.RegisterType{GethBlock, EventLog, PortalGuardianAction},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			path, err := GetDependencyPath(tc.rt)
+			assert.NoError(t, err)
+
+			actualPath := make([]models.RegisterType, 0, len(path))
+			for _, dr := range path {
+				actualPath = append(actualPath, dr.DataType)
+			}
+
+			assert.Equal(t, tc.expectedPath, actualPath)
+		})
+	}
+
+	_, err := GetDependencyPath("UNKNOWN")
+	assert.Error(t, err, "Ensuring unknown register types fail to resolve")
+}
+
+func Test_DataRegister_Validate(t *testing.T) {
+	invalidOracle := &DataRegister{
+		DataType:             "INVALID_ORACLE",
+		ComponentType:        models.Oracle,
+		ComponentConstructor: NewGethBlockOracle,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
+	assert.EqualError(t, invalidOracle.Validate(), fmt.Sprintf(oracleDependencyErr, "INVALID_ORACLE"))
+
+	invalidPipe := &DataRegister{
+		DataType:             "INVALID_PIPE",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewGethBlockOracle,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
+	assert.EqualError(t, invalidPipe.Validate(), fmt.Sprintf(constructorTypeErr, "INVALID_PIPE"))
+
+	for _, ct := range []models.ComponentType{models.Conveyor, models.Filter, models.Join, models.ComponentType(42)} {
+		unsupported := &DataRegister{
+			DataType:             "UNSUPPORTED",
+			ComponentType:        ct,
+			ComponentConstructor: NewGethBlockOracle,
+		}
+		assert.EqualError(t, unsupported.Validate(), fmt.Sprintf(unsupportedComponentErr, "UNSUPPORTED", ct),
+			"Ensuring only oracle & pipe registers are accepted")
+		assert.Error(t, AddRegister(unsupported))
+	}
+
+	// Leaving the component type unset defaults it to an oracle, which the pipe constructor must not satisfy
+	unsetType := &DataRegister{
+		DataType:             "UNSET_TYPE",
+		ComponentConstructor: NewWasmTransformPipe,
+		Dependencies:         []*DataRegister{gethBlockReg},
+	}
+	assert.Error(t, AddRegister(unsetType))
+}
+
+func Test_GetRegisters(t *testing.T) {
+	rts := ListRegisterTypes()
+	drs := GetRegisters()
+
+	assert.Len(t, drs, len(rts))
+	assert.IsIncreasing(t, rts, "Ensuring register types are sorted")
+
+	for i, dr := range drs {
+		assert.Equal(t, rts[i], dr.DataType)
+		assert.NotEmpty(t, dr.Description, "Ensuring all registers are described")
+		assert.NoError(t, dr.Validate(), "Ensuring all registers are valid")
+	}
+}
+
+func Test_AddRegister(t *testing.T) {
+	err := AddRegister(gethBlockReg)
+	assert.Error(t, err, "Ensuring existing register types can't be overwritten")
+
+	invalid := &DataRegister{
+		DataType:             "INVALID_PLUGIN_PIPE",
+		ComponentType:        models.Pipe,
+		ComponentConstructor: NewCreateContractTxPipe,
+	}
+	assert.EqualError(t, AddRegister(invalid), fmt.Sprintf(missingDependencyErr, "INVALID_PLUGIN_PIPE"))
+
+	_, err = GetRegister("INVALID_PLUGIN_PIPE")
+	assert.Error(t, err, "Ensuring invalid registers aren't added")
+
+	assert.NoError(t, LoadPlugins(t.TempDir()), "Ensuring empty plugin directories are a no-op")
+}
@@ -0,0 +1,164 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/config"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// OpNodeParam ... RPC endpoint of the watched op-node
+	OpNodeParam = "op_node"
+	// SequencerOpNodeParam ... Optional RPC endpoint of the sequencer's op-node
+	SequencerOpNodeParam = "sequencer_op_node"
+	// SyncPollIntervalParam ... Interval between sync status reads
+	SyncPollIntervalParam = "poll_interval"
+
+	defaultSyncPollInterval = 2 * time.Second
+)
+
+// SyncStatusSnapshot ... Sync status of the watched op-node and, when configured, the sequencer's op-node
+// read at the same time
+type SyncStatusSnapshot struct {
+	Node client.SyncStatus
+	// Nil when no sequencer op-node is configured
+	Sequencer *client.SyncStatus
+	Timestamp time.Time
+}
+
+// SyncStatusODef ... SyncStatus register oracle definition used to drive oracle component;
+// polls op-node sync status rather than reading blocks so it can't be backtested
+type SyncStatusODef struct {
+	nodeURL      string
+	sequencerURL string
+	interval     time.Duration
+
+	node      client.RollupClientInterface
+	sequencer client.RollupClientInterface
+
+	errorCallback
+}
+
+// NewSyncStatusOracle ... Initializer; requires the op_node param
+func NewSyncStatusOracle(ctx context.Context, ot pipeline.OracleType, _ *config.OracleConfig,
+	_ client.EthClientInterface, params models.Params) (pipeline.Component, error) {
+	nodeURL, err := params.String(OpNodeParam, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if nodeURL == "" {
+		return nil, fmt.Errorf("%s oracle requires an %s param", SyncStatus, OpNodeParam)
+	}
+
+	sequencerURL, err := params.String(SequencerOpNodeParam, "")
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := params.Duration(SyncPollIntervalParam, defaultSyncPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("%s must be positive", SyncPollIntervalParam)
+	}
+
+	od := &SyncStatusODef{
+		nodeURL:      nodeURL,
+		sequencerURL: sequencerURL,
+		interval:     interval,
+		node:         &client.RollupClient{},
+	}
+
+	if sequencerURL != "" {
+		od.sequencer = &client.RollupClient{}
+	}
+
+	return pipeline.NewOracle(ctx, ot, od)
+}
+
+// ConfigureRoutine ... Dials the op-node RPC endpoints
+func (oracle *SyncStatusODef) ConfigureRoutine() error {
+	ctxTimeout, ctxCancel := context.WithTimeout(context.Background(),
+		time.Second*time.Duration(models.EthClientTimeout))
+	defer ctxCancel()
+
+	logging.WithContext(ctxTimeout).Info("Setting up sync status clients")
+
+	if err := oracle.node.DialContext(ctxTimeout, oracle.nodeURL); err != nil {
+		return err
+	}
+
+	if oracle.sequencer == nil {
+		return nil
+	}
+
+	return oracle.sequencer.DialContext(ctxTimeout, oracle.sequencerURL)
+}
+
+// BackTestRoutine ... Unsupported; historical sync status can't be read
+func (oracle *SyncStatusODef) BackTestRoutine(_ context.Context, _ chan models.TransitData,
+	_ *big.Int, _ *big.Int) error {
+	return pipeline.NewFatalError(errors.New("sync status oracle does not support backtests"))
+}
+
+// snapshot ... Reads the sync status of every configured op-node
+func (oracle *SyncStatusODef) snapshot(ctx context.Context) (*SyncStatusSnapshot, error) {
+	node, err := oracle.node.SyncStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch op-node sync status: %w", err)
+	}
+
+	snapshot := &SyncStatusSnapshot{Node: *node, Timestamp: time.Now()}
+
+	if oracle.sequencer != nil {
+		sequencer, sErr := oracle.sequencer.SyncStatus(ctx)
+		if sErr != nil {
+			return nil, fmt.Errorf("could not fetch sequencer op-node sync status: %w", sErr)
+		}
+
+		snapshot.Sequencer = sequencer
+	}
+
+	return snapshot, nil
+}
+
+// ReadRoutine ... Polls the configured op-nodes for their sync status & writes a snapshot
+// to output listener components every interval
+func (oracle *SyncStatusODef) ReadRoutine(ctx context.Context, componentChan chan models.TransitData) error {
+	ticker := time.NewTicker(oracle.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			snapshot, err := oracle.snapshot(ctx)
+			if err != nil {
+				logging.WithContext(ctx).Error("problem fetching sync status", zap.Error(err))
+				oracle.reportError(pipeline.NewTransientError(err))
+				continue
+			}
+
+			componentChan <- models.TransitData{
+				Timestamp: snapshot.Timestamp,
+				Type:      SyncStatus,
+				Value:     *snapshot,
+				Height:    new(big.Int).SetUint64(snapshot.Node.UnsafeL2.Number),
+			}
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
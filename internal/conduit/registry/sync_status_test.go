@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type RollupClientMocked struct {
+	mock.Mock
+}
+
+func (rc *RollupClientMocked) DialContext(ctx context.Context, rawURL string) error {
+	args := rc.Called(ctx, rawURL)
+	return args.Error(0)
+}
+
+func (rc *RollupClientMocked) SyncStatus(ctx context.Context) (*client.SyncStatus, error) {
+	args := rc.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*client.SyncStatus), args.Error(1)
+}
+
+func (rc *RollupClientMocked) OutputAtBlock(ctx context.Context, blockNum uint64) (*client.OutputResponse, error) {
+	args := rc.Called(ctx, blockNum)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*client.OutputResponse), args.Error(1)
+}
+
+func (rc *RollupClientMocked) RollupConfig(ctx context.Context) (*client.RollupConfig, error) {
+	args := rc.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*client.RollupConfig), args.Error(1)
+}
+
+func Test_SyncStatus_ReadRoutine(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	status := func(unsafe uint64) *client.SyncStatus {
+		return &client.SyncStatus{UnsafeL2: client.BlockRef{Number: unsafe}}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		sequencer    bool
+		sequencerErr error
+		emitted      bool
+	}{
+		{
+			name:        "Node Only",
+			description: "Snapshots without a configured sequencer should only contain the node's status",
+			emitted:     true,
+		},
+		{
+			name:        "Node & Sequencer",
+			description: "Snapshots should contain the sequencer's status when configured",
+			sequencer:   true,
+			emitted:     true,
+		},
+		{
+			name:         "Sequencer Failure",
+			description:  "Snapshots should be skipped & reported when any op-node can't be read",
+			sequencer:    true,
+			sequencerErr: errors.New("connection refused"),
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			node := new(RollupClientMocked)
+			node.On("SyncStatus", mock.Anything).Return(status(10), nil)
+
+			od := &SyncStatusODef{interval: time.Millisecond, node: node}
+
+			if tc.sequencer {
+				sequencer := new(RollupClientMocked)
+				if tc.sequencerErr != nil {
+					sequencer.On("SyncStatus", mock.Anything).Return(nil, tc.sequencerErr)
+				} else {
+					sequencer.On("SyncStatus", mock.Anything).Return(status(12), nil)
+				}
+				od.sequencer = sequencer
+			}
+
+			errs := make(chan error, 10)
+			od.OnError(func(err error) {
+				select {
+				case errs <- err:
+				default:
+				}
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			outChan := make(chan models.TransitData)
+			go func() {
+				_ = od.ReadRoutine(ctx, outChan)
+			}()
+
+			if !tc.emitted {
+				err := <-errs
+				assert.Equal(t, pipeline.TransientErr, pipeline.KindOf(err, pipeline.FatalErr))
+				return
+			}
+
+			td := <-outChan
+			assert.Equal(t, SyncStatus, td.Type)
+			assert.Equal(t, uint64(10), td.Height.Uint64())
+
+			snapshot, err := models.ValueAs[SyncStatusSnapshot](td)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(10), snapshot.Node.UnsafeL2.Number)
+			if tc.sequencer {
+				assert.Equal(t, uint64(12), snapshot.Sequencer.UnsafeL2.Number)
+			} else {
+				assert.Nil(t, snapshot.Sequencer)
+			}
+		})
+	}
+}
+
+func Test_NewSyncStatusOracle(t *testing.T) {
+	_, err := NewSyncStatusOracle(context.Background(), pipeline.LiveOracle, nil, nil, models.Params{})
+	assert.Error(t, err, "an op_node param should be required")
+
+	_, err = NewSyncStatusOracle(context.Background(), pipeline.LiveOracle, nil, nil,
+		models.Params{OpNodeParam: "http://localhost:9545", SyncPollIntervalParam: "0s"})
+	assert.Error(t, err, "poll intervals should be positive")
+
+	component, err := NewSyncStatusOracle(context.Background(), pipeline.LiveOracle, nil, nil,
+		models.Params{OpNodeParam: "http://localhost:9545"})
+	assert.NoError(t, err)
+
+	cp, ok := component.(pipeline.Checkpointable)
+	assert.True(t, ok)
+	assert.False(t, cp.SupportsCheckpoints(), "sync status oracles don't read by height")
+}
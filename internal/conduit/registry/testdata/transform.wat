@@ -0,0 +1,79 @@
+;; Test fixture implementing the WASM transform ABI; transform.wasm is the binary encoding of this module.
+;; The transform's behavior is selected by the first digit of the input's timestamp so that a single
+;; module covers every host path:
+;;   1     - returns the JSON array [{"ok":true},1]
+;;   2     - returns output that isn't JSON
+;;   3     - returns an output range that lies outside of linear memory
+;;   5     - never returns
+;;   other - traps
+;; Every dealloc call increments the exported frees global.
+(module
+  (memory (export "memory") 1)
+
+  (global $heap (mut i32) (i32.const 1024))
+  (global $frees (export "frees") (mut i32) (i32.const 0))
+
+  (data (i32.const 0) "[{\"ok\":true},1]")
+  (data (i32.const 32) "not json")
+
+  (func (export "alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $heap
+    local.set $ptr
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap
+    local.get $ptr)
+
+  (func (export "dealloc") (param i32 i32)
+    global.get $frees
+    i32.const 1
+    i32.add
+    global.set $frees)
+
+  (func (export "transform") (param $ptr i32) (param $size i32) (result i64)
+    (local $mode i32)
+    local.get $ptr
+    i32.load8_u offset=13
+    local.set $mode
+
+    ;; '1' - pointer 0, size 15
+    local.get $mode
+    i32.const 49
+    i32.eq
+    if
+      i64.const 15
+      return
+    end
+
+    ;; '2' - pointer 32, size 8
+    local.get $mode
+    i32.const 50
+    i32.eq
+    if
+      i64.const 0x0000002000000008
+      return
+    end
+
+    ;; '3' - pointer 65536 (end of the single page of memory), size 16
+    local.get $mode
+    i32.const 51
+    i32.eq
+    if
+      i64.const 0x0001000000000010
+      return
+    end
+
+    ;; '5' - loops forever
+    local.get $mode
+    i32.const 53
+    i32.eq
+    if
+      loop
+        br 0
+      end
+    end
+
+    unreachable)
+)
@@ -0,0 +1,272 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"go.uber.org/zap"
+)
+
+/*
+	WASM transform modules must export the following ABI:
+
+	memory                                  - Linear memory used to exchange JSON payloads
+	alloc(size: i32) -> i32                 - Allocates size bytes & returns a pointer to them
+	transform(ptr: i32, size: i32) -> i64   - Transforms the JSON encoded transit data located at ptr & returns
+	                                          a pointer (upper 32 bits) and size (lower 32 bits) of a JSON array
+	                                          containing the output values
+	dealloc(ptr: i32, size: i32)            - (Optional) Frees memory previously returned by alloc or transform
+
+	Calls that run longer than the call timeout are interrupted & the module is reinstantiated, resetting its
+	memory. Linear memory can't grow beyond the memory limit
+*/
+
+const (
+	// ModulePathParam ... File path of the WASM module
+	ModulePathParam = "module_path"
+	// CallTimeoutParam ... Upper bound on every call into the WASM module (E.G, 500ms)
+	CallTimeoutParam = "call_timeout"
+	// MemoryLimitParam ... Upper bound on the WASM module's linear memory in 64KiB pages
+	MemoryLimitParam = "memory_limit_pages"
+
+	// defaultWasmCallTimeout ... Upper bound on every call into the WASM module
+	defaultWasmCallTimeout = time.Second
+	// defaultWasmMemoryLimit ... Upper bound on the WASM module's linear memory in pages; 16MiB
+	defaultWasmMemoryLimit = 256
+	// maxWasmMemoryLimit ... Number of pages addressable by 32-bit linear memory; 4GiB
+	maxWasmMemoryLimit = 65536
+
+	wasmAllocFunc     = "alloc"
+	wasmTransformFunc = "transform"
+	wasmDeallocFunc   = "dealloc"
+
+	wasmPtrShift = 32
+	wasmSizeMask = 0xFFFFFFFF
+)
+
+// wasmBlock ... JSON representation of a block; geth blocks don't expose exported fields for encoding
+type wasmBlock struct {
+	Header       *types.Header      `json:"header"`
+	Transactions types.Transactions `json:"transactions"`
+}
+
+// wasmInput ... JSON representation of transit data passed to WASM modules
+type wasmInput struct {
+	Timestamp int64               `json:"timestamp"`
+	Type      models.RegisterType `json:"type"`
+	Value     any                 `json:"value"`
+}
+
+// wasmTransform ... Executes a user supplied WASM module against transit data
+type wasmTransform struct {
+	ctx         context.Context
+	callTimeout time.Duration
+
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	module   api.Module
+
+	alloc     api.Function
+	transform api.Function
+	dealloc   api.Function
+}
+
+// newWasmTransform ... Instantiates the WASM module within a new runtime that's closed once ctx is done; guest
+// memory is capped at the page limit & every call is interrupted once the call timeout or ctx expires
+func newWasmTransform(ctx context.Context, wasmBytes []byte, callTimeout time.Duration,
+	memoryLimit uint32) (*wasmTransform, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(memoryLimit))
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+
+	compiled, err := runtime.CompileModule(ctx, wasmBytes)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+
+	wt := &wasmTransform{
+		ctx:         ctx,
+		callTimeout: callTimeout,
+		runtime:     runtime,
+		compiled:    compiled,
+	}
+
+	if err := wt.instantiate(); err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		if closeErr := runtime.Close(context.Background()); closeErr != nil {
+			logging.NoContext().Error("could not close wasm runtime", zap.Error(closeErr))
+		}
+	}()
+
+	return wt, nil
+}
+
+// instantiate ... Replaces the module instance with a new instance of the compiled module
+func (wt *wasmTransform) instantiate() error {
+	// Instances are anonymous so that a new instance never conflicts with the name of the previous one
+	module, err := wt.runtime.InstantiateModule(wt.ctx, wt.compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return err
+	}
+
+	alloc, transform := module.ExportedFunction(wasmAllocFunc), module.ExportedFunction(wasmTransformFunc)
+	if alloc == nil || transform == nil || module.Memory() == nil {
+		_ = module.Close(wt.ctx)
+		return fmt.Errorf("wasm module must export memory, %s and %s", wasmAllocFunc, wasmTransformFunc)
+	}
+
+	wt.module, wt.alloc, wt.transform = module, alloc, transform
+	wt.dealloc = module.ExportedFunction(wasmDeallocFunc)
+	return nil
+}
+
+// call ... Calls the guest function bounded by the call timeout; the runtime closes the module instance once
+// the timeout expires, so a new instance replaces it & the guest's memory is reset
+func (wt *wasmTransform) call(fn api.Function, params ...uint64) ([]uint64, error) {
+	ctx, cancel := context.WithTimeout(wt.ctx, wt.callTimeout)
+	defer cancel()
+
+	res, err := fn.Call(ctx, params...)
+	if err == nil || ctx.Err() == nil || wt.ctx.Err() != nil {
+		return res, err
+	}
+
+	err = fmt.Errorf("wasm %s call exceeded timeout of %s: %w", fn.Definition().Name(), wt.callTimeout, err)
+	if iErr := wt.instantiate(); iErr != nil {
+		return nil, fmt.Errorf("%w; could not reinstantiate module: %s", err, iErr)
+	}
+
+	return nil, err
+}
+
+// free ... Deallocates guest memory when the module supports it & the runtime hasn't been closed
+func (wt *wasmTransform) free(ptr, size uint32) {
+	if wt.dealloc == nil || wt.ctx.Err() != nil {
+		return
+	}
+
+	if _, err := wt.call(wt.dealloc, uint64(ptr), uint64(size)); err != nil {
+		logging.WithContext(wt.ctx).Error("could not deallocate wasm memory", zap.Error(err))
+	}
+}
+
+// marshalInput ... JSON encodes the transit data for the WASM module
+func marshalInput(td models.TransitData) ([]byte, error) {
+	input := wasmInput{
+		Timestamp: td.Timestamp.Unix(),
+		Type:      td.Type,
+		Value:     td.Value,
+	}
+
+	if block, ok := td.Value.(types.Block); ok {
+		input.Value = wasmBlock{Header: block.Header(), Transactions: block.Transactions()}
+	}
+
+	return json.Marshal(input)
+}
+
+// transformData ... Writes the transit data into guest memory, executes the transform & decodes its outputs
+func (wt *wasmTransform) transformData(td models.TransitData) ([]models.TransitData, error) {
+	payload, err := marshalInput(td)
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	allocRes, err := wt.call(wt.alloc, uint64(len(payload)))
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	inPtr, inSize := uint32(allocRes[0]), uint32(len(payload))
+	defer wt.free(inPtr, inSize)
+
+	if !wt.module.Memory().Write(inPtr, payload) {
+		return []models.TransitData{}, fmt.Errorf("wasm input write out of memory range")
+	}
+
+	transformRes, err := wt.call(wt.transform, uint64(inPtr), uint64(inSize))
+	if err != nil {
+		return []models.TransitData{}, err
+	}
+
+	outPtr, outSize := uint32(transformRes[0]>>wasmPtrShift), uint32(transformRes[0]&wasmSizeMask)
+	defer wt.free(outPtr, outSize)
+
+	outBytes, ok := wt.module.Memory().Read(outPtr, outSize)
+	if !ok {
+		return []models.TransitData{}, fmt.Errorf("wasm output read out of memory range")
+	}
+
+	var values []json.RawMessage
+	if decodeErr := json.Unmarshal(outBytes, &values); decodeErr != nil {
+		return []models.TransitData{}, fmt.Errorf("could not decode wasm output: %w", decodeErr)
+	}
+
+	outputs := make([]models.TransitData, 0, len(values))
+	for _, val := range values {
+		outputs = append(outputs, models.TransitData{
+			Timestamp: td.Timestamp,
+			Type:      WasmTransform,
+			Value:     val,
+		})
+	}
+
+	return outputs, nil
+}
+
+// NewWasmTransformPipe ... Initializer; the pipe executes the WASM module located at the module path
+// against every input and transits each output as raw JSON
+func NewWasmTransformPipe(ctx context.Context,
+	inputChan chan models.TransitData, params models.Params) (pipeline.Component, error) {
+	path, err := params.String(ModulePathParam, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("no wasm module path provided")
+	}
+
+	callTimeout, err := params.Duration(CallTimeoutParam, defaultWasmCallTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryLimit, err := params.Int(MemoryLimitParam, defaultWasmMemoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if callTimeout <= 0 || memoryLimit < 1 || memoryLimit > maxWasmMemoryLimit {
+		return nil, fmt.Errorf("invalid wasm limits; callTimeout: %s, memoryLimit: %d", callTimeout, memoryLimit)
+	}
+
+	wasmBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	wt, err := newWasmTransform(ctx, wasmBytes, callTimeout, uint32(memoryLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	return pipeline.NewPipe(ctx, wt.transformData, inputChan)
+}
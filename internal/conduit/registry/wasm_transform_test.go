@@ -0,0 +1,206 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const wasmFixturePath = "testdata/transform.wasm"
+
+func Test_WasmTransform_TransformData(t *testing.T) {
+	wasmBytes, err := os.ReadFile(wasmFixturePath)
+	assert.NoError(t, err)
+
+	// The fixture selects its behavior using the first digit of the input timestamp; see transform.wat
+	newTD := func(mode int64) models.TransitData {
+		return models.TransitData{Timestamp: time.Unix(mode, 0), Type: GethBlock, Value: "input"}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		input models.TransitData
+
+		expected []json.RawMessage
+		errMsg   string
+		frees    uint64
+	}{
+		{
+			name:        "Successful Transform",
+			description: "When the module returns a JSON array, every element should be transited as raw JSON",
+			input:       newTD(1),
+			expected:    []json.RawMessage{json.RawMessage(`{"ok":true}`), json.RawMessage(`1`)},
+			frees:       2,
+		},
+		{
+			name:        "Malformed Output",
+			description: "When the module returns output that isn't a JSON array, a decode error should be returned",
+			input:       newTD(2),
+			errMsg:      "could not decode wasm output",
+			frees:       2,
+		},
+		{
+			name:        "Out Of Range Output",
+			description: "When the module returns an output range outside of its memory, an error should be returned",
+			input:       newTD(3),
+			errMsg:      "wasm output read out of memory range",
+			frees:       2,
+		},
+		{
+			name:        "Trap",
+			description: "When the module traps, the error should be returned and the input still freed",
+			input:       newTD(4),
+			errMsg:      "unreachable",
+			frees:       1,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			wt, err := newWasmTransform(ctx, wasmBytes, defaultWasmCallTimeout, defaultWasmMemoryLimit)
+			assert.NoError(t, err)
+
+			outputs, err := wt.transformData(tc.input)
+			if tc.errMsg != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				assert.Empty(t, outputs)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, outputs, len(tc.expected))
+
+				for j, output := range outputs {
+					assert.Equal(t, WasmTransform, output.Type)
+					assert.Equal(t, tc.input.Timestamp, output.Timestamp)
+					assert.Equal(t, tc.expected[j], output.Value)
+				}
+			}
+
+			assert.Equal(t, tc.frees, wt.module.ExportedGlobal("frees").Get(),
+				"Ensuring guest memory is deallocated")
+		})
+	}
+}
+
+func Test_NewWasmTransformPipe(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		params models.Params
+		errMsg string
+	}{
+		{
+			name:        "Successful Load",
+			description: "When the module path points to a valid module, a pipe should be constructed",
+			params:      models.Params{ModulePathParam: wasmFixturePath},
+		},
+		{
+			name:        "Missing Path",
+			description: "When no module path is provided, an error should be returned",
+			params:      models.Params{},
+			errMsg:      "no wasm module path provided",
+		},
+		{
+			name:        "Unreadable Module",
+			description: "When the module path doesn't exist, an error should be returned",
+			params:      models.Params{ModulePathParam: "testdata/missing.wasm"},
+			errMsg:      "no such file",
+		},
+		{
+			name:        "Invalid Limits",
+			description: "When the call timeout isn't positive, an error should be returned",
+			params:      models.Params{ModulePathParam: wasmFixturePath, CallTimeoutParam: "0s"},
+			errMsg:      "invalid wasm limits",
+		},
+		{
+			name:        "Invalid Module",
+			description: "When the module isn't valid WASM, an error should be returned",
+			params:      models.Params{ModulePathParam: "testdata/transform.wat"},
+			errMsg:      "invalid magic number",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			pipe, err := NewWasmTransformPipe(ctx, make(chan models.TransitData), tc.params)
+			if tc.errMsg != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, pipe)
+		})
+	}
+}
+
+func Test_NewWasmTransform_MissingExports(t *testing.T) {
+	// Empty module; exports neither memory nor any of the ABI functions
+	emptyModule := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	_, err := newWasmTransform(context.Background(), emptyModule, defaultWasmCallTimeout, defaultWasmMemoryLimit)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "wasm module must export memory")
+}
+
+func Test_NewWasmTransform_MemoryLimit(t *testing.T) {
+	// Module declaring a minimum of two pages of memory
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x05, 0x03, 0x01, 0x00, 0x02}
+
+	_, err := newWasmTransform(context.Background(), module, defaultWasmCallTimeout, 1)
+	assert.Error(t, err, "Ensuring modules requiring more memory than the limit are rejected")
+}
+
+func Test_WasmTransform_CallTimeout(t *testing.T) {
+	wasmBytes, err := os.ReadFile(wasmFixturePath)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wt, err := newWasmTransform(ctx, wasmBytes, 50*time.Millisecond, defaultWasmMemoryLimit)
+	assert.NoError(t, err)
+
+	// Mode 5 loops forever; see transform.wat
+	start := time.Now()
+	_, err = wt.transformData(models.TransitData{Timestamp: time.Unix(5, 0), Type: GethBlock, Value: "input"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded timeout")
+	assert.Less(t, time.Since(start), 5*time.Second, "Ensuring the looping guest is interrupted")
+
+	// The interrupted instance is replaced so that subsequent inputs are still transformed
+	outputs, err := wt.transformData(models.TransitData{Timestamp: time.Unix(1, 0), Type: GethBlock, Value: "input"})
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 2)
+}
+
+func Test_WasmTransform_Shutdown(t *testing.T) {
+	wasmBytes, err := os.ReadFile(wasmFixturePath)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wt, err := newWasmTransform(ctx, wasmBytes, time.Hour, defaultWasmMemoryLimit)
+	assert.NoError(t, err)
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	// Mode 5 loops forever; cancellation must interrupt the guest well before the call timeout
+	_, err = wt.transformData(models.TransitData{Timestamp: time.Unix(5, 0), Type: GethBlock, Value: "input"})
+	assert.Error(t, err)
+}
@@ -1,13 +1,17 @@
 package config
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"math/big"
-	"strconv"
 	"strings"
+	"time"
 
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
 	"github.com/base-org/pessimism/internal/logging"
-	"github.com/joho/godotenv"
+	"github.com/base-org/pessimism/internal/secrets"
 
 	"os"
 )
@@ -18,50 +22,179 @@ type Env string
 
 const (
 	Development Env = "development"
+	Staging     Env = "staging"
 	Production  Env = "production"
 	Local       Env = "local"
 )
 
+// NetworkConfig ... Settings of a single network's chain
+type NetworkConfig struct {
+	// GETH compliant RPC API of the network
+	RPCEndpoint string
+	// WebSocket RPC API of the network that live oracles subscribe to new heads through; oracles poll the RPC
+	// endpoint when empty or while the WebSocket connection is down
+	WSEndpoint string
+	// Chain ID that the endpoints must serve; dials to endpoints serving another chain fail. Not verified when
+	// zero
+	ChainID uint64
+	// Number of blocks behind the chain tip that live oracles read at; reorgs shallower than the depth aren't
+	// observed. Oracles read the tip when zero
+	ConfirmationDepth uint64
+	// Period between the polls of the network's oracles; follows the global poll interval when zero
+	PollInterval time.Duration
+	// Limit on the requests sent to the RPC endpoint by every client; unlimited when zero
+	RateLimit client.RateLimit
+}
+
 // Config ... Application level configuration defined by `FilePath` value
 type Config struct {
-	L1RpcEndpoint string
-	L2RpcEndpoint string
-	Environment   Env
-	LoggerConfig  *logging.Config
+	// Every network read by pipelines & invariants; layer1 & layer2 are required, additional OP stack chains
+	// can be configured by YAML config files
+	Networks     map[models.Network]NetworkConfig
+	Environment  Env
+	LoggerConfig *logging.Config
+
+	// Directory scanned for third-party register plugins; plugin loading is skipped when empty
+	PluginDirectory string
+
+	// File that oracle height checkpoints are persisted to; checkpointing is disabled when empty
+	CheckpointPath string
+
+	// Period between the polls of every oracle
+	PollInterval time.Duration
+
+	// Backoff between the retries of every failed RPC call
+	RPCBackoff client.Backoff
+
+	// Blocks fetched per batched RPC request while backtesting; one or less fetches every block individually
+	RPCBatchSize int
+
+	// Headers & blocks cached in process & shared by every oracle client; caching is disabled when zero
+	RPCCacheSize int
+
+	// Upper bounds on the duration of every RPC call attempt by kind
+	RPCTimeouts client.Timeouts
+
+	// YAML or JSON file listing pipelines instantiated at boot; no pipelines are instantiated when empty
+	PipelineDefinitionsPath string
+
+	// Params passed to every component of a register type (E.G, thresholds or address lists); params of
+	// pipeline requests take precedence
+	RegisterParams map[models.RegisterType]models.Params
+
+	// Number of workers assessing session inputs; defaults to the number of CPUs when zero
+	EngineWorkers int
+
+	// YAML or JSON file listing invariant sessions created at boot; no sessions are created when empty
+	SessionBootstrapPath string
+
+	// YAML or JSON file configuring alert destinations & routing; invalidations are logged when empty
+	AlertConfigPath string
+
+	// File that dispatched alerts & their delivery state are persisted to; history is kept in memory when empty
+	AlertHistoryPath string
+
+	// Address the REST API server listens on
+	APIHost string
+	APIPort int
+	// Comma separated role:key pairs accepted by the REST API; requests aren't authenticated when empty
+	APIKeys string
+	// Requests allowed per minute for every API key & client IP; unlimited when zero
+	APIKeyRateLimit int
+	APIIPRateLimit  int
 }
 
 // OracleConfig ... Configuration passed through to an oracle component constructor
 type OracleConfig struct {
-	RPCEndpoint  string
-	StartHeight  *big.Int
-	EndHeight    *big.Int
+	RPCEndpoint string
+	// WSEndpoint ... WebSocket endpoint subscribed to by live routines; routines poll when empty
+	WSEndpoint string
+	// ChainID ... Chain ID that the endpoints must serve; not verified when zero
+	ChainID     uint64
+	StartHeight *big.Int
+	EndHeight   *big.Int
+	// NumOfRetries ... Retries of failed RPC calls; follows the RPC backoff's retries when zero
 	NumOfRetries int
+	// BatchSize ... Number of blocks transited per batch while backtesting; one or less disables batching
+	BatchSize int
+	// FetchBatchSize ... Number of blocks fetched per batched RPC request while backtesting; one or less fetches
+	// every block individually
+	FetchBatchSize int
+	// ConfirmationDepth ... Number of blocks behind the chain tip that live routines read at
+	ConfirmationDepth uint64
+	// PollInterval ... Fixed period between polls; follows the global poll interval when zero
+	PollInterval time.Duration
+	// Backoff ... Backoff between failed polls of the chain tip; set by the ETL manager to the RPC backoff,
+	// client.DefaultBackoff is used when zero
+	Backoff client.Backoff
+}
+
+// Subscribable ... Returns true if live routines can subscribe to new heads; IPC endpoints serve subscriptions
+// without a separate WebSocket endpoint
+func (oc *OracleConfig) Subscribable() bool {
+	return oc.WSEndpoint != "" || client.IsIPC(oc.RPCEndpoint)
 }
 
-// NewConfig ... Initializer
+// NewConfig ... Initializer; reads the config from an env file
 func NewConfig(fileName FilePath) *Config {
-	if err := godotenv.Load(string(fileName)); err != nil {
-		log.Fatalf("config file not found for file: %s", fileName)
+	cfg, err := Load(string(fileName), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return cfg
+}
+
+// loadEnv ... Loads the env file overlaid by the profile file of the active environment into the process
+// environment & reads the config from the environment & flags over the defaults of config.env.template; fail
+// with every missing required or malformed variable. Env vars overridden by flags aren't required
+func loadEnv(path string, overload bool, flags *Flags) (*Config, error) {
+	vars, err := readEnvFiles(path, profileOverride(flags, overload))
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file %s: %w", path, err)
 	}
 
-	config := &Config{
-		L1RpcEndpoint: getEnvStr("L1_RPC_ENDPOINT"),
-		L2RpcEndpoint: getEnvStr("L2_RPC_ENDPOINT"),
-
-		Environment: Env(getEnvStr("ENV")),
-
-		LoggerConfig: &logging.Config{
-			UseCustom:         getEnvBool("LOGGER_USE_CUSTOM"),
-			Level:             getEnvInt("LOGGER_LEVEL"),
-			DisableCaller:     getEnvBool("LOGGER_DISABLE_CALLER"),
-			DisableStacktrace: getEnvBool("LOGGER_DISABLE_STACKTRACE"),
-			Encoding:          getEnvStr("LOGGER_ENCODING"),
-			OutputPaths:       getEnvSlice("LOGGER_OUTPUT_PATHS"),
-			ErrorOutputPaths:  getEnvSlice("LOGGER_ERROR_OUTPUT_PATHS"),
-		},
+	for key, value := range vars {
+		if _, set := os.LookupEnv(key); set && !overload {
+			continue
+		}
+
+		if sErr := os.Setenv(key, value); sErr != nil {
+			return nil, sErr
+		}
+	}
+
+	var p problems
+	for _, s := range settings {
+		if _, found := flags.lookup(s); found || !s.required {
+			continue
+		}
+
+		if _, found := os.LookupEnv(s.env); !found {
+			p.addf("could not find env var given key: %s", s.env)
+		}
+	}
+
+	if err := p.err(); err != nil {
+		return nil, err
+	}
+
+	cfg := defaultFile().config()
+	if err := flags.apply(cfg, true); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Endpoints ... Returns the RPC endpoint of every network
+func (cfg *Config) Endpoints() map[models.Network]string {
+	endpoints := make(map[models.Network]string, len(cfg.Networks))
+	for network, nc := range cfg.Networks {
+		endpoints[network] = nc.RPCEndpoint
 	}
 
-	return config
+	return endpoints
 }
 
 // IsProduction ... Returns true if the env is production
@@ -69,6 +202,11 @@ func (cfg *Config) IsProduction() bool {
 	return cfg.Environment == Production
 }
 
+// IsStaging ... Returns true if the env is staging
+func (cfg *Config) IsStaging() bool {
+	return cfg.Environment == Staging
+}
+
 // IsDevelopment ... Returns true if the env is development
 func (cfg *Config) IsDevelopment() bool {
 	return cfg.Environment == Development
@@ -79,37 +217,47 @@ func (cfg *Config) IsLocal() bool {
 	return cfg.Environment == Local
 }
 
-// getEnvStr ... Reads env var from process environment, panics if not found
-func getEnvStr(key string) string {
-	envVar, ok := os.LookupEnv(key)
+// resolveSecrets ... Replaces secret references (E.G, vault://secret/data/pessimism#l1) of RPC endpoints &
+// API keys with the referenced secrets. Every API key entry can reference either its role:key pair or its key
+func (cfg *Config) resolveSecrets(ctx context.Context) error {
+	for network, nc := range cfg.Networks {
+		if err := secrets.ResolveAll(ctx, &nc.RPCEndpoint); err != nil {
+			return fmt.Errorf("%s RPC endpoint: %w", network, err)
+		}
 
-	// Not found
-	if !ok {
-		log.Fatalf("could not find env var given key: %s", key)
-	}
+		if err := secrets.ResolveAll(ctx, &nc.WSEndpoint); err != nil {
+			return fmt.Errorf("%s WS endpoint: %w", network, err)
+		}
 
-	return envVar
-}
+		cfg.Networks[network] = nc
+	}
 
-// getEnvBool ... Reads env vars and converts to booleans
-func getEnvBool(key string) bool {
-	if val := getEnvStr(key); val == "1" {
-		return true
+	if cfg.APIKeys == "" {
+		return nil
 	}
-	return false
-}
 
-// getEnvSlice ... Reads env vars and converts to string slice
-func getEnvSlice(key string) []string {
-	return strings.Split(getEnvStr(key), ",")
-}
+	entries := strings.Split(cfg.APIKeys, ",")
+	for i, entry := range entries {
+		if _, isRef, _ := secrets.ParseReference(entry); isRef {
+			if err := secrets.ResolveAll(ctx, &entries[i]); err != nil {
+				return fmt.Errorf("API key %d: %w", i, err)
+			}
 
-// getEnvInt ... Reads env vars and converts to int
-func getEnvInt(key string) int {
-	val := getEnvStr(key)
-	intRep, err := strconv.Atoi(val)
-	if err != nil {
-		log.Fatalf("env val is not int; got: %s=%s; err: %s", key, val, err.Error())
+			continue
+		}
+
+		role, key, found := strings.Cut(entry, ":")
+		if !found {
+			continue
+		}
+
+		if err := secrets.ResolveAll(ctx, &key); err != nil {
+			return fmt.Errorf("API key %d: %w", i, err)
+		}
+
+		entries[i] = role + ":" + key
 	}
-	return intRep
+
+	cfg.APIKeys = strings.Join(entries, ",")
+	return nil
 }
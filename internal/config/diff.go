@@ -0,0 +1,49 @@
+package config
+
+import (
+	"reflect"
+)
+
+// reloadable ... Settings that can be applied to a running process; every other setting is only read at boot
+var reloadable = map[string]bool{
+	"PollInterval":       true,
+	"LoggerConfig.Level": true,
+	// Routing of the alert config is re-read, destinations are reported as requiring a restart
+	"AlertConfigPath": true,
+}
+
+// Change ... A setting whose value differs between two configs
+type Change struct {
+	// Setting ... Field name; logger settings are prefixed with LoggerConfig (E.G, LoggerConfig.Level)
+	Setting string
+	// Reloadable ... Whether the change can be applied without restarting
+	Reloadable bool
+}
+
+// Diff ... Returns every setting whose value differs between the configs, in field order. Values aren't
+// included so that secrets (E.G, API keys) aren't leaked when changes are reported
+func Diff(old, new *Config) []Change {
+	changes := make([]Change, 0)
+	diffStruct("", reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem(), &changes)
+
+	return changes
+}
+
+// diffStruct ... Appends the changed fields of the structs to the changes; pointers to structs are compared
+// field by field
+func diffStruct(prefix string, old, new reflect.Value, changes *[]Change) {
+	for i := 0; i < old.NumField(); i++ {
+		name := prefix + old.Type().Field(i).Name
+		of, nf := old.Field(i), new.Field(i)
+
+		if of.Kind() == reflect.Pointer && of.Type().Elem().Kind() == reflect.Struct &&
+			!of.IsNil() && !nf.IsNil() {
+			diffStruct(name+".", of.Elem(), nf.Elem(), changes)
+			continue
+		}
+
+		if !reflect.DeepEqual(of.Interface(), nf.Interface()) {
+			*changes = append(*changes, Change{Setting: name, Reloadable: reloadable[name]})
+		}
+	}
+}
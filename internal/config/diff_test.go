@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Diff(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		modify  func(cfg *Config)
+		changes []Change
+	}{
+		{
+			name:        "Unchanged",
+			description: "Identical configs should have no changes",
+			modify:      func(*Config) {},
+			changes:     []Change{},
+		},
+		{
+			name:        "Reloadable",
+			description: "Poll interval & logger level changes should be reloadable",
+			modify: func(cfg *Config) {
+				cfg.PollInterval = time.Minute
+				cfg.LoggerConfig.Level = 1
+			},
+			changes: []Change{
+				{Setting: "LoggerConfig.Level", Reloadable: true},
+				{Setting: "PollInterval", Reloadable: true},
+			},
+		},
+		{
+			name:        "Restart Required",
+			description: "Changes to settings read at boot should require a restart",
+			modify: func(cfg *Config) {
+				cfg.Networks[models.Layer1] = NetworkConfig{RPCEndpoint: "http://other:8545"}
+				cfg.LoggerConfig.Encoding = "json"
+				cfg.APIPort = 9090
+			},
+			changes: []Change{
+				{Setting: "Networks"},
+				{Setting: "LoggerConfig.Encoding"},
+				{Setting: "APIPort"},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			updated := validConfig()
+			tc.modify(updated)
+
+			assert.Equal(t, tc.changes, Diff(validConfig(), updated))
+		})
+	}
+}
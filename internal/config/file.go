@@ -0,0 +1,310 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// defaultPollInterval ... Matches POLL_INTERVAL of config.env.template
+	defaultPollInterval = 200 * time.Millisecond
+	// defaultRPCBatchSize ... Matches RPC_BATCH_SIZE of config.env.template
+	defaultRPCBatchSize = 10
+	// defaultRPCCacheSize ... Matches RPC_CACHE_SIZE of config.env.template
+	defaultRPCCacheSize = 128
+)
+
+// networkSection ... Settings of a single network
+type networkSection struct {
+	RPCEndpoint       string        `yaml:"rpc_endpoint"`
+	WSEndpoint        string        `yaml:"ws_endpoint"`
+	ChainID           uint64        `yaml:"chain_id"`
+	ConfirmationDepth uint64        `yaml:"confirmation_depth"`
+	PollInterval      time.Duration `yaml:"poll_interval"`
+	RateLimit         float64       `yaml:"rate_limit"`
+	Burst             int           `yaml:"burst"`
+}
+
+// pipelinesSection ... Settings of the ETL pipelines
+type pipelinesSection struct {
+	DefinitionsPath string `yaml:"definitions_path"`
+	CheckpointPath  string `yaml:"checkpoint_path"`
+	PluginDirectory string `yaml:"plugin_directory"`
+	// Go duration string (E.G, 200ms)
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
+// rpcSection ... Settings shared by every RPC client
+type rpcSection struct {
+	Retries       int           `yaml:"retries"`
+	BackoffBase   time.Duration `yaml:"backoff_base"`
+	BackoffCap    time.Duration `yaml:"backoff_cap"`
+	BackoffJitter float64       `yaml:"backoff_jitter"`
+	BatchSize     int           `yaml:"batch_size"`
+	CacheSize     int           `yaml:"cache_size"`
+	HeaderTimeout time.Duration `yaml:"header_timeout"`
+	BlockTimeout  time.Duration `yaml:"block_timeout"`
+	CallTimeout   time.Duration `yaml:"call_timeout"`
+	TraceTimeout  time.Duration `yaml:"trace_timeout"`
+}
+
+// engineSection ... Settings of the risk engine
+type engineSection struct {
+	Workers       int    `yaml:"workers"`
+	BootstrapPath string `yaml:"bootstrap_path"`
+}
+
+// alertingSection ... Settings of the alerting subsystem
+type alertingSection struct {
+	ConfigPath  string `yaml:"config_path"`
+	HistoryPath string `yaml:"history_path"`
+}
+
+// apiSection ... Settings of the REST API server
+type apiSection struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+	// role:key pairs
+	Keys         []string `yaml:"keys"`
+	KeyRateLimit int      `yaml:"key_rate_limit"`
+	IPRateLimit  int      `yaml:"ip_rate_limit"`
+}
+
+// loggerSection ... Settings of the application logger
+type loggerSection struct {
+	UseCustom         bool     `yaml:"use_custom"`
+	Level             int      `yaml:"level"`
+	DisableCaller     bool     `yaml:"disable_caller"`
+	DisableStacktrace bool     `yaml:"disable_stacktrace"`
+	Encoding          string   `yaml:"encoding"`
+	OutputPaths       []string `yaml:"output_paths"`
+	ErrorOutputPaths  []string `yaml:"error_output_paths"`
+}
+
+// file ... Contents of a structured config file; sections group the settings that env files flatten
+type file struct {
+	Environment Env                               `yaml:"environment"`
+	Networks    map[models.Network]networkSection `yaml:"networks"`
+	// Params of every component of a register type
+	Registers map[models.RegisterType]models.Params `yaml:"registers"`
+	RPC       rpcSection                            `yaml:"rpc"`
+	Pipelines pipelinesSection                      `yaml:"pipelines"`
+	Engine    engineSection                         `yaml:"engine"`
+	Alerting  alertingSection                       `yaml:"alerting"`
+	API       apiSection                            `yaml:"api"`
+	Logger    loggerSection                         `yaml:"logger"`
+}
+
+// defaultFile ... Settings used for every value omitted from a config file; matches config.env.template
+func defaultFile() file {
+	backoff, timeouts := client.DefaultBackoff(), client.DefaultTimeouts()
+	return file{
+		Environment: Local,
+		RPC: rpcSection{
+			Retries:       backoff.Retries,
+			BackoffBase:   backoff.Base,
+			BackoffCap:    backoff.Cap,
+			BackoffJitter: backoff.Jitter,
+			BatchSize:     defaultRPCBatchSize,
+			CacheSize:     defaultRPCCacheSize,
+			HeaderTimeout: timeouts.Header,
+			BlockTimeout:  timeouts.Block,
+			CallTimeout:   timeouts.Call,
+			TraceTimeout:  timeouts.Trace,
+		},
+		Pipelines: pipelinesSection{PollInterval: defaultPollInterval},
+		API:       apiSection{Host: "localhost", Port: 8080, KeyRateLimit: 600, IPRateLimit: 300},
+		Logger: loggerSection{
+			Level:            -1,
+			Encoding:         "console",
+			OutputPaths:      []string{"stderr"},
+			ErrorOutputPaths: []string{"stderr"},
+		},
+	}
+}
+
+// config ... Returns the application config described by the file
+func (f file) config() *Config {
+	networks := make(map[models.Network]NetworkConfig, len(f.Networks))
+	for name, section := range f.Networks {
+		networks[name] = NetworkConfig{
+			RPCEndpoint:       section.RPCEndpoint,
+			WSEndpoint:        section.WSEndpoint,
+			ChainID:           section.ChainID,
+			ConfirmationDepth: section.ConfirmationDepth,
+			PollInterval:      section.PollInterval,
+			RateLimit:         client.RateLimit{PerSecond: section.RateLimit, Burst: section.Burst},
+		}
+	}
+
+	return &Config{
+		Networks:       networks,
+		RegisterParams: f.Registers,
+		Environment:    f.Environment,
+
+		PluginDirectory: f.Pipelines.PluginDirectory,
+		CheckpointPath:  f.Pipelines.CheckpointPath,
+		PollInterval:    f.Pipelines.PollInterval,
+		RPCBackoff: client.Backoff{
+			Retries: f.RPC.Retries,
+			Base:    f.RPC.BackoffBase,
+			Cap:     f.RPC.BackoffCap,
+			Jitter:  f.RPC.BackoffJitter,
+		},
+		RPCBatchSize: f.RPC.BatchSize,
+		RPCCacheSize: f.RPC.CacheSize,
+		RPCTimeouts: client.Timeouts{
+			Header: f.RPC.HeaderTimeout,
+			Block:  f.RPC.BlockTimeout,
+			Call:   f.RPC.CallTimeout,
+			Trace:  f.RPC.TraceTimeout,
+		},
+		PipelineDefinitionsPath: f.Pipelines.DefinitionsPath,
+
+		EngineWorkers:        f.Engine.Workers,
+		SessionBootstrapPath: f.Engine.BootstrapPath,
+
+		AlertConfigPath:  f.Alerting.ConfigPath,
+		AlertHistoryPath: f.Alerting.HistoryPath,
+
+		APIHost:         f.API.Host,
+		APIPort:         f.API.Port,
+		APIKeys:         strings.Join(f.API.Keys, ","),
+		APIKeyRateLimit: f.API.KeyRateLimit,
+		APIIPRateLimit:  f.API.IPRateLimit,
+
+		LoggerConfig: &logging.Config{
+			UseCustom:         f.Logger.UseCustom,
+			Level:             f.Logger.Level,
+			DisableCaller:     f.Logger.DisableCaller,
+			DisableStacktrace: f.Logger.DisableStacktrace,
+			Encoding:          f.Logger.Encoding,
+			OutputPaths:       f.Logger.OutputPaths,
+			ErrorOutputPaths:  f.Logger.ErrorOutputPaths,
+		},
+	}
+}
+
+// readFile ... Reads a structured YAML config file overlaid by the profile file of the active environment;
+// omitted settings take the defaults of config.env.template. The active environment is the override or, when
+// empty, the environment of the file. Fail on unknown fields
+func readFile(path string, override Env) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := decodeFile(raw, path)
+	if err != nil {
+		return nil, err
+	}
+
+	env := override
+	if env == "" {
+		env = f.Environment
+	}
+
+	profile, found, err := readProfile(path, env)
+	if err != nil || !found {
+		return f.config(), err
+	}
+
+	merged, err := overlayFile(raw, profile, env)
+	if err != nil {
+		return nil, fmt.Errorf("could not overlay %s: %w", path, err)
+	}
+
+	if f, err = decodeFile(merged, ProfilePath(path, env)); err != nil {
+		return nil, err
+	}
+
+	return f.config(), nil
+}
+
+// decodeFile ... Decodes the YAML config file read from the path over the defaults; fail on unknown fields
+func decodeFile(raw []byte, path string) (file, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+
+	f := defaultFile()
+	// Empty files hold no settings
+	if err := dec.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return file{}, fmt.Errorf("could not parse %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// LoadFile ... Reads a structured YAML config file without env var or flag overrides; omitted settings
+// take the defaults of config.env.template. Fail on unknown fields or unresolvable secret references
+func LoadFile(path string) (*Config, error) {
+	cfg, err := readFile(path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if sErr := cfg.resolveSecrets(context.Background()); sErr != nil {
+		return nil, fmt.Errorf("could not resolve secrets of %s: %w", path, sErr)
+	}
+
+	return cfg, nil
+}
+
+// Load ... Reads the config from a YAML file, or from an env file for any other extension, overlaid by the
+// profile file of the active environment (E.G, config.production.yaml). Flags take precedence over env vars,
+// which take precedence over profile files, which take precedence over base files, which take precedence
+// over defaults. Flags are optional
+func Load(path string, flags *Flags) (*Config, error) {
+	return load(path, flags, false)
+}
+
+// Reload ... Re-reads the config from the file it was loaded from. Unlike Load, env files override variables
+// already set in the process environment, so that edits to the file take effect
+func Reload(path string, flags *Flags) (*Config, error) {
+	return load(path, flags, true)
+}
+
+// load ... Reads the config, loading env files into the process environment, and resolves its secret
+// references; env files override variables already set in the process environment when overload is true
+func load(path string, flags *Flags, overload bool) (*Config, error) {
+	var (
+		cfg *Config
+		err error
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if cfg, err = readFile(path, profileOverride(flags, false)); err == nil {
+			err = flags.apply(cfg, true)
+		}
+
+	default:
+		cfg, err = loadEnv(path, overload, flags)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if pErr := applyRegisterParamsEnv(cfg); pErr != nil {
+		return nil, pErr
+	}
+
+	if sErr := cfg.resolveSecrets(context.Background()); sErr != nil {
+		return nil, fmt.Errorf("could not resolve secrets of %s: %w", path, sErr)
+	}
+
+	return cfg, nil
+}
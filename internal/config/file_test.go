@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LoadFile(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		contents string
+		err      bool
+		check    func(t *testing.T, cfg *Config)
+	}{
+		{
+			name:        "Sections",
+			description: "Settings of every section should be read",
+			contents: `
+environment: production
+networks:
+  layer1:
+    rpc_endpoint: http://l1:8545
+    chain_id: 1
+    confirmation_depth: 12
+  layer2:
+    rpc_endpoint: http://l2:8545
+    poll_interval: 2s
+  base:
+    rpc_endpoint: http://base:8545
+engine:
+  workers: 4
+api:
+  port: 9090
+  keys: [admin:0123456789abcdef, read:fedcba9876543210]
+logger:
+  level: 1
+`,
+			check: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.IsProduction())
+				assert.Equal(t, map[models.Network]NetworkConfig{
+					models.Layer1: {RPCEndpoint: "http://l1:8545", ChainID: 1, ConfirmationDepth: 12},
+					models.Layer2: {RPCEndpoint: "http://l2:8545", PollInterval: 2 * time.Second},
+					"base":        {RPCEndpoint: "http://base:8545"},
+				}, cfg.Networks)
+				assert.Equal(t, 4, cfg.EngineWorkers)
+				assert.Equal(t, 9090, cfg.APIPort)
+				assert.Equal(t, "admin:0123456789abcdef,read:fedcba9876543210", cfg.APIKeys)
+				assert.Equal(t, 1, cfg.LoggerConfig.Level)
+
+				// Omitted settings in a provided section should keep their defaults
+				assert.Equal(t, "localhost", cfg.APIHost)
+				assert.Equal(t, []string{"stderr"}, cfg.LoggerConfig.OutputPaths)
+			},
+		},
+		{
+			name:        "Empty",
+			description: "Empty files should use the defaults",
+			check: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.IsLocal())
+				assert.Equal(t, 8080, cfg.APIPort)
+				assert.Equal(t, 600, cfg.APIKeyRateLimit)
+			},
+		},
+		{
+			name:        "Unknown Field",
+			description: "Unknown fields should be rejected rather than ignored",
+			contents:    "api:\n  prot: 9090\n",
+			err:         true,
+		},
+		{
+			name:        "Unknown Network Field",
+			description: "Unknown fields of a network should be rejected rather than ignored",
+			contents:    "networks:\n  layer1:\n    confirmations: 12\n",
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			assert.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o600))
+
+			cfg, err := Load(path, nil)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tc.check(t, cfg)
+		})
+	}
+}
+
+func Test_LoadFile_Secrets(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/secret/data/pessimism", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data": {"data": {"l1": "https://l1:8545", "admin": "0123456789abcdef"},
+			"metadata": {"version": 1}}}`))
+	}))
+	defer vault.Close()
+
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", "token")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+networks:
+  layer1:
+    rpc_endpoint: vault://secret/data/pessimism#l1
+api:
+  keys: [admin:vault://secret/data/pessimism#admin, read:fedcba9876543210]
+`), 0o600))
+
+	cfg, err := LoadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://l1:8545", cfg.Networks[models.Layer1].RPCEndpoint)
+	assert.Equal(t, "admin:0123456789abcdef,read:fedcba9876543210", cfg.APIKeys)
+}
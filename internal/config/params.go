@@ -0,0 +1,45 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+// registerParamsPrefix ... Prefix of env vars holding a register type's params as a JSON object
+// (E.G, REGISTER_PARAMS_GAS_USAGE_ANOMALY={"threshold": 3})
+const registerParamsPrefix = "REGISTER_PARAMS_"
+
+// applyRegisterParamsEnv ... Sets the register params of every REGISTER_PARAMS_<register type> env var;
+// env params take precedence over params of the same register type set by a file. Fail with every
+// malformed var
+func applyRegisterParamsEnv(cfg *Config) error {
+	var p problems
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(key, registerParamsPrefix) {
+			continue
+		}
+
+		rt := models.RegisterType(strings.TrimPrefix(key, registerParamsPrefix))
+		if rt == "" {
+			p.addf("env var %s has no register type", key)
+			continue
+		}
+
+		var params models.Params
+		if err := json.Unmarshal([]byte(value), &params); err != nil {
+			p.addf("env val is not a JSON object; got: %s=%s", key, value)
+			continue
+		}
+
+		if cfg.RegisterParams == nil {
+			cfg.RegisterParams = make(map[models.RegisterType]models.Params)
+		}
+		cfg.RegisterParams[rt] = cfg.RegisterParams[rt].Merge(params)
+	}
+
+	return p.err()
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// envSetting ... Env var selecting the environment & therefore the active profile
+const envSetting = "ENV"
+
+// ProfilePath ... Returns the path of the profile file overlaying the base config file for the environment;
+// the environment is inserted before the extension (E.G, config.yaml & production is config.production.yaml)
+func ProfilePath(path string, env Env) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(path, ext), env, ext)
+}
+
+// profileOverride ... Returns the environment set by the ENV flag or, unless env files take precedence over
+// the process environment, the ENV env var; empty when neither is set
+func profileOverride(flags *Flags, overload bool) Env {
+	for _, s := range settings {
+		if s.env != envSetting {
+			continue
+		}
+
+		if value, found := flags.lookup(s); found {
+			return Env(value)
+		}
+	}
+
+	if overload {
+		return ""
+	}
+
+	return Env(os.Getenv(envSetting))
+}
+
+// readProfile ... Reads the profile file of the environment; false if the environment has no profile file
+func readProfile(path string, env Env) ([]byte, bool, error) {
+	if env == "" {
+		return nil, false, nil
+	}
+
+	raw, err := os.ReadFile(ProfilePath(path, env))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return raw, true, nil
+}
+
+// overlayFile ... Returns the YAML config file overlaid by the profile file; mappings are merged key by key
+// & all other values of the profile replace the base file's. Profile files can't select another environment
+func overlayFile(base, profile []byte, env Env) ([]byte, error) {
+	var baseDoc, profileDoc yaml.Node
+	if err := yaml.Unmarshal(base, &baseDoc); err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(profile, &profileDoc); err != nil {
+		return nil, fmt.Errorf("could not parse %s profile: %w", env, err)
+	}
+
+	// Empty documents hold no settings
+	if len(profileDoc.Content) == 0 {
+		return base, nil
+	}
+
+	var f struct {
+		Environment Env `yaml:"environment"`
+	}
+	if err := profileDoc.Decode(&f); err != nil {
+		return nil, fmt.Errorf("could not parse %s profile: %w", env, err)
+	}
+
+	if f.Environment != "" && f.Environment != env {
+		return nil, fmt.Errorf("%s profile sets environment %s", env, f.Environment)
+	}
+
+	if len(baseDoc.Content) == 0 {
+		baseDoc = profileDoc
+	} else {
+		mergeNodes(baseDoc.Content[0], profileDoc.Content[0])
+	}
+
+	return yaml.Marshal(&baseDoc)
+}
+
+// mergeNodes ... Merges the overlay into the base node in place
+func mergeNodes(base, overlay *yaml.Node) {
+	if base.Kind != yaml.MappingNode || overlay.Kind != yaml.MappingNode {
+		*base = *overlay
+		return
+	}
+
+	// Mapping content alternates between keys & values
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+
+		merged := false
+		for j := 0; j+1 < len(base.Content); j += 2 {
+			if base.Content[j].Value == key.Value {
+				mergeNodes(base.Content[j+1], value)
+				merged = true
+				break
+			}
+		}
+
+		if !merged {
+			base.Content = append(base.Content, key, value)
+		}
+	}
+}
+
+// readEnvFiles ... Reads the env file overlaid by the profile file of the active environment; the active
+// environment is the override or, when empty, the ENV var of the env file
+func readEnvFiles(path string, override Env) (map[string]string, error) {
+	vars, err := godotenv.Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	env := override
+	if env == "" {
+		env = Env(vars[envSetting])
+	}
+
+	raw, found, err := readProfile(path, env)
+	if err != nil || !found {
+		return vars, err
+	}
+
+	profile, err := godotenv.Unmarshal(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s profile: %w", env, err)
+	}
+
+	if value, set := profile[envSetting]; set && Env(value) != env {
+		return nil, fmt.Errorf("%s profile sets environment %s", env, value)
+	}
+
+	for key, value := range profile {
+		vars[key] = value
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProfilePath(t *testing.T) {
+	assert.Equal(t, "cfg/config.production.yaml", ProfilePath("cfg/config.yaml", Production))
+	assert.Equal(t, "config.staging.env", ProfilePath("config.env", Staging))
+}
+
+func Test_Load_Profiles(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		file  string
+		files map[string]string
+		env   map[string]string
+		flags []string
+		err   bool
+		check func(t *testing.T, cfg *Config)
+	}{
+		{
+			name:        "File Environment",
+			description: "The profile of the base file's environment should overlay the base file",
+			file:        "config.yaml",
+			files: map[string]string{
+				"config.yaml": "environment: production\nnetworks:\n  layer1:\n    rpc_endpoint: http://l1:8545\n" +
+					"api:\n  host: 0.0.0.0\n",
+				"config.production.yaml": "networks:\n  layer1:\n    confirmation_depth: 12\napi:\n  port: 9090\n",
+				"config.staging.yaml":    "api:\n  port: 7070\n",
+			},
+			check: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.IsProduction())
+				assert.Equal(t, 9090, cfg.APIPort)
+				assert.Equal(t, "0.0.0.0", cfg.APIHost, "Ensuring settings omitted by the profile are kept")
+				assert.Equal(t, NetworkConfig{RPCEndpoint: "http://l1:8545", ConfirmationDepth: 12},
+					cfg.Networks[models.Layer1], "Ensuring sections are merged")
+			},
+		},
+		{
+			name:        "Env Var Environment",
+			description: "The ENV env var should select the active profile",
+			file:        "config.yaml",
+			files: map[string]string{
+				"config.yaml":            "environment: production\n",
+				"config.production.yaml": "api:\n  port: 9090\n",
+				"config.staging.yaml":    "api:\n  port: 7070\n",
+			},
+			env: map[string]string{"ENV": "staging"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.IsStaging())
+				assert.False(t, cfg.IsProduction())
+				assert.Equal(t, 7070, cfg.APIPort)
+			},
+		},
+		{
+			name:        "Flag Environment",
+			description: "Environments without a profile file should only read the base file",
+			file:        "config.yaml",
+			files: map[string]string{
+				"config.yaml":            "environment: production\n",
+				"config.production.yaml": "api:\n  port: 9090\n",
+			},
+			env:   map[string]string{"ENV": "staging"},
+			flags: []string{"--env", "local"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.IsLocal())
+				assert.Equal(t, 8080, cfg.APIPort)
+			},
+		},
+		{
+			name:        "Env File",
+			description: "Env file profiles should overlay the base env file",
+			file:        "config.env",
+			files: map[string]string{
+				"config.env":            envFile + "L2_RPC_ENDPOINT=http://l2:8545\n",
+				"config.production.env": "API_PORT=9090\n",
+			},
+			env: map[string]string{"ENV": "production"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.IsProduction())
+				assert.Equal(t, 9090, cfg.APIPort)
+				assert.Equal(t, "localhost", cfg.APIHost)
+			},
+		},
+		{
+			name:        "Conflicting Environment",
+			description: "Profiles selecting another environment should be rejected",
+			file:        "config.yaml",
+			files: map[string]string{
+				"config.yaml":            "environment: production\n",
+				"config.production.yaml": "environment: local\n",
+			},
+			err: true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			isolateEnv(t)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			dir := t.TempDir()
+			for name, content := range tc.files {
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+
+			fs := pflag.NewFlagSet("pessimism", pflag.ContinueOnError)
+			flags := RegisterFlags(fs)
+			assert.NoError(t, fs.Parse(tc.flags))
+
+			cfg, err := Load(filepath.Join(dir, tc.file), flags)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			tc.check(t, cfg)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/spf13/pflag"
+)
+
+// setting ... A config value that can be set by an env var & overridden by a command line flag
+type setting struct {
+	// Env var holding the value; the flag name is derived from it (E.G, API_PORT is --api-port)
+	env   string
+	usage string
+	set   func(cfg *Config, value string) error
+	// Env files must set required settings; omitted settings take the defaults of config.env.template
+	required bool
+}
+
+// flagName ... Returns the name of the setting's command line flag
+func (s setting) flagName() string {
+	return strings.ToLower(strings.ReplaceAll(s.env, "_", "-"))
+}
+
+// settings ... Every setting in config.env.template order
+var settings = newSettings()
+
+// newSettings ... Returns every setting
+func newSettings() []setting {
+	s := []setting{
+		{
+			env:   envSetting,
+			usage: "environment & active profile; local, development, staging or production",
+			set: func(cfg *Config, v string) error {
+				cfg.Environment = Env(v)
+				return nil
+			},
+			required: true,
+		},
+	}
+
+	s = append(s, networkSettings("L1", models.Layer1)...)
+	s = append(s, networkSettings("L2", models.Layer2)...)
+	s = append(s,
+		intSetting("RPC_RETRIES", "retries of every failed RPC call",
+			func(cfg *Config) *int { return &cfg.RPCBackoff.Retries }),
+		durationSetting("RPC_BACKOFF_BASE", "delay before the first retry of a failed RPC call; doubled per retry",
+			func(cfg *Config) *time.Duration { return &cfg.RPCBackoff.Base }),
+		durationSetting("RPC_BACKOFF_CAP", "upper bound on the delay between RPC call retries; 0 is unbounded",
+			func(cfg *Config) *time.Duration { return &cfg.RPCBackoff.Cap }),
+		floatSetting("RPC_BACKOFF_JITTER", "fraction of every RPC retry delay that's randomized; 0 through 1",
+			func(cfg *Config) *float64 { return &cfg.RPCBackoff.Jitter }),
+		intSetting("RPC_BATCH_SIZE", "blocks fetched per batched RPC request while backtesting; 1 disables batching",
+			func(cfg *Config) *int { return &cfg.RPCBatchSize }),
+		intSetting("RPC_CACHE_SIZE", "headers & blocks cached in process; 0 disables caching",
+			func(cfg *Config) *int { return &cfg.RPCCacheSize }),
+		durationSetting("RPC_HEADER_TIMEOUT", "upper bound on every header read; 0 is unbounded",
+			func(cfg *Config) *time.Duration { return &cfg.RPCTimeouts.Header }),
+		durationSetting("RPC_BLOCK_TIMEOUT", "upper bound on every block, receipt & log read; 0 is unbounded",
+			func(cfg *Config) *time.Duration { return &cfg.RPCTimeouts.Block }),
+		durationSetting("RPC_CALL_TIMEOUT", "upper bound on every contract call & state read; 0 is unbounded",
+			func(cfg *Config) *time.Duration { return &cfg.RPCTimeouts.Call }),
+		durationSetting("RPC_TRACE_TIMEOUT", "upper bound on every debug trace; 0 is unbounded",
+			func(cfg *Config) *time.Duration { return &cfg.RPCTimeouts.Trace }),
+	)
+
+	return append(s,
+		strSetting("PLUGIN_DIRECTORY", "directory of third-party register plugins (*.so)",
+			func(cfg *Config) *string { return &cfg.PluginDirectory }),
+		strSetting("CHECKPOINT_PATH", "file that oracle heights are persisted to",
+			func(cfg *Config) *string { return &cfg.CheckpointPath }),
+		durationSetting("POLL_INTERVAL", "period between oracle polls (E.G, 200ms)",
+			func(cfg *Config) *time.Duration { return &cfg.PollInterval }),
+		strSetting("PIPELINE_DEFINITIONS_PATH", "file listing pipelines to run at boot",
+			func(cfg *Config) *string { return &cfg.PipelineDefinitionsPath }),
+		intSetting("ENGINE_WORKERS", "number of workers assessing session inputs; 0 uses the number of CPUs",
+			func(cfg *Config) *int { return &cfg.EngineWorkers }),
+		strSetting("SESSION_BOOTSTRAP_PATH", "file listing invariant sessions to create at boot",
+			func(cfg *Config) *string { return &cfg.SessionBootstrapPath }),
+		strSetting("ALERT_CONFIG_PATH", "file configuring alert destinations & routing",
+			func(cfg *Config) *string { return &cfg.AlertConfigPath }),
+		strSetting("ALERT_HISTORY_PATH", "file that dispatched alerts are recorded to",
+			func(cfg *Config) *string { return &cfg.AlertHistoryPath }),
+
+		strSetting("API_HOST", "address the REST API server listens on",
+			func(cfg *Config) *string { return &cfg.APIHost }),
+		intSetting("API_PORT", "port the REST API server listens on",
+			func(cfg *Config) *int { return &cfg.APIPort }),
+		strSetting("API_KEYS", "comma separated role:key pairs",
+			func(cfg *Config) *string { return &cfg.APIKeys }),
+		intSetting("API_KEY_RATE_LIMIT", "requests allowed per minute for every API key; 0 disables the limit",
+			func(cfg *Config) *int { return &cfg.APIKeyRateLimit }),
+		intSetting("API_IP_RATE_LIMIT", "requests allowed per minute for every client IP; 0 disables the limit",
+			func(cfg *Config) *int { return &cfg.APIIPRateLimit }),
+
+		required(boolSetting("LOGGER_USE_CUSTOM", "use the custom logger settings",
+			func(cfg *Config) *bool { return &cfg.LoggerConfig.UseCustom })),
+		required(intSetting("LOGGER_LEVEL", "custom logger level; -1 (debug) through 5 (fatal)",
+			func(cfg *Config) *int { return &cfg.LoggerConfig.Level })),
+		required(boolSetting("LOGGER_DISABLE_CALLER", "omit callers from custom logger entries",
+			func(cfg *Config) *bool { return &cfg.LoggerConfig.DisableCaller })),
+		required(boolSetting("LOGGER_DISABLE_STACKTRACE", "omit stacktraces from custom logger entries",
+			func(cfg *Config) *bool { return &cfg.LoggerConfig.DisableStacktrace })),
+		required(strSetting("LOGGER_ENCODING", "custom logger encoding; json or console",
+			func(cfg *Config) *string { return &cfg.LoggerConfig.Encoding })),
+		required(sliceSetting("LOGGER_OUTPUT_PATHS", "comma separated custom logger output paths",
+			func(cfg *Config) *[]string { return &cfg.LoggerConfig.OutputPaths })),
+		required(sliceSetting("LOGGER_ERROR_OUTPUT_PATHS", "comma separated custom logger error output paths",
+			func(cfg *Config) *[]string { return &cfg.LoggerConfig.ErrorOutputPaths })),
+	)
+}
+
+// networkSettings ... Returns the <prefix>_RPC_ENDPOINT, <prefix>_WS_ENDPOINT, <prefix>_CHAIN_ID,
+// <prefix>_CONFIRMATION_DEPTH, <prefix>_RPC_RATE_LIMIT, <prefix>_RPC_BURST & <prefix>_POLL_INTERVAL settings
+// of the network
+func networkSettings(prefix string, network models.Network) []setting {
+	// update ... Applies the change to the network's settings; map values aren't addressable
+	update := func(cfg *Config, change func(nc *NetworkConfig) error) error {
+		nc := cfg.Networks[network]
+		if err := change(&nc); err != nil {
+			return err
+		}
+
+		cfg.Networks[network] = nc
+		return nil
+	}
+
+	return []setting{
+		{
+			env:   prefix + "_RPC_ENDPOINT",
+			usage: fmt.Sprintf("RPC endpoint of the %s network", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					nc.RPCEndpoint = v
+					return nil
+				})
+			},
+			required: true,
+		},
+		{
+			env:   prefix + "_WS_ENDPOINT",
+			usage: fmt.Sprintf("WebSocket RPC endpoint that live %s oracles subscribe to; empty polls", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					nc.WSEndpoint = v
+					return nil
+				})
+			},
+		},
+		{
+			env:   prefix + "_CHAIN_ID",
+			usage: fmt.Sprintf("chain ID that the %s endpoints must serve; 0 isn't verified", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					chainID, err := strconv.ParseUint(v, 10, 64)
+					if err != nil {
+						return fmt.Errorf("not a non-negative int: %s", v)
+					}
+
+					nc.ChainID = chainID
+					return nil
+				})
+			},
+		},
+		{
+			env:   prefix + "_CONFIRMATION_DEPTH",
+			usage: fmt.Sprintf("blocks behind the %s chain tip that live oracles read at", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					depth, err := strconv.ParseUint(v, 10, 64)
+					if err != nil {
+						return fmt.Errorf("not a non-negative int: %s", v)
+					}
+
+					nc.ConfirmationDepth = depth
+					return nil
+				})
+			},
+		},
+		{
+			env:   prefix + "_RPC_RATE_LIMIT",
+			usage: fmt.Sprintf("requests per second sent to the %s RPC endpoint; 0 is unlimited", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					rate, err := strconv.ParseFloat(v, 64)
+					if err != nil {
+						return fmt.Errorf("not a number: %s", v)
+					}
+
+					nc.RateLimit.PerSecond = rate
+					return nil
+				})
+			},
+		},
+		{
+			env:   prefix + "_RPC_BURST",
+			usage: fmt.Sprintf("requests sent to the %s RPC endpoint at once; 0 is 1", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					burst, err := strconv.Atoi(v)
+					if err != nil {
+						return fmt.Errorf("not an int: %s", v)
+					}
+
+					nc.RateLimit.Burst = burst
+					return nil
+				})
+			},
+		},
+		{
+			env:   prefix + "_POLL_INTERVAL",
+			usage: fmt.Sprintf("period between %s oracle polls; 0 follows the poll interval", network),
+			set: func(cfg *Config, v string) error {
+				return update(cfg, func(nc *NetworkConfig) error {
+					return setDuration(&nc.PollInterval, v)
+				})
+			},
+		},
+	}
+}
+
+// required ... Marks the setting as one that env files must set
+func required(s setting) setting {
+	s.required = true
+	return s
+}
+
+// strSetting ... Setting of a string field
+func strSetting(env, usage string, field func(cfg *Config) *string) setting {
+	return setting{env: env, usage: usage, set: func(cfg *Config, v string) error {
+		*field(cfg) = v
+		return nil
+	}}
+}
+
+// intSetting ... Setting of an int field
+func intSetting(env, usage string, field func(cfg *Config) *int) setting {
+	return setting{env: env, usage: usage, set: func(cfg *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("not an int: %s", v)
+		}
+
+		*field(cfg) = n
+		return nil
+	}}
+}
+
+// floatSetting ... Setting of a float field
+func floatSetting(env, usage string, field func(cfg *Config) *float64) setting {
+	return setting{env: env, usage: usage, set: func(cfg *Config, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("not a number: %s", v)
+		}
+
+		*field(cfg) = f
+		return nil
+	}}
+}
+
+// boolSetting ... Setting of a bool field; 1 & true are true, 0 & false are false
+func boolSetting(env, usage string, field func(cfg *Config) *bool) setting {
+	return setting{env: env, usage: usage, set: func(cfg *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("not a bool: %s", v)
+		}
+
+		*field(cfg) = b
+		return nil
+	}}
+}
+
+// durationSetting ... Setting of a duration field; values are Go duration strings (E.G, 200ms)
+func durationSetting(env, usage string, field func(cfg *Config) *time.Duration) setting {
+	return setting{env: env, usage: usage, set: func(cfg *Config, v string) error {
+		return setDuration(field(cfg), v)
+	}}
+}
+
+// sliceSetting ... Setting of a string slice field; values are comma separated
+func sliceSetting(env, usage string, field func(cfg *Config) *[]string) setting {
+	return setting{env: env, usage: usage, set: func(cfg *Config, v string) error {
+		*field(cfg) = strings.Split(v, ",")
+		return nil
+	}}
+}
+
+// setDuration ... Parses the Go duration string into the field
+func setDuration(field *time.Duration, v string) error {
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("not a duration: %s", v)
+	}
+
+	*field = d
+	return nil
+}
+
+// Flags ... Command line flags overriding config values; take precedence over env vars, which take
+// precedence over config files, which take precedence over defaults
+type Flags struct {
+	values map[string]*string
+	fs     *pflag.FlagSet
+}
+
+// RegisterFlags ... Registers a flag for every setting on the flag set (E.G, --api-port overrides API_PORT)
+func RegisterFlags(fs *pflag.FlagSet) *Flags {
+	f := &Flags{values: make(map[string]*string, len(settings)), fs: fs}
+	for _, s := range settings {
+		f.values[s.env] = fs.String(s.flagName(), "", fmt.Sprintf("%s; overrides %s", s.usage, s.env))
+	}
+
+	return f
+}
+
+// lookup ... Returns the value of the setting's flag; false if the flag wasn't set or the flags are nil
+func (f *Flags) lookup(s setting) (string, bool) {
+	if f == nil || !f.fs.Changed(s.flagName()) {
+		return "", false
+	}
+
+	return *f.values[s.env], true
+}
+
+// apply ... Applies every setting whose value is set by a flag or, when env is true, an env var; flags take
+// precedence. Fail with every malformed value
+func (f *Flags) apply(cfg *Config, env bool) error {
+	var p problems
+	for _, s := range settings {
+		source, value, found := "flag --"+s.flagName(), "", false
+		if value, found = f.lookup(s); !found && env {
+			source = "env var " + s.env
+			value, found = os.LookupEnv(s.env)
+		}
+
+		if !found {
+			continue
+		}
+
+		if err := s.set(cfg, value); err != nil {
+			p.addf("invalid %s: %s", source, err)
+		}
+	}
+
+	return p.err()
+}
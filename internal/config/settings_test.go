@@ -0,0 +1,343 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+// envFile ... Env file setting every setting but L2_RPC_ENDPOINT
+const envFile = `
+ENV=local
+L1_RPC_ENDPOINT=http://l1:8545
+L1_WS_ENDPOINT=""
+L2_WS_ENDPOINT=""
+L1_CHAIN_ID=0
+L2_CHAIN_ID=0
+L1_CONFIRMATION_DEPTH=0
+L1_RPC_RATE_LIMIT=0
+L1_RPC_BURST=0
+L1_POLL_INTERVAL=0
+L2_CONFIRMATION_DEPTH=0
+L2_RPC_RATE_LIMIT=0
+L2_RPC_BURST=0
+L2_POLL_INTERVAL=0
+RPC_RETRIES=3
+RPC_BACKOFF_BASE=100ms
+RPC_BACKOFF_CAP=5s
+RPC_BACKOFF_JITTER=0.5
+RPC_BATCH_SIZE=10
+RPC_CACHE_SIZE=128
+RPC_HEADER_TIMEOUT=5s
+RPC_BLOCK_TIMEOUT=10s
+RPC_CALL_TIMEOUT=10s
+RPC_TRACE_TIMEOUT=30s
+PLUGIN_DIRECTORY=""
+CHECKPOINT_PATH=""
+POLL_INTERVAL=200ms
+PIPELINE_DEFINITIONS_PATH=""
+ENGINE_WORKERS=0
+SESSION_BOOTSTRAP_PATH=""
+ALERT_CONFIG_PATH=""
+ALERT_HISTORY_PATH=""
+API_HOST=localhost
+API_PORT=8080
+API_KEYS=""
+API_KEY_RATE_LIMIT=600
+API_IP_RATE_LIMIT=300
+LOGGER_USE_CUSTOM=0
+LOGGER_LEVEL=-1
+LOGGER_DISABLE_CALLER=0
+LOGGER_DISABLE_STACKTRACE=0
+LOGGER_ENCODING=console
+LOGGER_OUTPUT_PATHS=stderr
+LOGGER_ERROR_OUTPUT_PATHS=stderr
+`
+
+// isolateEnv ... Unsets every setting's env var for the duration of the test
+func isolateEnv(t *testing.T) {
+	for _, s := range settings {
+		t.Setenv(s.env, "")
+		assert.NoError(t, os.Unsetenv(s.env))
+	}
+}
+
+func Test_Load_Precedence(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		file  string
+		env   map[string]string
+		flags []string
+		check func(t *testing.T, cfg *Config)
+	}{
+		{
+			name:        "Defaults",
+			description: "Settings omitted everywhere should take their defaults",
+			file:        "config.yaml",
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 8080, cfg.APIPort)
+			},
+		},
+		{
+			name:        "File",
+			description: "Files should take precedence over defaults",
+			file:        "config.yaml",
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "0.0.0.0", cfg.APIHost)
+			},
+		},
+		{
+			name:        "Env Over File",
+			description: "Env vars should take precedence over files",
+			file:        "config.yaml",
+			env:         map[string]string{"API_HOST": "10.0.0.1", "L1_CONFIRMATION_DEPTH": "12"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "10.0.0.1", cfg.APIHost)
+				assert.Equal(t, NetworkConfig{RPCEndpoint: "http://l1:8545", ConfirmationDepth: 12},
+					cfg.Networks[models.Layer1], "Ensuring other network settings are kept")
+			},
+		},
+		{
+			name:        "Flag Over Env",
+			description: "Flags should take precedence over env vars",
+			file:        "config.yaml",
+			env:         map[string]string{"API_HOST": "10.0.0.1"},
+			flags:       []string{"--api-host", "10.0.0.2", "--logger-use-custom=true"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "10.0.0.2", cfg.APIHost)
+				assert.True(t, cfg.LoggerConfig.UseCustom)
+			},
+		},
+		{
+			name:        "Register Params",
+			description: "Register params env vars should take precedence over files per param",
+			file:        "config.yaml",
+			env:         map[string]string{"REGISTER_PARAMS_CONTRACT_CREATION_RATE": `{"max_creations": 10}`},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, map[models.RegisterType]models.Params{
+					"CONTRACT_CREATION_RATE": {"window": 100, "max_creations": float64(10)},
+				}, cfg.RegisterParams)
+			},
+		},
+		{
+			name:        "Flag Over Env File",
+			description: "Flags should take precedence over env files & replace the env vars they override",
+			file:        "config.env",
+			flags:       []string{"--api-port", "9090", "--l2-rpc-endpoint", "http://l2:8545"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 9090, cfg.APIPort)
+				assert.Equal(t, "http://l1:8545", cfg.Networks[models.Layer1].RPCEndpoint)
+				assert.Equal(t, "http://l2:8545", cfg.Networks[models.Layer2].RPCEndpoint)
+			},
+		},
+	}
+
+	files := map[string]string{
+		"config.yaml": "networks:\n  layer1:\n    rpc_endpoint: http://l1:8545\napi:\n  host: 0.0.0.0\n" +
+			"registers:\n  CONTRACT_CREATION_RATE:\n    window: 100\n    max_creations: 5\n",
+		// L2_RPC_ENDPOINT is omitted so that the flag overriding it is required
+		"config.env": envFile,
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			isolateEnv(t)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			path := filepath.Join(t.TempDir(), tc.file)
+			assert.NoError(t, os.WriteFile(path, []byte(files[tc.file]), 0o600))
+
+			fs := pflag.NewFlagSet("pessimism", pflag.ContinueOnError)
+			flags := RegisterFlags(fs)
+			assert.NoError(t, fs.Parse(tc.flags))
+
+			cfg, err := Load(path, flags)
+			assert.NoError(t, err)
+			tc.check(t, cfg)
+		})
+	}
+}
+
+func Test_Load_Problems(t *testing.T) {
+	isolateEnv(t)
+	t.Setenv("API_PORT", "http")
+	t.Setenv("L1_CONFIRMATION_DEPTH", "-1")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	fs := pflag.NewFlagSet("pessimism", pflag.ContinueOnError)
+	flags := RegisterFlags(fs)
+	assert.NoError(t, fs.Parse([]string{"--poll-interval", "often"}))
+
+	_, err := Load(path, flags)
+	var ve *ValidationError
+	assert.ErrorAs(t, err, &ve)
+	assert.Len(t, ve.Problems, 3, "Ensuring every malformed value is reported")
+}
+
+func Test_Load_Precedence_SameKey(t *testing.T) {
+	// Every layer sets API_PORT to a distinct value so that the winning layer is identifiable
+	files := map[string]string{
+		"config.yaml": "api:\n  port: 7000\n",
+		"config.env": strings.Replace(envFile, "API_PORT=8080", "API_PORT=7000", 1) +
+			"L2_RPC_ENDPOINT=http://l2:8545\n",
+		// Only sets the required settings
+		"minimal.env": "ENV=local\nL1_RPC_ENDPOINT=http://l1:8545\nL2_RPC_ENDPOINT=http://l2:8545\n" +
+			"LOGGER_USE_CUSTOM=0\nLOGGER_LEVEL=-1\nLOGGER_DISABLE_CALLER=0\nLOGGER_DISABLE_STACKTRACE=0\n" +
+			"LOGGER_ENCODING=console\nLOGGER_OUTPUT_PATHS=stderr\nLOGGER_ERROR_OUTPUT_PATHS=stderr\n",
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		file     string
+		env      bool
+		flag     bool
+		expected int
+	}{
+		{
+			name:        "YAML Flag",
+			description: "Flags should win over env vars & YAML files",
+
+			file:     "config.yaml",
+			env:      true,
+			flag:     true,
+			expected: 9000,
+		},
+		{
+			name:        "YAML Env",
+			description: "Env vars should win over YAML files",
+
+			file:     "config.yaml",
+			env:      true,
+			expected: 8000,
+		},
+		{
+			name:        "YAML File",
+			description: "YAML files should win over defaults",
+
+			file:     "config.yaml",
+			expected: 7000,
+		},
+		{
+			name:        "YAML Default",
+			description: "Defaults should apply when no layer sets the key",
+
+			expected: 8080,
+		},
+		{
+			name:        "Env File Flag",
+			description: "Flags should win over env vars & env files",
+
+			file:     "config.env",
+			env:      true,
+			flag:     true,
+			expected: 9000,
+		},
+		{
+			name:        "Env File Env",
+			description: "Env vars should win over env files",
+
+			file:     "config.env",
+			env:      true,
+			expected: 8000,
+		},
+		{
+			name:        "Env File",
+			description: "Env files should apply when nothing overrides them",
+
+			file:     "config.env",
+			expected: 7000,
+		},
+		{
+			name:        "Env File Default",
+			description: "Defaults should apply when no layer of an env file config sets the key",
+
+			file:     "minimal.env",
+			expected: 8080,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			isolateEnv(t)
+			if tc.env {
+				t.Setenv("API_PORT", "8000")
+			}
+
+			// Defaults are read through an empty YAML file
+			name, content := "config.yaml", ""
+			if tc.file != "" {
+				name, content = tc.file, files[tc.file]
+			}
+
+			path := filepath.Join(t.TempDir(), name)
+			assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			fs := pflag.NewFlagSet("pessimism", pflag.ContinueOnError)
+			flags := RegisterFlags(fs)
+
+			args := []string{}
+			if tc.flag {
+				args = append(args, "--api-port", "9000")
+			}
+			assert.NoError(t, fs.Parse(args))
+
+			cfg, err := Load(path, flags)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg.APIPort)
+		})
+	}
+}
+
+func Test_Load_BaselineEnvFile(t *testing.T) {
+	// Env file only setting the variables of the original config.env.template
+	baseline := `
+L1_RPC_ENDPOINT=http://l1:8545
+L2_RPC_ENDPOINT=http://l2:8545
+ENV=local
+LOGGER_USE_CUSTOM=0
+LOGGER_LEVEL=-1
+LOGGER_DISABLE_CALLER=0
+LOGGER_DISABLE_STACKTRACE=0
+LOGGER_ENCODING=console
+LOGGER_OUTPUT_PATHS=stderr
+LOGGER_ERROR_OUTPUT_PATHS=stderr
+`
+
+	isolateEnv(t)
+	path := filepath.Join(t.TempDir(), "config.env")
+	assert.NoError(t, os.WriteFile(path, []byte(baseline), 0o600))
+
+	cfg, err := Load(path, nil)
+	assert.NoError(t, err)
+
+	expected := defaultFile().config()
+	expected.Networks = map[models.Network]NetworkConfig{
+		models.Layer1: {RPCEndpoint: "http://l1:8545"},
+		models.Layer2: {RPCEndpoint: "http://l2:8545"},
+	}
+	assert.Equal(t, expected, cfg, "Ensuring omitted settings take their defaults")
+
+	// Only required settings are reported as missing
+	assert.NoError(t, os.WriteFile(path, []byte(strings.Replace(baseline, "L2_RPC_ENDPOINT=http://l2:8545\n", "", 1)),
+		0o600))
+	isolateEnv(t)
+
+	_, err = Load(path, nil)
+	var ve *ValidationError
+	assert.ErrorAs(t, err, &ve)
+	assert.Equal(t, []string{"could not find env var given key: L2_RPC_ENDPOINT"}, ve.Problems)
+}
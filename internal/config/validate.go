@@ -0,0 +1,228 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+const (
+	// minLoggerLevel & maxLoggerLevel ... Range of zap levels; debug through fatal
+	minLoggerLevel = -1
+	maxLoggerLevel = 5
+)
+
+// ValidationError ... Every problem found while validating a config
+type ValidationError struct {
+	Problems []string
+}
+
+// Error ... Lists every problem
+func (ve *ValidationError) Error() string {
+	return fmt.Sprintf("%d config problem(s): %s", len(ve.Problems), strings.Join(ve.Problems, "; "))
+}
+
+// problems ... Accumulates problems so that all of them are reported at once
+type problems []string
+
+// addf ... Records a problem
+func (p *problems) addf(format string, args ...any) {
+	*p = append(*p, fmt.Sprintf(format, args...))
+}
+
+// err ... Returns a ValidationError listing every problem; nil if there are none
+func (p problems) err() error {
+	if len(p) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Problems: p}
+}
+
+// ValidateEndpoint ... Ensures the endpoint is an HTTP, WebSocket or IPC URL
+func ValidateEndpoint(endpoint string) error {
+	if client.IsIPC(endpoint) {
+		if strings.TrimPrefix(endpoint, client.IPCScheme) == "" {
+			return errors.New("no socket path")
+		}
+
+		return nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return errors.New("no host")
+	}
+
+	return nil
+}
+
+// ValidateWSEndpoint ... Ensures the endpoint is a WebSocket URL
+func ValidateWSEndpoint(endpoint string) error {
+	if err := ValidateEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	if u, _ := url.Parse(endpoint); u.Scheme != "ws" && u.Scheme != "wss" {
+		return fmt.Errorf("unsupported scheme %q; ws or wss is required", u.Scheme)
+	}
+
+	return nil
+}
+
+// Validate ... Checks every setting & returns a ValidationError listing all problems found
+func (cfg *Config) Validate() error {
+	var p problems
+
+	switch cfg.Environment {
+	case Local, Development, Staging, Production:
+	default:
+		p.addf("environment must be one of local, development, staging or production; got %q", cfg.Environment)
+	}
+
+	for _, network := range []models.Network{models.Layer1, models.Layer2} {
+		if _, found := cfg.Networks[network]; !found {
+			p.addf("%s network is required", network)
+		}
+	}
+
+	networks := make([]models.Network, 0, len(cfg.Networks))
+	for network := range cfg.Networks {
+		networks = append(networks, network)
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i] < networks[j] })
+
+	for _, network := range networks {
+		nc := cfg.Networks[network]
+		if network == "" {
+			p.addf("network names must not be empty")
+		}
+
+		if nc.RPCEndpoint == "" {
+			p.addf("%s RPC endpoint is required", network)
+		} else if err := ValidateEndpoint(nc.RPCEndpoint); err != nil {
+			p.addf("%s RPC endpoint is invalid: %s", network, err)
+		}
+
+		if nc.WSEndpoint != "" {
+			if err := ValidateWSEndpoint(nc.WSEndpoint); err != nil {
+				p.addf("%s WS endpoint is invalid: %s", network, err)
+			}
+		}
+
+		if nc.PollInterval < 0 {
+			p.addf("%s poll interval must not be negative; got %s", network, nc.PollInterval)
+		}
+
+		if err := nc.RateLimit.Validate(); err != nil {
+			p.addf("%s RPC rate limit is invalid: %s", network, err)
+		}
+	}
+
+	if cfg.PollInterval <= 0 {
+		p.addf("poll interval must be positive; got %s", cfg.PollInterval)
+	}
+
+	if err := cfg.RPCBackoff.Validate(); err != nil {
+		p.addf("invalid RPC backoff: %s", err)
+	}
+
+	if err := cfg.RPCTimeouts.Validate(); err != nil {
+		p.addf("invalid RPC timeouts: %s", err)
+	}
+
+	if cfg.APIHost == "" {
+		p.addf("API host is required")
+	}
+
+	if cfg.APIPort < 1 || cfg.APIPort > 65535 {
+		p.addf("API port must be between 1 & 65535; got %d", cfg.APIPort)
+	}
+
+	// Listed in order so that problems are reported in a stable order
+	for _, count := range []struct {
+		name string
+		n    int
+	}{
+		{"API key rate limit", cfg.APIKeyRateLimit},
+		{"API IP rate limit", cfg.APIIPRateLimit},
+		{"engine workers", cfg.EngineWorkers},
+		{"RPC retries", cfg.RPCBackoff.Retries},
+		{"RPC batch size", cfg.RPCBatchSize},
+		{"RPC cache size", cfg.RPCCacheSize},
+	} {
+		if count.n < 0 {
+			p.addf("%s must not be negative; got %d", count.name, count.n)
+		}
+	}
+
+	// Both files are written to, so sharing one would corrupt it
+	if cfg.CheckpointPath != "" && cfg.AlertHistoryPath != "" &&
+		filepath.Clean(cfg.CheckpointPath) == filepath.Clean(cfg.AlertHistoryPath) {
+		p.addf("checkpoint path & alert history path must be different files; both are %s", cfg.CheckpointPath)
+	}
+
+	if lc := cfg.LoggerConfig; lc != nil && lc.UseCustom {
+		if lc.Level < minLoggerLevel || lc.Level > maxLoggerLevel {
+			p.addf("logger level must be between %d & %d; got %d", minLoggerLevel, maxLoggerLevel, lc.Level)
+		}
+
+		if lc.Encoding != "json" && lc.Encoding != "console" {
+			p.addf("logger encoding must be json or console; got %q", lc.Encoding)
+		}
+	}
+
+	return p.err()
+}
+
+// Validate ... Checks the height range, retries, batch size & poll interval and returns a ValidationError listing all
+// problems found
+func (oc *OracleConfig) Validate() error {
+	var p problems
+
+	if oc.StartHeight != nil && oc.StartHeight.Sign() < 0 {
+		p.addf("start height must not be negative; got %s", oc.StartHeight)
+	}
+
+	switch {
+	case oc.EndHeight != nil && oc.StartHeight == nil:
+		p.addf("end height %s requires a start height", oc.EndHeight)
+
+	case oc.EndHeight != nil && oc.StartHeight.Cmp(oc.EndHeight) > 0:
+		p.addf("start height %s exceeds end height %s", oc.StartHeight, oc.EndHeight)
+	}
+
+	if oc.NumOfRetries < 0 {
+		p.addf("number of retries must not be negative; got %d", oc.NumOfRetries)
+	}
+
+	if oc.BatchSize < 0 {
+		p.addf("batch size must not be negative; got %d", oc.BatchSize)
+	}
+
+	if oc.FetchBatchSize < 0 {
+		p.addf("fetch batch size must not be negative; got %d", oc.FetchBatchSize)
+	}
+
+	if oc.PollInterval < 0 {
+		p.addf("poll interval must not be negative; got %s", oc.PollInterval)
+	}
+
+	return p.err()
+}
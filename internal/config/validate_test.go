@@ -0,0 +1,191 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// validConfig ... Returns a config without problems
+func validConfig() *Config {
+	return &Config{
+		Networks: map[models.Network]NetworkConfig{
+			models.Layer1: {RPCEndpoint: "http://l1:8545", ConfirmationDepth: 2},
+			models.Layer2: {RPCEndpoint: "wss://l2:8546", PollInterval: time.Second},
+		},
+		Environment:     Local,
+		PollInterval:    time.Second,
+		APIHost:         "localhost",
+		APIPort:         8080,
+		APIKeyRateLimit: 600,
+		LoggerConfig:    &logging.Config{UseCustom: true, Level: -1, Encoding: "console"},
+	}
+}
+
+func Test_Config_Validate(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		modify   func(cfg *Config)
+		problems int
+	}{
+		{
+			name:        "Valid",
+			description: "Configs without problems should pass",
+			modify:      func(*Config) {},
+		},
+		{
+			name:        "Missing Endpoints",
+			description: "Both RPC endpoints should be required",
+			modify: func(cfg *Config) {
+				cfg.Networks[models.Layer1] = NetworkConfig{}
+				cfg.Networks[models.Layer2] = NetworkConfig{}
+			},
+			problems: 2,
+		},
+		{
+			name:        "Missing Network",
+			description: "Layer 1 & 2 networks should be required",
+			modify: func(cfg *Config) {
+				delete(cfg.Networks, models.Layer2)
+			},
+			problems: 1,
+		},
+		{
+			name:        "Additional Network",
+			description: "Networks other than layer 1 & 2 should be validated like them",
+			modify: func(cfg *Config) {
+				cfg.Networks["base"] = NetworkConfig{RPCEndpoint: "l3:8545", PollInterval: -time.Second}
+			},
+			problems: 2,
+		},
+		{
+			name:        "Invalid Endpoint",
+			description: "RPC endpoints should be HTTP, WebSocket or IPC URLs",
+			modify: func(cfg *Config) {
+				cfg.Networks[models.Layer1] = NetworkConfig{RPCEndpoint: "l1:8545"}
+			},
+			problems: 1,
+		},
+		{
+			name:        "IPC Endpoint",
+			description: "RPC endpoints should be IPC socket paths when co-located with the node",
+			modify: func(cfg *Config) {
+				cfg.Networks[models.Layer1] = NetworkConfig{RPCEndpoint: "ipc:///var/run/geth.ipc"}
+				cfg.Networks[models.Layer2] = NetworkConfig{RPCEndpoint: "ipc://"}
+			},
+			problems: 1,
+		},
+		{
+			name:        "Invalid WS Endpoint",
+			description: "WS endpoints should be WebSocket URLs when set",
+			modify: func(cfg *Config) {
+				cfg.Networks[models.Layer1] = NetworkConfig{RPCEndpoint: "http://l1:8545", WSEndpoint: "http://l1:8546"}
+			},
+			problems: 1,
+		},
+		{
+			name:        "Shared File",
+			description: "Checkpoints & alert history should be written to different files",
+			modify: func(cfg *Config) {
+				cfg.CheckpointPath, cfg.AlertHistoryPath = "data/state.json", "./data/state.json"
+			},
+			problems: 1,
+		},
+		{
+			name:        "Many Problems",
+			description: "Every problem should be reported at once",
+			modify: func(cfg *Config) {
+				cfg.Environment = "qa"
+				cfg.APIPort = 0
+				cfg.APIIPRateLimit = -1
+				cfg.LoggerConfig.Encoding = "xml"
+			},
+			problems: 4,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			cfg := validConfig()
+			tc.modify(cfg)
+
+			err := cfg.Validate()
+			if tc.problems == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var ve *ValidationError
+			assert.True(t, errors.As(err, &ve))
+			assert.Len(t, ve.Problems, tc.problems, ve.Error())
+		})
+	}
+}
+
+func Test_Config_Validate_Order(t *testing.T) {
+	cfg := validConfig()
+	cfg.RPCCacheSize, cfg.EngineWorkers, cfg.APIKeyRateLimit = -1, -2, -3
+
+	expected := []string{
+		"API key rate limit must not be negative; got -3",
+		"engine workers must not be negative; got -2",
+		"RPC cache size must not be negative; got -1",
+	}
+
+	// Map iteration order is randomized, so a single run could pass by chance
+	for i := 0; i < 10; i++ {
+		var ve *ValidationError
+		assert.True(t, errors.As(cfg.Validate(), &ve))
+		assert.Equal(t, expected, ve.Problems, "Ensuring problems are reported in a stable order")
+	}
+}
+
+func Test_OracleConfig_Validate(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		cfg      OracleConfig
+		problems int
+	}{
+		{
+			name:        "Bounded Range",
+			description: "Start heights at or before end heights should pass",
+			cfg:         OracleConfig{StartHeight: big.NewInt(1), EndHeight: big.NewInt(1)},
+		},
+		{
+			name:        "Inverted Range",
+			description: "Start heights after end heights should fail",
+			cfg:         OracleConfig{StartHeight: big.NewInt(2), EndHeight: big.NewInt(1)},
+			problems:    1,
+		},
+		{
+			name:        "Unbounded Start",
+			description: "End heights without a start height should fail",
+			cfg:         OracleConfig{EndHeight: big.NewInt(1), NumOfRetries: -1},
+			problems:    2,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.problems == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var ve *ValidationError
+			assert.True(t, errors.As(err, &ve))
+			assert.Len(t, ve.Problems, tc.problems, ve.Error())
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// backtestPollInterval ... Interval at which the state of a backtest's pipeline is checked for completion
+	backtestPollInterval = 100 * time.Millisecond
+	// defaultBacktestSettle ... Quiet period after a backtest's pipeline terminates before the backtest
+	// completes; allows data still in transit through the pipeline's components to be assessed
+	defaultBacktestSettle = time.Second
+)
+
+// BacktestRequest ... User facing description of an invariant backtest
+type BacktestRequest struct {
+	Invariant invariant.Type `json:"invariant"`
+	// Params passed to the invariant constructor
+	Params models.Params `json:"params,omitempty"`
+	// Optional; overrides the invariant's default severity
+	Severity invariant.Severity `json:"severity,omitempty"`
+
+	// Pipeline producing the assessed data; requires a start & end height, the oracle type defaults to
+	// a backtest oracle and the register type defaults to the invariant's input type
+	Pipeline etl.PipelineRequest `json:"pipeline"`
+}
+
+// BacktestReport ... Outcome of running an invariant against a historical height range
+type BacktestReport struct {
+	ID          SessionID          `json:"id"`
+	Invariant   invariant.Type     `json:"invariant"`
+	Params      models.Params      `json:"params,omitempty"`
+	Severity    invariant.Severity `json:"severity"`
+	Network     models.Network     `json:"network"`
+	StartHeight *big.Int           `json:"start_height"`
+	EndHeight   *big.Int           `json:"end_height"`
+	Started     time.Time          `json:"started"`
+	Finished    time.Time          `json:"finished"`
+
+	// Number of items assessed and the number of those that couldn't be assessed
+	Assessed int `json:"assessed"`
+	Errors   int `json:"errors"`
+	// Every invalidation that would have fired in the order raised; cooldowns aren't applied
+	Invalidations []Invalidation `json:"invalidations"`
+}
+
+// Backtest ... Runs the invariant against the pipeline's historical height range and reports every
+// invalidation that would have fired; blocks until the pipeline has been fully read or the context
+// is done. Backtests don't create sessions, don't emit invalidations and keep their state apart
+// from the engine's store
+func (e *Engine) Backtest(ctx context.Context, req BacktestRequest) (*BacktestReport, error) {
+	if req.Pipeline.OracleType == "" {
+		req.Pipeline.OracleType = pipeline.BacktestOracle
+	}
+
+	if req.Pipeline.OracleType != pipeline.BacktestOracle {
+		return nil, fmt.Errorf("backtests require a %s oracle; got %s", pipeline.BacktestOracle,
+			req.Pipeline.OracleType)
+	}
+
+	if req.Pipeline.StartHeight == nil || req.Pipeline.EndHeight == nil {
+		return nil, fmt.Errorf("backtests require a start & end height")
+	}
+
+	inv, severity, err := e.prepare(req.Invariant, req.Params, req.Severity, &req.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, correlated := inv.(invariant.Correlated); correlated {
+		return nil, fmt.Errorf("%s invariant is correlated; correlated invariants can't be backtested",
+			req.Invariant)
+	}
+
+	if stateful, ok := inv.(invariant.Stateful); ok {
+		stateful.SetState(state.NewMemoryStore())
+	}
+
+	output := make(chan models.TransitData)
+
+	pID, err := e.submitBacktest(req.Pipeline, output)
+	if err != nil {
+		return nil, err
+	}
+	defer e.removePipeline(pID)
+
+	s := &Session{
+		ID:         SessionID(uuid.NewString()),
+		Invariant:  req.Invariant,
+		Params:     req.Params,
+		Severity:   severity,
+		Network:    req.Pipeline.Network,
+		PipelineID: pID,
+		Created:    time.Now(),
+		inv:        inv,
+	}
+
+	logging.WithContext(e.ctx).Info("Started invariant backtest",
+		zap.String("backtest", string(s.ID)), zap.String("invariant", string(s.Invariant)),
+		zap.Int("pipeline", int(pID)))
+
+	report := &BacktestReport{
+		ID:            s.ID,
+		Invariant:     s.Invariant,
+		Params:        s.Params,
+		Severity:      s.Severity,
+		Network:       s.Network,
+		StartHeight:   req.Pipeline.StartHeight,
+		EndHeight:     req.Pipeline.EndHeight,
+		Started:       s.Created,
+		Invalidations: make([]Invalidation, 0),
+	}
+
+	if err = e.drain(ctx, s, output, report); err != nil {
+		return nil, err
+	}
+
+	report.Finished = time.Now()
+	return report, nil
+}
+
+// submitBacktest ... Submits the backtest's pipeline; fails once the engine is shut down
+func (e *Engine) submitBacktest(pr etl.PipelineRequest, output chan models.TransitData) (etl.PipelineID, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.ctx.Err() != nil {
+		return 0, fmt.Errorf("engine is shut down")
+	}
+
+	return e.pipelines.SubmitPipeline(pr, output)
+}
+
+// drain ... Assesses the pipeline's output until the pipeline has terminated and no data has been read
+// for the settle period
+func (e *Engine) drain(ctx context.Context, s *Session, output chan models.TransitData,
+	report *BacktestReport) error {
+	ticker := time.NewTicker(backtestPollInterval)
+	defer ticker.Stop()
+
+	// Nil until the pipeline terminates
+	var settled <-chan time.Time
+
+	for {
+		select {
+		case td := <-output:
+			for _, item := range models.Unbatch(td) {
+				e.record(s, item, report)
+			}
+
+			if settled != nil {
+				settled = time.After(e.backtestSettle)
+			}
+
+		case <-ticker.C:
+			if settled != nil {
+				continue
+			}
+
+			ps, err := e.pipelines.GetState(s.PipelineID)
+			if err != nil {
+				return err
+			}
+
+			if ps == models.TerminatedState {
+				settled = time.After(e.backtestSettle)
+			}
+
+		case <-settled:
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-e.ctx.Done():
+			return fmt.Errorf("engine is shut down")
+		}
+	}
+}
+
+// record ... Assesses a single item and adds the outcome to the report
+func (e *Engine) record(s *Session, td models.TransitData, report *BacktestReport) {
+	report.Assessed++
+
+	outcome, err := s.invalidate(td)
+	if err != nil {
+		report.Errors++
+		logging.WithContext(e.ctx).Debug("Could not assess invariant during backtest",
+			zap.String("backtest", string(s.ID)), zap.Error(err))
+		return
+	}
+
+	if outcome != nil {
+		report.Invalidations = append(report.Invalidations, s.invalidation(td, outcome, time.Now()))
+	}
+}
@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Engine_Backtest(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	pm := &pipelinesMock{}
+	e := NewEngine(context.Background(), pm, nil)
+	e.backtestSettle = 50 * time.Millisecond
+	defer e.Shutdown()
+
+	req := BacktestRequest{
+		Invariant: registry.LargeTxValue,
+		Params:    models.Params{registry.ThresholdParam: 100},
+		Pipeline: etl.PipelineRequest{
+			Network:     models.Layer1,
+			StartHeight: big.NewInt(1),
+			EndHeight:   big.NewInt(3),
+		},
+	}
+
+	type result struct {
+		report *BacktestReport
+		err    error
+	}
+
+	results := make(chan result)
+	go func() {
+		report, err := e.Backtest(context.Background(), req)
+		results <- result{report, err}
+	}()
+
+	to := common.HexToAddress("0x1")
+	transfer := func(height int64, value int64) models.TransitData {
+		return models.TransitData{
+			Type:   conduit.AddressWatchTX,
+			Value:  types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(value)}),
+			Height: big.NewInt(height),
+		}
+	}
+
+	output := pm.output(t, 0)
+	output <- transfer(1, 100)
+	output <- models.TransitData{Type: conduit.AddressWatchTX, Value: "malformed", Height: big.NewInt(2)}
+	pm.setState(1, models.TerminatedState)
+
+	// Data read after the pipeline terminates should still be assessed
+	output <- transfer(3, 200)
+
+	var res result
+	select {
+	case res = <-results:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the backtest to complete")
+	}
+
+	assert.NoError(t, res.err)
+	assert.Equal(t, 3, res.report.Assessed)
+	assert.Equal(t, 1, res.report.Errors)
+	assert.Equal(t, big.NewInt(1), res.report.StartHeight)
+	assert.Equal(t, big.NewInt(3), res.report.EndHeight)
+
+	assert.Len(t, res.report.Invalidations, 2)
+	assert.Equal(t, big.NewInt(1), res.report.Invalidations[0].Height)
+	assert.Equal(t, big.NewInt(3), res.report.Invalidations[1].Height)
+	assert.Equal(t, res.report.ID, res.report.Invalidations[0].SessionID)
+
+	assert.Equal(t, pipeline.BacktestOracle, pm.requests[0].OracleType)
+	assert.Equal(t, conduit.AddressWatchTX, pm.requests[0].RegisterType)
+	assert.Equal(t, []etl.PipelineID{1}, pm.removed)
+	assert.Empty(t, e.Sessions(), "Ensuring backtests don't create sessions")
+}
+
+func Test_Engine_Backtest_Invalid(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	heights := etl.PipelineRequest{StartHeight: big.NewInt(1), EndHeight: big.NewInt(2)}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		req BacktestRequest
+	}{
+		{
+			name:        "Live Oracle",
+			description: "Backtests should be rejected for non backtest oracles",
+			req: BacktestRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 100},
+				Pipeline: etl.PipelineRequest{OracleType: pipeline.LiveOracle,
+					StartHeight: big.NewInt(1), EndHeight: big.NewInt(2)},
+			},
+		},
+		{
+			name:        "Missing Heights",
+			description: "Backtests should require a height range",
+			req: BacktestRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 100},
+			},
+		},
+		{
+			name:        "Correlated Invariant",
+			description: "Correlated invariants should be rejected",
+			req: BacktestRequest{
+				Invariant: registry.WithdrawalCorrelation,
+				Params:    models.Params{conduit.PortalParam: "0x420"},
+				Pipeline:  heights,
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			pm := &pipelinesMock{}
+			e := NewEngine(context.Background(), pm, nil)
+			defer e.Shutdown()
+
+			_, err := e.Backtest(context.Background(), tc.req)
+			assert.Error(t, err)
+			assert.Empty(t, pm.requests)
+		})
+	}
+}
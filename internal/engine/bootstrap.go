@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"gopkg.in/yaml.v3"
+)
+
+// BootstrapSession ... Declarative invariant session created at boot
+type BootstrapSession struct {
+	// Unique name used to identify the session in logs
+	Name string `json:"name"`
+	SessionRequest
+}
+
+// Bootstrap ... Contents of a session bootstrap file
+type Bootstrap struct {
+	Sessions []BootstrapSession `json:"sessions"`
+}
+
+// LoadBootstrap ... Reads & validates a YAML or JSON session bootstrap file; the format is inferred from
+// the file extension. YAML files use the same field names as JSON files
+func LoadBootstrap(path string) (*Bootstrap, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+
+	case ".yaml", ".yml":
+		var doc any
+		if err = yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", path, err)
+		}
+
+		// Converted to JSON so that sessions are decoded using their JSON tags
+		if raw, err = json.Marshal(doc); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", path, err)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported bootstrap file extension: %s", filepath.Ext(path))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	boot := &Bootstrap{}
+	if dErr := dec.Decode(boot); dErr != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", path, dErr)
+	}
+
+	if vErr := boot.validate(); vErr != nil {
+		return nil, fmt.Errorf("invalid sessions in %s: %w", path, vErr)
+	}
+
+	return boot, nil
+}
+
+// validate ... Ensures every session is uniquely named & requests a known invariant with valid params;
+// pipelines are validated once the sessions are created
+func (boot *Bootstrap) validate() error {
+	names := make(map[string]struct{}, len(boot.Sessions))
+
+	for i, bs := range boot.Sessions {
+		if bs.Name == "" {
+			return fmt.Errorf("session %d has no name", i)
+		}
+
+		if _, found := names[bs.Name]; found {
+			return fmt.Errorf("session name %s is used more than once", bs.Name)
+		}
+		names[bs.Name] = struct{}{}
+
+		ir, err := registry.GetInvariant(bs.Invariant)
+		if err != nil {
+			return fmt.Errorf("session %s: %w", bs.Name, err)
+		}
+
+		if err = ir.Schema.Validate(bs.Params); err != nil {
+			return fmt.Errorf("session %s: invalid %s params: %w", bs.Name, bs.Invariant, err)
+		}
+	}
+
+	return nil
+}
+
+// Requests ... Returns the session request of every bootstrap session in file order
+func (boot *Bootstrap) Requests() []SessionRequest {
+	reqs := make([]SessionRequest, 0, len(boot.Sessions))
+	for _, bs := range boot.Sessions {
+		reqs = append(reqs, bs.SessionRequest)
+	}
+
+	return reqs
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LoadBootstrap(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		file    string
+		content string
+		valid   bool
+	}{
+		{
+			name:        "YAML Sessions",
+			description: "YAML files should be decoded using the JSON field names",
+			file:        "bootstrap.yaml",
+			content: `
+sessions:
+  - name: large-withdrawals
+    invariant: LARGE_TX_VALUE
+    severity: high
+    cooldown: 1m
+    params:
+      threshold: "1000000000000000000"
+    pipeline:
+      network: layer1
+`,
+			valid: true,
+		},
+		{
+			name:        "JSON Sessions",
+			description: "JSON files should be decoded directly",
+			file:        "bootstrap.json",
+			content: `{"sessions": [{"name": "large-withdrawals", "invariant": "LARGE_TX_VALUE", "severity": "high",
+				"cooldown": "1m", "params": {"threshold": "1000000000000000000"}, "pipeline": {"network": "layer1"}}]}`,
+			valid: true,
+		},
+		{
+			name:        "Unknown Field",
+			description: "Misspelt fields should be rejected rather than silently ignored",
+			file:        "bootstrap.yaml",
+			content:     "sessions:\n  - {name: a, invariant: LARGE_TX_VALUE, parmas: {threshold: 1}}\n",
+		},
+		{
+			name:        "Duplicate Names",
+			description: "Session names should be unique",
+			file:        "bootstrap.yaml",
+			content: "sessions:\n  - {name: a, invariant: LARGE_TX_VALUE, params: {threshold: 1}}\n" +
+				"  - {name: a, invariant: LARGE_TX_VALUE, params: {threshold: 1}}\n",
+		},
+		{
+			name:        "Unknown Invariant",
+			description: "Sessions of unknown invariants should be rejected",
+			file:        "bootstrap.yaml",
+			content:     "sessions:\n  - {name: a, invariant: UNKNOWN}\n",
+		},
+		{
+			name:        "Invalid Params",
+			description: "Sessions should be rejected when their params don't match the invariant's schema",
+			file:        "bootstrap.yaml",
+			content:     "sessions:\n  - {name: a, invariant: LARGE_TX_VALUE}\n",
+		},
+		{
+			name:        "Unsupported Extension",
+			description: "Files other than YAML & JSON should be rejected",
+			file:        "bootstrap.toml",
+			content:     "",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			boot, err := LoadBootstrap(path)
+			if !tc.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, boot.Sessions, 1)
+			assert.Equal(t, "large-withdrawals", boot.Sessions[0].Name)
+
+			reqs := boot.Requests()
+			assert.Equal(t, registry.LargeTxValue, reqs[0].Invariant)
+			assert.Equal(t, invariant.High, reqs[0].Severity)
+			assert.Equal(t, models.Duration(time.Minute), reqs[0].Cooldown)
+			assert.Equal(t, models.Layer1, reqs[0].Pipeline.Network)
+		})
+	}
+}
@@ -0,0 +1,836 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// InvalidationTopic ... Event bus topic that Invalidation payloads are published to
+	InvalidationTopic events.Topic = "invariant_invalidation"
+	// SessionEndedTopic ... Event bus topic that SessionSummary payloads are published to
+	SessionEndedTopic events.Topic = "invariant_session_ended"
+)
+
+const (
+	// workerQueueSize ... Number of items buffered per worker before session readers block
+	workerQueueSize = 64
+	// fingerprintSize ... Number of digest bytes kept in invalidation fingerprints
+	fingerprintSize = 16
+)
+
+// ErrSessionNotFound ... Returned when no session exists for an ID
+var ErrSessionNotFound = errors.New("no session exists")
+
+// SessionID ... Unique identifier assigned to every invariant session
+type SessionID string
+
+// Pipelines ... Subset of the ETL manager used to produce the data assessed by sessions
+type Pipelines interface {
+	SubmitPipeline(req etl.PipelineRequest, output chan models.TransitData) (etl.PipelineID, error)
+	RemovePipeline(id etl.PipelineID) error
+	GetState(id etl.PipelineID) (models.PipelineState, error)
+}
+
+// SessionRequest ... User facing description of an invariant session
+type SessionRequest struct {
+	Invariant invariant.Type `json:"invariant"`
+	// Params passed to the invariant constructor
+	Params models.Params `json:"params,omitempty"`
+	// Optional; overrides the invariant's default severity
+	Severity invariant.Severity `json:"severity,omitempty"`
+	// Optional; minimum period between invalidations, those raised within it are suppressed
+	Cooldown models.Duration `json:"cooldown,omitempty"`
+
+	// Pipeline producing the assessed data; the register type defaults to the invariant's input type
+	Pipeline etl.PipelineRequest `json:"pipeline"`
+	// Required by correlated invariants only; pipeline joined with the above, the register type defaults
+	// to the invariant's correlated input type
+	Correlated *etl.PipelineRequest `json:"correlated_pipeline,omitempty"`
+
+	// Optional; routes the session's invalidations rather than the default alert policy
+	AlertPolicy *AlertPolicy `json:"alert_policy,omitempty"`
+}
+
+// AlertPolicy ... Routing of a session's invalidations to alert destinations
+type AlertPolicy struct {
+	// Names of the alerted destinations; every destination is alerted when empty
+	Destinations []string `json:"destinations,omitempty"`
+	// Optional; invalidations less severe than this aren't alerted
+	MinSeverity invariant.Severity `json:"min_severity,omitempty"`
+}
+
+// PolicyRouter ... Subset of the alert manager that routes invalidations using per-session policies
+type PolicyRouter interface {
+	SetPolicy(id SessionID, p AlertPolicy) error
+	RemovePolicy(id SessionID)
+}
+
+// Session ... Running assessment of a single invariant against the output of a single pipeline, or of
+// two joined pipelines for correlated invariants
+type Session struct {
+	ID         SessionID
+	Invariant  invariant.Type
+	Params     models.Params
+	Severity   invariant.Severity
+	Network    models.Network
+	PipelineID etl.PipelineID
+	// Zero unless the invariant is correlated
+	CorrelatedPipelineID etl.PipelineID
+	Created              time.Time
+
+	inv invariant.Invariant
+	// Index of the worker that assesses every item of the session
+	worker int
+
+	// Cancelled once the session ends; items read afterwards are discarded
+	ctx  context.Context
+	stop context.CancelFunc
+	// Closed once the session's pipelines are removed; stops the session's reader & join
+	detached chan struct{}
+
+	// Nanoseconds; updated at runtime while the session's worker reads it
+	cooldownNs atomic.Int64
+
+	assessed      atomic.Int64
+	invalidations atomic.Int64
+	// Total suppressed over the session's lifetime
+	suppressedTotal atomic.Int64
+
+	// Only accessed by the session's worker
+	lastEmitted time.Time
+	suppressed  int
+}
+
+// cooldown ... Returns true if an invalidation raised at the time should be suppressed; the suppression
+// is counted so that it can be reported with the next emitted invalidation
+func (s *Session) cooldown(at time.Time) bool {
+	if s.lastEmitted.IsZero() || at.Sub(s.lastEmitted) >= s.Cooldown() {
+		return false
+	}
+
+	s.suppressed++
+	s.suppressedTotal.Add(1)
+	return true
+}
+
+// Cooldown ... Returns the minimum period between the session's invalidations
+func (s *Session) Cooldown() time.Duration {
+	return time.Duration(s.cooldownNs.Load())
+}
+
+// SetCooldown ... Sets the minimum period between the session's invalidations; applies from the next
+// invalidation onwards
+func (s *Session) SetCooldown(d time.Duration) {
+	s.cooldownNs.Store(int64(d))
+}
+
+// SessionSummary ... Event emitted once a session ends
+type SessionSummary struct {
+	SessionID SessionID      `json:"session_id"`
+	Invariant invariant.Type `json:"invariant"`
+	Network   models.Network `json:"network"`
+	Created   time.Time      `json:"created"`
+	// Zero while the session is running
+	Ended time.Time `json:"ended"`
+
+	// Number of items assessed, invalidations emitted & invalidations suppressed by the cooldown
+	Assessed      int64 `json:"assessed"`
+	Invalidations int64 `json:"invalidations"`
+	Suppressed    int64 `json:"suppressed"`
+}
+
+// Summary ... Returns the session's counts so far
+func (s *Session) Summary() SessionSummary {
+	return SessionSummary{
+		SessionID:     s.ID,
+		Invariant:     s.Invariant,
+		Network:       s.Network,
+		Created:       s.Created,
+		Assessed:      s.assessed.Load(),
+		Invalidations: s.invalidations.Load(),
+		Suppressed:    s.suppressedTotal.Load(),
+	}
+}
+
+// invalidation ... Returns the invalidation raised by the outcome of assessing the data
+func (s *Session) invalidation(td models.TransitData, outcome *invariant.Outcome, at time.Time) Invalidation {
+	severity := s.Severity
+	if outcome.Severity.Valid() {
+		severity = outcome.Severity
+	}
+
+	return Invalidation{
+		SessionID:   s.ID,
+		Invariant:   s.Invariant,
+		Severity:    severity,
+		Network:     s.Network,
+		Height:      td.Height,
+		Timestamp:   at,
+		Suppressed:  s.suppressed,
+		Message:     outcome.Message,
+		Context:     outcome.Context,
+		Fingerprint: fingerprint(outcome),
+	}
+}
+
+// fingerprint ... Returns a digest of the outcome's fingerprint, falling back to its message
+func fingerprint(outcome *invariant.Outcome) string {
+	condition := outcome.Fingerprint
+	if condition == "" {
+		condition = outcome.Message
+	}
+
+	digest := sha256.Sum256([]byte(condition))
+	return hex.EncodeToString(digest[:fingerprintSize])
+}
+
+// invalidate ... Evaluates the invariant against the data; panics are recovered & returned as errors so
+// that a single malformed input can't halt the engine
+func (s *Session) invalidate(td models.TransitData) (outcome *invariant.Outcome, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outcome, err = nil, fmt.Errorf("%s invariant panicked: %v", s.Invariant, r)
+		}
+	}()
+
+	return s.inv.Invalidate(td)
+}
+
+// Invalidation ... Event emitted every time a session's invariant is violated
+type Invalidation struct {
+	SessionID SessionID          `json:"session_id"`
+	Invariant invariant.Type     `json:"invariant"`
+	Severity  invariant.Severity `json:"severity"`
+	Network   models.Network     `json:"network"`
+	// Height of the block the invalidating data was derived from; nil when not derived from a block
+	Height    *big.Int  `json:"height,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	// Number of invalidations suppressed by the session's cooldown since the previous invalidation
+	Suppressed int `json:"suppressed"`
+
+	Message string         `json:"message"`
+	Context map[string]any `json:"context,omitempty"`
+	// Digest identifying the violated condition; equal across invalidations of the same condition
+	Fingerprint string `json:"fingerprint"`
+}
+
+// Option ...
+type Option = func(*Engine)
+
+// WithEventBus ... Additionally publishes every invalidation to the bus
+func WithEventBus(bus *events.Bus) Option {
+	return func(e *Engine) {
+		e.bus = bus
+	}
+}
+
+// WithClients ... Provides the RPC clients used by invariants that read chain state
+func WithClients(clients invariant.Clients) Option {
+	return func(e *Engine) {
+		e.clients = clients
+	}
+}
+
+// WithStateStore ... Stores the state of stateful invariants in the store rather than in memory
+func WithStateStore(store state.Store) Option {
+	return func(e *Engine) {
+		e.store = store
+	}
+}
+
+// WithPolicyRouter ... Registers the alert policy of sessions created with one with the router; sessions
+// requesting a policy are rejected when no router is configured
+func WithPolicyRouter(router PolicyRouter) Option {
+	return func(e *Engine) {
+		e.router = router
+	}
+}
+
+// WithWorkers ... Sets the number of workers that assess session inputs; defaults to the number of CPUs
+func WithWorkers(n int) Option {
+	return func(e *Engine) {
+		e.workerCount = n
+	}
+}
+
+// task ... Single item to assess for a session
+type task struct {
+	s  *Session
+	td models.TransitData
+}
+
+// Engine ... Risk engine subsystem used to run invariant sessions against pipeline output
+type Engine struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	pipelines Pipelines
+	clients   invariant.Clients
+	// State of every stateful invariant; keys are nested under their session ID
+	store state.Store
+
+	// Optional; invalidations are not published when nil
+	bus *events.Bus
+	// Optional; invalidations are not written to a channel when nil
+	output chan<- Invalidation
+	// Optional; sessions can't request an alert policy when nil
+	router PolicyRouter
+	// Quiet period awaited after a backtest's pipeline terminates
+	backtestSettle time.Duration
+
+	// Sessions are assigned to a single worker by ID so that their items are assessed in order while
+	// slow sessions only delay the sessions sharing their worker
+	workerCount int
+	workers     []chan task
+
+	mu        sync.RWMutex
+	waitGroup *sync.WaitGroup
+	sessions  map[SessionID]*Session
+}
+
+// NewEngine ... Initializer; a non-nil output channel must be read from for sessions to progress
+func NewEngine(ctx context.Context, pipelines Pipelines, output chan<- Invalidation, opts ...Option) *Engine {
+	ctx, cancel := context.WithCancel(ctx)
+
+	e := &Engine{
+		ctx:       ctx,
+		cancel:    cancel,
+		pipelines: pipelines,
+		output:    output,
+
+		backtestSettle: defaultBacktestSettle,
+		waitGroup:      &sync.WaitGroup{},
+		sessions:       make(map[SessionID]*Session),
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if e.store == nil {
+		e.store = state.NewMemoryStore()
+	}
+
+	if e.workerCount < 1 {
+		e.workerCount = runtime.NumCPU()
+	}
+
+	e.workers = make([]chan task, e.workerCount)
+	for i := range e.workers {
+		e.workers[i] = make(chan task, workerQueueSize)
+
+		e.waitGroup.Add(1)
+		go e.work(e.workers[i])
+	}
+
+	return e
+}
+
+// pendingSession ... Validated session request whose invariant has been constructed
+type pendingSession struct {
+	req        SessionRequest
+	inv        invariant.Invariant
+	severity   invariant.Severity
+	corr       invariant.Correlated
+	correlated bool
+}
+
+// CreateSession ... Constructs the invariant, creates the pipeline producing its input and starts
+// assessing the pipeline's output
+func (e *Engine) CreateSession(req SessionRequest) (SessionID, error) {
+	ps, err := e.prepareSession(req)
+	if err != nil {
+		return "", err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Shutdown cancels the context while holding the lock so no session can be created afterwards
+	if e.ctx.Err() != nil {
+		return "", fmt.Errorf("engine is shut down")
+	}
+
+	return e.startSession(ps)
+}
+
+// CreateSessions ... Creates a session for every request in order, or none at all; every request is
+// validated before any session is created and sessions created before a failure are torn down without
+// being observable. Fail with the index of the first failed request
+func (e *Engine) CreateSessions(reqs []SessionRequest) ([]SessionID, error) {
+	pending := make([]*pendingSession, 0, len(reqs))
+	for i, req := range reqs {
+		ps, err := e.prepareSession(req)
+		if err != nil {
+			return nil, fmt.Errorf("session %d: %w", i, err)
+		}
+
+		pending = append(pending, ps)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.ctx.Err() != nil {
+		return nil, fmt.Errorf("engine is shut down")
+	}
+
+	ids := make([]SessionID, 0, len(pending))
+	for i, ps := range pending {
+		id, err := e.startSession(ps)
+		if err != nil {
+			for j := len(ids) - 1; j >= 0; j-- {
+				e.teardown(e.sessions[ids[j]])
+			}
+
+			logging.WithContext(e.ctx).Warn("Rolled back invariant session batch",
+				zap.Int("failed", i), zap.Int("torn_down", len(ids)), zap.Error(err))
+			return nil, fmt.Errorf("session %d: %w", i, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// prepareSession ... Validates the request & constructs its invariant without creating any pipeline
+func (e *Engine) prepareSession(req SessionRequest) (*pendingSession, error) {
+	if req.Cooldown < 0 {
+		return nil, fmt.Errorf("cooldown must not be negative")
+	}
+
+	if req.AlertPolicy != nil && e.router == nil {
+		return nil, fmt.Errorf("alert policies are not supported without alerting")
+	}
+
+	inv, severity, err := e.prepare(req.Invariant, req.Params, req.Severity, &req.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	corr, correlated := inv.(invariant.Correlated)
+	if req.Correlated != nil {
+		// Copied so that defaulting the register type doesn't modify the caller's request
+		correlatedReq := *req.Correlated
+		req.Correlated = &correlatedReq
+	}
+
+	if err = validateCorrelated(req, corr, correlated); err != nil {
+		return nil, err
+	}
+
+	return &pendingSession{req: req, inv: inv, severity: severity, corr: corr, correlated: correlated}, nil
+}
+
+// startSession ... Creates the pipelines of the prepared session and starts assessing their output;
+// the engine lock must be held
+func (e *Engine) startSession(ps *pendingSession) (SessionID, error) {
+	req, inv := ps.req, ps.inv
+	id := SessionID(uuid.NewString())
+
+	if req.AlertPolicy != nil {
+		if err := e.router.SetPolicy(id, *req.AlertPolicy); err != nil {
+			return "", fmt.Errorf("invalid alert policy: %w", err)
+		}
+	}
+
+	output := make(chan models.TransitData)
+
+	pID, err := e.pipelines.SubmitPipeline(req.Pipeline, output)
+	if err != nil {
+		e.removePolicy(id)
+		return "", err
+	}
+
+	detached := make(chan struct{})
+
+	var cID etl.PipelineID
+	if ps.correlated {
+		cID, output, err = e.correlate(req, ps.corr, output, detached)
+		if err != nil {
+			e.removePipeline(pID)
+			e.removePolicy(id)
+			return "", err
+		}
+	}
+
+	if stateful, ok := inv.(invariant.Stateful); ok {
+		stateful.SetState(state.Scoped(e.store, string(id)))
+	}
+
+	ctx, stop := context.WithCancel(e.ctx)
+
+	s := &Session{
+		ID:         id,
+		Invariant:  req.Invariant,
+		Params:     req.Params,
+		Severity:   ps.severity,
+		Network:    req.Pipeline.Network,
+		PipelineID: pID,
+		Created:    time.Now(),
+		inv:        inv,
+		worker:     e.assign(id),
+		ctx:        ctx,
+		stop:       stop,
+		detached:   detached,
+
+		CorrelatedPipelineID: cID,
+	}
+	s.SetCooldown(time.Duration(req.Cooldown))
+	e.sessions[s.ID] = s
+
+	e.waitGroup.Add(1)
+	go e.run(s, output)
+
+	logging.WithContext(e.ctx).Info("Created invariant session",
+		zap.String("session", string(s.ID)), zap.String("invariant", string(s.Invariant)),
+		zap.Int("pipeline", int(pID)))
+
+	return s.ID, nil
+}
+
+// prepare ... Constructs the invariant, resolves its severity and defaults the pipeline's register type
+// to the invariant's input type
+func (e *Engine) prepare(it invariant.Type, params models.Params, override invariant.Severity,
+	pr *etl.PipelineRequest) (invariant.Invariant, invariant.Severity, error) {
+	ir, err := registry.GetInvariant(it)
+	if err != nil {
+		return nil, "", err
+	}
+
+	severity := ir.Severity
+	if override != "" {
+		severity = override
+	}
+
+	if !severity.Valid() {
+		return nil, "", fmt.Errorf("invalid severity: %s", severity)
+	}
+
+	if err = ir.Schema.Validate(params); err != nil {
+		return nil, "", fmt.Errorf("invalid %s params: %w", it, err)
+	}
+
+	inv, err := ir.Constructor(e.ctx, e.clients, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not construct %s invariant: %w", it, err)
+	}
+
+	if pr.RegisterType == "" {
+		pr.RegisterType = inv.InputType()
+	}
+
+	if pr.RegisterType != inv.InputType() {
+		return nil, "", fmt.Errorf("%s invariant assesses %s data; got %s pipeline",
+			it, inv.InputType(), pr.RegisterType)
+	}
+
+	return inv, severity, nil
+}
+
+// validateCorrelated ... Ensures that a correlated pipeline is requested if & only if the invariant is
+// correlated, and that its register type matches the invariant's correlated input type
+func validateCorrelated(req SessionRequest, corr invariant.Correlated, correlated bool) error {
+	switch {
+	case !correlated && req.Correlated == nil:
+		return nil
+
+	case !correlated:
+		return fmt.Errorf("%s invariant doesn't support a correlated pipeline", req.Invariant)
+
+	case req.Correlated == nil:
+		return fmt.Errorf("%s invariant requires a correlated pipeline", req.Invariant)
+	}
+
+	if req.Correlated.RegisterType == "" {
+		req.Correlated.RegisterType = corr.CorrelatedInput()
+	}
+
+	if req.Correlated.RegisterType != corr.CorrelatedInput() {
+		return fmt.Errorf("%s invariant correlates %s data; got %s pipeline",
+			req.Invariant, corr.CorrelatedInput(), req.Correlated.RegisterType)
+	}
+
+	return nil
+}
+
+// correlate ... Creates the correlated pipeline & joins its output with the left pipeline's output;
+// returns the ID of the correlated pipeline and the channel that the joined pairs are written to.
+// The join runs until the session's pipelines are detached so that their outputs are always read
+func (e *Engine) correlate(req SessionRequest, corr invariant.Correlated, left chan models.TransitData,
+	detached chan struct{}) (etl.PipelineID, chan models.TransitData, error) {
+	right := make(chan models.TransitData)
+
+	cID, err := e.pipelines.SubmitPipeline(*req.Correlated, right)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	spec := corr.JoinSpec()
+	spec.OutputType = models.RegisterType(req.Invariant)
+
+	ctx, cancel := context.WithCancel(e.ctx)
+
+	join, err := pipeline.NewJoin(ctx, spec, left, right)
+	if err != nil {
+		cancel()
+		e.removePipeline(cID)
+		return 0, nil, err
+	}
+
+	output := make(chan models.TransitData)
+	if err = join.AddDirective(0, output); err != nil {
+		cancel()
+		e.removePipeline(cID)
+		return 0, nil, err
+	}
+
+	go func() {
+		select {
+		case <-detached:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	// The join stops once the session is detached or the engine's context is cancelled
+	e.waitGroup.Add(1)
+	go func() {
+		defer e.waitGroup.Done()
+
+		if jErr := join.EventLoop(); jErr != nil {
+			logging.WithContext(e.ctx).Error("Correlation join failed", zap.Error(jErr))
+		}
+	}()
+
+	return cID, output, nil
+}
+
+// removePipeline ... Removes a pipeline created for a session that couldn't be started
+func (e *Engine) removePipeline(id etl.PipelineID) {
+	if err := e.pipelines.RemovePipeline(id); err != nil {
+		logging.WithContext(e.ctx).Warn("Could not remove pipeline",
+			zap.Int("pipeline", int(id)), zap.Error(err))
+	}
+}
+
+// assign ... Returns the index of the worker that the session is assigned to
+func (e *Engine) assign(id SessionID) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(id))
+
+	return int(hash.Sum32() % uint32(len(e.workers)))
+}
+
+// run ... Queues every item read from the session's pipeline onto the session's worker until the session
+// is detached or the engine is shut down; blocks while the worker's queue is full. Items read after the
+// session ends are discarded so that its pipelines can always be removed
+func (e *Engine) run(s *Session, input chan models.TransitData) {
+	defer e.waitGroup.Done()
+
+	for {
+		select {
+		case td := <-input:
+			for _, item := range models.Unbatch(td) {
+				select {
+				case e.workers[s.worker] <- task{s: s, td: item}:
+				case <-s.ctx.Done():
+				}
+			}
+
+		case <-s.detached:
+			return
+
+		case <-e.ctx.Done():
+			return
+		}
+	}
+}
+
+// work ... Assesses queued items until the engine is shut down
+func (e *Engine) work(queue chan task) {
+	defer e.waitGroup.Done()
+
+	for {
+		select {
+		case t := <-queue:
+			// Items queued before the session ended are discarded
+			if t.s.ctx.Err() != nil {
+				continue
+			}
+
+			e.assess(t.s, t.td)
+
+		case <-e.ctx.Done():
+			return
+		}
+	}
+}
+
+// assess ... Evaluates the invariant against a single item and emits an invalidation when it's violated;
+// items that can't be assessed are logged and skipped
+func (e *Engine) assess(s *Session, td models.TransitData) {
+	s.assessed.Add(1)
+
+	outcome, err := s.invalidate(td)
+	if err != nil {
+		logging.WithContext(e.ctx).Warn("Could not assess invariant",
+			zap.String("session", string(s.ID)), zap.Error(err))
+		return
+	}
+
+	if outcome == nil {
+		return
+	}
+
+	now := time.Now()
+	if s.cooldown(now) {
+		return
+	}
+
+	inval := s.invalidation(td, outcome, now)
+	s.lastEmitted, s.suppressed = now, 0
+	s.invalidations.Add(1)
+
+	if e.bus != nil {
+		e.bus.Publish(InvalidationTopic, inval)
+	}
+
+	if e.output == nil {
+		return
+	}
+
+	select {
+	case e.output <- inval:
+	case <-e.ctx.Done():
+	}
+}
+
+// GetSession ... Returns the session for the ID; fail if no session exists
+func (e *Engine) GetSession(id SessionID) (*Session, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	s, found := e.sessions[id]
+	if !found {
+		return nil, fmt.Errorf("%w for id: %s", ErrSessionNotFound, id)
+	}
+
+	return s, nil
+}
+
+// SetCooldown ... Updates the running session's cooldown; fail if no session exists or the cooldown is
+// negative
+func (e *Engine) SetCooldown(id SessionID, d time.Duration) error {
+	if d < 0 {
+		return fmt.Errorf("cooldown must not be negative; got %s", d)
+	}
+
+	s, err := e.GetSession(id)
+	if err != nil {
+		return err
+	}
+
+	s.SetCooldown(d)
+	return nil
+}
+
+// Sessions ... Returns every session in creation order
+func (e *Engine) Sessions() []*Session {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	sessions := make([]*Session, 0, len(e.sessions))
+	for _, s := range e.sessions {
+		sessions = append(sessions, s)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Created.Before(sessions[j].Created)
+	})
+
+	return sessions
+}
+
+// EndSession ... Stops assessing the session, removes its pipelines along with any components not shared
+// with other pipelines and clears its state; the session's summary is returned & published to the bus.
+// Fail if no session exists
+func (e *Engine) EndSession(id SessionID) (*SessionSummary, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s, found := e.sessions[id]
+	if !found {
+		return nil, fmt.Errorf("%w for id: %s", ErrSessionNotFound, id)
+	}
+
+	e.teardown(s)
+
+	summary := s.Summary()
+	summary.Ended = time.Now()
+
+	if e.bus != nil {
+		e.bus.Publish(SessionEndedTopic, summary)
+	}
+
+	logging.WithContext(e.ctx).Info("Ended invariant session",
+		zap.String("session", string(id)), zap.Int64("assessed", summary.Assessed),
+		zap.Int64("invalidations", summary.Invalidations))
+
+	return &summary, nil
+}
+
+// teardown ... Stops the session, removes its pipelines & alert policy and deletes its state; the engine
+// lock must be held
+func (e *Engine) teardown(s *Session) {
+	delete(e.sessions, s.ID)
+	s.stop()
+
+	e.removePipeline(s.PipelineID)
+	if s.CorrelatedPipelineID != 0 {
+		e.removePipeline(s.CorrelatedPipelineID)
+	}
+	close(s.detached)
+
+	e.store.Delete(state.Key{string(s.ID)})
+	e.removePolicy(s.ID)
+}
+
+// removePolicy ... Routes the session's invalidations using the default alert policy again
+func (e *Engine) removePolicy(id SessionID) {
+	if e.router != nil {
+		e.router.RemovePolicy(id)
+	}
+}
+
+// Shutdown ... Stops every session and waits for their assessments to finish; pipelines are owned
+// by the ETL manager and must be shut down separately
+func (e *Engine) Shutdown() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.cancel()
+	e.waitGroup.Wait()
+}
@@ -0,0 +1,597 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/etl"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/base-org/pessimism/internal/events"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// pipelinesMock ... Records submitted pipelines and exposes their output channels
+type pipelinesMock struct {
+	mu       sync.Mutex
+	requests []etl.PipelineRequest
+	outputs  []chan models.TransitData
+	removed  []etl.PipelineID
+	err      error
+	// Number of submissions that succeed before err is returned
+	accepted int
+	// Pipelines without a state are live
+	states map[etl.PipelineID]models.PipelineState
+}
+
+func (pm *pipelinesMock) SubmitPipeline(req etl.PipelineRequest,
+	output chan models.TransitData) (etl.PipelineID, error) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.err != nil && len(pm.outputs) >= pm.accepted {
+		return 0, pm.err
+	}
+
+	pm.requests = append(pm.requests, req)
+	pm.outputs = append(pm.outputs, output)
+	return etl.PipelineID(len(pm.outputs)), nil
+}
+
+func (pm *pipelinesMock) RemovePipeline(id etl.PipelineID) error {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.removed = append(pm.removed, id)
+	return nil
+}
+
+func (pm *pipelinesMock) GetState(id etl.PipelineID) (models.PipelineState, error) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if ps, found := pm.states[id]; found {
+		return ps, nil
+	}
+
+	return models.LiveState, nil
+}
+
+func (pm *pipelinesMock) setState(id etl.PipelineID, ps models.PipelineState) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.states == nil {
+		pm.states = make(map[etl.PipelineID]models.PipelineState)
+	}
+	pm.states[id] = ps
+}
+
+// output ... Returns the output channel of the nth submitted pipeline once it's submitted
+func (pm *pipelinesMock) output(t *testing.T, n int) chan models.TransitData {
+	assert.Eventually(t, func() bool {
+		pm.mu.Lock()
+		defer pm.mu.Unlock()
+
+		return len(pm.outputs) > n
+	}, 5*time.Second, 10*time.Millisecond)
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	return pm.outputs[n]
+}
+
+func Test_Engine_CreateSession(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		req      SessionRequest
+		err      error
+		valid    bool
+		register models.RegisterType
+		severity invariant.Severity
+	}{
+		{
+			name:        "Default Register",
+			description: "The pipeline register should default to the invariant's input type",
+			req: SessionRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 10},
+				Pipeline:  etl.PipelineRequest{Network: models.Layer1},
+			},
+			valid:    true,
+			register: conduit.AddressWatchTX,
+			severity: invariant.Medium,
+		},
+		{
+			name:        "Mismatched Register",
+			description: "Pipelines producing data the invariant can't assess should be rejected",
+			req: SessionRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 10},
+				Pipeline:  etl.PipelineRequest{Network: models.Layer1, RegisterType: conduit.GethBlock},
+			},
+		},
+		{
+			name:        "Severity Override",
+			description: "Sessions should be able to override the invariant's severity",
+			req: SessionRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 10},
+				Severity:  invariant.Critical,
+			},
+			valid:    true,
+			register: conduit.AddressWatchTX,
+			severity: invariant.Critical,
+		},
+		{
+			name:        "Invalid Severity",
+			description: "Unknown severities should be rejected",
+			req: SessionRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 10},
+				Severity:  "urgent",
+			},
+		},
+		{
+			name:        "Unknown Invariant",
+			description: "Unknown invariant types should be rejected",
+			req:         SessionRequest{Invariant: "UNKNOWN"},
+		},
+		{
+			name:        "Invalid Params",
+			description: "Invariant constructor failures should be surfaced",
+			req: SessionRequest{
+				Invariant: registry.HeuristicSignal,
+				Params:    models.Params{registry.RegisterParam: "UNKNOWN"},
+			},
+		},
+		{
+			name:        "Unknown Param",
+			description: "Params undeclared by the invariant's schema should be rejected",
+			req: SessionRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 10, "treshold": 10},
+			},
+		},
+		{
+			name:        "Pipeline Failure",
+			description: "Pipeline submission failures should be surfaced",
+			req: SessionRequest{
+				Invariant: registry.LargeTxValue,
+				Params:    models.Params{registry.ThresholdParam: 10},
+			},
+			err: fmt.Errorf("no endpoint"),
+		},
+		{
+			name:        "Missing Correlated Pipeline",
+			description: "Correlated invariants should require a correlated pipeline",
+			req: SessionRequest{
+				Invariant: registry.WithdrawalCorrelation,
+				Params:    models.Params{conduit.PortalParam: "0x420"},
+			},
+		},
+		{
+			name:        "Unexpected Correlated Pipeline",
+			description: "Correlated pipelines should be rejected by uncorrelated invariants",
+			req: SessionRequest{
+				Invariant:  registry.LargeTxValue,
+				Params:     models.Params{registry.ThresholdParam: 10},
+				Correlated: &etl.PipelineRequest{Network: models.Layer2},
+			},
+		},
+		{
+			name:        "Alert Policy Without Alerting",
+			description: "Alert policies should be rejected when no policy router is configured",
+			req: SessionRequest{
+				Invariant:   registry.LargeTxValue,
+				Params:      models.Params{registry.ThresholdParam: 10},
+				AlertPolicy: &AlertPolicy{Destinations: []string{"ops"}},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			pm := &pipelinesMock{err: tc.err}
+			e := NewEngine(context.Background(), pm, nil)
+			defer e.Shutdown()
+
+			id, err := e.CreateSession(tc.req)
+			if !tc.valid {
+				assert.Error(t, err)
+				assert.Empty(t, e.Sessions())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.register, pm.requests[0].RegisterType)
+
+			s, err := e.GetSession(id)
+			assert.NoError(t, err)
+			assert.Equal(t, etl.PipelineID(1), s.PipelineID)
+			assert.Equal(t, tc.req.Invariant, s.Invariant)
+			assert.Equal(t, tc.severity, s.Severity)
+			assert.Equal(t, []*Session{s}, e.Sessions())
+		})
+	}
+}
+
+func Test_Engine_Invalidation(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	pm := &pipelinesMock{}
+	bus := events.NewBus()
+	sub := bus.Subscribe(1)
+	output := make(chan Invalidation)
+
+	e := NewEngine(context.Background(), pm, output, WithEventBus(bus))
+	defer e.Shutdown()
+
+	id, err := e.CreateSession(SessionRequest{
+		Invariant: registry.LargeTxValue,
+		Params:    models.Params{registry.ThresholdParam: 100},
+		Pipeline:  etl.PipelineRequest{Network: models.Layer1},
+	})
+	assert.NoError(t, err)
+
+	to := common.HexToAddress("0x1")
+	small := types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(99)})
+	large := types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(100)})
+
+	// Only the transaction meeting the threshold should invalidate, including when batched
+	pm.outputs[0] <- models.NewBatch([]models.TransitData{
+		{Type: conduit.AddressWatchTX, Value: small, Height: big.NewInt(7)},
+		{Type: conduit.AddressWatchTX, Value: large, Height: big.NewInt(8)},
+	})
+
+	select {
+	case inval := <-output:
+		assert.Equal(t, id, inval.SessionID)
+		assert.Equal(t, registry.LargeTxValue, inval.Invariant)
+		assert.Equal(t, invariant.Medium, inval.Severity, "Ensuring the invariant's default severity is used")
+		assert.Equal(t, models.Layer1, inval.Network)
+		assert.Equal(t, big.NewInt(8), inval.Height)
+		assert.Equal(t, large.Hash().Hex(), inval.Context["tx_hash"])
+		assert.Len(t, inval.Fingerprint, 2*fingerprintSize, "Ensuring the message is fingerprinted")
+
+	case <-time.After(time.Second):
+		t.Fatal("expected an invalidation")
+	}
+
+	event := <-sub.Events
+	assert.Equal(t, InvalidationTopic, event.Topic)
+	assert.Equal(t, id, event.Payload.(Invalidation).SessionID)
+
+	select {
+	case <-output:
+		t.Fatal("expected a single invalidation")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	e.Shutdown()
+	_, err = e.CreateSession(SessionRequest{
+		Invariant: registry.LargeTxValue,
+		Params:    models.Params{registry.ThresholdParam: 100},
+	})
+	assert.Error(t, err)
+}
+
+func Test_Engine_Cooldown(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	pm := &pipelinesMock{}
+	output := make(chan Invalidation, 10)
+
+	e := NewEngine(context.Background(), pm, output)
+	defer e.Shutdown()
+
+	id, err := e.CreateSession(SessionRequest{
+		Invariant: registry.HeuristicSignal,
+		Params:    models.Params{registry.RegisterParam: string(conduit.GasUsageAnomaly)},
+		Cooldown:  models.Duration(100 * time.Millisecond),
+	})
+	assert.NoError(t, err)
+
+	signal := models.TransitData{Type: conduit.GasUsageAnomaly}
+
+	// Signals raised within the cooldown should be suppressed & counted on the next invalidation
+	pm.outputs[0] <- signal
+	pm.outputs[0] <- signal
+	pm.outputs[0] <- signal
+
+	first := <-output
+	assert.Equal(t, 0, first.Suppressed)
+
+	time.Sleep(150 * time.Millisecond)
+	pm.outputs[0] <- signal
+
+	second := <-output
+	assert.Equal(t, 2, second.Suppressed)
+	assert.Empty(t, output)
+
+	// Cooldowns updated at runtime apply from the next invalidation
+	assert.NoError(t, e.SetCooldown(id, 0))
+	pm.outputs[0] <- signal
+
+	third := <-output
+	assert.Equal(t, 0, third.Suppressed)
+
+	assert.Error(t, e.SetCooldown(id, -time.Second))
+	assert.ErrorIs(t, e.SetCooldown("unknown", time.Second), ErrSessionNotFound)
+
+	_, err = e.CreateSession(SessionRequest{
+		Invariant: registry.HeuristicSignal,
+		Params:    models.Params{registry.RegisterParam: string(conduit.GasUsageAnomaly)},
+		Cooldown:  models.Duration(-time.Second),
+	})
+	assert.Error(t, err, "negative cooldowns should be rejected")
+}
+
+func Test_Engine_Correlation(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	pm := &pipelinesMock{}
+	output := make(chan Invalidation)
+
+	e := NewEngine(context.Background(), pm, output)
+	defer e.Shutdown()
+
+	portal := common.HexToAddress("0x420")
+	correlated := &etl.PipelineRequest{Network: models.Layer2}
+
+	id, err := e.CreateSession(SessionRequest{
+		Invariant: registry.WithdrawalCorrelation,
+		Params: models.Params{
+			conduit.PortalParam:       portal.Hex(),
+			registry.JoinTimeoutParam: "50ms",
+		},
+		Pipeline:   etl.PipelineRequest{Network: models.Layer1},
+		Correlated: correlated,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, correlated.RegisterType, "Ensuring the caller's request isn't modified")
+
+	s, err := e.GetSession(id)
+	assert.NoError(t, err)
+	assert.Equal(t, etl.PipelineID(1), s.PipelineID)
+	assert.Equal(t, etl.PipelineID(2), s.CorrelatedPipelineID)
+	assert.Equal(t, conduit.EventLog, pm.requests[1].RegisterType)
+	assert.Equal(t, models.Layer2, pm.requests[1].Network)
+
+	// A withdrawal proven on L1 without ever being sent on L2 should invalidate once the join times out
+	withdrawalHash := common.HexToHash("0x69")
+	pm.outputs[0] <- models.TransitData{Type: conduit.EventLog, Height: big.NewInt(3), Value: types.Log{
+		Address: portal,
+		Topics: []common.Hash{crypto.Keccak256Hash([]byte("WithdrawalProven(bytes32,address,address)")),
+			withdrawalHash},
+	}}
+
+	select {
+	case inval := <-output:
+		assert.Equal(t, id, inval.SessionID)
+		assert.Equal(t, invariant.Critical, inval.Severity)
+		assert.Equal(t, big.NewInt(3), inval.Height)
+		assert.Equal(t, withdrawalHash.Hex(), inval.Context["withdrawal_hash"])
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected an invalidation")
+	}
+
+	// Ending the session should remove both pipelines
+	_, err = e.EndSession(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []etl.PipelineID{1, 2}, pm.removed)
+
+	// The first pipeline should be removed when the correlated pipeline can't be created
+	pm = &pipelinesMock{err: fmt.Errorf("no endpoint"), accepted: 1}
+	e = NewEngine(context.Background(), pm, output)
+	defer e.Shutdown()
+
+	_, err = e.CreateSession(SessionRequest{
+		Invariant:  registry.WithdrawalCorrelation,
+		Params:     models.Params{conduit.PortalParam: portal.Hex()},
+		Correlated: correlated,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []etl.PipelineID{1}, pm.removed)
+	assert.Empty(t, e.Sessions())
+}
+
+// panicker ... Invariant that panics on every input
+type panicker struct{}
+
+func (panicker) InputType() models.RegisterType { return conduit.GethBlock }
+
+func (panicker) Invalidate(models.TransitData) (*invariant.Outcome, error) {
+	var block *types.Block
+	return nil, fmt.Errorf("unreachable %d", block.NumberU64())
+}
+
+func Test_Session_Invalidate_Panic(t *testing.T) {
+	s := &Session{Invariant: "PANICKER", inv: panicker{}}
+
+	outcome, err := s.invalidate(models.TransitData{Type: conduit.GethBlock})
+	assert.Nil(t, outcome)
+	assert.ErrorContains(t, err, "PANICKER invariant panicked")
+}
+
+// blocker ... Invariant that invalidates on every input once released
+type blocker struct {
+	release chan struct{}
+}
+
+func (b blocker) InputType() models.RegisterType { return conduit.GethBlock }
+
+func (b blocker) Invalidate(models.TransitData) (*invariant.Outcome, error) {
+	if b.release != nil {
+		<-b.release
+	}
+
+	return &invariant.Outcome{Message: "invalidated"}, nil
+}
+
+func Test_Engine_Workers(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	output := make(chan Invalidation, 10)
+	e := NewEngine(context.Background(), &pipelinesMock{}, output, WithWorkers(2))
+	defer e.Shutdown()
+
+	assert.Len(t, e.workers, 2)
+	assert.Equal(t, e.assign("session"), e.assign("session"), "Ensuring sessions are assigned to a single worker")
+
+	release := make(chan struct{})
+	slow := &Session{ID: "slow", Severity: invariant.Low, inv: blocker{release: release}, worker: 0,
+		ctx: context.Background(), detached: make(chan struct{})}
+	fast := &Session{ID: "fast", Severity: invariant.Low, inv: blocker{}, worker: 1,
+		ctx: context.Background(), detached: make(chan struct{})}
+
+	slowInput, fastInput := make(chan models.TransitData), make(chan models.TransitData)
+	e.waitGroup.Add(2)
+	go e.run(slow, slowInput)
+	go e.run(fast, fastInput)
+
+	// The fast session should be assessed while the slow session's worker is blocked
+	slowInput <- models.TransitData{Type: conduit.GethBlock}
+	fastInput <- models.TransitData{Type: conduit.GethBlock}
+
+	select {
+	case inval := <-output:
+		assert.Equal(t, SessionID("fast"), inval.SessionID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the fast session to invalidate")
+	}
+
+	close(release)
+
+	select {
+	case inval := <-output:
+		assert.Equal(t, SessionID("slow"), inval.SessionID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the slow session to invalidate")
+	}
+}
+
+func Test_Engine_EndSession(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	pm := &pipelinesMock{}
+	bus := events.NewBus()
+	sub := bus.Subscribe(10)
+	store := state.NewMemoryStore()
+	output := make(chan Invalidation)
+
+	e := NewEngine(context.Background(), pm, output, WithEventBus(bus), WithStateStore(store))
+	defer e.Shutdown()
+
+	id, err := e.CreateSession(SessionRequest{
+		Invariant: registry.HeuristicSignal,
+		Params:    models.Params{registry.RegisterParam: string(conduit.GasUsageAnomaly)},
+		Pipeline:  etl.PipelineRequest{Network: models.Layer1},
+	})
+	assert.NoError(t, err)
+
+	store.Set(state.Key{string(id), "seen"}, true)
+
+	pm.outputs[0] <- models.TransitData{Type: conduit.GasUsageAnomaly}
+	<-output
+
+	summary, err := e.EndSession(id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, summary.SessionID)
+	assert.Equal(t, registry.HeuristicSignal, summary.Invariant)
+	assert.Equal(t, int64(1), summary.Assessed)
+	assert.Equal(t, int64(1), summary.Invalidations)
+
+	assert.Equal(t, InvalidationTopic, (<-sub.Events).Topic)
+	event := <-sub.Events
+	assert.Equal(t, SessionEndedTopic, event.Topic)
+	assert.Equal(t, *summary, event.Payload.(SessionSummary))
+
+	assert.Equal(t, []etl.PipelineID{1}, pm.removed)
+	assert.Empty(t, store.Children(state.Key{}), "Ensuring the session's state is cleared")
+
+	_, err = e.GetSession(id)
+	assert.Error(t, err)
+
+	_, err = e.EndSession(id)
+	assert.Error(t, err, "Ensuring sessions can only be ended once")
+}
+
+func Test_Engine_CreateSessions(t *testing.T) {
+	logging.NewLogger(nil, false)
+
+	valid := SessionRequest{
+		Invariant: registry.HeuristicSignal,
+		Params:    models.Params{registry.RegisterParam: string(conduit.GasUsageAnomaly)},
+		Pipeline:  etl.PipelineRequest{Network: models.Layer1},
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		pipelines *pipelinesMock
+		reqs      []SessionRequest
+
+		created int
+		removed []etl.PipelineID
+	}{
+		{
+			name:        "Batch",
+			description: "Every session should be created in order",
+			pipelines:   &pipelinesMock{},
+			reqs:        []SessionRequest{valid, valid, valid},
+			created:     3,
+		},
+		{
+			name:        "Invalid Request",
+			description: "No pipeline should be created when any request is invalid",
+			pipelines:   &pipelinesMock{},
+			reqs:        []SessionRequest{valid, {Invariant: registry.HeuristicSignal}},
+		},
+		{
+			name:        "Pipeline Failure",
+			description: "Sessions created before a pipeline failure should be torn down",
+			pipelines:   &pipelinesMock{err: fmt.Errorf("no endpoint"), accepted: 2},
+			reqs:        []SessionRequest{valid, valid, valid},
+			removed:     []etl.PipelineID{2, 1},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			e := NewEngine(context.Background(), tc.pipelines, make(chan Invalidation))
+			defer e.Shutdown()
+
+			ids, err := e.CreateSessions(tc.reqs)
+			assert.Len(t, e.Sessions(), tc.created)
+			assert.Equal(t, tc.removed, tc.pipelines.removed)
+
+			if tc.created == 0 {
+				assert.Error(t, err)
+				assert.Nil(t, ids)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, ids, tc.created)
+		})
+	}
+}
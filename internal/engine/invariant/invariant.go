@@ -0,0 +1,97 @@
+package invariant
+
+import (
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	"github.com/base-org/pessimism/internal/engine/state"
+)
+
+// Type ... Identifies an invariant implementation
+type Type string
+
+// Severity ... Impact of an invariant invalidation; used by downstream consumers to prioritize alerts
+type Severity string
+
+const (
+	Low      Severity = "low"
+	Medium   Severity = "medium"
+	High     Severity = "high"
+	Critical Severity = "critical"
+)
+
+// severityRanks ... Relative order of all known severities
+var severityRanks = map[Severity]int{
+	Low:      1,
+	Medium:   2,
+	High:     3,
+	Critical: 4,
+}
+
+// Valid ... Returns true if the severity is a known level
+func (s Severity) Valid() bool {
+	_, found := severityRanks[s]
+	return found
+}
+
+// AtLeast ... Returns true if the severity is as or more severe than the other; unknown severities
+// are less severe than any known level
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s] >= severityRanks[other]
+}
+
+// Outcome ... Details of a single invariant invalidation
+type Outcome struct {
+	// Human readable summary of why the invariant no longer holds
+	Message string
+	// Structured values that led to the invalidation; included in emitted events
+	Context map[string]any
+	// Optional; overrides the session's severity for this invalidation only
+	Severity Severity
+	// Optional; identifies the violated condition so that repeated observations of it can be deduplicated,
+	// E.G an address whose balance remains too low. The message is used when empty
+	Fingerprint string
+}
+
+// Invariant ... Safety property asserted against every piece of transit data produced by a pipeline
+type Invariant interface {
+	// InputType ... Returns the register type of the data that the invariant assesses
+	InputType() models.RegisterType
+	// Invalidate ... Returns an outcome when the data violates the invariant and nil while it holds;
+	// errors are reserved for data that couldn't be assessed
+	Invalidate(td models.TransitData) (*Outcome, error)
+}
+
+// Stateful ... Implemented by invariants that retain state across inputs in the engine's state store
+type Stateful interface {
+	// SetState ... Provides a store scoped to the invariant's session; called before any input is assessed
+	SetState(store state.Store)
+}
+
+// Correlated ... Implemented by invariants that assess two pipelines at once, E.G an L1 & an L2 pipeline;
+// the outputs of both are joined and the invariant assesses every pipeline.JoinedPair, including
+// those left unmatched once the join timeout elapses
+type Correlated interface {
+	// CorrelatedInput ... Returns the register type of the data joined to the right side of every pair;
+	// the left side is of the invariant's input type
+	CorrelatedInput() models.RegisterType
+	// JoinSpec ... Returns the key functions & timeout used to join both pipelines; the output type is
+	// assigned by the engine
+	JoinSpec() pipeline.JoinSpec
+}
+
+// Clients ... Dialed RPC clients keyed by network; used by invariants that read chain state
+// while assessing data
+type Clients map[models.Network]client.EthClientInterface
+
+// Client ... Returns the client for the network; fail if no client is configured
+func (c Clients) Client(n models.Network) (client.EthClientInterface, error) {
+	ec, found := c[n]
+	if !found || ec == nil {
+		return nil, fmt.Errorf("no client configured for network: %s", n)
+	}
+
+	return ec, nil
+}
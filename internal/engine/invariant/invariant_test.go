@@ -0,0 +1,18 @@
+package invariant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Severity(t *testing.T) {
+	assert.True(t, Critical.Valid())
+	assert.False(t, Severity("urgent").Valid())
+	assert.False(t, Severity("").Valid())
+
+	assert.True(t, Critical.AtLeast(High))
+	assert.True(t, Medium.AtLeast(Medium))
+	assert.False(t, Low.AtLeast(Medium))
+	assert.False(t, Severity("urgent").AtLeast(Low), "Ensuring unknown severities rank below known levels")
+}
@@ -0,0 +1,146 @@
+package invariant
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+)
+
+// Schema errors
+const (
+	unknownParamErr  = "unknown param %s"
+	missingParamErr  = "param %s is required"
+	paramBelowMinErr = "param %s must be at least %s; got %s"
+	paramAboveMaxErr = "param %s must be at most %s; got %s"
+	paramKindErr     = "param %s has unknown kind %s"
+	paramObjectErr   = "param %s has invalid type %T; expected an object"
+)
+
+// ParamKind ... Type of value accepted by a param
+type ParamKind string
+
+const (
+	StringParam    ParamKind = "string"
+	IntParam       ParamKind = "int"
+	FloatParam     ParamKind = "float"
+	BigIntParam    ParamKind = "bigint"
+	DurationParam  ParamKind = "duration"
+	AddressParam   ParamKind = "address"
+	AddressesParam ParamKind = "addresses"
+	ObjectParam    ParamKind = "object"
+)
+
+// ParamSpec ... Declaration of a single session param
+type ParamSpec struct {
+	Kind     ParamKind `json:"kind"`
+	Required bool      `json:"required,omitempty"`
+	// Human readable summary presented to users when discovering invariants
+	Description string `json:"description"`
+
+	// Optional; inclusive bounds of numeric params, durations are bounded in seconds
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+// Bound ... Returns a pointer to the value; used to declare param bounds
+func Bound(v float64) *float64 {
+	return &v
+}
+
+// Schema ... Declares every param accepted by an invariant keyed by param name; constructors may
+// still reject combinations of params that the schema can't express
+type Schema map[string]ParamSpec
+
+// Validate ... Ensures that every param is declared & of the declared kind, that required params are
+// present and that numeric params are within bounds; params are checked in lexicographical order so
+// the same params always produce the same error
+func (s Schema) Validate(params models.Params) error {
+	keys := make([]string, 0, len(params)+len(s))
+	for key := range params {
+		if _, found := s[key]; !found {
+			return fmt.Errorf(unknownParamErr, key)
+		}
+	}
+
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		spec := s[key]
+		if !params.Has(key) {
+			if spec.Required {
+				return fmt.Errorf(missingParamErr, key)
+			}
+			continue
+		}
+
+		value, err := spec.numeric(key, params)
+		if err != nil {
+			return err
+		}
+
+		if value == nil {
+			continue
+		}
+
+		if spec.Min != nil && value.Cmp(big.NewFloat(*spec.Min)) < 0 {
+			return fmt.Errorf(paramBelowMinErr, key, big.NewFloat(*spec.Min).String(), value.String())
+		}
+
+		if spec.Max != nil && value.Cmp(big.NewFloat(*spec.Max)) > 0 {
+			return fmt.Errorf(paramAboveMaxErr, key, big.NewFloat(*spec.Max).String(), value.String())
+		}
+	}
+
+	return nil
+}
+
+// numeric ... Parses the param as the spec's kind; returns the value of numeric params, in seconds for
+// durations, and nil for all other kinds
+func (ps ParamSpec) numeric(key string, params models.Params) (*big.Float, error) {
+	switch ps.Kind {
+	case StringParam:
+		_, err := params.String(key, "")
+		return nil, err
+
+	case IntParam:
+		v, err := params.Int(key, 0)
+		return big.NewFloat(float64(v)), err
+
+	case FloatParam:
+		v, err := params.Float(key, 0)
+		return big.NewFloat(v), err
+
+	case BigIntParam:
+		v, err := params.BigInt(key, nil)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Float).SetInt(v), nil
+
+	case DurationParam:
+		v, err := params.Duration(key, 0)
+		return big.NewFloat(v.Seconds()), err
+
+	case AddressParam:
+		_, err := params.Address(key)
+		return nil, err
+
+	case AddressesParam:
+		_, err := params.Addresses(key)
+		return nil, err
+
+	case ObjectParam:
+		if _, ok := params[key].(map[string]any); !ok {
+			return nil, fmt.Errorf(paramObjectErr, key, params[key])
+		}
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf(paramKindErr, key, ps.Kind)
+	}
+}
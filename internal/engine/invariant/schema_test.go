@@ -0,0 +1,87 @@
+package invariant
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Schema_Validate(t *testing.T) {
+	schema := Schema{
+		"address":   {Kind: AddressParam, Required: true},
+		"threshold": {Kind: BigIntParam, Min: Bound(1)},
+		"window":    {Kind: IntParam, Min: Bound(0), Max: Bound(100)},
+		"duration":  {Kind: DurationParam, Max: Bound(60)},
+		"vars":      {Kind: ObjectParam},
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		params models.Params
+		err    string
+	}{
+		{
+			name:        "Valid",
+			description: "Params of the declared kinds within bounds should be accepted",
+			params: models.Params{
+				"address":   "0x0000000000000000000000000000000000000420",
+				"threshold": "1000000000000000000000",
+				"window":    100.0,
+				"duration":  "1m",
+				"vars":      map[string]any{"limit": 1},
+			},
+		},
+		{
+			name:        "Missing Required",
+			description: "Required params should be present",
+			params:      models.Params{"window": 1},
+			err:         "param address is required",
+		},
+		{
+			name:        "Unknown",
+			description: "Undeclared params should be rejected",
+			params:      models.Params{"address": "0x0000000000000000000000000000000000000420", "treshold": 1},
+			err:         "unknown param treshold",
+		},
+		{
+			name:        "Invalid Kind",
+			description: "Params of the wrong kind should be rejected",
+			params:      models.Params{"address": "0x42"},
+			err:         "param address has invalid address value 0x42",
+		},
+		{
+			name:        "Below Min",
+			description: "Numeric params below the min should be rejected",
+			params:      models.Params{"address": "0x0000000000000000000000000000000000000420", "threshold": 0},
+			err:         "param threshold must be at least 1; got 0",
+		},
+		{
+			name:        "Above Max",
+			description: "Durations should be bounded in seconds",
+			params:      models.Params{"address": "0x0000000000000000000000000000000000000420", "duration": "2m"},
+			err:         "param duration must be at most 60; got 120",
+		},
+		{
+			name:        "Invalid Object",
+			description: "Object params should be maps",
+			params:      models.Params{"address": "0x0000000000000000000000000000000000000420", "vars": "limit"},
+			err:         "param vars has invalid type string; expected an object",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			err := schema.Validate(tc.params)
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// L2ETHSupplyParam ... L2 contract reporting the ETH minted by deposits through totalSupply(); native
+	// ETH supply isn't exposed by execution clients so ETH is only checked when configured alongside the portal
+	L2ETHSupplyParam = "l2_eth_supply"
+	// StandardBridgeParam ... L1StandardBridge contract address; required when tokens are configured
+	StandardBridgeParam = "standard_bridge"
+	// L1TokensParam ... L1 token addresses locked in the standard bridge
+	L1TokensParam = "l1_tokens"
+	// L2TokensParam ... L2 token addresses minted for the L1 token at the same index
+	L2TokensParam = "l2_tokens"
+	// ToleranceParam ... Maximum tolerated deficit, in the asset's smallest unit
+	ToleranceParam = "tolerance"
+)
+
+// bridgedSupplySchema ... Params accepted by the invariant
+var bridgedSupplySchema = invariant.Schema{
+	conduit.PortalParam: {Kind: invariant.AddressParam,
+		Description: "OptimismPortal address holding the ETH locked on L1"},
+	L2ETHSupplyParam: {Kind: invariant.AddressParam,
+		Description: "L2 contract reporting the ETH minted by deposits"},
+	StandardBridgeParam: {Kind: invariant.AddressParam,
+		Description: "L1StandardBridge address; required when tokens are configured"},
+	L1TokensParam: {Kind: invariant.AddressesParam, Description: "L1 token addresses"},
+	L2TokensParam: {Kind: invariant.AddressesParam, Description: "L2 token addresses in the order of the L1 tokens"},
+	ToleranceParam: {Kind: invariant.BigIntParam, Min: invariant.Bound(0),
+		Description: "Maximum tolerated deficit in the asset's smallest unit"},
+}
+
+// bridgedAsset ... Asset locked on L1 & minted on L2
+type bridgedAsset struct {
+	name string
+	// locked ... Returns the amount locked on L1 at the height
+	locked func(ctx context.Context, height *big.Int) (*big.Int, error)
+	// minted ... Returns the amount minted on L2 as of the latest block
+	minted func(ctx context.Context) (*big.Int, error)
+}
+
+// bridgedSupply ... Compares assets locked in L1 bridge contracts against their minted supply on L2;
+// minting more on L2 than is locked on L1 means the bridge is insolvent
+type bridgedSupply struct {
+	ctx       context.Context
+	tolerance *big.Int
+	assets    []bridgedAsset
+}
+
+// NewBridgedSupply ... Initializer; requires an L1 & L2 client and at least one asset
+func NewBridgedSupply(ctx context.Context, clients invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	l1Client, err := clients.Client(models.Layer1)
+	if err != nil {
+		return nil, err
+	}
+
+	l2Client, err := clients.Client(models.Layer2)
+	if err != nil {
+		return nil, err
+	}
+
+	tolerance, err := params.BigInt(ToleranceParam, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+
+	if tolerance.Sign() < 0 {
+		return nil, fmt.Errorf("%s must not be negative", ToleranceParam)
+	}
+
+	assets, err := ethAsset(l1Client, l2Client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := tokenAssets(l1Client, l2Client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	assets = append(assets, tokens...)
+	if len(assets) == 0 {
+		return nil, fmt.Errorf("%s invariant requires %s & %s params or %s params",
+			BridgedSupply, conduit.PortalParam, L2ETHSupplyParam, L1TokensParam)
+	}
+
+	return &bridgedSupply{ctx: ctx, tolerance: tolerance, assets: assets}, nil
+}
+
+// ethAsset ... Returns the ETH asset if both the portal & L2 supply contract are configured
+func ethAsset(l1Client, l2Client client.EthClientInterface, params models.Params) ([]bridgedAsset, error) {
+	portal, err := params.Address(conduit.PortalParam)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := params.Address(L2ETHSupplyParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if (portal == common.Address{}) != (supply == common.Address{}) {
+		return nil, fmt.Errorf("%s & %s params must be configured together", conduit.PortalParam, L2ETHSupplyParam)
+	}
+
+	if portal == (common.Address{}) {
+		return nil, nil
+	}
+
+	supplyContract := client.NewERC20(l2Client, supply)
+	return []bridgedAsset{{
+		name: "ETH",
+		locked: func(ctx context.Context, height *big.Int) (*big.Int, error) {
+			return l1Client.BalanceAt(ctx, portal, height)
+		},
+		minted: func(ctx context.Context) (*big.Int, error) {
+			return supplyContract.TotalSupply(ctx, nil)
+		},
+	}}, nil
+}
+
+// tokenAssets ... Returns an asset for every configured L1 & L2 token pair
+func tokenAssets(l1Client, l2Client client.EthClientInterface, params models.Params) ([]bridgedAsset, error) {
+	l1Tokens, err := params.Addresses(L1TokensParam)
+	if err != nil {
+		return nil, err
+	}
+
+	l2Tokens, err := params.Addresses(L2TokensParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(l1Tokens) != len(l2Tokens) {
+		return nil, fmt.Errorf("%s & %s params must have the same length", L1TokensParam, L2TokensParam)
+	}
+
+	if len(l1Tokens) == 0 {
+		return nil, nil
+	}
+
+	bridge, err := params.RequiredAddress(StandardBridgeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]bridgedAsset, 0, len(l1Tokens))
+	for i := range l1Tokens {
+		l1Token, l2Token := client.NewERC20(l1Client, l1Tokens[i]), client.NewERC20(l2Client, l2Tokens[i])
+
+		assets = append(assets, bridgedAsset{
+			name: l1Token.Address().Hex(),
+			locked: func(ctx context.Context, height *big.Int) (*big.Int, error) {
+				return l1Token.BalanceOf(ctx, bridge, height)
+			},
+			minted: func(ctx context.Context) (*big.Int, error) {
+				return l2Token.TotalSupply(ctx, nil)
+			},
+		})
+	}
+
+	return assets, nil
+}
+
+// InputType ... Returns the geth block register type; sessions should read L1 blocks
+func (bs *bridgedSupply) InputType() models.RegisterType {
+	return conduit.GethBlock
+}
+
+// Invalidate ... Invalidates when any asset's L2 supply exceeds its L1 locked balance by more than the
+// tolerance; L1 balances are read at the block's height and L2 supplies as of the latest L2 block
+func (bs *bridgedSupply) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	block, err := models.ValueAs[types.Block](td)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, ctxCancel := context.WithTimeout(bs.ctx, time.Second*time.Duration(models.EthClientTimeout))
+	defer ctxCancel()
+
+	deficits := make([]string, 0)
+	// Names of the assets in deficit; the condition persists across blocks while the same assets are
+	deficient := make([]string, 0)
+	ctx := map[string]any{
+		"l1_block_number": block.Number().String(),
+		"tolerance":       bs.tolerance.String(),
+	}
+
+	for _, asset := range bs.assets {
+		locked, lErr := asset.locked(ctxTimeout, block.Number())
+		if lErr != nil {
+			return nil, fmt.Errorf("could not read %s locked on L1: %w", asset.name, lErr)
+		}
+
+		minted, mErr := asset.minted(ctxTimeout)
+		if mErr != nil {
+			return nil, fmt.Errorf("could not read %s minted on L2: %w", asset.name, mErr)
+		}
+
+		deficit := new(big.Int).Sub(minted, locked)
+		if deficit.Cmp(bs.tolerance) <= 0 {
+			continue
+		}
+
+		deficits = append(deficits, fmt.Sprintf("%s deficit of %s", asset.name, deficit))
+		deficient = append(deficient, asset.name)
+		ctx[asset.name] = map[string]string{
+			"locked":  locked.String(),
+			"minted":  minted.String(),
+			"deficit": deficit.String(),
+		}
+	}
+
+	if len(deficits) == 0 {
+		return nil, nil
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("L2 supply exceeds L1 locked balance at L1 block %s: %s",
+			block.Number(), strings.Join(deficits, ", ")),
+		Context:     ctx,
+		Fingerprint: strings.Join(deficient, ","),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_BridgedSupply(t *testing.T) {
+	portal := common.HexToAddress("0x10")
+	bridge := common.HexToAddress("0x11")
+	l2ETHSupply := common.HexToAddress("0x12")
+	l1Token := common.HexToAddress("0x20")
+	l2Token := common.HexToAddress("0x21")
+
+	height := big.NewInt(100)
+	block := models.TransitData{
+		Type:  conduit.GethBlock,
+		Value: *types.NewBlockWithHeader(&types.Header{Number: height}),
+	}
+
+	// to ... Matches calls made to the address
+	to := func(addr common.Address) any {
+		return mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == addr
+		})
+	}
+	uint256 := func(v int64) []byte {
+		return common.BigToHash(big.NewInt(v)).Bytes()
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		params      models.Params
+		ethMinted   int64
+		tokenMinted int64
+		constructed bool
+		deficits    []string
+	}{
+		{
+			name:        "Solvent Bridge",
+			description: "Supplies backed by locked balances should not invalidate",
+			params: models.Params{conduit.PortalParam: portal.Hex(), L2ETHSupplyParam: l2ETHSupply.Hex(),
+				StandardBridgeParam: bridge.Hex(), L1TokensParam: []string{l1Token.Hex()},
+				L2TokensParam: []string{l2Token.Hex()}},
+			ethMinted:   1000,
+			tokenMinted: 500,
+			constructed: true,
+		},
+		{
+			name:        "Token Deficit",
+			description: "Token supplies exceeding the locked balance should invalidate",
+			params: models.Params{conduit.PortalParam: portal.Hex(), L2ETHSupplyParam: l2ETHSupply.Hex(),
+				StandardBridgeParam: bridge.Hex(), L1TokensParam: []string{l1Token.Hex()},
+				L2TokensParam: []string{l2Token.Hex()}},
+			ethMinted:   1000,
+			tokenMinted: 501,
+			constructed: true,
+			deficits:    []string{l1Token.Hex()},
+		},
+		{
+			name:        "Tolerated Deficit",
+			description: "Deficits within the tolerance should not invalidate",
+			params: models.Params{conduit.PortalParam: portal.Hex(), L2ETHSupplyParam: l2ETHSupply.Hex(),
+				ToleranceParam: 10},
+			ethMinted:   1010,
+			constructed: true,
+		},
+		{
+			name:        "ETH Deficit",
+			description: "ETH supplies exceeding the portal balance by more than the tolerance should invalidate",
+			params: models.Params{conduit.PortalParam: portal.Hex(), L2ETHSupplyParam: l2ETHSupply.Hex(),
+				ToleranceParam: 10},
+			ethMinted:   1011,
+			constructed: true,
+			deficits:    []string{"ETH"},
+		},
+		{
+			name:        "Missing Assets",
+			description: "Sessions without any asset should be rejected",
+			params:      models.Params{},
+		},
+		{
+			name:        "Incomplete ETH Config",
+			description: "The portal should require an L2 ETH supply contract",
+			params:      models.Params{conduit.PortalParam: portal.Hex()},
+		},
+		{
+			name:        "Mismatched Tokens",
+			description: "Every L1 token should have a corresponding L2 token",
+			params: models.Params{StandardBridgeParam: bridge.Hex(),
+				L1TokensParam: []string{l1Token.Hex()}, L2TokensParam: []string{}},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			l1Client, l2Client := new(mocks.EthClient), new(mocks.EthClient)
+			l1Client.On("BalanceAt", mock.Anything, portal, height).Return(big.NewInt(1000), nil)
+			l1Client.On("CallContract", mock.Anything, to(l1Token), height).Return(uint256(500), nil)
+			l2Client.On("CallContract", mock.Anything, to(l2ETHSupply), (*big.Int)(nil)).
+				Return(uint256(tc.ethMinted), nil)
+			l2Client.On("CallContract", mock.Anything, to(l2Token), (*big.Int)(nil)).
+				Return(uint256(tc.tokenMinted), nil)
+
+			inv, err := NewBridgedSupply(context.Background(),
+				invariant.Clients{models.Layer1: l1Client, models.Layer2: l2Client}, tc.params)
+			if !tc.constructed {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			outcome, err := inv.Invalidate(block)
+			assert.NoError(t, err)
+
+			if len(tc.deficits) == 0 {
+				assert.Nil(t, outcome)
+				return
+			}
+
+			assert.NotNil(t, outcome)
+			for _, asset := range tc.deficits {
+				assert.Contains(t, outcome.Context, asset)
+			}
+			assert.Equal(t, strings.Join(tc.deficits, ","), outcome.Fingerprint)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ethAssetKey ... State key segment used for native ETH outflows
+const ethAssetKey = "ETH"
+
+// cumulativeOutflowSchema ... Params accepted by the invariant
+var cumulativeOutflowSchema = invariant.Schema{
+	conduit.AddressesParam: {Kind: invariant.AddressesParam, Required: true, Description: "Watched addresses"},
+	ThresholdParam: {Kind: invariant.BigIntParam, Required: true, Min: invariant.Bound(1),
+		Description: "Cumulative outflow in wei that invalidates"},
+	conduit.BlockWindowParam: {Kind: invariant.IntParam, Min: invariant.Bound(0),
+		Description: "Number of blocks after which outflows reset; zero never resets"},
+}
+
+// outflow ... Value transferred out of an address within the current block window
+type outflow struct {
+	Start uint64
+	Total *big.Int
+	// Whether the total has already crossed the threshold within the window
+	Fired bool
+}
+
+// cumulativeOutflow ... Tracks the ETH sent by watched addresses across blocks; state is keyed by
+// address & asset so that each address accumulates independently
+type cumulativeOutflow struct {
+	addresses map[common.Address]struct{}
+	threshold *big.Int
+	// Number of blocks after which an address' total resets; zero never resets
+	window uint64
+
+	store state.Store
+}
+
+// NewCumulativeOutflow ... Initializer; requires the addresses & a positive threshold param
+func NewCumulativeOutflow(_ context.Context, _ invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	addresses, err := params.Addresses(conduit.AddressesParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("%s invariant requires at least one address", CumulativeOutflow)
+	}
+
+	threshold, err := params.BigInt(ThresholdParam, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if threshold == nil || threshold.Sign() <= 0 {
+		return nil, fmt.Errorf("%s invariant requires a positive %s param", CumulativeOutflow, ThresholdParam)
+	}
+
+	window, err := params.NonNegativeInt(conduit.BlockWindowParam, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	co := &cumulativeOutflow{
+		addresses: make(map[common.Address]struct{}, len(addresses)),
+		threshold: threshold,
+		window:    uint64(window),
+		store:     state.NewMemoryStore(),
+	}
+	for _, addr := range addresses {
+		co.addresses[addr] = struct{}{}
+	}
+
+	return co, nil
+}
+
+// SetState ... Stores per address outflows in the session's store
+func (co *cumulativeOutflow) SetState(store state.Store) {
+	co.store = store
+}
+
+// InputType ... Returns the address watch register type
+func (co *cumulativeOutflow) InputType() models.RegisterType {
+	return conduit.AddressWatchTX
+}
+
+// Invalidate ... Invalidates once per window when a watched address' cumulative outflow reaches the threshold;
+// transactions not sent by a watched address are ignored
+func (co *cumulativeOutflow) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	tx, err := models.ValueAs[*types.Transaction](td)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Value().Sign() == 0 {
+		return nil, nil
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover sender of %s: %w", tx.Hash().Hex(), err)
+	}
+
+	if _, watched := co.addresses[from]; !watched {
+		return nil, nil
+	}
+
+	var height uint64
+	if td.Height != nil {
+		height = td.Height.Uint64()
+	}
+
+	key := state.Key{from.Hex(), ethAssetKey}
+
+	current := outflow{Start: height, Total: new(big.Int)}
+	if val, found := co.store.Get(key); found {
+		current = val.(outflow)
+	}
+
+	if co.window > 0 && height >= current.Start+co.window {
+		current = outflow{Start: height, Total: new(big.Int)}
+	}
+
+	current.Total = new(big.Int).Add(current.Total, tx.Value())
+	crossed := !current.Fired && current.Total.Cmp(co.threshold) >= 0
+	if crossed {
+		current.Fired = true
+	}
+	co.store.Set(key, current)
+
+	if !crossed {
+		return nil, nil
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("%s sent %s wei since block %d; threshold is %s",
+			from.Hex(), current.Total, current.Start, co.threshold),
+		Context: map[string]any{
+			"address":     from.Hex(),
+			"asset":       ethAssetKey,
+			"total":       current.Total.String(),
+			"threshold":   co.threshold.String(),
+			"start_block": current.Start,
+			"tx_hash":     tx.Hash().Hex(),
+		},
+		// Every transaction exceeding the threshold within the same window is the same condition
+		Fingerprint: fmt.Sprintf("%s:%d", from.Hex(), current.Start),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CumulativeOutflow(t *testing.T) {
+	watchedKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	watched := crypto.PubkeyToAddress(watchedKey.PublicKey)
+	signer := types.NewLondonSigner(big.NewInt(1))
+	to := common.HexToAddress("0x1")
+
+	// transfer ... Returns a signed transfer of the value included at the height
+	transfer := func(from string, height int64, value int64) models.TransitData {
+		key := watchedKey
+		if from == "other" {
+			key = otherKey
+		}
+
+		tx, sErr := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+			ChainID: big.NewInt(1), To: &to, Value: big.NewInt(value),
+		}), signer, key)
+		assert.NoError(t, sErr)
+
+		return models.TransitData{Type: conduit.AddressWatchTX, Value: tx, Height: big.NewInt(height)}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		inputs      []models.TransitData
+		invalidated []bool
+		tracked     bool
+	}{
+		{
+			name:        "Cumulative Breach",
+			description: "Outflows should accumulate across blocks & invalidate once per window",
+			inputs: []models.TransitData{transfer("watched", 1, 40), transfer("watched", 2, 40),
+				transfer("watched", 3, 40), transfer("watched", 4, 40)},
+			invalidated: []bool{false, false, true, false},
+			tracked:     true,
+		},
+		{
+			name:        "Window Reset",
+			description: "Totals should reset once the block window elapses",
+			inputs: []models.TransitData{transfer("watched", 1, 60), transfer("watched", 11, 60),
+				transfer("watched", 12, 60)},
+			invalidated: []bool{false, false, true},
+			tracked:     true,
+		},
+		{
+			name:        "Unwatched Sender",
+			description: "Transfers from other addresses should be ignored",
+			inputs:      []models.TransitData{transfer("other", 1, 200)},
+			invalidated: []bool{false},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			inv, err := NewCumulativeOutflow(context.Background(), nil, models.Params{
+				conduit.AddressesParam:   []string{watched.Hex()},
+				ThresholdParam:           100,
+				conduit.BlockWindowParam: 10,
+			})
+			assert.NoError(t, err)
+
+			store := state.NewMemoryStore()
+			inv.(invariant.Stateful).SetState(state.Scoped(store, "session"))
+
+			for j, input := range tc.inputs {
+				outcome, err := inv.Invalidate(input)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.invalidated[j], outcome != nil, "input %d", j)
+			}
+
+			if tc.tracked {
+				assert.Equal(t, []string{ethAssetKey}, store.Children(state.Key{"session", watched.Hex()}),
+					"Ensuring outflows are nested by session, address & asset")
+			} else {
+				assert.Empty(t, store.Children(state.Key{"session"}))
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/cel-go/cel"
+)
+
+/*
+	Expression invariants evaluate a CEL (https://github.com/google/cel-spec) boolean expression against
+	every input & invalidate whenever it evaluates to true. Inputs are exposed through the following variables;
+	only the variable matching the input's value type is populated:
+
+	tx       - Transactions:  hash, to, value, gas, gas_price, nonce, data
+	block    - Blocks:        number, hash, timestamp, gas_used, gas_limit, base_fee, tx_count
+	log      - Event logs:    address, topics, data, tx_hash, block_number
+	value    - Anything else, decoded from its JSON representation
+	height   - Block height of the input; null if unknown
+	register - Register type of the input
+
+	Wei amounts are doubles so that they can be compared against literals like 100e18, and addresses & hashes
+	are lowercase hex strings. Entries of the vars param are declared as additional variables, with address
+	strings lowercased, so that expressions like `tx.value > 100e18 && tx.to in watchlist` can be written.
+*/
+
+const (
+	// ExpressionParam ... CEL expression that invalidates when it evaluates to true
+	ExpressionParam = "expression"
+	// VarsParam ... Mapping of additional variable names to values available to the expression
+	VarsParam = "vars"
+)
+
+// expressionSchema ... Params accepted by the invariant
+var expressionSchema = invariant.Schema{
+	RegisterParam:   {Kind: invariant.StringParam, Required: true, Description: "Register type of the assessed data"},
+	ExpressionParam: {Kind: invariant.StringParam, Required: true, Description: "CEL expression that invalidates"},
+	VarsParam:       {Kind: invariant.ObjectParam, Description: "Additional variables available to the expression"},
+}
+
+// reservedVars ... Variables populated from the input which can't be declared through the vars param
+var reservedVars = []string{"tx", "block", "log", "value", "height", "register"}
+
+// expression ... Generic invariant evaluating a user supplied CEL expression
+type expression struct {
+	register models.RegisterType
+	source   string
+	program  cel.Program
+	vars     map[string]any
+}
+
+// NewExpression ... Initializer; requires the register & expression params
+func NewExpression(_ context.Context, _ invariant.Clients, params models.Params) (invariant.Invariant, error) {
+	rt, err := params.String(RegisterParam, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if rt == "" {
+		return nil, fmt.Errorf("%s invariant requires a %s param", Expression, RegisterParam)
+	}
+
+	if _, rErr := conduit.GetRegister(models.RegisterType(rt)); rErr != nil {
+		return nil, rErr
+	}
+
+	source, err := params.String(ExpressionParam, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("%s invariant requires an %s param", Expression, ExpressionParam)
+	}
+
+	vars, err := expressionVars(params)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []cel.EnvOption{cel.CrossTypeNumericComparisons(true)}
+	for _, name := range reservedVars {
+		opts = append(opts, cel.Variable(name, cel.DynType))
+	}
+	for name := range vars {
+		opts = append(opts, cel.Variable(name, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ExpressionParam, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("%s must evaluate to a bool; got %s", ExpressionParam, ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expression{
+		register: models.RegisterType(rt),
+		source:   source,
+		program:  program,
+		vars:     vars,
+	}, nil
+}
+
+// expressionVars ... Returns the user declared variables with address strings lowercased
+func expressionVars(params models.Params) (map[string]any, error) {
+	raw, found := params[VarsParam]
+	if !found {
+		return map[string]any{}, nil
+	}
+
+	vars, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("param %s has invalid type %T; expected map[string]any", VarsParam, raw)
+	}
+
+	for _, name := range reservedVars {
+		if _, reserved := vars[name]; reserved {
+			return nil, fmt.Errorf("%s param can't declare reserved variable %s", VarsParam, name)
+		}
+	}
+
+	normalized := make(map[string]any, len(vars))
+	for name, val := range vars {
+		normalized[name] = lowerAddresses(val)
+	}
+
+	return normalized, nil
+}
+
+// lowerAddresses ... Lowercases address strings within the value & any nested lists
+func lowerAddresses(val any) any {
+	switch v := val.(type) {
+	case string:
+		if common.IsHexAddress(v) {
+			return strings.ToLower(v)
+		}
+		return v
+
+	case []string:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			items = append(items, lowerAddresses(item))
+		}
+		return items
+
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			items = append(items, lowerAddresses(item))
+		}
+		return items
+
+	default:
+		return val
+	}
+}
+
+// InputType ... Returns the configured register type
+func (e *expression) InputType() models.RegisterType {
+	return e.register
+}
+
+// Invalidate ... Invalidates when the expression evaluates to true; evaluation errors, E.G, accessing
+// a missing field, are returned so that the input is skipped
+func (e *expression) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	activation, err := e.activation(td)
+	if err != nil {
+		return nil, err
+	}
+
+	out, _, err := e.program.Eval(activation)
+	if err != nil {
+		return nil, fmt.Errorf("could not evaluate expression: %w", err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return nil, fmt.Errorf("expression evaluated to %T; expected bool", out.Value())
+	}
+
+	if !result {
+		return nil, nil
+	}
+
+	ctx := map[string]any{"expression": e.source}
+	for _, name := range reservedVars {
+		if fields, isMap := activation[name].(map[string]any); isMap && len(fields) == 0 {
+			continue
+		}
+		ctx[name] = activation[name]
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("expression `%s` evaluated to true", e.source),
+		Context: ctx,
+	}, nil
+}
+
+// activation ... Returns the expression variables populated from the transit data
+func (e *expression) activation(td models.TransitData) (map[string]any, error) {
+	activation := make(map[string]any, len(e.vars)+len(reservedVars))
+	for name, val := range e.vars {
+		activation[name] = val
+	}
+
+	activation["register"] = string(td.Type)
+	activation["height"] = nil
+	if td.Height != nil {
+		activation["height"] = td.Height.Int64()
+	}
+
+	for _, name := range []string{"tx", "block", "log", "value"} {
+		activation[name] = map[string]any{}
+	}
+
+	switch v := td.Value.(type) {
+	case *types.Transaction:
+		activation["tx"] = txVars(v)
+
+	case types.Block:
+		activation["block"] = blockVars(&v)
+
+	case *types.Block:
+		activation["block"] = blockVars(v)
+
+	case types.Log:
+		activation["log"] = logVars(&v)
+
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode %s value: %w", td.Type, err)
+		}
+
+		var decoded any
+		if dErr := json.Unmarshal(encoded, &decoded); dErr != nil {
+			return nil, fmt.Errorf("could not decode %s value: %w", td.Type, dErr)
+		}
+		activation["value"] = decoded
+	}
+
+	return activation, nil
+}
+
+// weiFloat ... Returns the amount as a double; precision beyond 53 bits is lost
+func weiFloat(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+
+	f, _ := new(big.Float).SetInt(amount).Float64()
+	return f
+}
+
+// lowerHex ... Returns the lowercase hex representation of the address or hash bytes
+func lowerHex(b []byte) string {
+	return strings.ToLower(hexutil.Encode(b))
+}
+
+// txVars ... Returns the expression fields of a transaction
+func txVars(tx *types.Transaction) map[string]any {
+	to := ""
+	if tx.To() != nil {
+		to = lowerHex(tx.To().Bytes())
+	}
+
+	return map[string]any{
+		"hash":      lowerHex(tx.Hash().Bytes()),
+		"to":        to,
+		"value":     weiFloat(tx.Value()),
+		"gas":       int64(tx.Gas()),
+		"gas_price": weiFloat(tx.GasPrice()),
+		"nonce":     int64(tx.Nonce()),
+		"data":      hexutil.Encode(tx.Data()),
+	}
+}
+
+// blockVars ... Returns the expression fields of a block
+func blockVars(block *types.Block) map[string]any {
+	return map[string]any{
+		"number":    block.Number().Int64(),
+		"hash":      lowerHex(block.Hash().Bytes()),
+		"timestamp": int64(block.Time()),
+		"gas_used":  int64(block.GasUsed()),
+		"gas_limit": int64(block.GasLimit()),
+		"base_fee":  weiFloat(block.BaseFee()),
+		"tx_count":  int64(len(block.Transactions())),
+	}
+}
+
+// logVars ... Returns the expression fields of an event log
+func logVars(log *types.Log) map[string]any {
+	topics := make([]any, 0, len(log.Topics))
+	for _, topic := range log.Topics {
+		topics = append(topics, lowerHex(topic.Bytes()))
+	}
+
+	return map[string]any{
+		"address":      lowerHex(log.Address.Bytes()),
+		"topics":       topics,
+		"data":         hexutil.Encode(log.Data),
+		"tx_hash":      lowerHex(log.TxHash.Bytes()),
+		"block_number": int64(log.BlockNumber),
+	}
+}
@@ -0,0 +1,149 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Expression(t *testing.T) {
+	watched := common.HexToAddress("0x00000000000000000000000000000000000000Ab")
+	ether := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	tx := func(to common.Address, eth int64) models.TransitData {
+		return models.TransitData{
+			Type:   conduit.AddressWatchTX,
+			Value:  types.NewTx(&types.LegacyTx{To: &to, Value: new(big.Int).Mul(big.NewInt(eth), ether)}),
+			Height: big.NewInt(10),
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		params      models.Params
+		constructed bool
+		input       models.TransitData
+		invalidated bool
+		err         bool
+	}{
+		{
+			name:        "Watchlist Transfer",
+			description: "Large transfers to watched addresses should invalidate regardless of address case",
+			params: models.Params{
+				RegisterParam:   string(conduit.AddressWatchTX),
+				ExpressionParam: "tx.value > 100e18 && tx.to in watchlist",
+				VarsParam:       map[string]any{"watchlist": []any{watched.Hex()}},
+			},
+			constructed: true,
+			input:       tx(watched, 101),
+			invalidated: true,
+		},
+		{
+			name:        "Small Watchlist Transfer",
+			description: "Transfers below the threshold should not invalidate",
+			params: models.Params{
+				RegisterParam:   string(conduit.AddressWatchTX),
+				ExpressionParam: "tx.value > 100e18 && tx.to in watchlist",
+				VarsParam:       map[string]any{"watchlist": []any{watched.Hex()}},
+			},
+			constructed: true,
+			input:       tx(watched, 100),
+		},
+		{
+			name:        "Block Fields",
+			description: "Block inputs should be exposed through the block variable",
+			params: models.Params{
+				RegisterParam:   string(conduit.GethBlock),
+				ExpressionParam: "block.gas_used > 100 && height == 7",
+			},
+			constructed: true,
+			input: models.TransitData{
+				Type:   conduit.GethBlock,
+				Value:  *types.NewBlockWithHeader(&types.Header{Number: big.NewInt(7), GasUsed: 101}),
+				Height: big.NewInt(7),
+			},
+			invalidated: true,
+		},
+		{
+			name:        "Generic Value",
+			description: "Other values should be exposed through their JSON representation",
+			params: models.Params{
+				RegisterParam:   string(conduit.ContractCreationRate),
+				ExpressionParam: "value.Count >= 3",
+			},
+			constructed: true,
+			input: models.TransitData{
+				Type:  conduit.ContractCreationRate,
+				Value: conduit.ContractCreationRateEvent{Count: 3},
+			},
+			invalidated: true,
+		},
+		{
+			name:        "Missing Field",
+			description: "Accessing fields the input doesn't have should be surfaced as an error",
+			params: models.Params{
+				RegisterParam:   string(conduit.GethBlock),
+				ExpressionParam: "tx.value > 0",
+			},
+			constructed: true,
+			input: models.TransitData{
+				Type:  conduit.GethBlock,
+				Value: *types.NewBlockWithHeader(&types.Header{Number: big.NewInt(7)}),
+			},
+			err: true,
+		},
+		{
+			name:        "Syntax Error",
+			description: "Invalid expressions should be rejected on construction",
+			params: models.Params{
+				RegisterParam:   string(conduit.GethBlock),
+				ExpressionParam: "block.gas_used >",
+			},
+		},
+		{
+			name:        "Non Boolean",
+			description: "Expressions that can't evaluate to a bool should be rejected on construction",
+			params: models.Params{
+				RegisterParam:   string(conduit.GethBlock),
+				ExpressionParam: "'not a bool'",
+			},
+		},
+		{
+			name:        "Reserved Variable",
+			description: "Vars shouldn't be able to shadow input variables",
+			params: models.Params{
+				RegisterParam:   string(conduit.GethBlock),
+				ExpressionParam: "true",
+				VarsParam:       map[string]any{"tx": 1},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			inv, err := NewExpression(context.Background(), nil, tc.params)
+			if !tc.constructed {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			outcome, err := inv.Invalidate(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.invalidated, outcome != nil)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// L2OutputOracleParam ... L2OutputOracle contract address
+	L2OutputOracleParam = "l2_output_oracle"
+)
+
+var (
+	outputProposedSig = crypto.Keccak256Hash([]byte("OutputProposed(bytes32,uint256,uint256,uint256)"))
+
+	// outputRootVersion ... Version of the output root preimage; only V0 is defined
+	outputRootVersion = common.Hash{}
+)
+
+// faultDetectorSchema ... Params accepted by the invariant
+var faultDetectorSchema = invariant.Schema{
+	L2OutputOracleParam: {Kind: invariant.AddressParam, Required: true, Description: "L2OutputOracle address"},
+	MessagePasserParam: {Kind: invariant.AddressParam,
+		Description: "L2ToL1MessagePasser address; defaults to the predeploy"},
+}
+
+// faultDetector ... Recomputes every output root proposed to the L2OutputOracle from the L2 node's view
+// of the proposed block; a divergent root means the proposer committed to an invalid L2 state
+type faultDetector struct {
+	ctx           context.Context
+	l2Client      client.EthClientInterface
+	outputOracle  common.Address
+	messagePasser common.Address
+}
+
+// NewFaultDetector ... Initializer; requires the l2_output_oracle param & an L2 client
+func NewFaultDetector(ctx context.Context, clients invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	outputOracle, err := params.RequiredAddress(L2OutputOracleParam)
+	if err != nil {
+		return nil, err
+	}
+
+	messagePasser, err := params.Address(MessagePasserParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if messagePasser == (common.Address{}) {
+		messagePasser = messagePasserPredeploy
+	}
+
+	l2Client, err := clients.Client(models.Layer2)
+	if err != nil {
+		return nil, err
+	}
+
+	return &faultDetector{
+		ctx:           ctx,
+		l2Client:      l2Client,
+		outputOracle:  outputOracle,
+		messagePasser: messagePasser,
+	}, nil
+}
+
+// InputType ... Returns the event log register type
+func (fd *faultDetector) InputType() models.RegisterType {
+	return conduit.EventLog
+}
+
+// Invalidate ... Invalidates when an OutputProposed event emitted by the output oracle commits to a root
+// that differs from the one computed from the L2 node; all other logs are ignored. Outputs proposed for
+// blocks that the L2 node hasn't synced yet can't be assessed and are returned as errors
+func (fd *faultDetector) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	log, err := models.ValueAs[types.Log](td)
+	if err != nil {
+		return nil, err
+	}
+
+	if log.Removed || log.Address != fd.outputOracle || len(log.Topics) < 4 || log.Topics[0] != outputProposedSig {
+		return nil, nil
+	}
+
+	proposed := log.Topics[1]
+	outputIndex := log.Topics[2].Big()
+	l2Height := log.Topics[3].Big()
+
+	expected, err := fd.outputRoot(l2Height)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute output root for L2 block %s: %w", l2Height, err)
+	}
+
+	if expected == proposed {
+		return nil, nil
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("output root %s proposed for L2 block %s diverges from the computed root %s",
+			proposed.Hex(), l2Height, expected.Hex()),
+		Context: map[string]any{
+			"proposed_root":   proposed.Hex(),
+			"expected_root":   expected.Hex(),
+			"output_index":    outputIndex.String(),
+			"l2_block_number": l2Height.String(),
+			"tx_hash":         log.TxHash.Hex(),
+		},
+	}, nil
+}
+
+// outputRoot ... Computes the V0 output root of the L2 block:
+// keccak256(version ++ state root ++ message passer storage root ++ block hash)
+func (fd *faultDetector) outputRoot(height *big.Int) (common.Hash, error) {
+	ctxTimeout, ctxCancel := context.WithTimeout(fd.ctx, time.Second*time.Duration(models.EthClientTimeout))
+	defer ctxCancel()
+
+	header, err := fd.l2Client.HeaderByNumber(ctxTimeout, height)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	proof, err := fd.l2Client.GetProof(ctxTimeout, fd.messagePasser, nil, height)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(
+		outputRootVersion.Bytes(),
+		header.Root.Bytes(),
+		proof.StorageHash.Bytes(),
+		header.Hash().Bytes(),
+	), nil
+}
@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_FaultDetector(t *testing.T) {
+	outputOracle := common.HexToAddress("0xdfe97868233d1aa22e815a266982f2cf17685a27")
+	l2Height := big.NewInt(105)
+	header := &types.Header{Number: l2Height, Root: common.HexToHash("0x5")}
+	storageRoot := common.HexToHash("0x6")
+
+	validRoot := crypto.Keccak256Hash(common.Hash{}.Bytes(), header.Root.Bytes(),
+		storageRoot.Bytes(), header.Hash().Bytes())
+
+	proposal := func(emitter common.Address, root common.Hash) models.TransitData {
+		return models.TransitData{
+			Type: conduit.EventLog,
+			Value: types.Log{
+				Address: emitter,
+				Topics: []common.Hash{outputProposedSig, root,
+					common.BigToHash(big.NewInt(3)), common.BigToHash(l2Height)},
+			},
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		input       models.TransitData
+		headerErr   error
+		called      bool
+		invalidated bool
+		err         bool
+	}{
+		{
+			name:        "Valid Output",
+			description: "Output roots matching the L2 node's state should not invalidate",
+			input:       proposal(outputOracle, validRoot),
+			called:      true,
+		},
+		{
+			name:        "Divergent Output",
+			description: "Output roots diverging from the L2 node's state should invalidate",
+			input:       proposal(outputOracle, common.HexToHash("0xbad")),
+			called:      true,
+			invalidated: true,
+		},
+		{
+			name:        "Unwatched Emitter",
+			description: "OutputProposed events emitted by other contracts should be ignored",
+			input:       proposal(common.HexToAddress("0x1"), common.HexToHash("0xbad")),
+		},
+		{
+			name:        "Unsynced L2 Node",
+			description: "Outputs for blocks the L2 node can't serve should be surfaced as errors",
+			input:       proposal(outputOracle, validRoot),
+			headerErr:   fmt.Errorf("not found"),
+			called:      true,
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			l2Client := new(mocks.EthClient)
+			if tc.headerErr != nil {
+				l2Client.On("HeaderByNumber", mock.Anything, l2Height).Return(nil, tc.headerErr)
+			} else {
+				l2Client.On("HeaderByNumber", mock.Anything, l2Height).Return(header, nil)
+			}
+			l2Client.On("GetProof", mock.Anything, messagePasserPredeploy, []string(nil), l2Height).
+				Return(&gethclient.AccountResult{StorageHash: storageRoot}, nil)
+
+			inv, err := NewFaultDetector(context.Background(),
+				invariant.Clients{models.Layer2: l2Client},
+				models.Params{L2OutputOracleParam: outputOracle.Hex()})
+			assert.NoError(t, err)
+
+			outcome, err := inv.Invalidate(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.invalidated, outcome != nil)
+			if tc.invalidated {
+				assert.Equal(t, validRoot.Hex(), outcome.Context["expected_root"])
+				assert.Equal(t, l2Height.String(), outcome.Context["l2_block_number"])
+			}
+
+			if tc.called {
+				l2Client.AssertNumberOfCalls(t, "HeaderByNumber", 1)
+			} else {
+				l2Client.AssertNotCalled(t, "HeaderByNumber", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// RegisterParam ... Register type whose data is assessed
+	RegisterParam = "register"
+)
+
+// heuristicSignalSchema ... Params accepted by the invariant
+var heuristicSignalSchema = invariant.Schema{
+	RegisterParam: {Kind: invariant.StringParam, Required: true, Description: "Heuristic register type"},
+}
+
+// heuristicSignal ... Treats every piece of data emitted by a heuristic register as an invalidation;
+// heuristic registers, E.G GAS_USAGE_ANOMALY, only emit data once their own condition is met
+type heuristicSignal struct {
+	register models.RegisterType
+}
+
+// NewHeuristicSignal ... Initializer; requires the register param
+func NewHeuristicSignal(_ context.Context, _ invariant.Clients, params models.Params) (invariant.Invariant, error) {
+	rt, err := params.String(RegisterParam, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if rt == "" {
+		return nil, fmt.Errorf("%s invariant requires a %s param", HeuristicSignal, RegisterParam)
+	}
+
+	if _, rErr := conduit.GetRegister(models.RegisterType(rt)); rErr != nil {
+		return nil, rErr
+	}
+
+	return &heuristicSignal{register: models.RegisterType(rt)}, nil
+}
+
+// InputType ... Returns the configured register type
+func (hs *heuristicSignal) InputType() models.RegisterType {
+	return hs.register
+}
+
+// Invalidate ... Invalidates on every input
+func (hs *heuristicSignal) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	msg := fmt.Sprintf("%s heuristic fired", td.Type)
+	if td.Height != nil {
+		msg = fmt.Sprintf("%s at height %s", msg, td.Height)
+	}
+
+	return &invariant.Outcome{
+		Message: msg,
+		Context: map[string]any{"event": td.Value},
+	}, nil
+}
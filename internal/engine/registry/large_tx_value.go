@@ -0,0 +1,74 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// ThresholdParam ... Minimum transferred value, in wei, that invalidates
+	ThresholdParam = "threshold"
+)
+
+// largeTxValueSchema ... Params accepted by the invariant
+var largeTxValueSchema = invariant.Schema{
+	ThresholdParam: {Kind: invariant.BigIntParam, Required: true, Min: invariant.Bound(1),
+		Description: "Minimum transferred value in wei"},
+}
+
+// largeTxValue ... Flags watched address transactions transferring at least the threshold
+type largeTxValue struct {
+	threshold *big.Int
+}
+
+// NewLargeTxValue ... Initializer; requires a positive threshold param
+func NewLargeTxValue(_ context.Context, _ invariant.Clients, params models.Params) (invariant.Invariant, error) {
+	threshold, err := params.BigInt(ThresholdParam, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if threshold == nil || threshold.Sign() <= 0 {
+		return nil, fmt.Errorf("%s invariant requires a positive %s param", LargeTxValue, ThresholdParam)
+	}
+
+	return &largeTxValue{threshold: threshold}, nil
+}
+
+// InputType ... Returns the address watch register type
+func (ltv *largeTxValue) InputType() models.RegisterType {
+	return conduit.AddressWatchTX
+}
+
+// Invalidate ... Invalidates when the transaction value meets the threshold
+func (ltv *largeTxValue) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	tx, err := models.ValueAs[*types.Transaction](td)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Value().Cmp(ltv.threshold) < 0 {
+		return nil, nil
+	}
+
+	ctx := map[string]any{
+		"tx_hash":   tx.Hash().Hex(),
+		"value":     tx.Value().String(),
+		"threshold": ltv.threshold.String(),
+	}
+	if tx.To() != nil {
+		ctx["to"] = tx.To().Hex()
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("transaction %s transferred %s wei; threshold is %s",
+			tx.Hash().Hex(), tx.Value(), ltv.threshold),
+		Context: ctx,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	HeuristicSignal       invariant.Type = "HEURISTIC_SIGNAL"
+	LargeTxValue          invariant.Type = "LARGE_TX_VALUE"
+	WithdrawalEnforcement invariant.Type = "WITHDRAWAL_ENFORCEMENT"
+	FaultDetector         invariant.Type = "FAULT_DETECTOR"
+	UnsafeHeadDivergence  invariant.Type = "UNSAFE_HEAD_DIVERGENCE"
+	SequencerLiveness     invariant.Type = "SEQUENCER_LIVENESS"
+	BridgedSupply         invariant.Type = "BRIDGED_SUPPLY"
+	Expression            invariant.Type = "EXPRESSION"
+	CumulativeOutflow     invariant.Type = "CUMULATIVE_OUTFLOW"
+	WithdrawalCorrelation invariant.Type = "WITHDRAWAL_CORRELATION"
+)
+
+// Constructor ... Builds an invariant from its session params; the context bounds any chain state
+// lookups performed by the invariant
+type Constructor = func(ctx context.Context, clients invariant.Clients,
+	params models.Params) (invariant.Invariant, error)
+
+// InvariantRegister ... Metadata used to construct an invariant for a session
+type InvariantRegister struct {
+	Type invariant.Type
+	// Human readable summary presented to users when discovering invariants
+	Description string
+	// Severity of the invariant's invalidations unless overridden by the session
+	Severity invariant.Severity
+	// Params accepted by the constructor; session params are validated against it before construction
+	Schema      invariant.Schema
+	Constructor Constructor
+}
+
+// invariants ... Lookup of all known invariant registers keyed by invariant type
+var invariants = map[invariant.Type]*InvariantRegister{
+	HeuristicSignal: {
+		Type:        HeuristicSignal,
+		Description: "Invalidates on every event emitted by a heuristic register such as GAS_USAGE_ANOMALY",
+		Severity:    invariant.Medium,
+		Schema:      heuristicSignalSchema,
+		Constructor: NewHeuristicSignal,
+	},
+	LargeTxValue: {
+		Type:        LargeTxValue,
+		Description: "Invalidates when a transaction touching a watched address transfers at least the threshold",
+		Severity:    invariant.Medium,
+		Schema:      largeTxValueSchema,
+		Constructor: NewLargeTxValue,
+	},
+	WithdrawalEnforcement: {
+		Type:        WithdrawalEnforcement,
+		Description: "Invalidates when a withdrawal proven on L1 doesn't exist in the L2ToL1MessagePasser",
+		Severity:    invariant.Critical,
+		Schema:      withdrawalEnforcementSchema,
+		Constructor: NewWithdrawalEnforcement,
+	},
+	FaultDetector: {
+		Type:        FaultDetector,
+		Description: "Invalidates when an output root proposed on L1 diverges from the root computed from the L2 node",
+		Severity:    invariant.Critical,
+		Schema:      faultDetectorSchema,
+		Constructor: NewFaultDetector,
+	},
+	UnsafeHeadDivergence: {
+		Type:        UnsafeHeadDivergence,
+		Description: "Invalidates when an op-node's unsafe head stays too far from the sequencer's unsafe head",
+		Severity:    invariant.High,
+		Schema:      unsafeHeadDivergenceSchema,
+		Constructor: NewUnsafeHeadDivergence,
+	},
+	SequencerLiveness: {
+		Type:        SequencerLiveness,
+		Description: "Invalidates when an op-node's unsafe, safe or finalized head stops advancing",
+		Severity:    invariant.High,
+		Schema:      sequencerLivenessSchema,
+		Constructor: NewSequencerLiveness,
+	},
+	BridgedSupply: {
+		Type:        BridgedSupply,
+		Description: "Invalidates when an asset's L2 supply exceeds the balance locked in its L1 bridge contract",
+		Severity:    invariant.Critical,
+		Schema:      bridgedSupplySchema,
+		Constructor: NewBridgedSupply,
+	},
+	Expression: {
+		Type:        Expression,
+		Description: "Invalidates when a user supplied CEL expression evaluates to true for a register's data",
+		Severity:    invariant.Medium,
+		Schema:      expressionSchema,
+		Constructor: NewExpression,
+	},
+	CumulativeOutflow: {
+		Type:        CumulativeOutflow,
+		Description: "Invalidates when the ETH sent by a watched address within a block window reaches the threshold",
+		Severity:    invariant.High,
+		Schema:      cumulativeOutflowSchema,
+		Constructor: NewCumulativeOutflow,
+	},
+	WithdrawalCorrelation: {
+		Type:        WithdrawalCorrelation,
+		Description: "Invalidates when a withdrawal proven on L1 isn't matched by a message sent on L2 within the timeout",
+		Severity:    invariant.Critical,
+		Schema:      withdrawalCorrelationSchema,
+		Constructor: NewWithdrawalCorrelation,
+	},
+}
+
+// GetInvariant ... Returns the invariant register for the type; fail if no invariant exists
+func GetInvariant(it invariant.Type) (*InvariantRegister, error) {
+	ir, found := invariants[it]
+	if !found {
+		return nil, fmt.Errorf("no invariant could be found for type: %s", it)
+	}
+
+	return ir, nil
+}
+
+// ListInvariantTypes ... Returns all known invariant types in lexicographical order
+func ListInvariantTypes() []invariant.Type {
+	its := make([]invariant.Type, 0, len(invariants))
+	for it := range invariants {
+		its = append(its, it)
+	}
+
+	sort.Slice(its, func(i, j int) bool {
+		return its[i] < its[j]
+	})
+
+	return its
+}
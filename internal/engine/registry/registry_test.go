@@ -0,0 +1,113 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Invariants(t *testing.T) {
+	to := common.HexToAddress("0x1")
+	tx := func(value int64) models.TransitData {
+		return models.TransitData{
+			Type:  conduit.AddressWatchTX,
+			Value: types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(value)}),
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		invariant   invariant.Type
+		params      models.Params
+		constructed bool
+		input       models.TransitData
+		invalidated bool
+	}{
+		{
+			name:        "Heuristic Signal",
+			description: "Heuristic signals should invalidate on every input",
+			invariant:   HeuristicSignal,
+			params:      models.Params{RegisterParam: string(conduit.GasUsageAnomaly)},
+			constructed: true,
+			input:       models.TransitData{Type: conduit.GasUsageAnomaly, Height: big.NewInt(1)},
+			invalidated: true,
+		},
+		{
+			name:        "Heuristic Signal Missing Register",
+			description: "Heuristic signals require a register param",
+			invariant:   HeuristicSignal,
+		},
+		{
+			name:        "Large Tx Value Below Threshold",
+			description: "Transactions below the threshold should not invalidate",
+			invariant:   LargeTxValue,
+			params:      models.Params{ThresholdParam: 10},
+			constructed: true,
+			input:       tx(9),
+		},
+		{
+			name:        "Large Tx Value At Threshold",
+			description: "Transactions meeting the threshold should invalidate",
+			invariant:   LargeTxValue,
+			params:      models.Params{ThresholdParam: 10},
+			constructed: true,
+			input:       tx(10),
+			invalidated: true,
+		},
+		{
+			name:        "Large Tx Value Non Positive Threshold",
+			description: "Thresholds must be positive",
+			invariant:   LargeTxValue,
+			params:      models.Params{ThresholdParam: 0},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ir, err := GetInvariant(tc.invariant)
+			assert.NoError(t, err)
+
+			if tc.constructed {
+				assert.NoError(t, ir.Schema.Validate(tc.params), "Ensuring constructable params satisfy the schema")
+			}
+
+			inv, err := ir.Constructor(context.Background(), nil, tc.params)
+			if !tc.constructed {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			outcome, err := inv.Invalidate(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.invalidated, outcome != nil)
+		})
+	}
+}
+
+func Test_Schemas(t *testing.T) {
+	for _, it := range ListInvariantTypes() {
+		ir, err := GetInvariant(it)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, ir.Schema, "%s should declare its params", it)
+
+		for key, spec := range ir.Schema {
+			assert.NotEmpty(t, spec.Description, "%s param %s should be described", it, key)
+		}
+	}
+}
+
+func Test_ListInvariantTypes(t *testing.T) {
+	assert.Equal(t, []invariant.Type{BridgedSupply, CumulativeOutflow, Expression, FaultDetector, HeuristicSignal, LargeTxValue,
+		SequencerLiveness, UnsafeHeadDivergence, WithdrawalCorrelation, WithdrawalEnforcement}, ListInvariantTypes())
+}
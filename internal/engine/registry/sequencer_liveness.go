@@ -0,0 +1,151 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// UnsafeThresholdParam ... Maximum period without a new unsafe head; zero disables the check
+	UnsafeThresholdParam = "unsafe_threshold"
+	// SafeThresholdParam ... Maximum period without a new safe head; zero disables the check
+	SafeThresholdParam = "safe_threshold"
+	// FinalizedThresholdParam ... Maximum period without a new finalized head; zero disables the check
+	FinalizedThresholdParam = "finalized_threshold"
+
+	defaultUnsafeThreshold    = time.Minute
+	defaultSafeThreshold      = 10 * time.Minute
+	defaultFinalizedThreshold = 30 * time.Minute
+)
+
+// sequencerLivenessSchema ... Params accepted by the invariant
+var sequencerLivenessSchema = invariant.Schema{
+	UnsafeThresholdParam: {Kind: invariant.DurationParam, Min: invariant.Bound(0),
+		Description: "Maximum period without a new unsafe head; zero disables the check"},
+	SafeThresholdParam: {Kind: invariant.DurationParam, Min: invariant.Bound(0),
+		Description: "Maximum period without a new safe head; zero disables the check"},
+	FinalizedThresholdParam: {Kind: invariant.DurationParam, Min: invariant.Bound(0),
+		Description: "Maximum period without a new finalized head; zero disables the check"},
+}
+
+// headTracker ... Tracks when a single L2 head last advanced
+type headTracker struct {
+	name      string
+	threshold time.Duration
+
+	number   uint64
+	advanced time.Time
+	// Whether the current stall has already invalidated
+	fired bool
+}
+
+// observe ... Records the head & returns true the first time the head has been stalled for the threshold
+func (ht *headTracker) observe(number uint64, at time.Time) bool {
+	if ht.threshold == 0 {
+		return false
+	}
+
+	if ht.advanced.IsZero() || number > ht.number {
+		ht.number, ht.advanced, ht.fired = number, at, false
+		return false
+	}
+
+	if ht.fired || at.Sub(ht.advanced) < ht.threshold {
+		return false
+	}
+
+	ht.fired = true
+	return true
+}
+
+// sequencerLiveness ... Detects L2 heads that stop advancing; a stalled unsafe head means the sequencer
+// stopped producing blocks while stalled safe or finalized heads mean batches aren't landing on L1.
+// Heads can only be assessed while the sync status oracle is producing snapshots
+type sequencerLiveness struct {
+	unsafe    *headTracker
+	safe      *headTracker
+	finalized *headTracker
+}
+
+// NewSequencerLiveness ... Initializer
+func NewSequencerLiveness(_ context.Context, _ invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	thresholds := make(map[string]time.Duration)
+
+	for key, def := range map[string]time.Duration{
+		UnsafeThresholdParam:    defaultUnsafeThreshold,
+		SafeThresholdParam:      defaultSafeThreshold,
+		FinalizedThresholdParam: defaultFinalizedThreshold,
+	} {
+		threshold, err := params.Duration(key, def)
+		if err != nil {
+			return nil, err
+		}
+
+		if threshold < 0 {
+			return nil, fmt.Errorf("%s must not be negative", key)
+		}
+
+		thresholds[key] = threshold
+	}
+
+	return &sequencerLiveness{
+		unsafe:    &headTracker{name: "unsafe", threshold: thresholds[UnsafeThresholdParam]},
+		safe:      &headTracker{name: "safe", threshold: thresholds[SafeThresholdParam]},
+		finalized: &headTracker{name: "finalized", threshold: thresholds[FinalizedThresholdParam]},
+	}, nil
+}
+
+// InputType ... Returns the sync status register type
+func (sl *sequencerLiveness) InputType() models.RegisterType {
+	return conduit.SyncStatus
+}
+
+// Invalidate ... Invalidates once per stall when any head hasn't advanced within its threshold;
+// heads stalling at the same time are reported together
+func (sl *sequencerLiveness) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	snapshot, err := models.ValueAs[conduit.SyncStatusSnapshot](td)
+	if err != nil {
+		return nil, err
+	}
+
+	status := snapshot.Node
+	heads := []struct {
+		tracker *headTracker
+		number  uint64
+	}{
+		{sl.unsafe, status.UnsafeL2.Number},
+		{sl.safe, status.SafeL2.Number},
+		{sl.finalized, status.FinalizedL2.Number},
+	}
+
+	stalled := make([]string, 0, len(heads))
+	ctx := make(map[string]any)
+
+	for _, head := range heads {
+		if !head.tracker.observe(head.number, snapshot.Timestamp) {
+			continue
+		}
+
+		stalled = append(stalled, fmt.Sprintf("%s head %d for %s", head.tracker.name, head.number,
+			snapshot.Timestamp.Sub(head.tracker.advanced).Round(time.Second)))
+		ctx[head.tracker.name+"_head"] = head.number
+		ctx[head.tracker.name+"_since"] = head.tracker.advanced
+		ctx[head.tracker.name+"_threshold"] = head.tracker.threshold.String()
+	}
+
+	if len(stalled) == 0 {
+		return nil, nil
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("no new L2 block observed; stalled %s", strings.Join(stalled, ", ")),
+		Context: ctx,
+	}, nil
+}
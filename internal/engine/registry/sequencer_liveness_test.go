@@ -0,0 +1,105 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SequencerLiveness(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+
+	// heads ... Returns a snapshot taken the number of seconds after start
+	heads := func(seconds int, unsafe, safe, finalized uint64) models.TransitData {
+		return models.TransitData{
+			Type: conduit.SyncStatus,
+			Value: conduit.SyncStatusSnapshot{
+				Node: client.SyncStatus{
+					UnsafeL2:    client.BlockRef{Number: unsafe},
+					SafeL2:      client.BlockRef{Number: safe},
+					FinalizedL2: client.BlockRef{Number: finalized},
+				},
+				Timestamp: start.Add(time.Duration(seconds) * time.Second),
+			},
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		params  models.Params
+		inputs  []models.TransitData
+		stalled [][]string
+	}{
+		{
+			name:        "Advancing Heads",
+			description: "Heads advancing within their thresholds should never invalidate",
+			inputs:      []models.TransitData{heads(0, 10, 5, 1), heads(20, 11, 6, 2), heads(40, 12, 7, 3)},
+			stalled:     [][]string{nil, nil, nil},
+		},
+		{
+			name:        "Stalled Unsafe Head",
+			description: "An unsafe head stalled beyond its threshold should invalidate once per stall",
+			inputs: []models.TransitData{heads(0, 10, 5, 1), heads(20, 10, 6, 2), heads(30, 10, 7, 3),
+				heads(40, 10, 8, 4), heads(50, 11, 9, 5), heads(80, 11, 10, 6)},
+			stalled: [][]string{nil, nil, {"unsafe"}, nil, nil, {"unsafe"}},
+		},
+		{
+			name:        "Stalled Heads Together",
+			description: "Heads stalling at the same time should be reported in a single outcome",
+			inputs:      []models.TransitData{heads(0, 10, 5, 1), heads(120, 10, 5, 1)},
+			stalled:     [][]string{nil, {"unsafe", "safe", "finalized"}},
+		},
+		{
+			name:        "Disabled Threshold",
+			description: "Zero thresholds should disable the head's check",
+			params: models.Params{UnsafeThresholdParam: 0, SafeThresholdParam: 0,
+				FinalizedThresholdParam: "100s"},
+			inputs:  []models.TransitData{heads(0, 10, 5, 1), heads(120, 10, 5, 1)},
+			stalled: [][]string{nil, {"finalized"}},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			params := tc.params
+			if params == nil {
+				params = models.Params{UnsafeThresholdParam: "30s", SafeThresholdParam: "1m",
+					FinalizedThresholdParam: "2m"}
+			}
+
+			inv, err := NewSequencerLiveness(context.Background(), nil, params)
+			assert.NoError(t, err)
+
+			for j, input := range tc.inputs {
+				outcome, err := inv.Invalidate(input)
+				assert.NoError(t, err)
+
+				if tc.stalled[j] == nil {
+					assert.Nil(t, outcome, "input %d", j)
+					continue
+				}
+
+				assert.NotNil(t, outcome, "input %d", j)
+
+				reported := make([]string, 0)
+				for _, head := range []string{"unsafe", "safe", "finalized"} {
+					if _, found := outcome.Context[head+"_head"]; found {
+						reported = append(reported, head)
+					}
+				}
+				assert.Equal(t, tc.stalled[j], reported, "input %d", j)
+			}
+		})
+	}
+
+	_, err := NewSequencerLiveness(context.Background(), nil, models.Params{SafeThresholdParam: "-1s"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+)
+
+const (
+	// MaxBlocksParam ... Maximum tolerated unsafe head distance, in blocks
+	MaxBlocksParam = "max_blocks"
+	// DurationParam ... Period that a condition must continuously hold for before invalidating
+	DurationParam = "duration"
+
+	defaultMaxDivergence      = 10
+	defaultDivergenceDuration = 30 * time.Second
+)
+
+// unsafeHeadDivergenceSchema ... Params accepted by the invariant
+var unsafeHeadDivergenceSchema = invariant.Schema{
+	MaxBlocksParam: {Kind: invariant.IntParam, Min: invariant.Bound(0),
+		Description: "Maximum tolerated unsafe head distance in blocks"},
+	DurationParam: {Kind: invariant.DurationParam, Min: invariant.Bound(0),
+		Description: "Period that the heads must diverge for before invalidating"},
+}
+
+// unsafeHeadDivergence ... Compares the watched op-node's unsafe head against the sequencer's; a node that
+// stays too far from the sequencer is either stalled or following a different chain
+type unsafeHeadDivergence struct {
+	maxBlocks uint64
+	duration  time.Duration
+
+	// Snapshot time at which the current divergence was first observed; zero while within bounds
+	divergingSince time.Time
+	// Whether the current divergence has already invalidated
+	fired bool
+}
+
+// NewUnsafeHeadDivergence ... Initializer
+func NewUnsafeHeadDivergence(_ context.Context, _ invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	maxBlocks, err := params.NonNegativeInt(MaxBlocksParam, defaultMaxDivergence)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := params.Duration(DurationParam, defaultDivergenceDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if duration < 0 {
+		return nil, fmt.Errorf("%s must not be negative", DurationParam)
+	}
+
+	return &unsafeHeadDivergence{maxBlocks: uint64(maxBlocks), duration: duration}, nil
+}
+
+// InputType ... Returns the sync status register type
+func (uhd *unsafeHeadDivergence) InputType() models.RegisterType {
+	return conduit.SyncStatus
+}
+
+// Invalidate ... Invalidates once per divergence when the unsafe heads have been more than max_blocks apart
+// for at least the duration; the divergence resets once the heads are back within bounds
+func (uhd *unsafeHeadDivergence) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	snapshot, err := models.ValueAs[conduit.SyncStatusSnapshot](td)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Sequencer == nil {
+		return nil, fmt.Errorf("%s invariant requires the %s oracle param",
+			UnsafeHeadDivergence, conduit.SequencerOpNodeParam)
+	}
+
+	node, sequencer := snapshot.Node.UnsafeL2.Number, snapshot.Sequencer.UnsafeL2.Number
+
+	distance := sequencer - node
+	if node > sequencer {
+		distance = node - sequencer
+	}
+
+	if distance <= uhd.maxBlocks {
+		uhd.divergingSince, uhd.fired = time.Time{}, false
+		return nil, nil
+	}
+
+	if uhd.divergingSince.IsZero() {
+		uhd.divergingSince = snapshot.Timestamp
+	}
+
+	elapsed := snapshot.Timestamp.Sub(uhd.divergingSince)
+	if uhd.fired || elapsed < uhd.duration {
+		return nil, nil
+	}
+
+	uhd.fired = true
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("op-node unsafe head %d has been %d blocks from the sequencer's unsafe head %d for %s",
+			node, distance, sequencer, elapsed.Round(time.Second)),
+		Context: map[string]any{
+			"node_unsafe_head":      node,
+			"sequencer_unsafe_head": sequencer,
+			"distance":              distance,
+			"max_blocks":            uhd.maxBlocks,
+			"diverging_since":       uhd.divergingSince,
+		},
+	}, nil
+}
@@ -0,0 +1,87 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UnsafeHeadDivergence(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+
+	// snapshot ... Returns a snapshot taken the number of seconds after start
+	snapshot := func(seconds int, node, sequencer uint64) models.TransitData {
+		return models.TransitData{
+			Type: conduit.SyncStatus,
+			Value: conduit.SyncStatusSnapshot{
+				Node:      client.SyncStatus{UnsafeL2: client.BlockRef{Number: node}},
+				Sequencer: &client.SyncStatus{UnsafeL2: client.BlockRef{Number: sequencer}},
+				Timestamp: start.Add(time.Duration(seconds) * time.Second),
+			},
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		inputs      []models.TransitData
+		invalidated []bool
+	}{
+		{
+			name:        "Within Bounds",
+			description: "Heads within max_blocks of each other should never invalidate",
+			inputs:      []models.TransitData{snapshot(0, 100, 105), snapshot(60, 110, 105)},
+			invalidated: []bool{false, false},
+		},
+		{
+			name:        "Sustained Divergence",
+			description: "Divergence should only invalidate once it has lasted the duration & only once",
+			inputs: []models.TransitData{snapshot(0, 100, 106), snapshot(5, 100, 110),
+				snapshot(10, 100, 112), snapshot(15, 100, 115)},
+			invalidated: []bool{false, false, true, false},
+		},
+		{
+			name:        "Recovered Divergence",
+			description: "Returning within bounds should reset the divergence",
+			inputs: []models.TransitData{snapshot(0, 100, 106), snapshot(5, 106, 106),
+				snapshot(10, 106, 112), snapshot(20, 106, 113)},
+			invalidated: []bool{false, false, false, true},
+		},
+		{
+			name:        "Node Ahead",
+			description: "Nodes ahead of the sequencer should be treated as diverging",
+			inputs:      []models.TransitData{snapshot(0, 120, 100), snapshot(10, 121, 100)},
+			invalidated: []bool{false, true},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			inv, err := NewUnsafeHeadDivergence(context.Background(), nil,
+				models.Params{MaxBlocksParam: 5, DurationParam: "10s"})
+			assert.NoError(t, err)
+
+			for j, input := range tc.inputs {
+				outcome, err := inv.Invalidate(input)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.invalidated[j], outcome != nil, "input %d", j)
+			}
+		})
+	}
+
+	inv, err := NewUnsafeHeadDivergence(context.Background(), nil, nil)
+	assert.NoError(t, err)
+
+	_, err = inv.Invalidate(models.TransitData{
+		Type:  conduit.SyncStatus,
+		Value: conduit.SyncStatusSnapshot{Timestamp: start},
+	})
+	assert.Error(t, err, "snapshots without a sequencer status can't be assessed")
+}
@@ -0,0 +1,186 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// JoinTimeoutParam ... Duration that a withdrawal is awaited on the other layer before being assessed
+	JoinTimeoutParam = "timeout"
+
+	// defaultJoinTimeout ... Withdrawals are usually proven within hours of being sent on L2
+	defaultJoinTimeout = 24 * time.Hour
+)
+
+var (
+	messagePassedSig = crypto.Keccak256Hash([]byte(
+		"MessagePassed(uint256,address,address,uint256,uint256,bytes,bytes32)"))
+)
+
+// withdrawalCorrelationSchema ... Params accepted by the invariant
+var withdrawalCorrelationSchema = invariant.Schema{
+	conduit.PortalParam: {Kind: invariant.AddressParam, Required: true, Description: "OptimismPortal address"},
+	MessagePasserParam: {Kind: invariant.AddressParam,
+		Description: "L2ToL1MessagePasser address; defaults to the predeploy"},
+	JoinTimeoutParam: {Kind: invariant.DurationParam, Min: invariant.Bound(0),
+		Description: "Period that a proven withdrawal is awaited on L2 for; defaults to a day"},
+}
+
+// messagePassedHashOffset ... Offset of the withdrawal hash within the MessagePassed event data;
+// preceded by the value, gas limit & the offset of the dynamic message data
+const messagePassedHashOffset = 96
+
+// withdrawalCorrelation ... Joins WithdrawalProven events emitted by the L1 portal with MessagePassed
+// events emitted by the L2ToL1MessagePasser by withdrawal hash; a withdrawal proven on L1 that isn't
+// matched by an L2 message within the timeout can only have been proven against a forged output.
+// Unlike WITHDRAWAL_ENFORCEMENT no L2 client is required, but the L2 pipeline must start early enough
+// to observe the messages of every withdrawal proven while the session runs
+type withdrawalCorrelation struct {
+	portal        common.Address
+	messagePasser common.Address
+	timeout       time.Duration
+
+	// Withdrawal hashes matched by an L2 message; withdrawals may be proven more than once
+	store state.Store
+}
+
+// NewWithdrawalCorrelation ... Initializer; requires the portal param
+func NewWithdrawalCorrelation(_ context.Context, _ invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	portal, err := params.RequiredAddress(conduit.PortalParam)
+	if err != nil {
+		return nil, err
+	}
+
+	messagePasser, err := params.Address(MessagePasserParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if messagePasser == (common.Address{}) {
+		messagePasser = messagePasserPredeploy
+	}
+
+	timeout, err := params.Duration(JoinTimeoutParam, defaultJoinTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s invariant requires a positive %s param", WithdrawalCorrelation, JoinTimeoutParam)
+	}
+
+	return &withdrawalCorrelation{
+		portal:        portal,
+		messagePasser: messagePasser,
+		timeout:       timeout,
+	}, nil
+}
+
+// InputType ... Returns the event log register type; the L1 pipeline is joined to the left side
+func (wc *withdrawalCorrelation) InputType() models.RegisterType {
+	return conduit.EventLog
+}
+
+// CorrelatedInput ... Returns the event log register type; the L2 pipeline is joined to the right side
+func (wc *withdrawalCorrelation) CorrelatedInput() models.RegisterType {
+	return conduit.EventLog
+}
+
+// JoinSpec ... Joins both layers by withdrawal hash; unrelated logs are discarded
+func (wc *withdrawalCorrelation) JoinSpec() pipeline.JoinSpec {
+	return pipeline.JoinSpec{
+		LeftKey:  wc.provenKey,
+		RightKey: wc.passedKey,
+		Timeout:  wc.timeout,
+	}
+}
+
+// SetState ... Sets the session scoped store
+func (wc *withdrawalCorrelation) SetState(store state.Store) {
+	wc.store = store
+}
+
+// provenKey ... Returns the withdrawal hash of WithdrawalProven events emitted by the portal
+func (wc *withdrawalCorrelation) provenKey(td models.TransitData) (string, error) {
+	log, err := models.ValueAs[types.Log](td)
+	if err != nil {
+		return "", err
+	}
+
+	if log.Removed || log.Address != wc.portal || len(log.Topics) < 2 || log.Topics[0] != withdrawalProvenSig {
+		return "", nil
+	}
+
+	return log.Topics[1].Hex(), nil
+}
+
+// passedKey ... Returns the withdrawal hash of MessagePassed events emitted by the message passer
+func (wc *withdrawalCorrelation) passedKey(td models.TransitData) (string, error) {
+	log, err := models.ValueAs[types.Log](td)
+	if err != nil {
+		return "", err
+	}
+
+	if log.Removed || log.Address != wc.messagePasser || len(log.Topics) == 0 || log.Topics[0] != messagePassedSig {
+		return "", nil
+	}
+
+	if len(log.Data) < messagePassedHashOffset+common.HashLength {
+		return "", fmt.Errorf("malformed MessagePassed event in tx %s", log.TxHash.Hex())
+	}
+
+	return common.BytesToHash(log.Data[messagePassedHashOffset : messagePassedHashOffset+common.HashLength]).Hex(), nil
+}
+
+// Invalidate ... Invalidates when a proven withdrawal expires without having ever been matched by an
+// L2 message; L2 messages that are never proven are ignored
+func (wc *withdrawalCorrelation) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	pair, err := models.ValueAs[pipeline.JoinedPair](td)
+	if err != nil {
+		return nil, err
+	}
+
+	key := state.Key{pair.Key}
+
+	if pair.Matched() {
+		wc.store.Set(key, true)
+		return nil, nil
+	}
+
+	if pair.Left == nil {
+		return nil, nil
+	}
+
+	if _, sent := wc.store.Get(key); sent {
+		return nil, nil
+	}
+
+	log, err := models.ValueAs[types.Log](*pair.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("withdrawal %s proven on L1 in tx %s wasn't sent on L2 within %s; possible forged withdrawal",
+			pair.Key, log.TxHash.Hex(), wc.timeout),
+		Context: map[string]any{
+			"withdrawal_hash": pair.Key,
+			"tx_hash":         log.TxHash.Hex(),
+			"portal":          wc.portal.Hex(),
+			"message_passer":  wc.messagePasser.Hex(),
+			"timeout":         wc.timeout.String(),
+		},
+	}, nil
+}
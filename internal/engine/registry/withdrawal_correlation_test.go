@@ -0,0 +1,114 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/conduit/pipeline"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/base-org/pessimism/internal/engine/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WithdrawalCorrelation(t *testing.T) {
+	portal := common.HexToAddress("0x420")
+	withdrawalHash := common.HexToHash("0x69")
+
+	inv, err := NewWithdrawalCorrelation(context.Background(), nil, models.Params{
+		conduit.PortalParam: portal.Hex(),
+	})
+	assert.NoError(t, err)
+
+	corr, ok := inv.(invariant.Correlated)
+	assert.True(t, ok)
+	assert.Equal(t, conduit.EventLog, corr.CorrelatedInput())
+
+	inv.(invariant.Stateful).SetState(state.NewMemoryStore())
+	spec := corr.JoinSpec()
+	assert.Equal(t, defaultJoinTimeout, spec.Timeout)
+
+	proven := models.TransitData{Type: conduit.EventLog, Value: types.Log{
+		Address: portal,
+		Topics:  []common.Hash{withdrawalProvenSig, withdrawalHash},
+	}}
+
+	data := make([]byte, messagePassedHashOffset+common.HashLength)
+	copy(data[messagePassedHashOffset:], withdrawalHash.Bytes())
+	passed := models.TransitData{Type: conduit.EventLog, Value: types.Log{
+		Address: messagePasserPredeploy,
+		Topics:  []common.Hash{messagePassedSig},
+		Data:    data,
+	}}
+
+	unrelated := models.TransitData{Type: conduit.EventLog, Value: types.Log{Address: portal}}
+
+	// Both layers are keyed by the withdrawal hash & unrelated logs are discarded
+	key, err := spec.LeftKey(proven)
+	assert.NoError(t, err)
+	assert.Equal(t, withdrawalHash.Hex(), key)
+
+	key, err = spec.RightKey(passed)
+	assert.NoError(t, err)
+	assert.Equal(t, withdrawalHash.Hex(), key)
+
+	key, err = spec.LeftKey(unrelated)
+	assert.NoError(t, err)
+	assert.Empty(t, key)
+
+	pair := func(left, right *models.TransitData) models.TransitData {
+		return models.TransitData{
+			Type:  models.RegisterType(WithdrawalCorrelation),
+			Value: pipeline.JoinedPair{Key: withdrawalHash.Hex(), Left: left, Right: right},
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		input       models.TransitData
+		invalidated bool
+	}{
+		{
+			name:        "Unproven Message",
+			description: "Messages sent on L2 that are never proven should be ignored",
+			input:       pair(nil, &passed),
+		},
+		{
+			name:        "Unsent Withdrawal",
+			description: "Withdrawals proven without a matching L2 message should invalidate",
+			input:       pair(&proven, nil),
+			invalidated: true,
+		},
+		{
+			name:        "Sent Withdrawal",
+			description: "Withdrawals proven with a matching L2 message should hold",
+			input:       pair(&proven, &passed),
+		},
+		{
+			name:        "Reproven Withdrawal",
+			description: "Withdrawals proven again after being matched should hold",
+			input:       pair(&proven, nil),
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			outcome, err := inv.Invalidate(tc.input)
+			assert.NoError(t, err)
+
+			if !tc.invalidated {
+				assert.Nil(t, outcome)
+				return
+			}
+
+			assert.NotNil(t, outcome)
+			assert.Equal(t, withdrawalHash.Hex(), outcome.Context["withdrawal_hash"])
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// MessagePasserParam ... L2ToL1MessagePasser contract address; defaults to the L2 predeploy
+	MessagePasserParam = "message_passer"
+)
+
+var (
+	// messagePasserPredeploy ... L2ToL1MessagePasser predeploy address shared by every OP Stack chain
+	messagePasserPredeploy = common.HexToAddress("0x4200000000000000000000000000000000000016")
+
+	withdrawalProvenSig = crypto.Keccak256Hash([]byte("WithdrawalProven(bytes32,address,address)"))
+)
+
+// withdrawalEnforcementSchema ... Params accepted by the invariant
+var withdrawalEnforcementSchema = invariant.Schema{
+	conduit.PortalParam: {Kind: invariant.AddressParam, Required: true, Description: "OptimismPortal address"},
+	MessagePasserParam: {Kind: invariant.AddressParam,
+		Description: "L2ToL1MessagePasser address; defaults to the predeploy"},
+}
+
+// withdrawalEnforcement ... Verifies that every withdrawal proven on L1 was initiated on L2; a proven
+// withdrawal that the L2ToL1MessagePasser never sent can only have been proven against a forged output
+type withdrawalEnforcement struct {
+	ctx           context.Context
+	portal        common.Address
+	messagePasser *client.MessagePasser
+}
+
+// NewWithdrawalEnforcement ... Initializer; requires the portal param & an L2 client
+func NewWithdrawalEnforcement(ctx context.Context, clients invariant.Clients,
+	params models.Params) (invariant.Invariant, error) {
+	portal, err := params.RequiredAddress(conduit.PortalParam)
+	if err != nil {
+		return nil, err
+	}
+
+	messagePasser, err := params.Address(MessagePasserParam)
+	if err != nil {
+		return nil, err
+	}
+
+	if messagePasser == (common.Address{}) {
+		messagePasser = messagePasserPredeploy
+	}
+
+	l2Client, err := clients.Client(models.Layer2)
+	if err != nil {
+		return nil, err
+	}
+
+	return &withdrawalEnforcement{
+		ctx:           ctx,
+		portal:        portal,
+		messagePasser: client.NewMessagePasser(l2Client, messagePasser),
+	}, nil
+}
+
+// InputType ... Returns the event log register type
+func (we *withdrawalEnforcement) InputType() models.RegisterType {
+	return conduit.EventLog
+}
+
+// Invalidate ... Invalidates when a WithdrawalProven event emitted by the portal references a withdrawal
+// hash that doesn't exist in the L2ToL1MessagePasser; all other logs are ignored
+func (we *withdrawalEnforcement) Invalidate(td models.TransitData) (*invariant.Outcome, error) {
+	log, err := models.ValueAs[types.Log](td)
+	if err != nil {
+		return nil, err
+	}
+
+	if log.Removed || log.Address != we.portal || len(log.Topics) < 2 || log.Topics[0] != withdrawalProvenSig {
+		return nil, nil
+	}
+
+	withdrawalHash := log.Topics[1]
+
+	sent, err := we.sent(withdrawalHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not look up withdrawal %s: %w", withdrawalHash.Hex(), err)
+	}
+
+	if sent {
+		return nil, nil
+	}
+
+	ctx := map[string]any{
+		"withdrawal_hash": withdrawalHash.Hex(),
+		"tx_hash":         log.TxHash.Hex(),
+		"portal":          we.portal.Hex(),
+		"message_passer":  we.messagePasser.Address().Hex(),
+	}
+	if len(log.Topics) == 4 {
+		ctx["from"] = common.BytesToAddress(log.Topics[2].Bytes()).Hex()
+		ctx["to"] = common.BytesToAddress(log.Topics[3].Bytes()).Hex()
+	}
+
+	return &invariant.Outcome{
+		Message: fmt.Sprintf("withdrawal %s proven on L1 in tx %s was never sent on L2; possible forged withdrawal",
+			withdrawalHash.Hex(), log.TxHash.Hex()),
+		Context: ctx,
+	}, nil
+}
+
+// sent ... Returns true if the L2ToL1MessagePasser has recorded the withdrawal hash as of the latest L2 block
+func (we *withdrawalEnforcement) sent(withdrawalHash common.Hash) (bool, error) {
+	ctxTimeout, ctxCancel := context.WithTimeout(we.ctx, time.Second*time.Duration(models.EthClientTimeout))
+	defer ctxCancel()
+
+	return we.messagePasser.SentMessages(ctxTimeout, withdrawalHash, nil)
+}
@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/client/mocks"
+	"github.com/base-org/pessimism/internal/conduit/models"
+	conduit "github.com/base-org/pessimism/internal/conduit/registry"
+	"github.com/base-org/pessimism/internal/engine/invariant"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_WithdrawalEnforcement(t *testing.T) {
+	portal := common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed")
+	withdrawalHash := common.HexToHash("0xabc")
+
+	proven := func(emitter common.Address) models.TransitData {
+		return models.TransitData{
+			Type: conduit.EventLog,
+			Value: types.Log{
+				Address: emitter,
+				Topics: []common.Hash{withdrawalProvenSig, withdrawalHash,
+					common.HexToHash("0x1"), common.HexToHash("0x2")},
+				TxHash: common.HexToHash("0xdef"),
+			},
+		}
+	}
+
+	var tests = []struct {
+		name        string
+		description string
+
+		input       models.TransitData
+		sent        []byte
+		callErr     error
+		called      bool
+		invalidated bool
+		err         bool
+	}{
+		{
+			name:        "Sent Withdrawal",
+			description: "Proven withdrawals that were sent on L2 should not invalidate",
+			input:       proven(portal),
+			sent:        common.BigToHash(big.NewInt(1)).Bytes(),
+			called:      true,
+		},
+		{
+			name:        "Forged Withdrawal",
+			description: "Proven withdrawals that were never sent on L2 should invalidate",
+			input:       proven(portal),
+			sent:        common.Hash{}.Bytes(),
+			called:      true,
+			invalidated: true,
+		},
+		{
+			name:        "Unwatched Emitter",
+			description: "WithdrawalProven events emitted by other contracts should be ignored",
+			input:       proven(common.HexToAddress("0x1")),
+		},
+		{
+			name:        "Unrelated Log",
+			description: "Logs other than WithdrawalProven should be ignored",
+			input: models.TransitData{
+				Type:  conduit.EventLog,
+				Value: types.Log{Address: portal, Topics: []common.Hash{common.HexToHash("0x1")}},
+			},
+		},
+		{
+			name:        "Lookup Failure",
+			description: "Failed L2 lookups should be surfaced as errors rather than invalidations",
+			input:       proven(portal),
+			callErr:     fmt.Errorf("connection refused"),
+			called:      true,
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			l2Client := new(mocks.EthClient)
+			l2Client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return *msg.To == messagePasserPredeploy &&
+					common.BytesToHash(msg.Data[4:]) == withdrawalHash
+			}), (*big.Int)(nil)).Return(tc.sent, tc.callErr)
+
+			inv, err := NewWithdrawalEnforcement(context.Background(),
+				invariant.Clients{models.Layer2: l2Client},
+				models.Params{conduit.PortalParam: portal.Hex()})
+			assert.NoError(t, err)
+
+			outcome, err := inv.Invalidate(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.invalidated, outcome != nil)
+			if tc.invalidated {
+				assert.Equal(t, withdrawalHash.Hex(), outcome.Context["withdrawal_hash"])
+			}
+
+			if tc.called {
+				l2Client.AssertNumberOfCalls(t, "CallContract", 1)
+			} else {
+				l2Client.AssertNotCalled(t, "CallContract", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	_, err := NewWithdrawalEnforcement(context.Background(), invariant.Clients{},
+		models.Params{conduit.PortalParam: portal.Hex()})
+	assert.Error(t, err, "an L2 client should be required")
+}
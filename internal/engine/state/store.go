@@ -0,0 +1,161 @@
+package state
+
+import (
+	"sort"
+	"sync"
+)
+
+// Key ... Hierarchical state key; E.G, {session, address, token}
+type Key []string
+
+// Store ... Keyed state store supporting nested keys; a key can hold a value and nested keys at once
+type Store interface {
+	// Get ... Returns the value for the key; false if no value is set
+	Get(key Key) (any, bool)
+	// Set ... Sets the value for the key
+	Set(key Key, value any)
+	// Delete ... Removes the key's value & all nested keys
+	Delete(key Key)
+	// Children ... Returns the next segment of every nested key under the prefix in lexicographical order
+	Children(prefix Key) []string
+}
+
+// node ... Single segment of the state tree
+type node struct {
+	value    any
+	set      bool
+	children map[string]*node
+}
+
+// MemoryStore ... Non-persistent store; state is lost on restart
+type MemoryStore struct {
+	mu   sync.RWMutex
+	root *node
+}
+
+// NewMemoryStore ... Initializer
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{root: &node{}}
+}
+
+// find ... Returns the node for the key; nil if it doesn't exist
+func (ms *MemoryStore) find(key Key) *node {
+	n := ms.root
+	for _, segment := range key {
+		child, found := n.children[segment]
+		if !found {
+			return nil
+		}
+		n = child
+	}
+
+	return n
+}
+
+// Get ... Returns the value for the key
+func (ms *MemoryStore) Get(key Key) (any, bool) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	n := ms.find(key)
+	if n == nil || !n.set {
+		return nil, false
+	}
+
+	return n.value, true
+}
+
+// Set ... Sets the value for the key, creating intermediate keys as required
+func (ms *MemoryStore) Set(key Key, value any) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	n := ms.root
+	for _, segment := range key {
+		if n.children == nil {
+			n.children = make(map[string]*node)
+		}
+
+		child, found := n.children[segment]
+		if !found {
+			child = &node{}
+			n.children[segment] = child
+		}
+		n = child
+	}
+
+	n.value, n.set = value, true
+}
+
+// Delete ... Removes the key's value & all nested keys; deleting the empty key clears the store
+func (ms *MemoryStore) Delete(key Key) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if len(key) == 0 {
+		ms.root = &node{}
+		return
+	}
+
+	parent := ms.find(key[:len(key)-1])
+	if parent != nil {
+		delete(parent.children, key[len(key)-1])
+	}
+}
+
+// Children ... Returns the next segment of every nested key under the prefix
+func (ms *MemoryStore) Children(prefix Key) []string {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	n := ms.find(prefix)
+	if n == nil {
+		return []string{}
+	}
+
+	segments := make([]string, 0, len(n.children))
+	for segment := range n.children {
+		segments = append(segments, segment)
+	}
+	sort.Strings(segments)
+
+	return segments
+}
+
+// scoped ... Store view that prefixes every key
+type scoped struct {
+	store  Store
+	prefix Key
+}
+
+// Scoped ... Returns a view of the store where every key is nested under the prefix;
+// used to isolate the state of each session
+func Scoped(store Store, prefix ...string) Store {
+	return &scoped{store: store, prefix: prefix}
+}
+
+// join ... Returns the key nested under the prefix
+func (s *scoped) join(key Key) Key {
+	joined := make(Key, 0, len(s.prefix)+len(key))
+	return append(append(joined, s.prefix...), key...)
+}
+
+// Get ... Returns the value for the key
+func (s *scoped) Get(key Key) (any, bool) {
+	return s.store.Get(s.join(key))
+}
+
+// Set ... Sets the value for the key
+func (s *scoped) Set(key Key, value any) {
+	s.store.Set(s.join(key), value)
+}
+
+// Delete ... Removes the key's value & all nested keys
+func (s *scoped) Delete(key Key) {
+	s.store.Delete(s.join(key))
+}
+
+// Children ... Returns the next segment of every nested key under the prefix
+func (s *scoped) Children(prefix Key) []string {
+	return s.store.Children(s.join(prefix))
+}
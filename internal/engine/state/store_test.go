@@ -0,0 +1,56 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+
+	store.Set(Key{"session", "0xa", "ETH"}, 1)
+	store.Set(Key{"session", "0xa", "USDC"}, 2)
+	store.Set(Key{"session", "0xb", "ETH"}, 3)
+	store.Set(Key{"session", "0xa"}, "parent")
+
+	val, found := store.Get(Key{"session", "0xa", "USDC"})
+	assert.True(t, found)
+	assert.Equal(t, 2, val)
+
+	val, found = store.Get(Key{"session", "0xa"})
+	assert.True(t, found, "Ensuring keys can hold values alongside nested keys")
+	assert.Equal(t, "parent", val)
+
+	_, found = store.Get(Key{"session"})
+	assert.False(t, found, "Ensuring intermediate keys don't hold values")
+
+	assert.Equal(t, []string{"0xa", "0xb"}, store.Children(Key{"session"}))
+	assert.Equal(t, []string{"ETH", "USDC"}, store.Children(Key{"session", "0xa"}))
+	assert.Empty(t, store.Children(Key{"missing"}))
+
+	store.Delete(Key{"session", "0xa"})
+	_, found = store.Get(Key{"session", "0xa", "ETH"})
+	assert.False(t, found, "Ensuring nested keys are deleted with their parent")
+	assert.Equal(t, []string{"0xb"}, store.Children(Key{"session"}))
+
+	store.Delete(Key{})
+	assert.Empty(t, store.Children(Key{}))
+}
+
+func Test_Scoped(t *testing.T) {
+	store := NewMemoryStore()
+	first, second := Scoped(store, "first"), Scoped(store, "second")
+
+	first.Set(Key{"0xa"}, 1)
+	second.Set(Key{"0xa"}, 2)
+
+	val, _ := first.Get(Key{"0xa"})
+	assert.Equal(t, 1, val)
+
+	val, _ = store.Get(Key{"second", "0xa"})
+	assert.Equal(t, 2, val, "Ensuring scoped keys are nested under the prefix")
+
+	first.Delete(Key{})
+	assert.Equal(t, []string{"second"}, store.Children(Key{}), "Ensuring scopes can be cleared")
+}
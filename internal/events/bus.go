@@ -0,0 +1,77 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Topic ... Category of an event
+type Topic string
+
+// Event ... Message broadcast to all bus subscribers
+type Event struct {
+	Topic     Topic
+	Timestamp time.Time
+	Payload   any
+}
+
+// Subscription ... Receives events published to the bus until unsubscribed
+type Subscription struct {
+	ID     int
+	Events <-chan Event
+}
+
+// Bus ... In-process publish/subscribe event bus; publishing never blocks and events are
+// dropped for subscribers whose buffer is full
+type Bus struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]chan Event
+}
+
+// NewBus ... Initializer
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[int]chan Event)}
+}
+
+// Subscribe ... Registers a new subscriber that can buffer up to size events
+func (b *Bus) Subscribe(size int) Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	ch := make(chan Event, size)
+	b.subscribers[b.nextID] = ch
+
+	return Subscription{ID: b.nextID, Events: ch}
+}
+
+// Unsubscribe ... Removes the subscriber and closes its channel; no-op for unknown IDs
+func (b *Bus) Unsubscribe(id int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if ch, found := b.subscribers[id]; found {
+		close(ch)
+		delete(b.subscribers, id)
+	}
+}
+
+// Publish ... Broadcasts the event to every subscriber
+func (b *Bus) Publish(topic Topic, payload any) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	event := Event{
+		Topic:     topic,
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
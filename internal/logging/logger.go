@@ -13,6 +13,9 @@ const loggerKey loggerKeyType = iota
 
 var logger *zap.Logger
 
+// level ... Minimum level logged; shared by every logger so that it can be changed at runtime
+var level = zap.NewAtomicLevel()
+
 // Config ... Configuration passed through to the logging constructor
 type Config struct {
 	UseCustom         bool
@@ -46,6 +49,9 @@ func NewLogger(cfg *Config, isProduction bool) {
 		// InitialFields not defined in cfg
 	}
 
+	level.SetLevel(zapCfg.Level.Level())
+	zapCfg.Level = level
+
 	zapCfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	zapCfg.EncoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
 	zapCfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
@@ -57,6 +63,16 @@ func NewLogger(cfg *Config, isProduction bool) {
 	}
 }
 
+// Level ... Returns the minimum level logged
+func Level() zapcore.Level {
+	return level.Level()
+}
+
+// SetLevel ... Sets the minimum level logged by every logger, including those already created
+func SetLevel(l zapcore.Level) {
+	level.SetLevel(l)
+}
+
 // NewContext ... A helper for middleware to create requestId or other context fields
 // and return a context which logger can understand.
 func NewContext(ctx context.Context, fields ...zap.Field) context.Context {
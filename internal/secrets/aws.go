@@ -0,0 +1,111 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/sigv4"
+)
+
+const (
+	// awsRegionEnv ... Environment variable holding the region of secrets whose reference has none
+	awsRegionEnv = "AWS_REGION"
+	// awsContentType ... Content type of the Secrets Manager JSON API
+	awsContentType = "application/x-amz-json-1.1"
+)
+
+// awsProvider ... Reads secrets from AWS Secrets Manager; the region is taken from the secret's ARN, the
+// region option or the AWS_REGION env var. Options: region, version_stage
+type awsProvider struct {
+	signer *sigv4.Signer
+	// Optional; regional endpoints are used when empty
+	endpoint string
+	client   *http.Client
+}
+
+// newAWSProvider ... Initializer; credentials are resolved through the default AWS credential chain
+func newAWSProvider(ctx context.Context) (*awsProvider, error) {
+	signer, err := sigv4.NewSigner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awsProvider{signer: signer, client: newHTTPClient()}, nil
+}
+
+// region ... Returns the region holding the referenced secret
+func (ap *awsProvider) region(ref Reference) (string, error) {
+	if region := ref.Options.Get("region"); region != "" {
+		return region, nil
+	}
+
+	// arn:<partition>:secretsmanager:<region>:<account>:secret:<name>
+	if parts := strings.Split(ref.Path, ":"); len(parts) > 3 && parts[0] == "arn" {
+		return parts[3], nil
+	}
+
+	if region := os.Getenv(awsRegionEnv); region != "" {
+		return region, nil
+	}
+
+	return "", fmt.Errorf("no region; set the region option or %s", awsRegionEnv)
+}
+
+// Secret ... Returns the secret string of the referenced secret
+func (ap *awsProvider) Secret(ctx context.Context, ref Reference) ([]byte, error) {
+	region, err := ap.region(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ap.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", region)
+	}
+
+	input := map[string]string{"SecretId": ref.Path}
+	if stage := ref.Options.Get("version_stage"); stage != "" {
+		input["VersionStage"] = stage
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := ap.signer.Sign(ctx, region, "secretsmanager", endpoint, body, awsContentType, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", awsContentType)
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	var out struct {
+		SecretString *string `json:"SecretString"`
+	}
+	if dErr := do(ap.client, req, &out); dErr != nil {
+		return nil, dErr
+	}
+
+	if out.SecretString == nil {
+		return nil, errors.New("binary secrets aren't supported")
+	}
+
+	return []byte(*out.SecretString), nil
+}
@@ -0,0 +1,97 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	// gcpTokenEnv ... Environment variable holding an OAuth access token; the metadata server is used when unset
+	gcpTokenEnv = "GOOGLE_OAUTH_ACCESS_TOKEN"
+
+	gcpEndpoint      = "https://secretmanager.googleapis.com"
+	gcpMetadataToken = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// gcpProvider ... Reads secrets from GCP Secret Manager; paths are resource names
+// (projects/<project>/secrets/<secret>[/versions/<version>]) and the latest version is read when omitted
+type gcpProvider struct {
+	endpoint string
+	// Used to fetch access tokens when no token is set
+	metadataURL string
+	token       string
+	client      *http.Client
+}
+
+// newGCPProvider ... Initializer; the access token is read from the environment or, on GCP, the metadata
+// server
+func newGCPProvider() *gcpProvider {
+	return &gcpProvider{
+		endpoint:    gcpEndpoint,
+		metadataURL: gcpMetadataToken,
+		token:       os.Getenv(gcpTokenEnv),
+		client:      newHTTPClient(),
+	}
+}
+
+// accessToken ... Returns the configured token or one issued to the instance's service account
+func (gp *gcpProvider) accessToken(ctx context.Context) (string, error) {
+	if gp.token != "" {
+		return gp.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gp.metadataURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	var out struct {
+		AccessToken string `json:"access_token"`
+	}
+	if dErr := do(gp.client, req, &out); dErr != nil {
+		return "", fmt.Errorf("could not fetch an access token; set %s outside of GCP: %w", gcpTokenEnv, dErr)
+	}
+
+	return out.AccessToken, nil
+}
+
+// Secret ... Returns the payload of the referenced secret version
+func (gp *gcpProvider) Secret(ctx context.Context, ref Reference) ([]byte, error) {
+	parts := strings.Split(ref.Path, "/")
+	if len(parts) != 4 && len(parts) != 6 || parts[0] != "projects" || parts[2] != "secrets" {
+		return nil, errors.New("path must be projects/<project>/secrets/<secret>[/versions/<version>]")
+	}
+
+	name := ref.Path
+	if len(parts) == 4 {
+		name += "/versions/latest"
+	}
+
+	token, err := gp.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s:access", gp.endpoint, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var out struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if dErr := do(gp.client, req, &out); dErr != nil {
+		return nil, dErr
+	}
+
+	return base64.StdEncoding.DecodeString(out.Payload.Data)
+}
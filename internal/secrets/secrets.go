@@ -0,0 +1,234 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Schemes of secret references; <scheme>://<path>[?<options>][#<key>]
+	AWSScheme   = "aws-sm"
+	GCPScheme   = "gcp-sm"
+	VaultScheme = "vault"
+
+	// requestTimeout ... Upper bound on reading a single secret
+	requestTimeout = 10 * time.Second
+)
+
+// Reference ... Location of a secret held by a secrets manager
+type Reference struct {
+	Scheme string
+	// Provider specific path of the secret (E.G, a secret ID, resource name or KV path)
+	Path string
+	// Provider specific options (E.G, region=us-east-1)
+	Options url.Values
+	// Optional; field of a JSON object secret to use rather than the whole secret
+	Key string
+}
+
+// String ... Returns the reference as written in config files
+func (ref Reference) String() string {
+	s := ref.Scheme + "://" + ref.Path
+	if len(ref.Options) > 0 {
+		s += "?" + ref.Options.Encode()
+	}
+
+	if ref.Key != "" {
+		s += "#" + ref.Key
+	}
+
+	return s
+}
+
+// ParseReference ... Parses a secret reference; false for values that aren't references. Paths aren't
+// parsed as URLs since secret IDs can contain colons (E.G, AWS ARNs)
+func ParseReference(value string) (Reference, bool, error) {
+	scheme, rest, found := strings.Cut(value, "://")
+	if !found {
+		return Reference{}, false, nil
+	}
+
+	switch scheme {
+	case AWSScheme, GCPScheme, VaultScheme:
+	default:
+		return Reference{}, false, nil
+	}
+
+	ref := Reference{Scheme: scheme}
+	rest, ref.Key, _ = strings.Cut(rest, "#")
+	rest, rawOptions, _ := strings.Cut(rest, "?")
+
+	options, err := url.ParseQuery(rawOptions)
+	if err != nil {
+		return Reference{}, true, fmt.Errorf("invalid options of secret reference %s: %w", value, err)
+	}
+
+	ref.Path, ref.Options = strings.Trim(rest, "/"), options
+	if ref.Path == "" {
+		return Reference{}, true, fmt.Errorf("secret reference %s has no path", value)
+	}
+
+	return ref, true, nil
+}
+
+// Provider ... Reads secrets from a secrets manager
+type Provider interface {
+	// Secret ... Returns the raw secret at the reference's path
+	Secret(ctx context.Context, ref Reference) ([]byte, error)
+}
+
+// Option ... Resolver configuration
+type Option = func(*Resolver)
+
+// WithProvider ... Reads references of the scheme using the provider rather than the default provider
+func WithProvider(scheme string, p Provider) Option {
+	return func(r *Resolver) {
+		r.providers[scheme] = p
+	}
+}
+
+// Resolver ... Replaces secret references with the secrets they reference
+type Resolver struct {
+	mu        sync.Mutex
+	providers map[string]Provider
+}
+
+// NewResolver ... Initializer; default providers are configured from the environment once first used so
+// that their settings are only required when referenced
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{providers: make(map[string]Provider)}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// provider ... Returns the provider of the scheme, constructing the default provider if unset
+func (r *Resolver) provider(ctx context.Context, scheme string) (Provider, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if p, found := r.providers[scheme]; found {
+		return p, nil
+	}
+
+	var (
+		p   Provider
+		err error
+	)
+
+	switch scheme {
+	case AWSScheme:
+		p, err = newAWSProvider(ctx)
+	case GCPScheme:
+		p = newGCPProvider()
+	case VaultScheme:
+		p, err = newVaultProvider()
+	default:
+		err = fmt.Errorf("unknown secret scheme: %s", scheme)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not configure %s secrets: %w", scheme, err)
+	}
+
+	r.providers[scheme] = p
+	return p, nil
+}
+
+// Resolve ... Returns the secret referenced by the value; values that aren't references are returned as is
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	ref, isRef, err := ParseReference(value)
+	if !isRef || err != nil {
+		return value, err
+	}
+
+	p, err := r.provider(ctx, ref.Scheme)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	raw, err := p.Secret(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("could not read secret %s: %w", ref, err)
+	}
+
+	if ref.Key == "" {
+		return string(raw), nil
+	}
+
+	return field(raw, ref)
+}
+
+// ResolveAll ... Replaces every referencing value in place; fail with the first unresolvable reference
+func (r *Resolver) ResolveAll(ctx context.Context, values ...*string) error {
+	for _, value := range values {
+		resolved, err := r.Resolve(ctx, *value)
+		if err != nil {
+			return err
+		}
+
+		*value = resolved
+	}
+
+	return nil
+}
+
+// defaultResolver ... Resolver of ResolveAll; uses the default providers
+var defaultResolver = NewResolver()
+
+// ResolveAll ... Replaces every referencing value in place using the default providers; fail with the
+// first unresolvable reference
+func ResolveAll(ctx context.Context, values ...*string) error {
+	return defaultResolver.ResolveAll(ctx, values...)
+}
+
+// field ... Returns the referenced field of a JSON object secret; non-string fields are returned as JSON
+func field(raw []byte, ref Reference) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object: %w", ref, err)
+	}
+
+	value, found := fields[ref.Key]
+	if !found {
+		return "", fmt.Errorf("secret %s has no %s field", ref, ref.Key)
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return string(value), nil
+	}
+
+	return s, nil
+}
+
+// newHTTPClient ... HTTP client used by the default providers
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: requestTimeout}
+}
+
+// do ... Sends the request & decodes the JSON response into the value; fail on non-2xx responses
+func do(client *http.Client, req *http.Request, v any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,178 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/sigv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseReference(t *testing.T) {
+	var tests = []struct {
+		name        string
+		description string
+
+		value    string
+		isRef    bool
+		err      bool
+		expected Reference
+	}{
+		{
+			name:        "Plain Value",
+			description: "Values without a secret scheme shouldn't be references",
+			value:       "https://l1:8545",
+		},
+		{
+			name:        "AWS ARN",
+			description: "Paths should keep their colons & options & keys should be split off",
+			value:       "aws-sm://arn:aws:secretsmanager:us-east-1:123:secret:rpc?version_stage=AWSPREVIOUS#url",
+			isRef:       true,
+			expected: Reference{Scheme: AWSScheme, Path: "arn:aws:secretsmanager:us-east-1:123:secret:rpc",
+				Options: url.Values{"version_stage": {"AWSPREVIOUS"}}, Key: "url"},
+		},
+		{
+			name:        "Vault Path",
+			description: "Paths shouldn't require options",
+			value:       "vault://secret/data/pessimism#token",
+			isRef:       true,
+			expected: Reference{Scheme: VaultScheme, Path: "secret/data/pessimism", Options: url.Values{},
+				Key: "token"},
+		},
+		{
+			name:        "Empty Path",
+			description: "References without a path should be rejected",
+			value:       "gcp-sm://#key",
+			isRef:       true,
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			ref, isRef, err := ParseReference(tc.value)
+			assert.Equal(t, tc.isRef, isRef)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+		})
+	}
+}
+
+func Test_Resolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("X-Amz-Target") == "secretsmanager.GetSecretValue":
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"),
+				"Ensuring AWS requests are signed")
+			assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/secretsmanager/aws4_request")
+
+			var in map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+			_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": `{"url":"https://aws:8545"}`})
+
+		case strings.HasPrefix(r.URL.Path, "/v1/projects/"):
+			assert.Equal(t, "Bearer gcp-token", r.Header.Get("Authorization"))
+			assert.Equal(t, "/v1/projects/p/secrets/rpc/versions/latest:access", r.URL.Path,
+				"Ensuring the latest version is read by default")
+
+			payload := base64.StdEncoding.EncodeToString([]byte("https://gcp:8545"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"payload": map[string]string{"data": payload}})
+
+		case r.URL.Path == "/v1/secret/data/pessimism":
+			assert.Equal(t, "vault-token", r.Header.Get("X-Vault-Token"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"data":     map[string]any{"token": "vault-secret", "port": 8545},
+				"metadata": map[string]any{"version": 1},
+			}})
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewResolver(
+		WithProvider(AWSScheme, &awsProvider{signer: sigv4.NewStaticSigner("AKID", "secret", ""),
+			endpoint: server.URL, client: server.Client()}),
+		WithProvider(GCPScheme, &gcpProvider{endpoint: server.URL, token: "gcp-token", client: server.Client()}),
+		WithProvider(VaultScheme, &vaultProvider{addr: server.URL, token: "vault-token", client: server.Client()}),
+	)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		value    string
+		expected string
+		err      bool
+	}{
+		{
+			name:        "Plain Value",
+			description: "Values that aren't references should be returned as is",
+			value:       "https://l1:8545",
+			expected:    "https://l1:8545",
+		},
+		{
+			name:        "AWS",
+			description: "Fields of AWS secrets should be read",
+			value:       "aws-sm://rpc?region=us-east-1#url",
+			expected:    "https://aws:8545",
+		},
+		{
+			name:        "GCP",
+			description: "Whole GCP secrets should be read",
+			value:       "gcp-sm://projects/p/secrets/rpc",
+			expected:    "https://gcp:8545",
+		},
+		{
+			name:        "Vault",
+			description: "Fields of KV version 2 secrets should be read",
+			value:       "vault://secret/data/pessimism#token",
+			expected:    "vault-secret",
+		},
+		{
+			name:        "Non-string Field",
+			description: "Non-string fields should be returned as JSON",
+			value:       "vault://secret/data/pessimism#port",
+			expected:    "8545",
+		},
+		{
+			name:        "Missing Field",
+			description: "References to missing fields should fail",
+			value:       "vault://secret/data/pessimism#password",
+			err:         true,
+		},
+		{
+			name:        "Missing Secret",
+			description: "References to missing secrets should fail",
+			value:       "vault://secret/data/unknown#token",
+			err:         true,
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			value := tc.value
+			err := resolver.ResolveAll(context.Background(), &value)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	// Environment variables holding the Vault server address & token
+	vaultAddrEnv  = "VAULT_ADDR"
+	vaultTokenEnv = "VAULT_TOKEN"
+	// vaultNamespaceEnv ... Optional; Vault Enterprise namespace
+	vaultNamespaceEnv = "VAULT_NAMESPACE"
+)
+
+// vaultProvider ... Reads secrets from Vault KV secrets engines; paths are API paths below /v1
+// (E.G, secret/data/pessimism for KV version 2) and references must select a field of the secret
+type vaultProvider struct {
+	addr      string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+// newVaultProvider ... Initializer; the address & token are read from the environment
+func newVaultProvider() (*vaultProvider, error) {
+	vp := &vaultProvider{
+		addr:      strings.TrimSuffix(os.Getenv(vaultAddrEnv), "/"),
+		token:     os.Getenv(vaultTokenEnv),
+		namespace: os.Getenv(vaultNamespaceEnv),
+		client:    newHTTPClient(),
+	}
+
+	if vp.addr == "" || vp.token == "" {
+		return nil, fmt.Errorf("%s & %s must be set", vaultAddrEnv, vaultTokenEnv)
+	}
+
+	return vp, nil
+}
+
+// Secret ... Returns the fields of the referenced secret as a JSON object; KV version 2 secrets are
+// unwrapped from their metadata
+func (vp *vaultProvider) Secret(ctx context.Context, ref Reference) ([]byte, error) {
+	if ref.Key == "" {
+		return nil, errors.New("vault references must select a field (E.G, vault://secret/data/pessimism#token)")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s", vp.addr, ref.Path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Vault-Token", vp.token)
+	if vp.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", vp.namespace)
+	}
+
+	var out struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if dErr := do(vp.client, req, &out); dErr != nil {
+		return nil, dErr
+	}
+
+	// KV version 2 nests the secret's fields under data alongside its metadata
+	if nested, found := out.Data["data"]; found {
+		if _, versioned := out.Data["metadata"]; versioned {
+			return nested, nil
+		}
+	}
+
+	return json.Marshal(out.Data)
+}
@@ -0,0 +1,93 @@
+package sigv4
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sign(t *testing.T) {
+	// Vectors from the AWS signature version 4 test suite; every vector is signed at the same time with
+	// the suite's credentials
+	signer := NewStaticSigner("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "")
+	at := time.Date(2015, time.August, 30, 12, 36, 0, 0, time.UTC)
+
+	var tests = []struct {
+		name        string
+		description string
+
+		contentType string
+		body        string
+
+		signedHeaders string
+		signature     string
+	}{
+		{
+			name:          "post-x-www-form-urlencoded",
+			description:   "Form encoded bodies should be signed along with their content type",
+			contentType:   "application/x-www-form-urlencoded",
+			body:          "Param1=value1",
+			signedHeaders: "content-type;host;x-amz-date",
+			signature:     "ff11897932ad3f4e8b18135d722051e5ac45fc38421b1da7b9d196a0fe09473a",
+		},
+		{
+			name:          "post-x-www-form-urlencoded-parameters",
+			description:   "Content type parameters should be included in the signed content type",
+			contentType:   "application/x-www-form-urlencoded; charset=utf8",
+			body:          "Param1=value1",
+			signedHeaders: "content-type;host;x-amz-date",
+			signature:     "1a72ec8f64bd914b0e42e42607c7fbce7fb2c7465f63e3092b3b0d39fa77a6fe",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			headers, err := signer.Sign(context.Background(), "us-east-1", "service",
+				"https://example.amazonaws.com/", []byte(tc.body), tc.contentType, at)
+			assert.NoError(t, err)
+
+			assert.Equal(t, "20150830T123600Z", headers["X-Amz-Date"])
+			assert.Equal(t, fmt.Sprintf("AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+				"SignedHeaders=%s, Signature=%s", tc.signedHeaders, tc.signature), headers["Authorization"])
+			assert.NotContains(t, headers, "X-Amz-Security-Token")
+		})
+	}
+}
+
+func Test_Sign_SessionToken(t *testing.T) {
+	signer := NewStaticSigner("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "token")
+
+	headers, err := signer.Sign(context.Background(), "us-east-1", "sns", "https://sns.us-east-1.amazonaws.com/",
+		nil, "application/x-www-form-urlencoded", time.Now())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "token", headers["X-Amz-Security-Token"])
+	assert.Contains(t, headers["Authorization"], "x-amz-security-token", "Ensuring the session token is signed")
+}
+
+func Test_NewSigner(t *testing.T) {
+	// Isolates the default chain from the host's shared config & instance metadata
+	t.Setenv("AWS_CONFIG_FILE", t.TempDir()+"/config")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", t.TempDir()+"/credentials")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	_, err := NewSigner(context.Background())
+	assert.Error(t, err, "Ensuring missing credentials are detected")
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	signer, err := NewSigner(context.Background())
+	assert.NoError(t, err)
+
+	headers, err := signer.Sign(context.Background(), "us-east-1", "sns", "https://sns.us-east-1.amazonaws.com/",
+		nil, "application/x-www-form-urlencoded", time.Now())
+	assert.NoError(t, err)
+	assert.Contains(t, headers["Authorization"], "Credential=AKID/")
+}
@@ -0,0 +1,147 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+// Type ... Identifies a sink implementation
+type Type string
+
+const (
+	// LogSink ... Logs every piece of transit data
+	LogSink Type = "log"
+	// FileSink ... Appends every piece of transit data to a file as a JSON line
+	FileSink Type = "file"
+)
+
+// Config ... Configuration used to construct a sink
+type Config struct {
+	Type Type `json:"type"`
+	// File appended to by file sinks
+	Path string `json:"path,omitempty"`
+}
+
+// Validate ... Ensures the config describes a constructable sink
+func (cfg Config) Validate() error {
+	switch cfg.Type {
+	case LogSink:
+		return nil
+
+	case FileSink:
+		if cfg.Path == "" {
+			return fmt.Errorf("%s sink requires a path", cfg.Type)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown sink type: %s", cfg.Type)
+	}
+}
+
+// Sink ... Destination that a pipeline's output is written to
+type Sink interface {
+	Write(td models.TransitData) error
+	Close() error
+}
+
+// New ... Constructs the sink described by the config; name identifies the writing pipeline
+func New(name string, cfg Config) (Sink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case FileSink:
+		return newFileSink(name, cfg.Path)
+
+	case LogSink:
+		fallthrough
+	default:
+		return &logSink{name: name}, nil
+	}
+}
+
+// logSink ... Logs every piece of transit data using the application logger
+type logSink struct {
+	name string
+}
+
+// Write ... Logs the transit data
+func (ls *logSink) Write(td models.TransitData) error {
+	// Logger is unset when running outside of the application
+	if log := logging.NoContext(); log != nil {
+		log.Info("Received pipeline output", zap.String("pipeline", ls.name),
+			zap.String("type", string(td.Type)), zap.Any("transitData", td))
+	}
+
+	return nil
+}
+
+// Close ... No-op
+func (ls *logSink) Close() error {
+	return nil
+}
+
+// fileRecord ... Line written by file sinks
+type fileRecord struct {
+	Pipeline string              `json:"pipeline"`
+	Type     models.RegisterType `json:"type"`
+	OriginID string              `json:"origin_id"`
+	Height   string              `json:"height,omitempty"`
+	Sequence uint64              `json:"sequence"`
+	Value    any                 `json:"value"`
+}
+
+// fileSink ... Appends every piece of transit data to a file as a JSON line; every line is written
+// using a single append so that pipelines can share a file
+type fileSink struct {
+	mu   sync.Mutex
+	name string
+	file *os.File
+	enc  *json.Encoder
+}
+
+// newFileSink ... Opens the file for appending, creating it if necessary
+func newFileSink(name, path string) (*fileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{name: name, file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Write ... Appends the transit data
+func (fs *fileSink) Write(td models.TransitData) error {
+	record := fileRecord{
+		Pipeline: fs.name,
+		Type:     td.Type,
+		OriginID: td.OriginID.String(),
+		Sequence: td.Sequence,
+		Value:    td.Value,
+	}
+
+	if td.Height != nil {
+		record.Height = td.Height.String()
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.enc.Encode(record)
+}
+
+// Close ... Closes the file
+func (fs *fileSink) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.file.Close()
+}
@@ -0,0 +1,51 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/conduit/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+
+	first, err := New("first", Config{Type: FileSink, Path: path})
+	assert.NoError(t, err)
+	second, err := New("second", Config{Type: FileSink, Path: path})
+	assert.NoError(t, err)
+
+	assert.NoError(t, first.Write(models.TransitData{Type: "TEST", Value: 1, Height: big.NewInt(7), Sequence: 1}))
+	assert.NoError(t, second.Write(models.TransitData{Type: "TEST", Value: "two", Sequence: 2}))
+	assert.NoError(t, first.Close())
+	assert.NoError(t, second.Close())
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	records := make([]map[string]any, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := make(map[string]any)
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	assert.Len(t, records, 2, "Ensuring sinks sharing a file both append")
+	assert.Equal(t, "first", records[0]["pipeline"])
+	assert.Equal(t, "7", records[0]["height"])
+	assert.Equal(t, "two", records[1]["value"])
+	assert.NotContains(t, records[1], "height")
+}
+
+func Test_Config_Validate(t *testing.T) {
+	assert.NoError(t, Config{Type: LogSink}.Validate())
+	assert.Error(t, Config{Type: FileSink}.Validate(), "Ensuring file sinks require a path")
+	assert.Error(t, Config{Type: "kafka"}.Validate(), "Ensuring unknown sinks are rejected")
+}